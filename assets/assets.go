@@ -0,0 +1,28 @@
+// Package assets はバイナリへ埋め込むマイグレーション・シード SQL を提供します。
+package assets
+
+import "embed"
+
+// Migrations は assets/migrations 配下のマイグレーション SQL です。
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// Seeds は assets/seeds 配下のシード SQL です。
+//
+//go:embed seeds/*.sql
+var Seeds embed.FS
+
+// SQLiteMigrations は assets/sqlite/migrations 配下の SQLite 用マイグレーション SQL です。
+//
+//go:embed sqlite/migrations/*.sql
+var SQLiteMigrations embed.FS
+
+const (
+	// MigrationsDir は Migrations 内のマイグレーションディレクトリです。
+	MigrationsDir = "migrations"
+	// SeedsDir は Seeds 内のシードディレクトリです。
+	SeedsDir = "seeds"
+	// SQLiteMigrationsDir は SQLiteMigrations 内のマイグレーションディレクトリです。
+	SQLiteMigrationsDir = "sqlite/migrations"
+)
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_companies_parent_company_id;
+
+ALTER TABLE companies
+    DROP CONSTRAINT IF EXISTS companies_parent_not_self;
+
+ALTER TABLE companies
+    DROP CONSTRAINT IF EXISTS companies_parent_company_id_fkey;
+
+ALTER TABLE companies
+    DROP COLUMN IF EXISTS parent_company_id;
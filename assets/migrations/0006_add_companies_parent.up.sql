@@ -0,0 +1,11 @@
+ALTER TABLE companies
+    ADD COLUMN IF NOT EXISTS parent_company_id UUID;
+
+ALTER TABLE companies
+    ADD CONSTRAINT companies_parent_company_id_fkey
+        FOREIGN KEY (parent_company_id) REFERENCES companies(id) ON DELETE RESTRICT;
+
+ALTER TABLE companies
+    ADD CONSTRAINT companies_parent_not_self CHECK (parent_company_id IS NULL OR parent_company_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_companies_parent_company_id ON companies (parent_company_id);
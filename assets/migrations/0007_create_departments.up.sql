@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS departments (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    parent_department_id UUID,
+    name TEXT NOT NULL,
+    code TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT departments_company_code_unique UNIQUE (company_id, code),
+    CONSTRAINT departments_company_id_id_unique UNIQUE (company_id, id),
+    CONSTRAINT departments_parent_department_fkey
+        FOREIGN KEY (company_id, parent_department_id) REFERENCES departments (company_id, id),
+    CONSTRAINT departments_parent_not_self CHECK (parent_department_id IS NULL OR parent_department_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_departments_parent_department_id ON departments (parent_department_id);
+
+ALTER TABLE employees
+    ADD COLUMN IF NOT EXISTS department_id UUID;
+
+ALTER TABLE employees
+    ADD CONSTRAINT employees_department_fkey
+        FOREIGN KEY (company_id, department_id) REFERENCES departments (company_id, id);
+
+CREATE INDEX IF NOT EXISTS idx_employees_department_id ON employees (department_id);
@@ -0,0 +1,13 @@
+DROP INDEX IF EXISTS idx_employees_manager_employee_id;
+
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_manager_not_self;
+
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_manager_fkey;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS manager_employee_id;
+
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_company_id_id_unique;
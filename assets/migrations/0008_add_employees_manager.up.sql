@@ -0,0 +1,14 @@
+ALTER TABLE employees
+    ADD CONSTRAINT employees_company_id_id_unique UNIQUE (company_id, id);
+
+ALTER TABLE employees
+    ADD COLUMN IF NOT EXISTS manager_employee_id UUID;
+
+ALTER TABLE employees
+    ADD CONSTRAINT employees_manager_fkey
+        FOREIGN KEY (company_id, manager_employee_id) REFERENCES employees (company_id, id);
+
+ALTER TABLE employees
+    ADD CONSTRAINT employees_manager_not_self CHECK (manager_employee_id IS NULL OR manager_employee_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_employees_manager_employee_id ON employees (manager_employee_id);
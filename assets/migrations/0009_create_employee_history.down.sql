@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_employee_history_employee_id_effective_from;
+DROP INDEX IF EXISTS idx_employee_history_open;
+DROP TABLE IF EXISTS employee_history;
@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS employee_history (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    employee_id UUID NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    company_id UUID NOT NULL,
+    employee_code TEXT NOT NULL,
+    user_id UUID NOT NULL,
+    department_id UUID,
+    manager_employee_id UUID,
+    status TEXT NOT NULL,
+    hired_at DATE,
+    terminated_at DATE,
+    effective_from DATE NOT NULL,
+    effective_to DATE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT employee_history_effective_range CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_employee_history_open ON employee_history (employee_id) WHERE effective_to IS NULL;
+CREATE INDEX IF NOT EXISTS idx_employee_history_employee_id_effective_from ON employee_history (employee_id, effective_from DESC);
+
+-- 既存の社員は現在の内容を作成日から有効な履歴として登録します。
+INSERT INTO employee_history (employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, effective_from, created_at)
+SELECT id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, (created_at AT TIME ZONE 'UTC')::date, updated_at
+  FROM employees;
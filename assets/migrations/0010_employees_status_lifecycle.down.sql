@@ -0,0 +1,8 @@
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_status_valid;
+
+UPDATE employees SET status = 'inactive' WHERE status = 'terminated';
+UPDATE employees SET status = 'active' WHERE status IN ('pending', 'on_leave');
+
+UPDATE employee_history SET status = 'inactive' WHERE status = 'terminated';
+UPDATE employee_history SET status = 'active' WHERE status IN ('pending', 'on_leave');
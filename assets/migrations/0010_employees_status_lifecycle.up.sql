@@ -0,0 +1,34 @@
+-- 旧 inactive は terminated へ移行し、退職日が未設定の場合は最終更新日を退職日とします。
+-- 入社日が最終更新日より後の場合は employees_terminated_after_hired を満たすよう入社日を退職日とします。
+UPDATE employees
+   SET status = 'terminated',
+       terminated_at = COALESCE(terminated_at, GREATEST(updated_at::date, hired_at))
+ WHERE status = 'inactive';
+
+UPDATE employee_history
+   SET status = 'terminated',
+       terminated_at = COALESCE(terminated_at, GREATEST(effective_from, hired_at))
+ WHERE status = 'inactive';
+
+-- 日付と矛盾する状態を日付に合わせます。
+UPDATE employees
+   SET status = 'terminated'
+ WHERE status <> 'terminated'
+   AND terminated_at IS NOT NULL
+   AND terminated_at <= CURRENT_DATE;
+
+UPDATE employees
+   SET status = 'pending'
+ WHERE status = 'active'
+   AND hired_at > CURRENT_DATE;
+
+-- 現在有効な履歴は社員と同じ内容にし、as_of を指定した参照と GetEmployee の結果を揃えます。
+UPDATE employee_history AS h
+   SET status = e.status,
+       terminated_at = e.terminated_at
+  FROM employees AS e
+ WHERE h.employee_id = e.id
+   AND h.effective_to IS NULL;
+
+ALTER TABLE employees
+    ADD CONSTRAINT employees_status_valid CHECK (status IN ('pending', 'active', 'on_leave', 'terminated'));
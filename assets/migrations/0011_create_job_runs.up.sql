@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS job_runs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_name TEXT NOT NULL,
+    status TEXT NOT NULL,
+    scheduled_at TIMESTAMPTZ NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    finished_at TIMESTAMPTZ,
+    error TEXT,
+    -- 同じ予定時刻の実行はリーダーが交代した場合でも 1 回に限ります。
+    CONSTRAINT job_runs_job_name_scheduled_at_key UNIQUE (job_name, scheduled_at)
+);
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_employees_transferred_from_employee_id;
+
+ALTER TABLE employees
+    DROP CONSTRAINT IF EXISTS employees_transferred_from_fkey;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS transferred_from_employee_id;
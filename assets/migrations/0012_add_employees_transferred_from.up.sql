@@ -0,0 +1,8 @@
+ALTER TABLE employees
+    ADD COLUMN IF NOT EXISTS transferred_from_employee_id UUID;
+
+ALTER TABLE employees
+    ADD CONSTRAINT employees_transferred_from_fkey
+        FOREIGN KEY (transferred_from_employee_id) REFERENCES employees (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_transferred_from_employee_id ON employees (transferred_from_employee_id);
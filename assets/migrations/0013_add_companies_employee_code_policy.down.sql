@@ -0,0 +1,7 @@
+ALTER TABLE companies
+    DROP CONSTRAINT IF EXISTS companies_employee_code_policy_check;
+
+ALTER TABLE companies
+    DROP COLUMN IF EXISTS employee_code_next_sequence,
+    DROP COLUMN IF EXISTS employee_code_width,
+    DROP COLUMN IF EXISTS employee_code_prefix;
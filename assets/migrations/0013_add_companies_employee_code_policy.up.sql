@@ -0,0 +1,8 @@
+ALTER TABLE companies
+    ADD COLUMN IF NOT EXISTS employee_code_prefix TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS employee_code_width INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS employee_code_next_sequence BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE companies
+    ADD CONSTRAINT companies_employee_code_policy_check
+        CHECK (employee_code_width BETWEEN 0 AND 18 AND employee_code_next_sequence >= 1);
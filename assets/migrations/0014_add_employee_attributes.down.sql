@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_employees_attributes;
+
+ALTER TABLE employees
+    DROP COLUMN IF EXISTS attributes;
+
+DROP TABLE IF EXISTS company_employee_attributes;
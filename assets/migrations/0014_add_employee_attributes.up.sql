@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS company_employee_attributes (
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    type TEXT NOT NULL,
+    required BOOLEAN NOT NULL DEFAULT FALSE,
+    allowed_values JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (company_id, key),
+    CONSTRAINT company_employee_attributes_type_check CHECK (type IN ('string', 'number', 'date', 'enum'))
+);
+
+ALTER TABLE employees
+    ADD COLUMN IF NOT EXISTS attributes JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+CREATE INDEX IF NOT EXISTS idx_employees_attributes ON employees USING GIN (attributes jsonb_path_ops);
@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_users_labels;
+DROP INDEX IF EXISTS idx_companies_labels;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS labels;
+
+ALTER TABLE companies
+    DROP COLUMN IF EXISTS labels;
@@ -0,0 +1,9 @@
+ALTER TABLE companies
+    ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS labels JSONB NOT NULL DEFAULT '{}'::jsonb;
+
+-- ラベルセレクタの一致 (@>) と存在 (?) の両方に利用するため、既定の jsonb_ops で作成します。
+CREATE INDEX IF NOT EXISTS idx_companies_labels ON companies USING GIN (labels);
+CREATE INDEX IF NOT EXISTS idx_users_labels ON users USING GIN (labels);
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS company_phones;
+
+DROP TABLE IF EXISTS company_addresses;
+
+ALTER TABLE companies
+    DROP COLUMN IF EXISTS website;
@@ -0,0 +1,25 @@
+ALTER TABLE companies
+    ADD COLUMN IF NOT EXISTS website TEXT;
+
+CREATE TABLE IF NOT EXISTS company_addresses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    country TEXT NOT NULL,
+    postal_code TEXT NOT NULL DEFAULT '',
+    lines TEXT[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT company_addresses_type_check CHECK (type IN ('billing', 'registered', 'office')),
+    CONSTRAINT company_addresses_country_check CHECK (country ~ '^[A-Z]{2}$')
+);
+
+CREATE INDEX IF NOT EXISTS idx_company_addresses_company_id ON company_addresses (company_id, created_at);
+
+CREATE TABLE IF NOT EXISTS company_phones (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    company_id UUID NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    number TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT company_phones_company_number_unique UNIQUE (company_id, number),
+    CONSTRAINT company_phones_number_check CHECK (number ~ '^\+[1-9][0-9]{1,14}$')
+);
@@ -0,0 +1,11 @@
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_phone_check;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS avatar_url,
+    DROP COLUMN IF EXISTS phone,
+    DROP COLUMN IF EXISTS time_zone,
+    DROP COLUMN IF EXISTS locale,
+    DROP COLUMN IF EXISTS display_name,
+    DROP COLUMN IF EXISTS given_name,
+    DROP COLUMN IF EXISTS family_name;
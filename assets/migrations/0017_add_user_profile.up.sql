@@ -0,0 +1,14 @@
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS family_name TEXT,
+    ADD COLUMN IF NOT EXISTS given_name TEXT,
+    ADD COLUMN IF NOT EXISTS display_name TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS locale TEXT,
+    ADD COLUMN IF NOT EXISTS time_zone TEXT,
+    ADD COLUMN IF NOT EXISTS phone TEXT,
+    ADD COLUMN IF NOT EXISTS avatar_url TEXT;
+
+-- 既存のユーザーは姓名が未設定のため、表示名は name と同じです。
+UPDATE users SET display_name = name WHERE display_name = '';
+
+ALTER TABLE users
+    ADD CONSTRAINT users_phone_check CHECK (phone ~ '^\+[1-9][0-9]{1,14}$');
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS employees (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    employee_code TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    status TEXT NOT NULL DEFAULT 'active',
+    hired_at TEXT,
+    terminated_at TEXT,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT employees_company_code_unique UNIQUE (company_id, employee_code),
+    CONSTRAINT employees_terminated_after_hired CHECK (
+        terminated_at IS NULL OR hired_at IS NULL OR terminated_at >= hired_at
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_employees_company_id_status ON employees (company_id, status);
+CREATE INDEX IF NOT EXISTS idx_employees_user_id ON employees (user_id);
@@ -0,0 +1,5 @@
+ALTER TABLE companies
+    ADD COLUMN parent_company_id TEXT REFERENCES companies(id) ON DELETE RESTRICT
+        CHECK (parent_company_id IS NULL OR parent_company_id <> id);
+
+CREATE INDEX IF NOT EXISTS idx_companies_parent_company_id ON companies (parent_company_id);
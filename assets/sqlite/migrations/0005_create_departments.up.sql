@@ -0,0 +1,48 @@
+CREATE TABLE IF NOT EXISTS departments (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    parent_department_id TEXT,
+    name TEXT NOT NULL,
+    code TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT departments_company_code_unique UNIQUE (company_id, code),
+    CONSTRAINT departments_company_id_id_unique UNIQUE (company_id, id),
+    CONSTRAINT departments_parent_department_fkey
+        FOREIGN KEY (company_id, parent_department_id) REFERENCES departments (company_id, id),
+    CONSTRAINT departments_parent_not_self CHECK (parent_department_id IS NULL OR parent_department_id <> id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_departments_parent_department_id ON departments (parent_department_id);
+
+-- SQLite は既存テーブルへ複合外部キーを追加できないため、employees を作り直します。
+CREATE TABLE employees_new (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    employee_code TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    department_id TEXT,
+    status TEXT NOT NULL DEFAULT 'active',
+    hired_at TEXT,
+    terminated_at TEXT,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT employees_company_code_unique UNIQUE (company_id, employee_code),
+    CONSTRAINT employees_department_fkey
+        FOREIGN KEY (company_id, department_id) REFERENCES departments (company_id, id),
+    CONSTRAINT employees_terminated_after_hired CHECK (
+        terminated_at IS NULL OR hired_at IS NULL OR terminated_at >= hired_at
+    )
+);
+
+INSERT INTO employees_new (id, company_id, employee_code, user_id, status, hired_at, terminated_at, created_at, updated_at)
+SELECT id, company_id, employee_code, user_id, status, hired_at, terminated_at, created_at, updated_at
+  FROM employees;
+
+DROP TABLE employees;
+
+ALTER TABLE employees_new RENAME TO employees;
+
+CREATE INDEX IF NOT EXISTS idx_employees_company_id_status ON employees (company_id, status);
+CREATE INDEX IF NOT EXISTS idx_employees_user_id ON employees (user_id);
+CREATE INDEX IF NOT EXISTS idx_employees_department_id ON employees (department_id);
@@ -0,0 +1,30 @@
+CREATE TABLE employees_old (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    employee_code TEXT NOT NULL,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE RESTRICT,
+    department_id TEXT,
+    status TEXT NOT NULL DEFAULT 'active',
+    hired_at TEXT,
+    terminated_at TEXT,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    CONSTRAINT employees_company_code_unique UNIQUE (company_id, employee_code),
+    CONSTRAINT employees_department_fkey
+        FOREIGN KEY (company_id, department_id) REFERENCES departments (company_id, id),
+    CONSTRAINT employees_terminated_after_hired CHECK (
+        terminated_at IS NULL OR hired_at IS NULL OR terminated_at >= hired_at
+    )
+);
+
+INSERT INTO employees_old (id, company_id, employee_code, user_id, department_id, status, hired_at, terminated_at, created_at, updated_at)
+SELECT id, company_id, employee_code, user_id, department_id, status, hired_at, terminated_at, created_at, updated_at
+  FROM employees;
+
+DROP TABLE employees;
+
+ALTER TABLE employees_old RENAME TO employees;
+
+CREATE INDEX IF NOT EXISTS idx_employees_company_id_status ON employees (company_id, status);
+CREATE INDEX IF NOT EXISTS idx_employees_user_id ON employees (user_id);
+CREATE INDEX IF NOT EXISTS idx_employees_department_id ON employees (department_id);
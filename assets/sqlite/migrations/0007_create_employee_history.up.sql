@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS employee_history (
+    id TEXT PRIMARY KEY,
+    employee_id TEXT NOT NULL REFERENCES employees(id) ON DELETE CASCADE,
+    company_id TEXT NOT NULL,
+    employee_code TEXT NOT NULL,
+    user_id TEXT NOT NULL,
+    department_id TEXT,
+    manager_employee_id TEXT,
+    status TEXT NOT NULL,
+    hired_at TEXT,
+    terminated_at TEXT,
+    effective_from TEXT NOT NULL,
+    effective_to TEXT,
+    created_at TEXT NOT NULL,
+    CONSTRAINT employee_history_effective_range CHECK (effective_to IS NULL OR effective_to > effective_from)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_employee_history_open ON employee_history (employee_id) WHERE effective_to IS NULL;
+CREATE INDEX IF NOT EXISTS idx_employee_history_employee_id_effective_from ON employee_history (employee_id, effective_from DESC);
+
+-- 既存の社員は現在の内容を作成日から有効な履歴として登録します。
+INSERT INTO employee_history (id, employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, effective_from, created_at)
+SELECT lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' ||
+             substr('89ab', 1 + (abs(random()) % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6))),
+       id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, substr(created_at, 1, 10), updated_at
+  FROM employees;
@@ -0,0 +1,5 @@
+UPDATE employees SET status = 'inactive' WHERE status = 'terminated';
+UPDATE employees SET status = 'active' WHERE status IN ('pending', 'on_leave');
+
+UPDATE employee_history SET status = 'inactive' WHERE status = 'terminated';
+UPDATE employee_history SET status = 'active' WHERE status IN ('pending', 'on_leave');
@@ -0,0 +1,29 @@
+-- 旧 inactive は terminated へ移行し、退職日が未設定の場合は最終更新日を退職日とします。
+-- 入社日が最終更新日より後の場合は employees_terminated_after_hired を満たすよう入社日を退職日とします。
+UPDATE employees
+   SET status = 'terminated',
+       terminated_at = COALESCE(terminated_at, max(substr(updated_at, 1, 10), COALESCE(hired_at, substr(updated_at, 1, 10))))
+ WHERE status = 'inactive';
+
+UPDATE employee_history
+   SET status = 'terminated',
+       terminated_at = COALESCE(terminated_at, max(effective_from, COALESCE(hired_at, effective_from)))
+ WHERE status = 'inactive';
+
+-- 日付と矛盾する状態を日付に合わせます。
+UPDATE employees
+   SET status = 'terminated'
+ WHERE status <> 'terminated'
+   AND terminated_at IS NOT NULL
+   AND terminated_at <= date('now');
+
+UPDATE employees
+   SET status = 'pending'
+ WHERE status = 'active'
+   AND hired_at > date('now');
+
+-- 現在有効な履歴は社員と同じ内容にし、as_of を指定した参照と GetEmployee の結果を揃えます。
+UPDATE employee_history
+   SET status = (SELECT e.status FROM employees AS e WHERE e.id = employee_history.employee_id),
+       terminated_at = (SELECT e.terminated_at FROM employees AS e WHERE e.id = employee_history.employee_id)
+ WHERE effective_to IS NULL;
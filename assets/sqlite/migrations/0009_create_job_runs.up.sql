@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS job_runs (
+    id TEXT PRIMARY KEY,
+    job_name TEXT NOT NULL,
+    status TEXT NOT NULL,
+    scheduled_at TEXT NOT NULL,
+    started_at TEXT NOT NULL,
+    finished_at TEXT,
+    error TEXT,
+    CONSTRAINT job_runs_job_name_scheduled_at_key UNIQUE (job_name, scheduled_at)
+);
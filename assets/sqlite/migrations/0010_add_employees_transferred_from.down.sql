@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_employees_transferred_from_employee_id;
+
+ALTER TABLE employees DROP COLUMN transferred_from_employee_id;
@@ -0,0 +1,4 @@
+ALTER TABLE employees
+    ADD COLUMN transferred_from_employee_id TEXT REFERENCES employees (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_employees_transferred_from_employee_id ON employees (transferred_from_employee_id);
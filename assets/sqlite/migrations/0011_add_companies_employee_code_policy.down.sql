@@ -0,0 +1,3 @@
+ALTER TABLE companies DROP COLUMN employee_code_next_sequence;
+ALTER TABLE companies DROP COLUMN employee_code_width;
+ALTER TABLE companies DROP COLUMN employee_code_prefix;
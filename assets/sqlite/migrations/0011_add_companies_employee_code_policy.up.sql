@@ -0,0 +1,5 @@
+ALTER TABLE companies ADD COLUMN employee_code_prefix TEXT NOT NULL DEFAULT '';
+ALTER TABLE companies ADD COLUMN employee_code_width INTEGER NOT NULL DEFAULT 0
+    CONSTRAINT companies_employee_code_width_check CHECK (employee_code_width BETWEEN 0 AND 18);
+ALTER TABLE companies ADD COLUMN employee_code_next_sequence INTEGER NOT NULL DEFAULT 1
+    CONSTRAINT companies_employee_code_next_sequence_check CHECK (employee_code_next_sequence >= 1);
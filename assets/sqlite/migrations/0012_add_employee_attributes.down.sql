@@ -0,0 +1,3 @@
+ALTER TABLE employees DROP COLUMN attributes;
+
+DROP TABLE IF EXISTS company_employee_attributes;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS company_employee_attributes (
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    key TEXT NOT NULL,
+    type TEXT NOT NULL,
+    required INTEGER NOT NULL DEFAULT 0,
+    allowed_values TEXT NOT NULL DEFAULT '[]',
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL,
+    PRIMARY KEY (company_id, key),
+    CONSTRAINT company_employee_attributes_type_check CHECK (type IN ('string', 'number', 'date', 'enum'))
+);
+
+ALTER TABLE employees ADD COLUMN attributes TEXT NOT NULL DEFAULT '{}';
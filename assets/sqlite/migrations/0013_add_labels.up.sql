@@ -0,0 +1,2 @@
+ALTER TABLE companies ADD COLUMN labels TEXT NOT NULL DEFAULT '{}';
+ALTER TABLE users ADD COLUMN labels TEXT NOT NULL DEFAULT '{}';
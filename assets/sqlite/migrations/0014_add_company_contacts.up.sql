@@ -0,0 +1,22 @@
+ALTER TABLE companies ADD COLUMN website TEXT;
+
+CREATE TABLE IF NOT EXISTS company_addresses (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    type TEXT NOT NULL,
+    country TEXT NOT NULL,
+    postal_code TEXT NOT NULL DEFAULT '',
+    lines TEXT NOT NULL DEFAULT '[]',
+    created_at TEXT NOT NULL,
+    CONSTRAINT company_addresses_type_check CHECK (type IN ('billing', 'registered', 'office'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_company_addresses_company_id ON company_addresses (company_id, created_at);
+
+CREATE TABLE IF NOT EXISTS company_phones (
+    id TEXT PRIMARY KEY,
+    company_id TEXT NOT NULL REFERENCES companies(id) ON DELETE CASCADE,
+    number TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    CONSTRAINT company_phones_company_number_unique UNIQUE (company_id, number)
+);
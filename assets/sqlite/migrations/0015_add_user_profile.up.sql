@@ -0,0 +1,9 @@
+ALTER TABLE users ADD COLUMN family_name TEXT;
+ALTER TABLE users ADD COLUMN given_name TEXT;
+ALTER TABLE users ADD COLUMN display_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN locale TEXT;
+ALTER TABLE users ADD COLUMN time_zone TEXT;
+ALTER TABLE users ADD COLUMN phone TEXT;
+ALTER TABLE users ADD COLUMN avatar_url TEXT;
+
+UPDATE users SET display_name = name WHERE display_name = '';
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS password_setup_tokens (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash TEXT NOT NULL,
+    expires_at TEXT NOT NULL,
+    used_at TEXT,
+    created_at TEXT NOT NULL,
+    CONSTRAINT password_setup_tokens_token_hash_unique UNIQUE (token_hash)
+);
+
+CREATE INDEX IF NOT EXISTS idx_password_setup_tokens_user_id ON password_setup_tokens (user_id);
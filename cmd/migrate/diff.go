@@ -0,0 +1,147 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/migration"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/schema"
+)
+
+// undefinedTableCode は参照したテーブルが存在しない場合の SQLSTATE です。
+const undefinedTableCode = "42P01"
+
+// runDiff は targetSchema に適用済みのバージョンまでマイグレーションを一時スキーマへ適用し、targetSchema と構造を比較します。
+// 未適用のマイグレーションは差分とは別に一覧で出力します。差分がある場合は差分を w に出力し true を返します。
+func runDiff(ctx context.Context, w io.Writer, fsys fs.FS, dir, dsn, targetSchema string) (bool, error) {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return false, fmt.Errorf("connect: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	version, hasVersion, dirty, err := appliedVersion(ctx, conn, targetSchema)
+	if err != nil {
+		return false, err
+	}
+	if dirty {
+		return false, fmt.Errorf("schema %q is dirty at version %d; fix it with force before running diff", targetSchema, version)
+	}
+
+	scratch, err := scratchSchemaName()
+	if err != nil {
+		return false, err
+	}
+
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA "+pgx.Identifier{scratch}.Sanitize()); err != nil {
+		return false, fmt.Errorf("create scratch schema: %w", err)
+	}
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "DROP SCHEMA IF EXISTS "+pgx.Identifier{scratch}.Sanitize()+" CASCADE")
+	}()
+
+	scratchDSN, err := dsnWithSearchPath(dsn, scratch)
+	if err != nil {
+		return false, err
+	}
+	if hasVersion {
+		if err := migration.To(fsys, dir, scratchDSN, version); err != nil {
+			return false, fmt.Errorf("apply migrations to scratch schema: %w", err)
+		}
+	}
+
+	expected, err := schema.Inspect(ctx, conn, scratch)
+	if err != nil {
+		return false, err
+	}
+	actual, err := schema.Inspect(ctx, conn, targetSchema)
+	if err != nil {
+		return false, err
+	}
+
+	entries, err := migrationStatus(fsys, dir, version, hasVersion, false)
+	if err != nil {
+		return false, err
+	}
+	printPending(w, targetSchema, entries)
+
+	lines := schema.Diff(expected, actual)
+	if len(lines) == 0 {
+		fmt.Fprintf(w, "schema %q matches migrations\n", targetSchema)
+		return false, nil
+	}
+
+	fmt.Fprintf(w, "schema %q differs from migrations (- missing in database, + only in database, ~ changed):\n", targetSchema)
+	for _, line := range lines {
+		fmt.Fprintln(w, line)
+	}
+	return true, nil
+}
+
+type rowQueryer interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// appliedVersion は targetSchema の schema_migrations から適用済みのバージョンを返します。
+// 管理テーブルが存在しない、または空の場合は hasVersion に false を返します。
+func appliedVersion(ctx context.Context, q rowQueryer, targetSchema string) (version uint, hasVersion, dirty bool, err error) {
+	var v int64
+	err = q.QueryRow(ctx, "SELECT version, dirty FROM "+pgx.Identifier{targetSchema, "schema_migrations"}.Sanitize()+" LIMIT 1").Scan(&v, &dirty)
+	var pgErr *pgconn.PgError
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return 0, false, false, nil
+	case errors.As(err, &pgErr) && pgErr.Code == undefinedTableCode:
+		return 0, false, false, nil
+	case err != nil:
+		return 0, false, false, fmt.Errorf("read applied version: %w", err)
+	case v < 0:
+		// golang-migrate は全件ロールバック後のバージョンを -1 で表します。
+		return 0, false, false, nil
+	}
+	return uint(v), true, dirty, nil
+}
+
+// printPending は未適用のマイグレーションを出力します。未適用のマイグレーションは差分として扱いません。
+func printPending(w io.Writer, targetSchema string, entries []statusEntry) {
+	var pending []statusEntry
+	for _, e := range entries {
+		if !e.Applied {
+			pending = append(pending, e)
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+	fmt.Fprintf(w, "schema %q has %d pending migration(s) (not compared):\n", targetSchema, len(pending))
+	for _, e := range pending {
+		fmt.Fprintf(w, "  %04d %s\n", e.Version, e.Identifier)
+	}
+}
+
+func scratchSchemaName() (string, error) {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate scratch schema name: %w", err)
+	}
+	return "migrate_diff_" + hex.EncodeToString(b), nil
+}
+
+func dsnWithSearchPath(dsn, searchPath string) (string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", fmt.Errorf("parse dsn: %w", err)
+	}
+	query := u.Query()
+	query.Set("search_path", searchPath)
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
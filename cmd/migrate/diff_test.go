@@ -0,0 +1,105 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"regexp"
+	"testing"
+	"testing/fstest"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestAppliedVersion(t *testing.T) {
+	t.Parallel()
+
+	query := regexp.QuoteMeta(`SELECT version, dirty FROM "audit"."schema_migrations" LIMIT 1`)
+
+	cases := map[string]struct {
+		expect      func(mock pgxmock.PgxConnIface)
+		wantVersion uint
+		wantHas     bool
+		wantDirty   bool
+	}{
+		"applied": {
+			expect: func(mock pgxmock.PgxConnIface) {
+				mock.ExpectQuery(query).WillReturnRows(pgxmock.NewRows([]string{"version", "dirty"}).AddRow(int64(12), false))
+			},
+			wantVersion: 12,
+			wantHas:     true,
+		},
+		"dirty": {
+			expect: func(mock pgxmock.PgxConnIface) {
+				mock.ExpectQuery(query).WillReturnRows(pgxmock.NewRows([]string{"version", "dirty"}).AddRow(int64(3), true))
+			},
+			wantVersion: 3,
+			wantHas:     true,
+			wantDirty:   true,
+		},
+		"empty table": {
+			expect: func(mock pgxmock.PgxConnIface) {
+				mock.ExpectQuery(query).WillReturnError(pgx.ErrNoRows)
+			},
+		},
+		"missing table": {
+			expect: func(mock pgxmock.PgxConnIface) {
+				mock.ExpectQuery(query).WillReturnError(&pgconn.PgError{Code: undefinedTableCode})
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mock, err := pgxmock.NewConn()
+			if err != nil {
+				t.Fatalf("failed to create mock conn: %v", err)
+			}
+			defer mock.Close(context.Background())
+			tc.expect(mock)
+
+			version, hasVersion, dirty, err := appliedVersion(context.Background(), mock, "audit")
+			if err != nil {
+				t.Fatalf("appliedVersion returned error: %v", err)
+			}
+			if version != tc.wantVersion || hasVersion != tc.wantHas || dirty != tc.wantDirty {
+				t.Fatalf("unexpected result: version=%d has=%v dirty=%v", version, hasVersion, dirty)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Fatalf("unmet expectations: %v", err)
+			}
+		})
+	}
+}
+
+func TestPrintPending(t *testing.T) {
+	t.Parallel()
+
+	fsys := fstest.MapFS{
+		"m/0001_create_users.up.sql":     {},
+		"m/0002_create_companies.up.sql": {},
+		"m/0003_create_employees.up.sql": {},
+	}
+	entries, err := migrationStatus(fsys, "m", 1, true, false)
+	if err != nil {
+		t.Fatalf("migrationStatus returned error: %v", err)
+	}
+
+	var buf bytes.Buffer
+	printPending(&buf, "public", entries)
+	want := "schema \"public\" has 2 pending migration(s) (not compared):\n  0002 create_companies\n  0003 create_employees\n"
+	if buf.String() != want {
+		t.Fatalf("unexpected output:\n got: %q\nwant: %q", buf.String(), want)
+	}
+
+	all, err := migrationStatus(fsys, "m", 3, true, false)
+	if err != nil {
+		t.Fatalf("migrationStatus returned error: %v", err)
+	}
+	buf.Reset()
+	printPending(&buf, "public", all)
+	if buf.Len() != 0 {
+		t.Fatalf("expected no output when everything is applied, got %q", buf.String())
+	}
+}
@@ -1,28 +1,74 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 
 	"github.com/golang-migrate/migrate/v4"
-	_ "github.com/golang-migrate/migrate/v4/database/postgres"
-	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/ogurasousui/codex-grpc-clean-arch/assets"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/migration"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
 )
 
+const usage = `usage: migrate [flags] <action> [args]
+
+actions:
+  up                 apply all pending migrations
+  down N             roll back N migrations
+  down --yes         roll back all migrations
+  steps N            apply (N > 0) or roll back (N < 0) N migrations
+  goto V             migrate up or down to version V
+  force V            set version V without running migrations (clears the dirty state)
+  status             list applied and pending migrations
+  version            print the current version
+  drop --yes         drop everything in the database
+  create NAME        scaffold the next numbered up/down migration pair on disk
+  diff               compare the live schema with the applied migrations (exit code 1 on drift)
+
+flags:
+`
+
 func main() {
 	var (
 		configPath    = flag.String("config", "", "path to config file (defaults to CONFIG_PATH env or assets/local.yaml)")
-		migrationsDir = flag.String("dir", "assets/migrations", "directory containing migration files")
+		migrationsDir = flag.String("dir", "", "directory containing migration files (defaults to the embedded assets/migrations)")
+		seeds         = flag.Bool("seeds", false, "use the embedded assets/seeds instead of migrations")
+		yes           = flag.Bool("yes", false, "confirm destructive actions (down without steps, drop)")
+		targetSchema  = flag.String("schema", "public", "database schema compared by diff")
 	)
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
 	flag.Parse()
 
+	args, confirmed := extractConfirmation(flag.Args())
+	confirmed = confirmed || *yes
+
 	action := "up"
-	if flag.NArg() > 0 {
-		action = flag.Arg(0)
+	if len(args) > 0 {
+		action = args[0]
+		args = args[1:]
+	}
+
+	if action == "create" {
+		if len(args) != 1 {
+			log.Fatalf("create requires exactly one NAME argument")
+		}
+		up, down, err := createMigration(scaffoldDir(*migrationsDir, *seeds), args[0])
+		if err != nil {
+			log.Fatalf("migration create failed: %v", err)
+		}
+		log.Printf("created %s", up)
+		log.Printf("created %s", down)
+		return
 	}
 
 	cfgPath := effectiveConfigPath(*configPath)
@@ -31,7 +77,31 @@ func main() {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
-	if err := runMigration(action, *migrationsDir, cfg.Database.DSN()); err != nil {
+	dsn, err := migrationDSN(cfg.Database)
+	if err != nil {
+		log.Fatalf("failed to resolve database: %v", err)
+	}
+
+	fsys, dir, err := migrationSource(*migrationsDir, *seeds, cfg.Database.Driver)
+	if err != nil {
+		log.Fatalf("failed to resolve migration source: %v", err)
+	}
+
+	if action == "diff" {
+		if cfg.Database.Driver != config.DriverPostgres {
+			log.Fatalf("diff is only supported for the %s driver", config.DriverPostgres)
+		}
+		drifted, err := runDiff(context.Background(), os.Stdout, fsys, dir, dsn, *targetSchema)
+		if err != nil {
+			log.Fatalf("migration diff failed: %v", err)
+		}
+		if drifted {
+			os.Exit(1)
+		}
+		return
+	}
+
+	if err := runMigration(action, args, confirmed, fsys, dir, dsn); err != nil {
 		log.Fatalf("migration %s failed: %v", action, err)
 	}
 
@@ -48,43 +118,170 @@ func effectiveConfigPath(flagValue string) string {
 	return "assets/local.yaml"
 }
 
-func runMigration(action, dir, dsn string) error {
-	absDir, err := filepath.Abs(dir)
-	if err != nil {
-		return fmt.Errorf("resolve path for %s: %w", dir, err)
+// extractConfirmation はアクションの後ろに置かれた --yes / -yes を取り除き、指定有無を返します。
+func extractConfirmation(args []string) ([]string, bool) {
+	rest := make([]string, 0, len(args))
+	confirmed := false
+	for _, arg := range args {
+		if arg == "--yes" || arg == "-yes" {
+			confirmed = true
+			continue
+		}
+		rest = append(rest, arg)
+	}
+	return rest, confirmed
+}
+
+// migrationDSN は database.driver に応じた golang-migrate 用の接続 URL を返します。
+func migrationDSN(cfg config.DatabaseConfig) (string, error) {
+	switch cfg.Driver {
+	case config.DriverPostgres:
+		return cfg.DSN(), nil
+	case config.DriverSQLite:
+		return sqlitedb.MigrationURL(cfg.Path), nil
+	default:
+		return "", fmt.Errorf("driver %q has no migrations", cfg.Driver)
+	}
+}
+
+// migrationSource は -dir 指定時はディスク上のディレクトリを、未指定時はドライバに対応する埋め込み SQL を返します。
+func migrationSource(dir string, seeds bool, driver string) (fs.FS, string, error) {
+	if dir != "" {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, "", fmt.Errorf("resolve path for %s: %w", dir, err)
+		}
+		return os.DirFS(absDir), ".", nil
+	}
+	if driver == config.DriverSQLite {
+		if seeds {
+			return nil, "", fmt.Errorf("seeds are not available for the %s driver", driver)
+		}
+		return assets.SQLiteMigrations, assets.SQLiteMigrationsDir, nil
 	}
+	if seeds {
+		return assets.Seeds, assets.SeedsDir, nil
+	}
+	return assets.Migrations, assets.MigrationsDir, nil
+}
 
-	m, err := migrate.New(fmt.Sprintf("file://%s", filepath.ToSlash(absDir)), dsn)
+// scaffoldDir は create で雛形を書き出すディスク上のディレクトリを返します。
+func scaffoldDir(dir string, seeds bool) string {
+	if dir != "" {
+		return dir
+	}
+	if seeds {
+		return filepath.Join("assets", assets.SeedsDir)
+	}
+	return filepath.Join("assets", assets.MigrationsDir)
+}
+
+func runMigration(action string, args []string, confirmed bool, fsys fs.FS, dir, dsn string) error {
+	m, err := migration.New(fsys, dir, dsn)
 	if err != nil {
-		return fmt.Errorf("create migrate instance: %w", err)
+		return err
 	}
 	defer m.Close()
 
 	switch action {
 	case "up":
-		if err := m.Up(); err != nil && err != migrate.ErrNoChange {
+		return ignoreNoChange(m.Up())
+	case "down":
+		if len(args) > 0 {
+			n, err := parseIntArg(action, args)
+			if err != nil {
+				return err
+			}
+			if n <= 0 {
+				return fmt.Errorf("down requires a positive number of steps")
+			}
+			return ignoreNoChange(m.Steps(-n))
+		}
+		if !confirmed {
+			return fmt.Errorf("down without steps rolls back every migration; pass --yes to confirm or specify N")
+		}
+		return ignoreNoChange(m.Down())
+	case "steps":
+		n, err := parseIntArg(action, args)
+		if err != nil {
 			return err
 		}
-		return nil
-	case "down":
-		if err := m.Down(); err != nil && err != migrate.ErrNoChange {
+		if n == 0 {
+			return fmt.Errorf("steps requires a non-zero number")
+		}
+		return ignoreNoChange(m.Steps(n))
+	case "goto":
+		v, err := parseIntArg(action, args)
+		if err != nil {
 			return err
 		}
-		return nil
+		if v < 0 {
+			return fmt.Errorf("goto requires a non-negative version")
+		}
+		return ignoreNoChange(m.Migrate(uint(v)))
+	case "force":
+		v, err := parseIntArg(action, args)
+		if err != nil {
+			return err
+		}
+		return m.Force(v)
 	case "drop":
+		if !confirmed {
+			return fmt.Errorf("drop removes every database object; pass --yes to confirm")
+		}
 		return m.Drop()
+	case "status":
+		current, dirty, hasVersion, err := currentVersion(m)
+		if err != nil {
+			return err
+		}
+		entries, err := migrationStatus(fsys, dir, current, hasVersion, dirty)
+		if err != nil {
+			return err
+		}
+		printStatus(os.Stdout, entries)
+		return nil
 	case "version":
-		version, dirty, err := m.Version()
+		version, dirty, hasVersion, err := currentVersion(m)
 		if err != nil {
-			if err == migrate.ErrNilVersion {
-				log.Printf("no migration applied")
-				return nil
-			}
 			return err
 		}
+		if !hasVersion {
+			log.Printf("no migration applied")
+			return nil
+		}
 		log.Printf("version=%d dirty=%t", version, dirty)
 		return nil
 	default:
 		return fmt.Errorf("unsupported action %q", action)
 	}
 }
+
+func currentVersion(m *migrate.Migrate) (uint, bool, bool, error) {
+	version, dirty, err := m.Version()
+	if err != nil {
+		if err == migrate.ErrNilVersion {
+			return 0, false, false, nil
+		}
+		return 0, false, false, err
+	}
+	return version, dirty, true, nil
+}
+
+func parseIntArg(action string, args []string) (int, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("%s requires exactly one numeric argument", action)
+	}
+	n, err := strconv.Atoi(args[0])
+	if err != nil {
+		return 0, fmt.Errorf("%s: invalid number %q", action, args[0])
+	}
+	return n, nil
+}
+
+func ignoreNoChange(err error) error {
+	if err != nil && err != migrate.ErrNoChange {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/golang-migrate/migrate/v4/source"
+)
+
+// statusEntry はマイグレーション 1 バージョン分の適用状況です。
+type statusEntry struct {
+	Version    uint
+	Identifier string
+	Applied    bool
+	Dirty      bool
+}
+
+// migrationStatus はソース内のマイグレーションを昇順に並べ、現在のバージョンと比較した適用状況を返します。
+func migrationStatus(fsys fs.FS, dir string, current uint, hasVersion, dirty bool) ([]statusEntry, error) {
+	migrations, err := listMigrations(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]statusEntry, 0, len(migrations))
+	for _, m := range migrations {
+		applied := hasVersion && m.Version <= current
+		entries = append(entries, statusEntry{
+			Version:    m.Version,
+			Identifier: m.Identifier,
+			Applied:    applied,
+			Dirty:      dirty && m.Version == current,
+		})
+	}
+	return entries, nil
+}
+
+func printStatus(w io.Writer, entries []statusEntry) {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "VERSION\tNAME\tSTATUS")
+	for _, e := range entries {
+		state := "pending"
+		switch {
+		case e.Dirty:
+			state = "dirty"
+		case e.Applied:
+			state = "applied"
+		}
+		fmt.Fprintf(tw, "%04d\t%s\t%s\n", e.Version, e.Identifier, state)
+	}
+	_ = tw.Flush()
+}
+
+// listMigrations はソース内のマイグレーションをバージョンごとに 1 件ずつ昇順で返します。
+func listMigrations(fsys fs.FS, dir string) ([]*source.Migration, error) {
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("read migrations in %s: %w", dir, err)
+	}
+
+	byVersion := make(map[uint]*source.Migration)
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		m, err := source.DefaultParse(f.Name())
+		if err != nil {
+			continue
+		}
+		if _, ok := byVersion[m.Version]; !ok {
+			byVersion[m.Version] = m
+		}
+	}
+
+	migrations := make([]*source.Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		migrations = append(migrations, m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9]+`)
+
+// createMigration は dir 内の最大バージョン + 1 で up/down の空マイグレーションを作成します。
+func createMigration(dir, name string) (string, string, error) {
+	identifier := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if identifier == "" {
+		return "", "", errors.New("migration name must contain letters or digits")
+	}
+
+	migrations, err := listMigrations(os.DirFS(dir), ".")
+	if err != nil {
+		return "", "", err
+	}
+
+	var next uint = 1
+	if len(migrations) > 0 {
+		next = migrations[len(migrations)-1].Version + 1
+	}
+
+	base := fmt.Sprintf("%04d_%s", next, identifier)
+	upPath := filepath.Join(dir, base+".up.sql")
+	downPath := filepath.Join(dir, base+".down.sql")
+
+	for _, path := range []string{upPath, downPath} {
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return "", "", fmt.Errorf("create %s: %w", path, err)
+		}
+		if _, err := fmt.Fprintf(f, "-- %s\n", filepath.Base(path)); err != nil {
+			_ = f.Close()
+			return "", "", fmt.Errorf("write %s: %w", path, err)
+		}
+		if err := f.Close(); err != nil {
+			return "", "", fmt.Errorf("close %s: %w", path, err)
+		}
+	}
+
+	return upPath, downPath, nil
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/jwt"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/password"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+)
+
+// newAuthService は auth 設定に応じて認証ユースケースを組み立てます。
+// jwt_secret が未設定の場合はトークンを発行せず、Login と RefreshToken は Unimplemented を返します。
+// 初回のパスワード設定トークンは mail 設定のメール送信で届けます。
+func newAuthService(cfg config.AuthConfig, repos *backend, employeeSvc *employee.Service, mailSender verification.Mailer) (*auth.Service, error) {
+	hasher, err := password.NewHasher(cfg.PasswordHash)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := []auth.Option{
+		auth.WithEmployments(authEmployments{employments: userEmployments{svc: employeeSvc}}),
+		auth.WithLockout(cfg.MaxFailedAttempts, cfg.LockoutDuration),
+		auth.WithPasswordSetup(repos.setupTokens, mailSender),
+	}
+	if cfg.JWTSecret != "" {
+		opts = append(opts, auth.WithTokens(jwt.NewSigner([]byte(cfg.JWTSecret), cfg.Issuer), cfg.AccessTokenTTL, cfg.RefreshTokenTTL))
+	} else {
+		log.Printf("auth.jwt_secret is not set; Login and RefreshToken are disabled")
+	}
+
+	return auth.NewService(repos.credentials, authUsers{repo: repos.users}, hasher, nil, repos.txManager, opts...), nil
+}
+
+// authUsers はユーザーリポジトリを auth.Users として提供します。
+// 認証ユースケースはユーザーユースケースを経由せず、リポジトリを直接参照します。
+type authUsers struct {
+	repo user.Repository
+}
+
+var _ auth.Users = authUsers{}
+
+// FindByEmail はメールアドレスでユーザーを取得します。
+func (a authUsers) FindByEmail(ctx context.Context, email string) (*auth.Account, error) {
+	return toAuthAccount(a.repo.FindByEmail(ctx, email))
+}
+
+// FindByID は ID でユーザーを取得します。
+func (a authUsers) FindByID(ctx context.Context, id string) (*auth.Account, error) {
+	return toAuthAccount(a.repo.FindByID(ctx, id))
+}
+
+func toAuthAccount(u *user.User, err error) (*auth.Account, error) {
+	if errors.Is(err, user.ErrUserNotFound) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &auth.Account{UserID: u.ID, Email: u.Email, Active: u.Status == user.StatusActive}, nil
+}
+
+// authEmployments は社員レコードのうち退職済みを除いたものを auth.Employments として提供します。
+type authEmployments struct {
+	employments userEmployments
+}
+
+var _ auth.Employments = authEmployments{}
+
+// ListByUser は退職済みを除くユーザーの社員レコードを返します。
+func (a authEmployments) ListByUser(ctx context.Context, userID string) ([]auth.Employment, error) {
+	all, err := a.employments.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	employments := make([]auth.Employment, 0, len(all))
+	for _, e := range all {
+		if e.Status == string(employee.StatusTerminated) {
+			continue
+		}
+		employments = append(employments, auth.Employment{
+			CompanyID:    e.CompanyID,
+			EmployeeID:   e.EmployeeID,
+			EmployeeCode: e.EmployeeCode,
+			Status:       e.Status,
+		})
+	}
+	return employments, nil
+}
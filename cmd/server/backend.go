@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/assets"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/memory"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/postgres"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/sqlite"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/migration"
+	pg "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// schedulerLockKey はジョブスケジューラのリーダー選出に使うアドバイザリロックキーです。
+const schedulerLockKey int64 = 0x7363686564 // "sched"
+
+// backend は database.driver に応じて組み立てたリポジトリとトランザクションマネージャです。
+// memory・sqlite では単一プロセスでの実行を前提とし、ジョブスケジューラは常にリーダーとして動作します。
+type backend struct {
+	users             user.Repository
+	companies         company.Repository
+	employees         employee.Repository
+	departments       department.Repository
+	jobRuns           scheduler.RunRepository
+	elector           scheduler.LeaderElector
+	emailChangeTokens user.EmailChangeTokenRepository
+	credentials       auth.CredentialRepository
+	setupTokens       auth.PasswordSetupTokenRepository
+	txManager         user.TransactionManager
+	close             func()
+}
+
+func newBackend(ctx context.Context, cfg config.DatabaseConfig) (*backend, error) {
+	switch cfg.Driver {
+	case config.DriverMemory:
+		return newMemoryBackend(), nil
+	case config.DriverSQLite:
+		return newSQLiteBackend(ctx, cfg)
+	case config.DriverPostgres:
+		return newPostgresBackend(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unsupported database driver %q", cfg.Driver)
+	}
+}
+
+func newMemoryBackend() *backend {
+	store := memory.NewStore()
+	return &backend{
+		users:             memory.NewUserRepository(store),
+		companies:         memory.NewCompanyRepository(store),
+		employees:         memory.NewEmployeeRepository(store),
+		departments:       memory.NewDepartmentRepository(store),
+		jobRuns:           memory.NewJobRunRepository(store),
+		emailChangeTokens: memory.NewEmailChangeTokenRepository(store),
+		credentials:       memory.NewCredentialRepository(store),
+		setupTokens:       memory.NewPasswordSetupTokenRepository(store),
+		elector:           scheduler.StandaloneElector{},
+		txManager:         memory.NewTransactionManager(store),
+		close:             func() {},
+	}
+}
+
+func newSQLiteBackend(ctx context.Context, cfg config.DatabaseConfig) (*backend, error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+		return nil, fmt.Errorf("create directory for %s: %w", cfg.Path, err)
+	}
+
+	if cfg.AutoMigrate {
+		if err := migration.Up(assets.SQLiteMigrations, assets.SQLiteMigrationsDir, sqlitedb.MigrationURL(cfg.Path)); err != nil {
+			return nil, fmt.Errorf("apply migrations: %w", err)
+		}
+		log.Printf("database migrations applied")
+	}
+
+	db, err := sqlitedb.Open(ctx, cfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &backend{
+		users:             sqlite.NewUserRepository(db),
+		companies:         sqlite.NewCompanyRepository(db),
+		employees:         sqlite.NewEmployeeRepository(db),
+		departments:       sqlite.NewDepartmentRepository(db),
+		jobRuns:           sqlite.NewJobRunRepository(db),
+		emailChangeTokens: sqlite.NewEmailChangeTokenRepository(db),
+		credentials:       sqlite.NewCredentialRepository(db),
+		setupTokens:       sqlite.NewPasswordSetupTokenRepository(db),
+		elector:           scheduler.StandaloneElector{},
+		txManager:         sqlitedb.NewTransactionManager(db),
+		close:             func() { _ = db.Close() },
+	}, nil
+}
+
+func newPostgresBackend(ctx context.Context, cfg config.DatabaseConfig) (*backend, error) {
+	if cfg.AutoMigrate {
+		if err := migration.UpWithLock(ctx, assets.Migrations, assets.MigrationsDir, cfg.DSN()); err != nil {
+			return nil, fmt.Errorf("apply migrations: %w", err)
+		}
+		log.Printf("database migrations applied")
+	}
+
+	dbPool, err := pg.NewPool(ctx, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("initialize database pool: %w", err)
+	}
+	closers := []func(){dbPool.Close}
+	closeAll := func() {
+		for i := len(closers) - 1; i >= 0; i-- {
+			closers[i]()
+		}
+	}
+
+	replicaPools := make([]pg.Pool, 0, len(cfg.Replicas))
+	for _, replicaCfg := range cfg.ReplicaDatabaseConfigs() {
+		replicaPool, err := pg.NewPool(ctx, replicaCfg)
+		if err != nil {
+			closeAll()
+			return nil, fmt.Errorf("initialize replica pool %s: %w", replicaCfg.Host, err)
+		}
+		closers = append(closers, replicaPool.Close)
+		replicaPools = append(replicaPools, replicaPool)
+	}
+
+	db := pg.NewReplicaSet(dbPool, replicaPools, pg.ReplicaSetOptions{
+		ReadYourWrites:      cfg.ReadYourWrites,
+		HealthCheckInterval: cfg.ReplicaHealthCheckInterval,
+	})
+	go db.Run(ctx)
+
+	return &backend{
+		users:             postgres.NewUserRepository(db),
+		companies:         postgres.NewCompanyRepository(db),
+		employees:         postgres.NewEmployeeRepository(db),
+		departments:       postgres.NewDepartmentRepository(db),
+		jobRuns:           postgres.NewJobRunRepository(db),
+		emailChangeTokens: postgres.NewEmailChangeTokenRepository(db),
+		credentials:       postgres.NewCredentialRepository(db),
+		setupTokens:       postgres.NewPasswordSetupTokenRepository(db),
+		elector:           pg.NewAdvisoryLockElector(dbPool, schedulerLockKey),
+		txManager: pg.NewTransactionManager(db, pg.WithStatementTimeouts(
+			cfg.ReadOnlyStatementTimeout,
+			cfg.ReadWriteStatementTimeout,
+		)),
+		close: closeAll,
+	}, nil
+}
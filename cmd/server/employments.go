@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// userEmployments は社員ユースケースを user.Employments として提供します。
+type userEmployments struct {
+	svc *employee.Service
+}
+
+var _ user.Employments = userEmployments{}
+
+// ListByUser はユーザーの社員レコードをすべて取得します。
+func (u userEmployments) ListByUser(ctx context.Context, userID string) ([]user.Employment, error) {
+	var employments []user.Employment
+	token := ""
+	for {
+		result, err := u.svc.ListUserEmployments(ctx, employee.ListUserEmploymentsInput{UserID: userID, PageSize: 200, PageToken: token})
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range result.Employments {
+			employments = append(employments, user.Employment{
+				EmployeeID:   e.Employee.ID,
+				CompanyID:    e.Employee.CompanyID,
+				EmployeeCode: e.Employee.EmployeeCode,
+				Status:       string(e.Employee.Status),
+			})
+		}
+		if result.NextPageToken == "" {
+			return employments, nil
+		}
+		token = result.NextPageToken
+	}
+}
+
+// TerminateByUser はユーザーの在籍中の社員レコードを退職させます。
+func (u userEmployments) TerminateByUser(ctx context.Context, userID string) error {
+	_, err := u.svc.TerminateUserEmployments(ctx, userID)
+	return err
+}
+
+// DeleteByUser はユーザーの社員レコードを削除します。
+func (u userEmployments) DeleteByUser(ctx context.Context, userID string) error {
+	return u.svc.DeleteUserEmployments(ctx, userID)
+}
+
+// companyEmployees は社員ユースケースを company.Employees として提供します。
+type companyEmployees struct {
+	svc *employee.Service
+}
+
+var _ company.Employees = companyEmployees{}
+
+// CountByCompany は会社に所属する社員数を返します。
+func (c companyEmployees) CountByCompany(ctx context.Context, companyID string) (company.EmployeeCounts, error) {
+	counts, err := c.svc.CountCompanyEmployees(ctx, companyID)
+	if err != nil {
+		return company.EmployeeCounts{}, err
+	}
+	return company.EmployeeCounts{Total: counts.Total, Active: counts.Active}, nil
+}
+
+// TerminateByCompany は会社の在籍中の社員に退職日を設定します。
+func (c companyEmployees) TerminateByCompany(ctx context.Context, companyID string, terminatedAt time.Time) error {
+	_, err := c.svc.TerminateCompanyEmployees(ctx, companyID, terminatedAt)
+	return err
+}
+
+// employeeAttributeSchemas は会社リポジトリの社員属性の定義を employee.AttributeSchemas として提供します。
+// 会社ユースケースは社員ユースケースに依存するため、リポジトリを直接参照します。
+type employeeAttributeSchemas struct {
+	repo company.Repository
+}
+
+var _ employee.AttributeSchemas = employeeAttributeSchemas{}
+
+// ListByCompany は会社の社員属性の定義を返します。
+func (e employeeAttributeSchemas) ListByCompany(ctx context.Context, companyID string) ([]employee.AttributeDefinition, error) {
+	attributes, err := e.repo.ListEmployeeAttributes(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+	definitions := make([]employee.AttributeDefinition, 0, len(attributes))
+	for _, a := range attributes {
+		definitions = append(definitions, employee.AttributeDefinition{
+			Key:           a.Key,
+			Type:          employee.AttributeType(a.Type),
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		})
+	}
+	return definitions, nil
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// employeeStatusTransitionsJob は入社日・退職日を迎えた社員の状態を更新するジョブ名です。
+const employeeStatusTransitionsJob = "employee_status_transitions"
+
+// newScheduler はジョブを登録した Scheduler を生成します。
+func newScheduler(cfg config.SchedulerConfig, repos *backend, employeeSvc *employee.Service) (*scheduler.Scheduler, error) {
+	s := scheduler.New(repos.elector, repos.jobRuns, nil)
+
+	if err := s.Register(employeeStatusTransitionsJob, cfg.EmployeeStatusTransitions, applyEmployeeStatusTransitions(employeeSvc)); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// applyEmployeeStatusTransitions は社員の状態を日付に合わせて更新し、更新できなかった社員をエラーとして返します。
+func applyEmployeeStatusTransitions(svc *employee.Service) scheduler.JobFunc {
+	return func(ctx context.Context) error {
+		result, err := svc.ApplyStatusTransitions(ctx)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("%s: %d employees transitioned, %d failed", employeeStatusTransitionsJob, len(result.Transitioned), len(result.Failures))
+
+		errs := make([]error, 0, len(result.Failures))
+		for _, failure := range result.Failures {
+			errs = append(errs, fmt.Errorf("employee %s: %w", failure.EmployeeID, failure.Err))
+		}
+		return errors.Join(errs...)
+	}
+}
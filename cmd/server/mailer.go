@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/mailer"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+)
+
+// newMailer は mail.driver に応じた verification.Mailer と、終了時に呼び出すクローズ関数を返します。
+func newMailer(cfg config.MailConfig) (verification.Mailer, func(), error) {
+	switch cfg.Driver {
+	case config.MailDriverLog:
+		return mailer.NewLogMailer(nil, cfg.From), func() {}, nil
+	case config.MailDriverFile:
+		if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o755); err != nil {
+			return nil, nil, fmt.Errorf("create directory for %s: %w", cfg.Path, err)
+		}
+		f, err := os.OpenFile(cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open mail file %s: %w", cfg.Path, err)
+		}
+		return mailer.NewLogMailer(log.New(f, "", log.LstdFlags), cfg.From), func() { _ = f.Close() }, nil
+	case config.MailDriverSMTP:
+		return mailer.NewSMTPMailer(cfg.SMTP.Host, cfg.SMTP.Port, cfg.SMTP.Username, cfg.SMTP.Password, cfg.From), func() {}, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported mail driver %q", cfg.Driver)
+	}
+}
@@ -6,15 +6,18 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	// ユーザーのタイムゾーンの検証がホストの zoneinfo に依存しないよう、tzdata を埋め込みます。
+	_ "time/tzdata"
 
-	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/postgres"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/hello"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
 	pg "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/server"
+	"google.golang.org/grpc"
 )
 
 func main() {
@@ -31,25 +34,57 @@ func main() {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
-	dbPool, err := pg.NewPool(ctx, cfg.Database)
+	repos, err := newBackend(ctx, cfg.Database)
 	if err != nil {
-		log.Fatalf("failed to initialize database pool: %v", err)
+		log.Fatalf("failed to initialize %s backend: %v", cfg.Database.Driver, err)
 	}
-	defer dbPool.Close()
+	defer repos.close()
+
+	mailSender, closeMailer, err := newMailer(cfg.Mail)
+	if err != nil {
+		log.Fatalf("failed to initialize %s mailer: %v", cfg.Mail.Driver, err)
+	}
+	defer closeMailer()
 
 	greeterSvc := hello.NewService()
-	txManager := pg.NewTransactionManager(dbPool)
-	userRepo := postgres.NewUserRepository(dbPool)
-	userSvc := user.NewService(userRepo, nil, txManager)
-	companyRepo := postgres.NewCompanyRepository(dbPool)
-	companySvc := company.NewService(companyRepo, nil, txManager)
-	employeeRepo := postgres.NewEmployeeRepository(dbPool)
-	employeeSvc := employee.NewService(employeeRepo, nil, txManager)
-	grpcServer := server.New(cfg.Server.ListenAddr, greeterSvc, userSvc, companySvc, employeeSvc)
+	employeeSvc := employee.NewService(repos.employees, nil, repos.txManager, employee.WithAttributeSchemas(employeeAttributeSchemas{repo: repos.companies}))
+	userSvc := user.NewService(repos.users, nil, repos.txManager, user.WithEmployments(userEmployments{svc: employeeSvc}), user.WithEmailChange(repos.emailChangeTokens, mailSender))
+	companySvc := company.NewService(repos.companies, nil, repos.txManager, company.WithEmployees(companyEmployees{svc: employeeSvc}))
+	departmentSvc := department.NewService(repos.departments, nil, repos.txManager)
+	authSvc, err := newAuthService(cfg.Auth, repos, employeeSvc, mailSender)
+	if err != nil {
+		log.Fatalf("failed to initialize auth service: %v", err)
+	}
+	grpcServer := server.New(cfg.Server.ListenAddr, greeterSvc, userSvc, companySvc, employeeSvc, departmentSvc, authSvc,
+		grpc.ChainUnaryInterceptor(writeTrackingInterceptor),
+	)
+
+	schedulerDone := make(chan struct{})
+	if cfg.Scheduler.Enabled {
+		jobScheduler, err := newScheduler(cfg.Scheduler, repos, employeeSvc)
+		if err != nil {
+			log.Fatalf("failed to initialize scheduler: %v", err)
+		}
+		go func() {
+			defer close(schedulerDone)
+			if err := jobScheduler.Run(ctx); err != nil {
+				log.Printf("scheduler stopped with error: %v", err)
+			}
+		}()
+		log.Printf("job scheduler started")
+	} else {
+		close(schedulerDone)
+	}
 
 	log.Printf("gRPC server listening on %s", cfg.Server.ListenAddr)
 
 	if err := grpcServer.Run(ctx); err != nil {
 		log.Fatalf("server stopped with error: %v", err)
 	}
+	<-schedulerDone
+}
+
+// writeTrackingInterceptor はリクエストごとに書き込み追跡領域を用意し、read-your-writes を実現します。
+func writeTrackingInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(pg.ContextWithWriteTracking(ctx), req)
 }
@@ -72,22 +72,208 @@ func (CompanyStatus) EnumDescriptor() ([]byte, []int) {
 	return file_company_v1_company_proto_rawDescGZIP(), []int{0}
 }
 
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	// 10 進数の数値です。
+	AttributeType_ATTRIBUTE_TYPE_NUMBER AttributeType = 2
+	// YYYY-MM-DD 形式の日付です。
+	AttributeType_ATTRIBUTE_TYPE_DATE AttributeType = 3
+	// allowed_values のいずれかの値です。
+	AttributeType_ATTRIBUTE_TYPE_ENUM AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_DATE",
+		4: "ATTRIBUTE_TYPE_ENUM",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_DATE":        3,
+		"ATTRIBUTE_TYPE_ENUM":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_company_v1_company_proto_enumTypes[1].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_company_v1_company_proto_enumTypes[1]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{1}
+}
+
+type AddressType int32
+
+const (
+	AddressType_ADDRESS_TYPE_UNSPECIFIED AddressType = 0
+	// 請求先です。
+	AddressType_ADDRESS_TYPE_BILLING AddressType = 1
+	// 登記上の所在地です。
+	AddressType_ADDRESS_TYPE_REGISTERED AddressType = 2
+	// 事業所です。
+	AddressType_ADDRESS_TYPE_OFFICE AddressType = 3
+)
+
+// Enum value maps for AddressType.
+var (
+	AddressType_name = map[int32]string{
+		0: "ADDRESS_TYPE_UNSPECIFIED",
+		1: "ADDRESS_TYPE_BILLING",
+		2: "ADDRESS_TYPE_REGISTERED",
+		3: "ADDRESS_TYPE_OFFICE",
+	}
+	AddressType_value = map[string]int32{
+		"ADDRESS_TYPE_UNSPECIFIED": 0,
+		"ADDRESS_TYPE_BILLING":     1,
+		"ADDRESS_TYPE_REGISTERED":  2,
+		"ADDRESS_TYPE_OFFICE":      3,
+	}
+)
+
+func (x AddressType) Enum() *AddressType {
+	p := new(AddressType)
+	*p = x
+	return p
+}
+
+func (x AddressType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AddressType) Descriptor() protoreflect.EnumDescriptor {
+	return file_company_v1_company_proto_enumTypes[2].Descriptor()
+}
+
+func (AddressType) Type() protoreflect.EnumType {
+	return &file_company_v1_company_proto_enumTypes[2]
+}
+
+func (x AddressType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AddressType.Descriptor instead.
+func (AddressType) EnumDescriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{2}
+}
+
+// EmployeeCodePolicy は社員コードの自動採番規則です。
+// 社員コード省略時は prefix に next_sequence を width 桁でゼロ埋めした値を連結したコードを払い出します。
+type EmployeeCodePolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 小文字/数字/ハイフン/アンダースコアのみ。空文字の場合は連番のみとします。
+	Prefix string `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	// ゼロ埋めの桁数（0-18）です。0 の場合はゼロ埋めしません。
+	Width int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	// 次に払い出す連番（1 以上）です。0 の場合は作成時は 1、更新時は現在の値を維持します。
+	NextSequence  int64 `protobuf:"varint,3,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeCodePolicy) Reset() {
+	*x = EmployeeCodePolicy{}
+	mi := &file_company_v1_company_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeCodePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeCodePolicy) ProtoMessage() {}
+
+func (x *EmployeeCodePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeCodePolicy.ProtoReflect.Descriptor instead.
+func (*EmployeeCodePolicy) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmployeeCodePolicy) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *EmployeeCodePolicy) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *EmployeeCodePolicy) GetNextSequence() int64 {
+	if x != nil {
+		return x.NextSequence
+	}
+	return 0
+}
+
 type Company struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                  `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Status        CompanyStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	Id                 string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name               string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code               string                  `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Status             CompanyStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	Description        *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedAt          *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ParentCompanyId    *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=parent_company_id,json=parentCompanyId,proto3" json:"parent_company_id,omitempty"`
+	EmployeeCodePolicy *EmployeeCodePolicy     `protobuf:"bytes,9,opt,name=employee_code_policy,json=employeeCodePolicy,proto3" json:"employee_code_policy,omitempty"`
+	Labels             map[string]string       `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Website            *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=website,proto3" json:"website,omitempty"`
+	// 登録順に並びます。
+	Addresses []*Address `protobuf:"bytes,12,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// 登録順に並びます。
+	Phones        []*Phone `protobuf:"bytes,13,rep,name=phones,proto3" json:"phones,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Company) Reset() {
 	*x = Company{}
-	mi := &file_company_v1_company_proto_msgTypes[0]
+	mi := &file_company_v1_company_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -99,7 +285,7 @@ func (x *Company) String() string {
 func (*Company) ProtoMessage() {}
 
 func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[0]
+	mi := &file_company_v1_company_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +298,7 @@ func (x *Company) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Company.ProtoReflect.Descriptor instead.
 func (*Company) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{0}
+	return file_company_v1_company_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *Company) GetId() string {
@@ -157,37 +343,1490 @@ func (x *Company) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-func (x *Company) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *Company) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Company) GetParentCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentCompanyId
+	}
+	return nil
+}
+
+func (x *Company) GetEmployeeCodePolicy() *EmployeeCodePolicy {
+	if x != nil {
+		return x.EmployeeCodePolicy
+	}
+	return nil
+}
+
+func (x *Company) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Company) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+func (x *Company) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *Company) GetPhones() []*Phone {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+// Address は会社の住所です。
+type Address struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  AddressType            `protobuf:"varint,2,opt,name=type,proto3,enum=company.v1.AddressType" json:"type,omitempty"`
+	// ISO 3166-1 alpha-2 の国コードです。
+	Country string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	// 郵便番号のない国では空文字です。
+	PostalCode    string                 `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Lines         []string               `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_company_v1_company_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Address) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Address) GetType() AddressType {
+	if x != nil {
+		return x.Type
+	}
+	return AddressType_ADDRESS_TYPE_UNSPECIFIED
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Address) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Phone は会社の電話番号です。
+type Phone struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// E.164 形式（例: +81312345678）です。
+	Number        string                 `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Phone) Reset() {
+	*x = Phone{}
+	mi := &file_company_v1_company_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Phone) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phone) ProtoMessage() {}
+
+func (x *Phone) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
+func (*Phone) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Phone) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Phone) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *Phone) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateCompanyRequest struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Name            string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code            string                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Description     *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	ParentCompanyId *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=parent_company_id,json=parentCompanyId,proto3" json:"parent_company_id,omitempty"`
+	// 未指定の場合は接頭辞なし・ゼロ埋めなし・連番 1 から採番します。
+	EmployeeCodePolicy *EmployeeCodePolicy `protobuf:"bytes,5,opt,name=employee_code_policy,json=employeeCodePolicy,proto3" json:"employee_code_policy,omitempty"`
+	// キーは [prefix/]name 形式、値は 63 文字以内です。最大 64 件まで指定できます。
+	Labels map[string]string `protobuf:"bytes,6,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// http(s) の絶対 URL です。
+	Website       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyRequest) Reset() {
+	*x = CreateCompanyRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyRequest) ProtoMessage() {}
+
+func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateCompanyRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCompanyRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetParentCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentCompanyId
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetEmployeeCodePolicy() *EmployeeCodePolicy {
+	if x != nil {
+		return x.EmployeeCodePolicy
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *CreateCompanyRequest) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+type CreateCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompanyResponse) Reset() {
+	*x = CreateCompanyResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompanyResponse) ProtoMessage() {}
+
+func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type GetCompanyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyRequest) Reset() {
+	*x = GetCompanyRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyRequest) ProtoMessage() {}
+
+func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyResponse) Reset() {
+	*x = GetCompanyResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyResponse) ProtoMessage() {}
+
+func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type ListCompaniesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status    CompanyStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	// ラベルセレクタです（例: region=apac,tier!=free）。key、!key による存在判定も指定できます。
+	LabelSelector string `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesRequest) Reset() {
+	*x = ListCompaniesRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesRequest) ProtoMessage() {}
+
+func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCompaniesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCompaniesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListCompaniesRequest) GetStatus() CompanyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompanyStatus_COMPANY_STATUS_UNSPECIFIED
+}
+
+func (x *ListCompaniesRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
+type ListCompaniesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompaniesResponse) Reset() {
+	*x = ListCompaniesResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompaniesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompaniesResponse) ProtoMessage() {}
+
+func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListCompaniesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *ListCompaniesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateCompanyRequest struct {
+	state       protoimpl.MessageState  `protogen:"open.v1"`
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code        *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Status      CompanyStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	Description *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// 空文字を指定すると親会社との関連を解除します。
+	ParentCompanyId *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=parent_company_id,json=parentCompanyId,proto3" json:"parent_company_id,omitempty"`
+	// status を INACTIVE に変更する際に true を指定すると、在籍中の社員に退職日を設定します。
+	CascadeEmployees bool `protobuf:"varint,7,opt,name=cascade_employees,json=cascadeEmployees,proto3" json:"cascade_employees,omitempty"`
+	// cascade_employees 指定時の退職日（YYYY-MM-DD）です。未指定の場合は本日とします。
+	EmployeesTerminatedAt *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=employees_terminated_at,json=employeesTerminatedAt,proto3" json:"employees_terminated_at,omitempty"`
+	// 指定した場合は採番規則を置き換えます。
+	EmployeeCodePolicy *EmployeeCodePolicy `protobuf:"bytes,9,opt,name=employee_code_policy,json=employeeCodePolicy,proto3" json:"employee_code_policy,omitempty"`
+	// 指定したラベルを追加・上書きします。
+	Labels map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 指定したキーのラベルを削除します。
+	RemoveLabels []string `protobuf:"bytes,11,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`
+	// 空文字を指定すると Web サイトを削除します。
+	Website       *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=website,proto3" json:"website,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyRequest) Reset() {
+	*x = UpdateCompanyRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyRequest) ProtoMessage() {}
+
+func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCompanyRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetStatus() CompanyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompanyStatus_COMPANY_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateCompanyRequest) GetDescription() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetParentCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentCompanyId
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetCascadeEmployees() bool {
+	if x != nil {
+		return x.CascadeEmployees
+	}
+	return false
+}
+
+func (x *UpdateCompanyRequest) GetEmployeesTerminatedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EmployeesTerminatedAt
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetEmployeeCodePolicy() *EmployeeCodePolicy {
+	if x != nil {
+		return x.EmployeeCodePolicy
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+func (x *UpdateCompanyRequest) GetWebsite() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Website
+	}
+	return nil
+}
+
+type UpdateCompanyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanyResponse) Reset() {
+	*x = UpdateCompanyResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanyResponse) ProtoMessage() {}
+
+func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanyResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateCompanyResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
+type DeleteCompanyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// true の場合、在籍中の社員がいても社員ごと削除します。
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyRequest) Reset() {
+	*x = DeleteCompanyRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyRequest) ProtoMessage() {}
+
+func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteCompanyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeleteCompanyRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type DeleteCompanyResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 会社と合わせて削除した社員数です。
+	DeletedEmployees int32 `protobuf:"varint,1,opt,name=deleted_employees,json=deletedEmployees,proto3" json:"deleted_employees,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeleteCompanyResponse) Reset() {
+	*x = DeleteCompanyResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompanyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompanyResponse) ProtoMessage() {}
+
+func (x *DeleteCompanyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompanyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCompanyResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteCompanyResponse) GetDeletedEmployees() int32 {
+	if x != nil {
+		return x.DeletedEmployees
+	}
+	return 0
+}
+
+type ListSubsidiariesRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// true の場合は孫会社以下も含めて返します。
+	Recursive     bool          `protobuf:"varint,2,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	PageSize      int32         `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string        `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status        CompanyStatus `protobuf:"varint,5,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubsidiariesRequest) Reset() {
+	*x = ListSubsidiariesRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubsidiariesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubsidiariesRequest) ProtoMessage() {}
+
+func (x *ListSubsidiariesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubsidiariesRequest.ProtoReflect.Descriptor instead.
+func (*ListSubsidiariesRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListSubsidiariesRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListSubsidiariesRequest) GetRecursive() bool {
+	if x != nil {
+		return x.Recursive
+	}
+	return false
+}
+
+func (x *ListSubsidiariesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSubsidiariesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSubsidiariesRequest) GetStatus() CompanyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CompanyStatus_COMPANY_STATUS_UNSPECIFIED
+}
+
+type ListSubsidiariesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSubsidiariesResponse) Reset() {
+	*x = ListSubsidiariesResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSubsidiariesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubsidiariesResponse) ProtoMessage() {}
+
+func (x *ListSubsidiariesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubsidiariesResponse.ProtoReflect.Descriptor instead.
+func (*ListSubsidiariesResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListSubsidiariesResponse) GetCompanies() []*Company {
+	if x != nil {
+		return x.Companies
+	}
+	return nil
+}
+
+func (x *ListSubsidiariesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetCompanyAncestryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyAncestryRequest) Reset() {
+	*x = GetCompanyAncestryRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyAncestryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyAncestryRequest) ProtoMessage() {}
+
+func (x *GetCompanyAncestryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyAncestryRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanyAncestryRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetCompanyAncestryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCompanyAncestryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 直近の親会社から最上位の会社までの順に並びます。
+	Ancestors     []*Company `protobuf:"bytes,1,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanyAncestryResponse) Reset() {
+	*x = GetCompanyAncestryResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanyAncestryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanyAncestryResponse) ProtoMessage() {}
+
+func (x *GetCompanyAncestryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanyAncestryResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanyAncestryResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetCompanyAncestryResponse) GetAncestors() []*Company {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+// EmployeeAttribute は会社が社員に設定できる属性の定義です。
+type EmployeeAttribute struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// 小文字英字で始まる小文字英数字/アンダースコア（64 文字以内）です。
+	Key  string        `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Type AttributeType `protobuf:"varint,3,opt,name=type,proto3,enum=company.v1.AttributeType" json:"type,omitempty"`
+	// true の場合は社員の作成・更新時に値の指定が必要です。
+	Required bool `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	// ATTRIBUTE_TYPE_ENUM の場合の選択肢です。
+	AllowedValues []string               `protobuf:"bytes,5,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeAttribute) Reset() {
+	*x = EmployeeAttribute{}
+	mi := &file_company_v1_company_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeAttribute) ProtoMessage() {}
+
+func (x *EmployeeAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeAttribute.ProtoReflect.Descriptor instead.
+func (*EmployeeAttribute) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EmployeeAttribute) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *EmployeeAttribute) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *EmployeeAttribute) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *EmployeeAttribute) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *EmployeeAttribute) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+func (x *EmployeeAttribute) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeAttribute) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateEmployeeAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Type          AttributeType          `protobuf:"varint,3,opt,name=type,proto3,enum=company.v1.AttributeType" json:"type,omitempty"`
+	Required      bool                   `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,5,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeAttributeRequest) Reset() {
+	*x = CreateEmployeeAttributeRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeAttributeRequest) ProtoMessage() {}
+
+func (x *CreateEmployeeAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateEmployeeAttributeRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreateEmployeeAttributeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *CreateEmployeeAttributeRequest) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateEmployeeAttributeRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *CreateEmployeeAttributeRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type CreateEmployeeAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *EmployeeAttribute     `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateEmployeeAttributeResponse) Reset() {
+	*x = CreateEmployeeAttributeResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateEmployeeAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmployeeAttributeResponse) ProtoMessage() {}
+
+func (x *CreateEmployeeAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmployeeAttributeResponse.ProtoReflect.Descriptor instead.
+func (*CreateEmployeeAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateEmployeeAttributeResponse) GetAttribute() *EmployeeAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type UpdateEmployeeAttributeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Key       string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// 型は変更できません。required と allowed_values は指定した値で置き換えます。
+	Required      bool     `protobuf:"varint,3,opt,name=required,proto3" json:"required,omitempty"`
+	AllowedValues []string `protobuf:"bytes,4,rep,name=allowed_values,json=allowedValues,proto3" json:"allowed_values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeAttributeRequest) Reset() {
+	*x = UpdateEmployeeAttributeRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateEmployeeAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateEmployeeAttributeRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeAttributeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *UpdateEmployeeAttributeRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *UpdateEmployeeAttributeRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type UpdateEmployeeAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *EmployeeAttribute     `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateEmployeeAttributeResponse) Reset() {
+	*x = UpdateEmployeeAttributeResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateEmployeeAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateEmployeeAttributeResponse) ProtoMessage() {}
+
+func (x *UpdateEmployeeAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateEmployeeAttributeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateEmployeeAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateEmployeeAttributeResponse) GetAttribute() *EmployeeAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type DeleteEmployeeAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Key           string                 `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeAttributeRequest) Reset() {
+	*x = DeleteEmployeeAttributeRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteEmployeeAttributeRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *DeleteEmployeeAttributeRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+type DeleteEmployeeAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeAttributeResponse) Reset() {
+	*x = DeleteEmployeeAttributeResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteEmployeeAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[24]
 	if x != nil {
-		return x.UpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-type CreateCompanyRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Name          string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Code          string                  `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+// Deprecated: Use DeleteEmployeeAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{24}
+}
+
+type ListEmployeeAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyRequest) Reset() {
-	*x = CreateCompanyRequest{}
-	mi := &file_company_v1_company_proto_msgTypes[1]
+func (x *ListEmployeeAttributesRequest) Reset() {
+	*x = ListEmployeeAttributesRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyRequest) String() string {
+func (x *ListEmployeeAttributesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyRequest) ProtoMessage() {}
+func (*ListEmployeeAttributesRequest) ProtoMessage() {}
 
-func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[1]
+func (x *ListEmployeeAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -198,54 +1837,41 @@ func (x *CreateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*CreateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *CreateCompanyRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// Deprecated: Use ListEmployeeAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeeAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateCompanyRequest) GetCode() string {
+func (x *ListEmployeeAttributesRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Code
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *CreateCompanyRequest) GetDescription() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Description
-	}
-	return nil
-}
-
-type CreateCompanyResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
+type ListEmployeeAttributesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// key の昇順に並びます。
+	Attributes    []*EmployeeAttribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateCompanyResponse) Reset() {
-	*x = CreateCompanyResponse{}
-	mi := &file_company_v1_company_proto_msgTypes[2]
+func (x *ListEmployeeAttributesResponse) Reset() {
+	*x = ListEmployeeAttributesResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCompanyResponse) String() string {
+func (x *ListEmployeeAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCompanyResponse) ProtoMessage() {}
+func (*ListEmployeeAttributesResponse) ProtoMessage() {}
 
-func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[2]
+func (x *ListEmployeeAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,40 +1882,47 @@ func (x *CreateCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateCompanyResponse.ProtoReflect.Descriptor instead.
-func (*CreateCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListEmployeeAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeeAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CreateCompanyResponse) GetCompany() *Company {
+func (x *ListEmployeeAttributesResponse) GetAttributes() []*EmployeeAttribute {
 	if x != nil {
-		return x.Company
+		return x.Attributes
 	}
 	return nil
 }
 
-type GetCompanyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type AddCompanyAddressRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Type      AddressType            `protobuf:"varint,2,opt,name=type,proto3,enum=company.v1.AddressType" json:"type,omitempty"`
+	// ISO 3166-1 alpha-2 の国コードです。大文字小文字は区別しません。
+	Country string `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	// 国ごとの形式で指定します（例: JP は 100-0001）。
+	PostalCode string `protobuf:"bytes,4,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	// 1-4 行、1 行あたり 200 文字以内です。
+	Lines         []string `protobuf:"bytes,5,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyRequest) Reset() {
-	*x = GetCompanyRequest{}
-	mi := &file_company_v1_company_proto_msgTypes[3]
+func (x *AddCompanyAddressRequest) Reset() {
+	*x = AddCompanyAddressRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyRequest) String() string {
+func (x *AddCompanyAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyRequest) ProtoMessage() {}
+func (*AddCompanyAddressRequest) ProtoMessage() {}
 
-func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[3]
+func (x *AddCompanyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,40 +1933,68 @@ func (x *GetCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use AddCompanyAddressRequest.ProtoReflect.Descriptor instead.
+func (*AddCompanyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetCompanyRequest) GetId() string {
+func (x *AddCompanyAddressRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
 	return ""
 }
 
-type GetCompanyResponse struct {
+func (x *AddCompanyAddressRequest) GetType() AddressType {
+	if x != nil {
+		return x.Type
+	}
+	return AddressType_ADDRESS_TYPE_UNSPECIFIED
+}
+
+func (x *AddCompanyAddressRequest) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *AddCompanyAddressRequest) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *AddCompanyAddressRequest) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type AddCompanyAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanyResponse) Reset() {
-	*x = GetCompanyResponse{}
-	mi := &file_company_v1_company_proto_msgTypes[4]
+func (x *AddCompanyAddressResponse) Reset() {
+	*x = AddCompanyAddressResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanyResponse) String() string {
+func (x *AddCompanyAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanyResponse) ProtoMessage() {}
+func (*AddCompanyAddressResponse) ProtoMessage() {}
 
-func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[4]
+func (x *AddCompanyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,42 +2005,41 @@ func (x *GetCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanyResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use AddCompanyAddressResponse.ProtoReflect.Descriptor instead.
+func (*AddCompanyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetCompanyResponse) GetCompany() *Company {
+func (x *AddCompanyAddressResponse) GetCompany() *Company {
 	if x != nil {
 		return x.Company
 	}
 	return nil
 }
 
-type ListCompaniesRequest struct {
+type RemoveCompanyAddressRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Status        CompanyStatus          `protobuf:"varint,3,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	AddressId     string                 `protobuf:"bytes,2,opt,name=address_id,json=addressId,proto3" json:"address_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompaniesRequest) Reset() {
-	*x = ListCompaniesRequest{}
-	mi := &file_company_v1_company_proto_msgTypes[5]
+func (x *RemoveCompanyAddressRequest) Reset() {
+	*x = RemoveCompanyAddressRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompaniesRequest) String() string {
+func (x *RemoveCompanyAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompaniesRequest) ProtoMessage() {}
+func (*RemoveCompanyAddressRequest) ProtoMessage() {}
 
-func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[5]
+func (x *RemoveCompanyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,55 +2050,47 @@ func (x *ListCompaniesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompaniesRequest.ProtoReflect.Descriptor instead.
-func (*ListCompaniesRequest) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{5}
-}
-
-func (x *ListCompaniesRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use RemoveCompanyAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListCompaniesRequest) GetPageToken() string {
+func (x *RemoveCompanyAddressRequest) GetCompanyId() string {
 	if x != nil {
-		return x.PageToken
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *ListCompaniesRequest) GetStatus() CompanyStatus {
+func (x *RemoveCompanyAddressRequest) GetAddressId() string {
 	if x != nil {
-		return x.Status
+		return x.AddressId
 	}
-	return CompanyStatus_COMPANY_STATUS_UNSPECIFIED
+	return ""
 }
 
-type ListCompaniesResponse struct {
+type RemoveCompanyAddressResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Companies     []*Company             `protobuf:"bytes,1,rep,name=companies,proto3" json:"companies,omitempty"`
-	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCompaniesResponse) Reset() {
-	*x = ListCompaniesResponse{}
-	mi := &file_company_v1_company_proto_msgTypes[6]
+func (x *RemoveCompanyAddressResponse) Reset() {
+	*x = RemoveCompanyAddressResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCompaniesResponse) String() string {
+func (x *RemoveCompanyAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCompaniesResponse) ProtoMessage() {}
+func (*RemoveCompanyAddressResponse) ProtoMessage() {}
 
-func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[6]
+func (x *RemoveCompanyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -449,51 +2101,42 @@ func (x *ListCompaniesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCompaniesResponse.ProtoReflect.Descriptor instead.
-func (*ListCompaniesResponse) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use RemoveCompanyAddressResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListCompaniesResponse) GetCompanies() []*Company {
+func (x *RemoveCompanyAddressResponse) GetCompany() *Company {
 	if x != nil {
-		return x.Companies
+		return x.Company
 	}
 	return nil
 }
 
-func (x *ListCompaniesResponse) GetNextPageToken() string {
-	if x != nil {
-		return x.NextPageToken
-	}
-	return ""
-}
-
-type UpdateCompanyRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Code          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	Status        CompanyStatus           `protobuf:"varint,4,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
-	Description   *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+type AddCompanyPhoneRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// E.164 形式です。空白/ハイフン/括弧/ピリオドの区切り文字は取り除きます。
+	Number        string `protobuf:"bytes,2,opt,name=number,proto3" json:"number,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyRequest) Reset() {
-	*x = UpdateCompanyRequest{}
-	mi := &file_company_v1_company_proto_msgTypes[7]
+func (x *AddCompanyPhoneRequest) Reset() {
+	*x = AddCompanyPhoneRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyRequest) String() string {
+func (x *AddCompanyPhoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyRequest) ProtoMessage() {}
+func (*AddCompanyPhoneRequest) ProtoMessage() {}
 
-func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[7]
+func (x *AddCompanyPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,68 +2147,47 @@ func (x *UpdateCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use AddCompanyPhoneRequest.ProtoReflect.Descriptor instead.
+func (*AddCompanyPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *UpdateCompanyRequest) GetId() string {
+func (x *AddCompanyPhoneRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
 	return ""
 }
 
-func (x *UpdateCompanyRequest) GetName() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Name
-	}
-	return nil
-}
-
-func (x *UpdateCompanyRequest) GetCode() *wrapperspb.StringValue {
-	if x != nil {
-		return x.Code
-	}
-	return nil
-}
-
-func (x *UpdateCompanyRequest) GetStatus() CompanyStatus {
-	if x != nil {
-		return x.Status
-	}
-	return CompanyStatus_COMPANY_STATUS_UNSPECIFIED
-}
-
-func (x *UpdateCompanyRequest) GetDescription() *wrapperspb.StringValue {
+func (x *AddCompanyPhoneRequest) GetNumber() string {
 	if x != nil {
-		return x.Description
+		return x.Number
 	}
-	return nil
+	return ""
 }
 
-type UpdateCompanyResponse struct {
+type AddCompanyPhoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanyResponse) Reset() {
-	*x = UpdateCompanyResponse{}
-	mi := &file_company_v1_company_proto_msgTypes[8]
+func (x *AddCompanyPhoneResponse) Reset() {
+	*x = AddCompanyPhoneResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanyResponse) String() string {
+func (x *AddCompanyPhoneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanyResponse) ProtoMessage() {}
+func (*AddCompanyPhoneResponse) ProtoMessage() {}
 
-func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[8]
+func (x *AddCompanyPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -576,40 +2198,41 @@ func (x *UpdateCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanyResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use AddCompanyPhoneResponse.ProtoReflect.Descriptor instead.
+func (*AddCompanyPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *UpdateCompanyResponse) GetCompany() *Company {
+func (x *AddCompanyPhoneResponse) GetCompany() *Company {
 	if x != nil {
 		return x.Company
 	}
 	return nil
 }
 
-type DeleteCompanyRequest struct {
+type RemoveCompanyPhoneRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	PhoneId       string                 `protobuf:"bytes,2,opt,name=phone_id,json=phoneId,proto3" json:"phone_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyRequest) Reset() {
-	*x = DeleteCompanyRequest{}
-	mi := &file_company_v1_company_proto_msgTypes[9]
+func (x *RemoveCompanyPhoneRequest) Reset() {
+	*x = RemoveCompanyPhoneRequest{}
+	mi := &file_company_v1_company_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyRequest) String() string {
+func (x *RemoveCompanyPhoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyRequest) ProtoMessage() {}
+func (*RemoveCompanyPhoneRequest) ProtoMessage() {}
 
-func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[9]
+func (x *RemoveCompanyPhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -620,39 +2243,47 @@ func (x *DeleteCompanyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyRequest.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyRequest) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use RemoveCompanyPhoneRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyPhoneRequest) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *DeleteCompanyRequest) GetId() string {
+func (x *RemoveCompanyPhoneRequest) GetCompanyId() string {
 	if x != nil {
-		return x.Id
+		return x.CompanyId
 	}
 	return ""
 }
 
-type DeleteCompanyResponse struct {
+func (x *RemoveCompanyPhoneRequest) GetPhoneId() string {
+	if x != nil {
+		return x.PhoneId
+	}
+	return ""
+}
+
+type RemoveCompanyPhoneResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Company       *Company               `protobuf:"bytes,1,opt,name=company,proto3" json:"company,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteCompanyResponse) Reset() {
-	*x = DeleteCompanyResponse{}
-	mi := &file_company_v1_company_proto_msgTypes[10]
+func (x *RemoveCompanyPhoneResponse) Reset() {
+	*x = RemoveCompanyPhoneResponse{}
+	mi := &file_company_v1_company_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCompanyResponse) String() string {
+func (x *RemoveCompanyPhoneResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCompanyResponse) ProtoMessage() {}
+func (*RemoveCompanyPhoneResponse) ProtoMessage() {}
 
-func (x *DeleteCompanyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_company_v1_company_proto_msgTypes[10]
+func (x *RemoveCompanyPhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_company_v1_company_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -663,9 +2294,16 @@ func (x *DeleteCompanyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteCompanyResponse.ProtoReflect.Descriptor instead.
-func (*DeleteCompanyResponse) Descriptor() ([]byte, []int) {
-	return file_company_v1_company_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use RemoveCompanyPhoneResponse.ProtoReflect.Descriptor instead.
+func (*RemoveCompanyPhoneResponse) Descriptor() ([]byte, []int) {
+	return file_company_v1_company_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *RemoveCompanyPhoneResponse) GetCompany() *Company {
+	if x != nil {
+		return x.Company
+	}
+	return nil
 }
 
 var File_company_v1_company_proto protoreflect.FileDescriptor
@@ -673,7 +2311,11 @@ var File_company_v1_company_proto protoreflect.FileDescriptor
 const file_company_v1_company_proto_rawDesc = "" +
 	"\n" +
 	"\x18company/v1/company.proto\x12\n" +
-	"company.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xaa\x02\n" +
+	"company.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"g\n" +
+	"\x12EmployeeCodePolicy\x12\x16\n" +
+	"\x06prefix\x18\x01 \x01(\tR\x06prefix\x12\x14\n" +
+	"\x05width\x18\x02 \x01(\x05R\x05width\x12#\n" +
+	"\rnext_sequence\x18\x03 \x01(\x03R\fnextSequence\"\xd0\x05\n" +
 	"\aCompany\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -683,47 +2325,197 @@ const file_company_v1_company_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"~\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12H\n" +
+	"\x11parent_company_id\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\x0fparentCompanyId\x12P\n" +
+	"\x14employee_code_policy\x18\t \x01(\v2\x1e.company.v1.EmployeeCodePolicyR\x12employeeCodePolicy\x127\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2\x1f.company.v1.Company.LabelsEntryR\x06labels\x126\n" +
+	"\awebsite\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x121\n" +
+	"\taddresses\x18\f \x03(\v2\x13.company.v1.AddressR\taddresses\x12)\n" +
+	"\x06phones\x18\r \x03(\v2\x11.company.v1.PhoneR\x06phones\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd2\x01\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.company.v1.AddressTypeR\x04type\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x05lines\x18\x05 \x03(\tR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"j\n" +
+	"\x05Phone\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x03\n" +
 	"\x14CreateCompanyRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
 	"\x04code\x18\x02 \x01(\tR\x04code\x12>\n" +
-	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\"F\n" +
+	"\vdescription\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12H\n" +
+	"\x11parent_company_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x0fparentCompanyId\x12P\n" +
+	"\x14employee_code_policy\x18\x05 \x01(\v2\x1e.company.v1.EmployeeCodePolicyR\x12employeeCodePolicy\x12D\n" +
+	"\x06labels\x18\x06 \x03(\v2,.company.v1.CreateCompanyRequest.LabelsEntryR\x06labels\x126\n" +
+	"\awebsite\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
 	"\x15CreateCompanyResponse\x12-\n" +
 	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"#\n" +
 	"\x11GetCompanyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
 	"\x12GetCompanyResponse\x12-\n" +
-	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"\x85\x01\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"\xac\x01\n" +
 	"\x14ListCompaniesRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x121\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x19.company.v1.CompanyStatusR\x06status\"r\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x19.company.v1.CompanyStatusR\x06status\x12%\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tR\rlabelSelector\"r\n" +
 	"\x15ListCompaniesResponse\x121\n" +
 	"\tcompanies\x18\x01 \x03(\v2\x13.company.v1.CompanyR\tcompanies\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfd\x01\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xfa\x05\n" +
 	"\x14UpdateCompanyRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
 	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x120\n" +
 	"\x04code\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04code\x121\n" +
 	"\x06status\x18\x04 \x01(\x0e2\x19.company.v1.CompanyStatusR\x06status\x12>\n" +
-	"\vdescription\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\"F\n" +
+	"\vdescription\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\vdescription\x12H\n" +
+	"\x11parent_company_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x0fparentCompanyId\x12+\n" +
+	"\x11cascade_employees\x18\a \x01(\bR\x10cascadeEmployees\x12T\n" +
+	"\x17employees_terminated_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\x15employeesTerminatedAt\x12P\n" +
+	"\x14employee_code_policy\x18\t \x01(\v2\x1e.company.v1.EmployeeCodePolicyR\x12employeeCodePolicy\x12D\n" +
+	"\x06labels\x18\n" +
+	" \x03(\v2,.company.v1.UpdateCompanyRequest.LabelsEntryR\x06labels\x12#\n" +
+	"\rremove_labels\x18\v \x03(\tR\fremoveLabels\x126\n" +
+	"\awebsite\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\awebsite\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"F\n" +
 	"\x15UpdateCompanyResponse\x12-\n" +
-	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"&\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"<\n" +
 	"\x14DeleteCompanyRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
-	"\x15DeleteCompanyResponse*g\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"D\n" +
+	"\x15DeleteCompanyResponse\x12+\n" +
+	"\x11deleted_employees\x18\x01 \x01(\x05R\x10deletedEmployees\"\xc5\x01\n" +
+	"\x17ListSubsidiariesRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1c\n" +
+	"\trecursive\x18\x02 \x01(\bR\trecursive\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x121\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x19.company.v1.CompanyStatusR\x06status\"u\n" +
+	"\x18ListSubsidiariesResponse\x121\n" +
+	"\tcompanies\x18\x01 \x03(\v2\x13.company.v1.CompanyR\tcompanies\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"+\n" +
+	"\x19GetCompanyAncestryRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"O\n" +
+	"\x1aGetCompanyAncestryResponse\x121\n" +
+	"\tancestors\x18\x01 \x03(\v2\x13.company.v1.CompanyR\tancestors\"\xac\x02\n" +
+	"\x11EmployeeAttribute\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.company.v1.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x05 \x03(\tR\rallowedValues\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc3\x01\n" +
+	"\x1eCreateEmployeeAttributeRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.company.v1.AttributeTypeR\x04type\x12\x1a\n" +
+	"\brequired\x18\x04 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x05 \x03(\tR\rallowedValues\"^\n" +
+	"\x1fCreateEmployeeAttributeResponse\x12;\n" +
+	"\tattribute\x18\x01 \x01(\v2\x1d.company.v1.EmployeeAttributeR\tattribute\"\x94\x01\n" +
+	"\x1eUpdateEmployeeAttributeRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\x12\x1a\n" +
+	"\brequired\x18\x03 \x01(\bR\brequired\x12%\n" +
+	"\x0eallowed_values\x18\x04 \x03(\tR\rallowedValues\"^\n" +
+	"\x1fUpdateEmployeeAttributeResponse\x12;\n" +
+	"\tattribute\x18\x01 \x01(\v2\x1d.company.v1.EmployeeAttributeR\tattribute\"Q\n" +
+	"\x1eDeleteEmployeeAttributeRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x10\n" +
+	"\x03key\x18\x02 \x01(\tR\x03key\"!\n" +
+	"\x1fDeleteEmployeeAttributeResponse\">\n" +
+	"\x1dListEmployeeAttributesRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\"_\n" +
+	"\x1eListEmployeeAttributesResponse\x12=\n" +
+	"\n" +
+	"attributes\x18\x01 \x03(\v2\x1d.company.v1.EmployeeAttributeR\n" +
+	"attributes\"\xb7\x01\n" +
+	"\x18AddCompanyAddressRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12+\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x17.company.v1.AddressTypeR\x04type\x12\x18\n" +
+	"\acountry\x18\x03 \x01(\tR\acountry\x12\x1f\n" +
+	"\vpostal_code\x18\x04 \x01(\tR\n" +
+	"postalCode\x12\x14\n" +
+	"\x05lines\x18\x05 \x03(\tR\x05lines\"J\n" +
+	"\x19AddCompanyAddressResponse\x12-\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"[\n" +
+	"\x1bRemoveCompanyAddressRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1d\n" +
+	"\n" +
+	"address_id\x18\x02 \x01(\tR\taddressId\"M\n" +
+	"\x1cRemoveCompanyAddressResponse\x12-\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"O\n" +
+	"\x16AddCompanyPhoneRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\tR\x06number\"H\n" +
+	"\x17AddCompanyPhoneResponse\x12-\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany\"U\n" +
+	"\x19RemoveCompanyPhoneRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x19\n" +
+	"\bphone_id\x18\x02 \x01(\tR\aphoneId\"K\n" +
+	"\x1aRemoveCompanyPhoneResponse\x12-\n" +
+	"\acompany\x18\x01 \x01(\v2\x13.company.v1.CompanyR\acompany*g\n" +
 	"\rCompanyStatus\x12\x1e\n" +
 	"\x1aCOMPANY_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15COMPANY_STATUS_ACTIVE\x10\x01\x12\x1b\n" +
-	"\x17COMPANY_STATUS_INACTIVE\x10\x022\xb5\x03\n" +
+	"\x17COMPANY_STATUS_INACTIVE\x10\x02*\x97\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_DATE\x10\x03\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_ENUM\x10\x04*{\n" +
+	"\vAddressType\x12\x1c\n" +
+	"\x18ADDRESS_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ADDRESS_TYPE_BILLING\x10\x01\x12\x1b\n" +
+	"\x17ADDRESS_TYPE_REGISTERED\x10\x02\x12\x17\n" +
+	"\x13ADDRESS_TYPE_OFFICE\x10\x032\xd4\v\n" +
 	"\x0eCompanyService\x12T\n" +
 	"\rCreateCompany\x12 .company.v1.CreateCompanyRequest\x1a!.company.v1.CreateCompanyResponse\x12K\n" +
 	"\n" +
 	"GetCompany\x12\x1d.company.v1.GetCompanyRequest\x1a\x1e.company.v1.GetCompanyResponse\x12T\n" +
 	"\rListCompanies\x12 .company.v1.ListCompaniesRequest\x1a!.company.v1.ListCompaniesResponse\x12T\n" +
 	"\rUpdateCompany\x12 .company.v1.UpdateCompanyRequest\x1a!.company.v1.UpdateCompanyResponse\x12T\n" +
-	"\rDeleteCompany\x12 .company.v1.DeleteCompanyRequest\x1a!.company.v1.DeleteCompanyResponseB^Z\\github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1;companypbb\x06proto3"
+	"\rDeleteCompany\x12 .company.v1.DeleteCompanyRequest\x1a!.company.v1.DeleteCompanyResponse\x12]\n" +
+	"\x10ListSubsidiaries\x12#.company.v1.ListSubsidiariesRequest\x1a$.company.v1.ListSubsidiariesResponse\x12c\n" +
+	"\x12GetCompanyAncestry\x12%.company.v1.GetCompanyAncestryRequest\x1a&.company.v1.GetCompanyAncestryResponse\x12r\n" +
+	"\x17CreateEmployeeAttribute\x12*.company.v1.CreateEmployeeAttributeRequest\x1a+.company.v1.CreateEmployeeAttributeResponse\x12r\n" +
+	"\x17UpdateEmployeeAttribute\x12*.company.v1.UpdateEmployeeAttributeRequest\x1a+.company.v1.UpdateEmployeeAttributeResponse\x12r\n" +
+	"\x17DeleteEmployeeAttribute\x12*.company.v1.DeleteEmployeeAttributeRequest\x1a+.company.v1.DeleteEmployeeAttributeResponse\x12o\n" +
+	"\x16ListEmployeeAttributes\x12).company.v1.ListEmployeeAttributesRequest\x1a*.company.v1.ListEmployeeAttributesResponse\x12`\n" +
+	"\x11AddCompanyAddress\x12$.company.v1.AddCompanyAddressRequest\x1a%.company.v1.AddCompanyAddressResponse\x12i\n" +
+	"\x14RemoveCompanyAddress\x12'.company.v1.RemoveCompanyAddressRequest\x1a(.company.v1.RemoveCompanyAddressResponse\x12Z\n" +
+	"\x0fAddCompanyPhone\x12\".company.v1.AddCompanyPhoneRequest\x1a#.company.v1.AddCompanyPhoneResponse\x12c\n" +
+	"\x12RemoveCompanyPhone\x12%.company.v1.RemoveCompanyPhoneRequest\x1a&.company.v1.RemoveCompanyPhoneResponseB^Z\\github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1;companypbb\x06proto3"
 
 var (
 	file_company_v1_company_proto_rawDescOnce sync.Once
@@ -737,54 +2529,136 @@ func file_company_v1_company_proto_rawDescGZIP() []byte {
 	return file_company_v1_company_proto_rawDescData
 }
 
-var file_company_v1_company_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_company_v1_company_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_company_v1_company_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_company_v1_company_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
 var file_company_v1_company_proto_goTypes = []any{
-	(CompanyStatus)(0),             // 0: company.v1.CompanyStatus
-	(*Company)(nil),                // 1: company.v1.Company
-	(*CreateCompanyRequest)(nil),   // 2: company.v1.CreateCompanyRequest
-	(*CreateCompanyResponse)(nil),  // 3: company.v1.CreateCompanyResponse
-	(*GetCompanyRequest)(nil),      // 4: company.v1.GetCompanyRequest
-	(*GetCompanyResponse)(nil),     // 5: company.v1.GetCompanyResponse
-	(*ListCompaniesRequest)(nil),   // 6: company.v1.ListCompaniesRequest
-	(*ListCompaniesResponse)(nil),  // 7: company.v1.ListCompaniesResponse
-	(*UpdateCompanyRequest)(nil),   // 8: company.v1.UpdateCompanyRequest
-	(*UpdateCompanyResponse)(nil),  // 9: company.v1.UpdateCompanyResponse
-	(*DeleteCompanyRequest)(nil),   // 10: company.v1.DeleteCompanyRequest
-	(*DeleteCompanyResponse)(nil),  // 11: company.v1.DeleteCompanyResponse
-	(*wrapperspb.StringValue)(nil), // 12: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),  // 13: google.protobuf.Timestamp
+	(CompanyStatus)(0),                      // 0: company.v1.CompanyStatus
+	(AttributeType)(0),                      // 1: company.v1.AttributeType
+	(AddressType)(0),                        // 2: company.v1.AddressType
+	(*EmployeeCodePolicy)(nil),              // 3: company.v1.EmployeeCodePolicy
+	(*Company)(nil),                         // 4: company.v1.Company
+	(*Address)(nil),                         // 5: company.v1.Address
+	(*Phone)(nil),                           // 6: company.v1.Phone
+	(*CreateCompanyRequest)(nil),            // 7: company.v1.CreateCompanyRequest
+	(*CreateCompanyResponse)(nil),           // 8: company.v1.CreateCompanyResponse
+	(*GetCompanyRequest)(nil),               // 9: company.v1.GetCompanyRequest
+	(*GetCompanyResponse)(nil),              // 10: company.v1.GetCompanyResponse
+	(*ListCompaniesRequest)(nil),            // 11: company.v1.ListCompaniesRequest
+	(*ListCompaniesResponse)(nil),           // 12: company.v1.ListCompaniesResponse
+	(*UpdateCompanyRequest)(nil),            // 13: company.v1.UpdateCompanyRequest
+	(*UpdateCompanyResponse)(nil),           // 14: company.v1.UpdateCompanyResponse
+	(*DeleteCompanyRequest)(nil),            // 15: company.v1.DeleteCompanyRequest
+	(*DeleteCompanyResponse)(nil),           // 16: company.v1.DeleteCompanyResponse
+	(*ListSubsidiariesRequest)(nil),         // 17: company.v1.ListSubsidiariesRequest
+	(*ListSubsidiariesResponse)(nil),        // 18: company.v1.ListSubsidiariesResponse
+	(*GetCompanyAncestryRequest)(nil),       // 19: company.v1.GetCompanyAncestryRequest
+	(*GetCompanyAncestryResponse)(nil),      // 20: company.v1.GetCompanyAncestryResponse
+	(*EmployeeAttribute)(nil),               // 21: company.v1.EmployeeAttribute
+	(*CreateEmployeeAttributeRequest)(nil),  // 22: company.v1.CreateEmployeeAttributeRequest
+	(*CreateEmployeeAttributeResponse)(nil), // 23: company.v1.CreateEmployeeAttributeResponse
+	(*UpdateEmployeeAttributeRequest)(nil),  // 24: company.v1.UpdateEmployeeAttributeRequest
+	(*UpdateEmployeeAttributeResponse)(nil), // 25: company.v1.UpdateEmployeeAttributeResponse
+	(*DeleteEmployeeAttributeRequest)(nil),  // 26: company.v1.DeleteEmployeeAttributeRequest
+	(*DeleteEmployeeAttributeResponse)(nil), // 27: company.v1.DeleteEmployeeAttributeResponse
+	(*ListEmployeeAttributesRequest)(nil),   // 28: company.v1.ListEmployeeAttributesRequest
+	(*ListEmployeeAttributesResponse)(nil),  // 29: company.v1.ListEmployeeAttributesResponse
+	(*AddCompanyAddressRequest)(nil),        // 30: company.v1.AddCompanyAddressRequest
+	(*AddCompanyAddressResponse)(nil),       // 31: company.v1.AddCompanyAddressResponse
+	(*RemoveCompanyAddressRequest)(nil),     // 32: company.v1.RemoveCompanyAddressRequest
+	(*RemoveCompanyAddressResponse)(nil),    // 33: company.v1.RemoveCompanyAddressResponse
+	(*AddCompanyPhoneRequest)(nil),          // 34: company.v1.AddCompanyPhoneRequest
+	(*AddCompanyPhoneResponse)(nil),         // 35: company.v1.AddCompanyPhoneResponse
+	(*RemoveCompanyPhoneRequest)(nil),       // 36: company.v1.RemoveCompanyPhoneRequest
+	(*RemoveCompanyPhoneResponse)(nil),      // 37: company.v1.RemoveCompanyPhoneResponse
+	nil,                                     // 38: company.v1.Company.LabelsEntry
+	nil,                                     // 39: company.v1.CreateCompanyRequest.LabelsEntry
+	nil,                                     // 40: company.v1.UpdateCompanyRequest.LabelsEntry
+	(*wrapperspb.StringValue)(nil),          // 41: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),           // 42: google.protobuf.Timestamp
 }
 var file_company_v1_company_proto_depIdxs = []int32{
 	0,  // 0: company.v1.Company.status:type_name -> company.v1.CompanyStatus
-	12, // 1: company.v1.Company.description:type_name -> google.protobuf.StringValue
-	13, // 2: company.v1.Company.created_at:type_name -> google.protobuf.Timestamp
-	13, // 3: company.v1.Company.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 4: company.v1.CreateCompanyRequest.description:type_name -> google.protobuf.StringValue
-	1,  // 5: company.v1.CreateCompanyResponse.company:type_name -> company.v1.Company
-	1,  // 6: company.v1.GetCompanyResponse.company:type_name -> company.v1.Company
-	0,  // 7: company.v1.ListCompaniesRequest.status:type_name -> company.v1.CompanyStatus
-	1,  // 8: company.v1.ListCompaniesResponse.companies:type_name -> company.v1.Company
-	12, // 9: company.v1.UpdateCompanyRequest.name:type_name -> google.protobuf.StringValue
-	12, // 10: company.v1.UpdateCompanyRequest.code:type_name -> google.protobuf.StringValue
-	0,  // 11: company.v1.UpdateCompanyRequest.status:type_name -> company.v1.CompanyStatus
-	12, // 12: company.v1.UpdateCompanyRequest.description:type_name -> google.protobuf.StringValue
-	1,  // 13: company.v1.UpdateCompanyResponse.company:type_name -> company.v1.Company
-	2,  // 14: company.v1.CompanyService.CreateCompany:input_type -> company.v1.CreateCompanyRequest
-	4,  // 15: company.v1.CompanyService.GetCompany:input_type -> company.v1.GetCompanyRequest
-	6,  // 16: company.v1.CompanyService.ListCompanies:input_type -> company.v1.ListCompaniesRequest
-	8,  // 17: company.v1.CompanyService.UpdateCompany:input_type -> company.v1.UpdateCompanyRequest
-	10, // 18: company.v1.CompanyService.DeleteCompany:input_type -> company.v1.DeleteCompanyRequest
-	3,  // 19: company.v1.CompanyService.CreateCompany:output_type -> company.v1.CreateCompanyResponse
-	5,  // 20: company.v1.CompanyService.GetCompany:output_type -> company.v1.GetCompanyResponse
-	7,  // 21: company.v1.CompanyService.ListCompanies:output_type -> company.v1.ListCompaniesResponse
-	9,  // 22: company.v1.CompanyService.UpdateCompany:output_type -> company.v1.UpdateCompanyResponse
-	11, // 23: company.v1.CompanyService.DeleteCompany:output_type -> company.v1.DeleteCompanyResponse
-	19, // [19:24] is the sub-list for method output_type
-	14, // [14:19] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	41, // 1: company.v1.Company.description:type_name -> google.protobuf.StringValue
+	42, // 2: company.v1.Company.created_at:type_name -> google.protobuf.Timestamp
+	42, // 3: company.v1.Company.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 4: company.v1.Company.parent_company_id:type_name -> google.protobuf.StringValue
+	3,  // 5: company.v1.Company.employee_code_policy:type_name -> company.v1.EmployeeCodePolicy
+	38, // 6: company.v1.Company.labels:type_name -> company.v1.Company.LabelsEntry
+	41, // 7: company.v1.Company.website:type_name -> google.protobuf.StringValue
+	5,  // 8: company.v1.Company.addresses:type_name -> company.v1.Address
+	6,  // 9: company.v1.Company.phones:type_name -> company.v1.Phone
+	2,  // 10: company.v1.Address.type:type_name -> company.v1.AddressType
+	42, // 11: company.v1.Address.created_at:type_name -> google.protobuf.Timestamp
+	42, // 12: company.v1.Phone.created_at:type_name -> google.protobuf.Timestamp
+	41, // 13: company.v1.CreateCompanyRequest.description:type_name -> google.protobuf.StringValue
+	41, // 14: company.v1.CreateCompanyRequest.parent_company_id:type_name -> google.protobuf.StringValue
+	3,  // 15: company.v1.CreateCompanyRequest.employee_code_policy:type_name -> company.v1.EmployeeCodePolicy
+	39, // 16: company.v1.CreateCompanyRequest.labels:type_name -> company.v1.CreateCompanyRequest.LabelsEntry
+	41, // 17: company.v1.CreateCompanyRequest.website:type_name -> google.protobuf.StringValue
+	4,  // 18: company.v1.CreateCompanyResponse.company:type_name -> company.v1.Company
+	4,  // 19: company.v1.GetCompanyResponse.company:type_name -> company.v1.Company
+	0,  // 20: company.v1.ListCompaniesRequest.status:type_name -> company.v1.CompanyStatus
+	4,  // 21: company.v1.ListCompaniesResponse.companies:type_name -> company.v1.Company
+	41, // 22: company.v1.UpdateCompanyRequest.name:type_name -> google.protobuf.StringValue
+	41, // 23: company.v1.UpdateCompanyRequest.code:type_name -> google.protobuf.StringValue
+	0,  // 24: company.v1.UpdateCompanyRequest.status:type_name -> company.v1.CompanyStatus
+	41, // 25: company.v1.UpdateCompanyRequest.description:type_name -> google.protobuf.StringValue
+	41, // 26: company.v1.UpdateCompanyRequest.parent_company_id:type_name -> google.protobuf.StringValue
+	41, // 27: company.v1.UpdateCompanyRequest.employees_terminated_at:type_name -> google.protobuf.StringValue
+	3,  // 28: company.v1.UpdateCompanyRequest.employee_code_policy:type_name -> company.v1.EmployeeCodePolicy
+	40, // 29: company.v1.UpdateCompanyRequest.labels:type_name -> company.v1.UpdateCompanyRequest.LabelsEntry
+	41, // 30: company.v1.UpdateCompanyRequest.website:type_name -> google.protobuf.StringValue
+	4,  // 31: company.v1.UpdateCompanyResponse.company:type_name -> company.v1.Company
+	0,  // 32: company.v1.ListSubsidiariesRequest.status:type_name -> company.v1.CompanyStatus
+	4,  // 33: company.v1.ListSubsidiariesResponse.companies:type_name -> company.v1.Company
+	4,  // 34: company.v1.GetCompanyAncestryResponse.ancestors:type_name -> company.v1.Company
+	1,  // 35: company.v1.EmployeeAttribute.type:type_name -> company.v1.AttributeType
+	42, // 36: company.v1.EmployeeAttribute.created_at:type_name -> google.protobuf.Timestamp
+	42, // 37: company.v1.EmployeeAttribute.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 38: company.v1.CreateEmployeeAttributeRequest.type:type_name -> company.v1.AttributeType
+	21, // 39: company.v1.CreateEmployeeAttributeResponse.attribute:type_name -> company.v1.EmployeeAttribute
+	21, // 40: company.v1.UpdateEmployeeAttributeResponse.attribute:type_name -> company.v1.EmployeeAttribute
+	21, // 41: company.v1.ListEmployeeAttributesResponse.attributes:type_name -> company.v1.EmployeeAttribute
+	2,  // 42: company.v1.AddCompanyAddressRequest.type:type_name -> company.v1.AddressType
+	4,  // 43: company.v1.AddCompanyAddressResponse.company:type_name -> company.v1.Company
+	4,  // 44: company.v1.RemoveCompanyAddressResponse.company:type_name -> company.v1.Company
+	4,  // 45: company.v1.AddCompanyPhoneResponse.company:type_name -> company.v1.Company
+	4,  // 46: company.v1.RemoveCompanyPhoneResponse.company:type_name -> company.v1.Company
+	7,  // 47: company.v1.CompanyService.CreateCompany:input_type -> company.v1.CreateCompanyRequest
+	9,  // 48: company.v1.CompanyService.GetCompany:input_type -> company.v1.GetCompanyRequest
+	11, // 49: company.v1.CompanyService.ListCompanies:input_type -> company.v1.ListCompaniesRequest
+	13, // 50: company.v1.CompanyService.UpdateCompany:input_type -> company.v1.UpdateCompanyRequest
+	15, // 51: company.v1.CompanyService.DeleteCompany:input_type -> company.v1.DeleteCompanyRequest
+	17, // 52: company.v1.CompanyService.ListSubsidiaries:input_type -> company.v1.ListSubsidiariesRequest
+	19, // 53: company.v1.CompanyService.GetCompanyAncestry:input_type -> company.v1.GetCompanyAncestryRequest
+	22, // 54: company.v1.CompanyService.CreateEmployeeAttribute:input_type -> company.v1.CreateEmployeeAttributeRequest
+	24, // 55: company.v1.CompanyService.UpdateEmployeeAttribute:input_type -> company.v1.UpdateEmployeeAttributeRequest
+	26, // 56: company.v1.CompanyService.DeleteEmployeeAttribute:input_type -> company.v1.DeleteEmployeeAttributeRequest
+	28, // 57: company.v1.CompanyService.ListEmployeeAttributes:input_type -> company.v1.ListEmployeeAttributesRequest
+	30, // 58: company.v1.CompanyService.AddCompanyAddress:input_type -> company.v1.AddCompanyAddressRequest
+	32, // 59: company.v1.CompanyService.RemoveCompanyAddress:input_type -> company.v1.RemoveCompanyAddressRequest
+	34, // 60: company.v1.CompanyService.AddCompanyPhone:input_type -> company.v1.AddCompanyPhoneRequest
+	36, // 61: company.v1.CompanyService.RemoveCompanyPhone:input_type -> company.v1.RemoveCompanyPhoneRequest
+	8,  // 62: company.v1.CompanyService.CreateCompany:output_type -> company.v1.CreateCompanyResponse
+	10, // 63: company.v1.CompanyService.GetCompany:output_type -> company.v1.GetCompanyResponse
+	12, // 64: company.v1.CompanyService.ListCompanies:output_type -> company.v1.ListCompaniesResponse
+	14, // 65: company.v1.CompanyService.UpdateCompany:output_type -> company.v1.UpdateCompanyResponse
+	16, // 66: company.v1.CompanyService.DeleteCompany:output_type -> company.v1.DeleteCompanyResponse
+	18, // 67: company.v1.CompanyService.ListSubsidiaries:output_type -> company.v1.ListSubsidiariesResponse
+	20, // 68: company.v1.CompanyService.GetCompanyAncestry:output_type -> company.v1.GetCompanyAncestryResponse
+	23, // 69: company.v1.CompanyService.CreateEmployeeAttribute:output_type -> company.v1.CreateEmployeeAttributeResponse
+	25, // 70: company.v1.CompanyService.UpdateEmployeeAttribute:output_type -> company.v1.UpdateEmployeeAttributeResponse
+	27, // 71: company.v1.CompanyService.DeleteEmployeeAttribute:output_type -> company.v1.DeleteEmployeeAttributeResponse
+	29, // 72: company.v1.CompanyService.ListEmployeeAttributes:output_type -> company.v1.ListEmployeeAttributesResponse
+	31, // 73: company.v1.CompanyService.AddCompanyAddress:output_type -> company.v1.AddCompanyAddressResponse
+	33, // 74: company.v1.CompanyService.RemoveCompanyAddress:output_type -> company.v1.RemoveCompanyAddressResponse
+	35, // 75: company.v1.CompanyService.AddCompanyPhone:output_type -> company.v1.AddCompanyPhoneResponse
+	37, // 76: company.v1.CompanyService.RemoveCompanyPhone:output_type -> company.v1.RemoveCompanyPhoneResponse
+	62, // [62:77] is the sub-list for method output_type
+	47, // [47:62] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
 }
 
 func init() { file_company_v1_company_proto_init() }
@@ -797,8 +2671,8 @@ func file_company_v1_company_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_company_v1_company_proto_rawDesc), len(file_company_v1_company_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      3,
+			NumMessages:   38,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
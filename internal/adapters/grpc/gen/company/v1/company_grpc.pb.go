@@ -19,11 +19,21 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CompanyService_CreateCompany_FullMethodName = "/company.v1.CompanyService/CreateCompany"
-	CompanyService_GetCompany_FullMethodName    = "/company.v1.CompanyService/GetCompany"
-	CompanyService_ListCompanies_FullMethodName = "/company.v1.CompanyService/ListCompanies"
-	CompanyService_UpdateCompany_FullMethodName = "/company.v1.CompanyService/UpdateCompany"
-	CompanyService_DeleteCompany_FullMethodName = "/company.v1.CompanyService/DeleteCompany"
+	CompanyService_CreateCompany_FullMethodName           = "/company.v1.CompanyService/CreateCompany"
+	CompanyService_GetCompany_FullMethodName              = "/company.v1.CompanyService/GetCompany"
+	CompanyService_ListCompanies_FullMethodName           = "/company.v1.CompanyService/ListCompanies"
+	CompanyService_UpdateCompany_FullMethodName           = "/company.v1.CompanyService/UpdateCompany"
+	CompanyService_DeleteCompany_FullMethodName           = "/company.v1.CompanyService/DeleteCompany"
+	CompanyService_ListSubsidiaries_FullMethodName        = "/company.v1.CompanyService/ListSubsidiaries"
+	CompanyService_GetCompanyAncestry_FullMethodName      = "/company.v1.CompanyService/GetCompanyAncestry"
+	CompanyService_CreateEmployeeAttribute_FullMethodName = "/company.v1.CompanyService/CreateEmployeeAttribute"
+	CompanyService_UpdateEmployeeAttribute_FullMethodName = "/company.v1.CompanyService/UpdateEmployeeAttribute"
+	CompanyService_DeleteEmployeeAttribute_FullMethodName = "/company.v1.CompanyService/DeleteEmployeeAttribute"
+	CompanyService_ListEmployeeAttributes_FullMethodName  = "/company.v1.CompanyService/ListEmployeeAttributes"
+	CompanyService_AddCompanyAddress_FullMethodName       = "/company.v1.CompanyService/AddCompanyAddress"
+	CompanyService_RemoveCompanyAddress_FullMethodName    = "/company.v1.CompanyService/RemoveCompanyAddress"
+	CompanyService_AddCompanyPhone_FullMethodName         = "/company.v1.CompanyService/AddCompanyPhone"
+	CompanyService_RemoveCompanyPhone_FullMethodName      = "/company.v1.CompanyService/RemoveCompanyPhone"
 )
 
 // CompanyServiceClient is the client API for CompanyService service.
@@ -35,6 +45,17 @@ type CompanyServiceClient interface {
 	ListCompanies(ctx context.Context, in *ListCompaniesRequest, opts ...grpc.CallOption) (*ListCompaniesResponse, error)
 	UpdateCompany(ctx context.Context, in *UpdateCompanyRequest, opts ...grpc.CallOption) (*UpdateCompanyResponse, error)
 	DeleteCompany(ctx context.Context, in *DeleteCompanyRequest, opts ...grpc.CallOption) (*DeleteCompanyResponse, error)
+	ListSubsidiaries(ctx context.Context, in *ListSubsidiariesRequest, opts ...grpc.CallOption) (*ListSubsidiariesResponse, error)
+	GetCompanyAncestry(ctx context.Context, in *GetCompanyAncestryRequest, opts ...grpc.CallOption) (*GetCompanyAncestryResponse, error)
+	CreateEmployeeAttribute(ctx context.Context, in *CreateEmployeeAttributeRequest, opts ...grpc.CallOption) (*CreateEmployeeAttributeResponse, error)
+	UpdateEmployeeAttribute(ctx context.Context, in *UpdateEmployeeAttributeRequest, opts ...grpc.CallOption) (*UpdateEmployeeAttributeResponse, error)
+	// 属性の定義を削除し、会社の社員が持つその属性の値も削除します。
+	DeleteEmployeeAttribute(ctx context.Context, in *DeleteEmployeeAttributeRequest, opts ...grpc.CallOption) (*DeleteEmployeeAttributeResponse, error)
+	ListEmployeeAttributes(ctx context.Context, in *ListEmployeeAttributesRequest, opts ...grpc.CallOption) (*ListEmployeeAttributesResponse, error)
+	AddCompanyAddress(ctx context.Context, in *AddCompanyAddressRequest, opts ...grpc.CallOption) (*AddCompanyAddressResponse, error)
+	RemoveCompanyAddress(ctx context.Context, in *RemoveCompanyAddressRequest, opts ...grpc.CallOption) (*RemoveCompanyAddressResponse, error)
+	AddCompanyPhone(ctx context.Context, in *AddCompanyPhoneRequest, opts ...grpc.CallOption) (*AddCompanyPhoneResponse, error)
+	RemoveCompanyPhone(ctx context.Context, in *RemoveCompanyPhoneRequest, opts ...grpc.CallOption) (*RemoveCompanyPhoneResponse, error)
 }
 
 type companyServiceClient struct {
@@ -95,6 +116,106 @@ func (c *companyServiceClient) DeleteCompany(ctx context.Context, in *DeleteComp
 	return out, nil
 }
 
+func (c *companyServiceClient) ListSubsidiaries(ctx context.Context, in *ListSubsidiariesRequest, opts ...grpc.CallOption) (*ListSubsidiariesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubsidiariesResponse)
+	err := c.cc.Invoke(ctx, CompanyService_ListSubsidiaries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) GetCompanyAncestry(ctx context.Context, in *GetCompanyAncestryRequest, opts ...grpc.CallOption) (*GetCompanyAncestryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanyAncestryResponse)
+	err := c.cc.Invoke(ctx, CompanyService_GetCompanyAncestry_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) CreateEmployeeAttribute(ctx context.Context, in *CreateEmployeeAttributeRequest, opts ...grpc.CallOption) (*CreateEmployeeAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateEmployeeAttributeResponse)
+	err := c.cc.Invoke(ctx, CompanyService_CreateEmployeeAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) UpdateEmployeeAttribute(ctx context.Context, in *UpdateEmployeeAttributeRequest, opts ...grpc.CallOption) (*UpdateEmployeeAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateEmployeeAttributeResponse)
+	err := c.cc.Invoke(ctx, CompanyService_UpdateEmployeeAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) DeleteEmployeeAttribute(ctx context.Context, in *DeleteEmployeeAttributeRequest, opts ...grpc.CallOption) (*DeleteEmployeeAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteEmployeeAttributeResponse)
+	err := c.cc.Invoke(ctx, CompanyService_DeleteEmployeeAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) ListEmployeeAttributes(ctx context.Context, in *ListEmployeeAttributesRequest, opts ...grpc.CallOption) (*ListEmployeeAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeAttributesResponse)
+	err := c.cc.Invoke(ctx, CompanyService_ListEmployeeAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) AddCompanyAddress(ctx context.Context, in *AddCompanyAddressRequest, opts ...grpc.CallOption) (*AddCompanyAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCompanyAddressResponse)
+	err := c.cc.Invoke(ctx, CompanyService_AddCompanyAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) RemoveCompanyAddress(ctx context.Context, in *RemoveCompanyAddressRequest, opts ...grpc.CallOption) (*RemoveCompanyAddressResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCompanyAddressResponse)
+	err := c.cc.Invoke(ctx, CompanyService_RemoveCompanyAddress_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) AddCompanyPhone(ctx context.Context, in *AddCompanyPhoneRequest, opts ...grpc.CallOption) (*AddCompanyPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddCompanyPhoneResponse)
+	err := c.cc.Invoke(ctx, CompanyService_AddCompanyPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *companyServiceClient) RemoveCompanyPhone(ctx context.Context, in *RemoveCompanyPhoneRequest, opts ...grpc.CallOption) (*RemoveCompanyPhoneResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveCompanyPhoneResponse)
+	err := c.cc.Invoke(ctx, CompanyService_RemoveCompanyPhone_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CompanyServiceServer is the server API for CompanyService service.
 // All implementations must embed UnimplementedCompanyServiceServer
 // for forward compatibility.
@@ -104,6 +225,17 @@ type CompanyServiceServer interface {
 	ListCompanies(context.Context, *ListCompaniesRequest) (*ListCompaniesResponse, error)
 	UpdateCompany(context.Context, *UpdateCompanyRequest) (*UpdateCompanyResponse, error)
 	DeleteCompany(context.Context, *DeleteCompanyRequest) (*DeleteCompanyResponse, error)
+	ListSubsidiaries(context.Context, *ListSubsidiariesRequest) (*ListSubsidiariesResponse, error)
+	GetCompanyAncestry(context.Context, *GetCompanyAncestryRequest) (*GetCompanyAncestryResponse, error)
+	CreateEmployeeAttribute(context.Context, *CreateEmployeeAttributeRequest) (*CreateEmployeeAttributeResponse, error)
+	UpdateEmployeeAttribute(context.Context, *UpdateEmployeeAttributeRequest) (*UpdateEmployeeAttributeResponse, error)
+	// 属性の定義を削除し、会社の社員が持つその属性の値も削除します。
+	DeleteEmployeeAttribute(context.Context, *DeleteEmployeeAttributeRequest) (*DeleteEmployeeAttributeResponse, error)
+	ListEmployeeAttributes(context.Context, *ListEmployeeAttributesRequest) (*ListEmployeeAttributesResponse, error)
+	AddCompanyAddress(context.Context, *AddCompanyAddressRequest) (*AddCompanyAddressResponse, error)
+	RemoveCompanyAddress(context.Context, *RemoveCompanyAddressRequest) (*RemoveCompanyAddressResponse, error)
+	AddCompanyPhone(context.Context, *AddCompanyPhoneRequest) (*AddCompanyPhoneResponse, error)
+	RemoveCompanyPhone(context.Context, *RemoveCompanyPhoneRequest) (*RemoveCompanyPhoneResponse, error)
 	mustEmbedUnimplementedCompanyServiceServer()
 }
 
@@ -129,6 +261,36 @@ func (UnimplementedCompanyServiceServer) UpdateCompany(context.Context, *UpdateC
 func (UnimplementedCompanyServiceServer) DeleteCompany(context.Context, *DeleteCompanyRequest) (*DeleteCompanyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompany not implemented")
 }
+func (UnimplementedCompanyServiceServer) ListSubsidiaries(context.Context, *ListSubsidiariesRequest) (*ListSubsidiariesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubsidiaries not implemented")
+}
+func (UnimplementedCompanyServiceServer) GetCompanyAncestry(context.Context, *GetCompanyAncestryRequest) (*GetCompanyAncestryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompanyAncestry not implemented")
+}
+func (UnimplementedCompanyServiceServer) CreateEmployeeAttribute(context.Context, *CreateEmployeeAttributeRequest) (*CreateEmployeeAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEmployeeAttribute not implemented")
+}
+func (UnimplementedCompanyServiceServer) UpdateEmployeeAttribute(context.Context, *UpdateEmployeeAttributeRequest) (*UpdateEmployeeAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEmployeeAttribute not implemented")
+}
+func (UnimplementedCompanyServiceServer) DeleteEmployeeAttribute(context.Context, *DeleteEmployeeAttributeRequest) (*DeleteEmployeeAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployeeAttribute not implemented")
+}
+func (UnimplementedCompanyServiceServer) ListEmployeeAttributes(context.Context, *ListEmployeeAttributesRequest) (*ListEmployeeAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployeeAttributes not implemented")
+}
+func (UnimplementedCompanyServiceServer) AddCompanyAddress(context.Context, *AddCompanyAddressRequest) (*AddCompanyAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompanyAddress not implemented")
+}
+func (UnimplementedCompanyServiceServer) RemoveCompanyAddress(context.Context, *RemoveCompanyAddressRequest) (*RemoveCompanyAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyAddress not implemented")
+}
+func (UnimplementedCompanyServiceServer) AddCompanyPhone(context.Context, *AddCompanyPhoneRequest) (*AddCompanyPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCompanyPhone not implemented")
+}
+func (UnimplementedCompanyServiceServer) RemoveCompanyPhone(context.Context, *RemoveCompanyPhoneRequest) (*RemoveCompanyPhoneResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCompanyPhone not implemented")
+}
 func (UnimplementedCompanyServiceServer) mustEmbedUnimplementedCompanyServiceServer() {}
 func (UnimplementedCompanyServiceServer) testEmbeddedByValue()                        {}
 
@@ -240,6 +402,186 @@ func _CompanyService_DeleteCompany_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CompanyService_ListSubsidiaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubsidiariesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).ListSubsidiaries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_ListSubsidiaries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).ListSubsidiaries(ctx, req.(*ListSubsidiariesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_GetCompanyAncestry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanyAncestryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).GetCompanyAncestry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_GetCompanyAncestry_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).GetCompanyAncestry(ctx, req.(*GetCompanyAncestryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_CreateEmployeeAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEmployeeAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).CreateEmployeeAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_CreateEmployeeAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).CreateEmployeeAttribute(ctx, req.(*CreateEmployeeAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_UpdateEmployeeAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateEmployeeAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).UpdateEmployeeAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_UpdateEmployeeAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).UpdateEmployeeAttribute(ctx, req.(*UpdateEmployeeAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_DeleteEmployeeAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEmployeeAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).DeleteEmployeeAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_DeleteEmployeeAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).DeleteEmployeeAttribute(ctx, req.(*DeleteEmployeeAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_ListEmployeeAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).ListEmployeeAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_ListEmployeeAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).ListEmployeeAttributes(ctx, req.(*ListEmployeeAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_AddCompanyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCompanyAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).AddCompanyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_AddCompanyAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).AddCompanyAddress(ctx, req.(*AddCompanyAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_RemoveCompanyAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).RemoveCompanyAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_RemoveCompanyAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).RemoveCompanyAddress(ctx, req.(*RemoveCompanyAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_AddCompanyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCompanyPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).AddCompanyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_AddCompanyPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).AddCompanyPhone(ctx, req.(*AddCompanyPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CompanyService_RemoveCompanyPhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCompanyPhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CompanyServiceServer).RemoveCompanyPhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CompanyService_RemoveCompanyPhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CompanyServiceServer).RemoveCompanyPhone(ctx, req.(*RemoveCompanyPhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CompanyService_ServiceDesc is the grpc.ServiceDesc for CompanyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +609,46 @@ var CompanyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCompany",
 			Handler:    _CompanyService_DeleteCompany_Handler,
 		},
+		{
+			MethodName: "ListSubsidiaries",
+			Handler:    _CompanyService_ListSubsidiaries_Handler,
+		},
+		{
+			MethodName: "GetCompanyAncestry",
+			Handler:    _CompanyService_GetCompanyAncestry_Handler,
+		},
+		{
+			MethodName: "CreateEmployeeAttribute",
+			Handler:    _CompanyService_CreateEmployeeAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateEmployeeAttribute",
+			Handler:    _CompanyService_UpdateEmployeeAttribute_Handler,
+		},
+		{
+			MethodName: "DeleteEmployeeAttribute",
+			Handler:    _CompanyService_DeleteEmployeeAttribute_Handler,
+		},
+		{
+			MethodName: "ListEmployeeAttributes",
+			Handler:    _CompanyService_ListEmployeeAttributes_Handler,
+		},
+		{
+			MethodName: "AddCompanyAddress",
+			Handler:    _CompanyService_AddCompanyAddress_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyAddress",
+			Handler:    _CompanyService_RemoveCompanyAddress_Handler,
+		},
+		{
+			MethodName: "AddCompanyPhone",
+			Handler:    _CompanyService_AddCompanyPhone_Handler,
+		},
+		{
+			MethodName: "RemoveCompanyPhone",
+			Handler:    _CompanyService_RemoveCompanyPhone_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "company/v1/company.proto",
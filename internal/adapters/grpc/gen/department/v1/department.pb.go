@@ -0,0 +1,875 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: department/v1/department.proto
+
+package departmentpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Department struct {
+	state              protoimpl.MessageState  `protogen:"open.v1"`
+	Id                 string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId          string                  `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	ParentDepartmentId *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=parent_department_id,json=parentDepartmentId,proto3" json:"parent_department_id,omitempty"`
+	Name               string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Code               string                  `protobuf:"bytes,5,opt,name=code,proto3" json:"code,omitempty"`
+	CreatedAt          *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp  `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Department) Reset() {
+	*x = Department{}
+	mi := &file_department_v1_department_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Department) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Department) ProtoMessage() {}
+
+func (x *Department) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Department.ProtoReflect.Descriptor instead.
+func (*Department) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Department) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Department) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *Department) GetParentDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentDepartmentId
+	}
+	return nil
+}
+
+func (x *Department) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Department) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Department) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Department) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CreateDepartmentRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code      string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// 未指定の場合は会社直下の部署として作成します。
+	ParentDepartmentId *wrapperspb.StringValue `protobuf:"bytes,4,opt,name=parent_department_id,json=parentDepartmentId,proto3" json:"parent_department_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentRequest) Reset() {
+	*x = CreateDepartmentRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentRequest) ProtoMessage() {}
+
+func (x *CreateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDepartmentRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateDepartmentRequest) GetParentDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentDepartmentId
+	}
+	return nil
+}
+
+type CreateDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDepartmentResponse) Reset() {
+	*x = CreateDepartmentResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDepartmentResponse) ProtoMessage() {}
+
+func (x *CreateDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type GetDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentRequest) Reset() {
+	*x = GetDepartmentRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentRequest) ProtoMessage() {}
+
+func (x *GetDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*GetDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDepartmentResponse) Reset() {
+	*x = GetDepartmentResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDepartmentResponse) ProtoMessage() {}
+
+func (x *GetDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*GetDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type ListDepartmentsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// 指定した場合は直下の部署のみを返します。
+	ParentDepartmentId *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=parent_department_id,json=parentDepartmentId,proto3" json:"parent_department_id,omitempty"`
+	PageSize           int32                   `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken          string                  `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsRequest) Reset() {
+	*x = ListDepartmentsRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsRequest) ProtoMessage() {}
+
+func (x *ListDepartmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDepartmentsRequest) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *ListDepartmentsRequest) GetParentDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentDepartmentId
+	}
+	return nil
+}
+
+func (x *ListDepartmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDepartmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDepartmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Departments   []*Department          `protobuf:"bytes,1,rep,name=departments,proto3" json:"departments,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDepartmentsResponse) Reset() {
+	*x = ListDepartmentsResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDepartmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDepartmentsResponse) ProtoMessage() {}
+
+func (x *ListDepartmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDepartmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDepartmentsResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListDepartmentsResponse) GetDepartments() []*Department {
+	if x != nil {
+		return x.Departments
+	}
+	return nil
+}
+
+func (x *ListDepartmentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type UpdateDepartmentRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentRequest) Reset() {
+	*x = UpdateDepartmentRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentRequest) ProtoMessage() {}
+
+func (x *UpdateDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDepartmentRequest) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *UpdateDepartmentRequest) GetCode() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+type UpdateDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDepartmentResponse) Reset() {
+	*x = UpdateDepartmentResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDepartmentResponse) ProtoMessage() {}
+
+func (x *UpdateDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type MoveDepartmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 未指定または空文字の場合は会社直下へ移動します。
+	ParentDepartmentId *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=parent_department_id,json=parentDepartmentId,proto3" json:"parent_department_id,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MoveDepartmentRequest) Reset() {
+	*x = MoveDepartmentRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDepartmentRequest) ProtoMessage() {}
+
+func (x *MoveDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*MoveDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MoveDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveDepartmentRequest) GetParentDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentDepartmentId
+	}
+	return nil
+}
+
+type MoveDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Department    *Department            `protobuf:"bytes,1,opt,name=department,proto3" json:"department,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveDepartmentResponse) Reset() {
+	*x = MoveDepartmentResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDepartmentResponse) ProtoMessage() {}
+
+func (x *MoveDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*MoveDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MoveDepartmentResponse) GetDepartment() *Department {
+	if x != nil {
+		return x.Department
+	}
+	return nil
+}
+
+type DeleteDepartmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentRequest) Reset() {
+	*x = DeleteDepartmentRequest{}
+	mi := &file_department_v1_department_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentRequest) ProtoMessage() {}
+
+func (x *DeleteDepartmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentRequest) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DeleteDepartmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDepartmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDepartmentResponse) Reset() {
+	*x = DeleteDepartmentResponse{}
+	mi := &file_department_v1_department_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDepartmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDepartmentResponse) ProtoMessage() {}
+
+func (x *DeleteDepartmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_department_v1_department_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDepartmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDepartmentResponse) Descriptor() ([]byte, []int) {
+	return file_department_v1_department_proto_rawDescGZIP(), []int{12}
+}
+
+var File_department_v1_department_proto protoreflect.FileDescriptor
+
+const file_department_v1_department_proto_rawDesc = "" +
+	"\n" +
+	"\x1edepartment/v1/department.proto\x12\rdepartment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xa9\x02\n" +
+	"\n" +
+	"Department\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x02 \x01(\tR\tcompanyId\x12N\n" +
+	"\x14parent_department_id\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x12parentDepartmentId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x05 \x01(\tR\x04code\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xb0\x01\n" +
+	"\x17CreateDepartmentRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12N\n" +
+	"\x14parent_department_id\x18\x04 \x01(\v2\x1c.google.protobuf.StringValueR\x12parentDepartmentId\"U\n" +
+	"\x18CreateDepartmentResponse\x129\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x19.department.v1.DepartmentR\n" +
+	"department\"&\n" +
+	"\x14GetDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
+	"\x15GetDepartmentResponse\x129\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x19.department.v1.DepartmentR\n" +
+	"department\"\xc3\x01\n" +
+	"\x16ListDepartmentsRequest\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x01 \x01(\tR\tcompanyId\x12N\n" +
+	"\x14parent_department_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x12parentDepartmentId\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"~\n" +
+	"\x17ListDepartmentsResponse\x12;\n" +
+	"\vdepartments\x18\x01 \x03(\v2\x19.department.v1.DepartmentR\vdepartments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x8d\x01\n" +
+	"\x17UpdateDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
+	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x120\n" +
+	"\x04code\x18\x03 \x01(\v2\x1c.google.protobuf.StringValueR\x04code\"U\n" +
+	"\x18UpdateDepartmentResponse\x129\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x19.department.v1.DepartmentR\n" +
+	"department\"w\n" +
+	"\x15MoveDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12N\n" +
+	"\x14parent_department_id\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x12parentDepartmentId\"S\n" +
+	"\x16MoveDepartmentResponse\x129\n" +
+	"\n" +
+	"department\x18\x01 \x01(\v2\x19.department.v1.DepartmentR\n" +
+	"department\")\n" +
+	"\x17DeleteDepartmentRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
+	"\x18DeleteDepartmentResponse2\xdf\x04\n" +
+	"\x11DepartmentService\x12c\n" +
+	"\x10CreateDepartment\x12&.department.v1.CreateDepartmentRequest\x1a'.department.v1.CreateDepartmentResponse\x12Z\n" +
+	"\rGetDepartment\x12#.department.v1.GetDepartmentRequest\x1a$.department.v1.GetDepartmentResponse\x12`\n" +
+	"\x0fListDepartments\x12%.department.v1.ListDepartmentsRequest\x1a&.department.v1.ListDepartmentsResponse\x12c\n" +
+	"\x10UpdateDepartment\x12&.department.v1.UpdateDepartmentRequest\x1a'.department.v1.UpdateDepartmentResponse\x12]\n" +
+	"\x0eMoveDepartment\x12$.department.v1.MoveDepartmentRequest\x1a%.department.v1.MoveDepartmentResponse\x12c\n" +
+	"\x10DeleteDepartment\x12&.department.v1.DeleteDepartmentRequest\x1a'.department.v1.DeleteDepartmentResponseBdZbgithub.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/department/v1;departmentpbb\x06proto3"
+
+var (
+	file_department_v1_department_proto_rawDescOnce sync.Once
+	file_department_v1_department_proto_rawDescData []byte
+)
+
+func file_department_v1_department_proto_rawDescGZIP() []byte {
+	file_department_v1_department_proto_rawDescOnce.Do(func() {
+		file_department_v1_department_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_department_v1_department_proto_rawDesc), len(file_department_v1_department_proto_rawDesc)))
+	})
+	return file_department_v1_department_proto_rawDescData
+}
+
+var file_department_v1_department_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_department_v1_department_proto_goTypes = []any{
+	(*Department)(nil),               // 0: department.v1.Department
+	(*CreateDepartmentRequest)(nil),  // 1: department.v1.CreateDepartmentRequest
+	(*CreateDepartmentResponse)(nil), // 2: department.v1.CreateDepartmentResponse
+	(*GetDepartmentRequest)(nil),     // 3: department.v1.GetDepartmentRequest
+	(*GetDepartmentResponse)(nil),    // 4: department.v1.GetDepartmentResponse
+	(*ListDepartmentsRequest)(nil),   // 5: department.v1.ListDepartmentsRequest
+	(*ListDepartmentsResponse)(nil),  // 6: department.v1.ListDepartmentsResponse
+	(*UpdateDepartmentRequest)(nil),  // 7: department.v1.UpdateDepartmentRequest
+	(*UpdateDepartmentResponse)(nil), // 8: department.v1.UpdateDepartmentResponse
+	(*MoveDepartmentRequest)(nil),    // 9: department.v1.MoveDepartmentRequest
+	(*MoveDepartmentResponse)(nil),   // 10: department.v1.MoveDepartmentResponse
+	(*DeleteDepartmentRequest)(nil),  // 11: department.v1.DeleteDepartmentRequest
+	(*DeleteDepartmentResponse)(nil), // 12: department.v1.DeleteDepartmentResponse
+	(*wrapperspb.StringValue)(nil),   // 13: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),    // 14: google.protobuf.Timestamp
+}
+var file_department_v1_department_proto_depIdxs = []int32{
+	13, // 0: department.v1.Department.parent_department_id:type_name -> google.protobuf.StringValue
+	14, // 1: department.v1.Department.created_at:type_name -> google.protobuf.Timestamp
+	14, // 2: department.v1.Department.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 3: department.v1.CreateDepartmentRequest.parent_department_id:type_name -> google.protobuf.StringValue
+	0,  // 4: department.v1.CreateDepartmentResponse.department:type_name -> department.v1.Department
+	0,  // 5: department.v1.GetDepartmentResponse.department:type_name -> department.v1.Department
+	13, // 6: department.v1.ListDepartmentsRequest.parent_department_id:type_name -> google.protobuf.StringValue
+	0,  // 7: department.v1.ListDepartmentsResponse.departments:type_name -> department.v1.Department
+	13, // 8: department.v1.UpdateDepartmentRequest.name:type_name -> google.protobuf.StringValue
+	13, // 9: department.v1.UpdateDepartmentRequest.code:type_name -> google.protobuf.StringValue
+	0,  // 10: department.v1.UpdateDepartmentResponse.department:type_name -> department.v1.Department
+	13, // 11: department.v1.MoveDepartmentRequest.parent_department_id:type_name -> google.protobuf.StringValue
+	0,  // 12: department.v1.MoveDepartmentResponse.department:type_name -> department.v1.Department
+	1,  // 13: department.v1.DepartmentService.CreateDepartment:input_type -> department.v1.CreateDepartmentRequest
+	3,  // 14: department.v1.DepartmentService.GetDepartment:input_type -> department.v1.GetDepartmentRequest
+	5,  // 15: department.v1.DepartmentService.ListDepartments:input_type -> department.v1.ListDepartmentsRequest
+	7,  // 16: department.v1.DepartmentService.UpdateDepartment:input_type -> department.v1.UpdateDepartmentRequest
+	9,  // 17: department.v1.DepartmentService.MoveDepartment:input_type -> department.v1.MoveDepartmentRequest
+	11, // 18: department.v1.DepartmentService.DeleteDepartment:input_type -> department.v1.DeleteDepartmentRequest
+	2,  // 19: department.v1.DepartmentService.CreateDepartment:output_type -> department.v1.CreateDepartmentResponse
+	4,  // 20: department.v1.DepartmentService.GetDepartment:output_type -> department.v1.GetDepartmentResponse
+	6,  // 21: department.v1.DepartmentService.ListDepartments:output_type -> department.v1.ListDepartmentsResponse
+	8,  // 22: department.v1.DepartmentService.UpdateDepartment:output_type -> department.v1.UpdateDepartmentResponse
+	10, // 23: department.v1.DepartmentService.MoveDepartment:output_type -> department.v1.MoveDepartmentResponse
+	12, // 24: department.v1.DepartmentService.DeleteDepartment:output_type -> department.v1.DeleteDepartmentResponse
+	19, // [19:25] is the sub-list for method output_type
+	13, // [13:19] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
+}
+
+func init() { file_department_v1_department_proto_init() }
+func file_department_v1_department_proto_init() {
+	if File_department_v1_department_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_department_v1_department_proto_rawDesc), len(file_department_v1_department_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_department_v1_department_proto_goTypes,
+		DependencyIndexes: file_department_v1_department_proto_depIdxs,
+		MessageInfos:      file_department_v1_department_proto_msgTypes,
+	}.Build()
+	File_department_v1_department_proto = out.File
+	file_department_v1_department_proto_goTypes = nil
+	file_department_v1_department_proto_depIdxs = nil
+}
@@ -7,6 +7,7 @@
 package employeepb
 
 import (
+	v11 "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1"
 	v1 "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -29,7 +30,13 @@ type EmployeeStatus int32
 const (
 	EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED EmployeeStatus = 0
 	EmployeeStatus_EMPLOYEE_STATUS_ACTIVE      EmployeeStatus = 1
-	EmployeeStatus_EMPLOYEE_STATUS_INACTIVE    EmployeeStatus = 2
+	// 互換性のために残しています。リクエストでは TERMINATED と同じ扱いで、レスポンスには使われません。
+	//
+	// Deprecated: Marked as deprecated in employee/v1/employee.proto.
+	EmployeeStatus_EMPLOYEE_STATUS_INACTIVE   EmployeeStatus = 2
+	EmployeeStatus_EMPLOYEE_STATUS_PENDING    EmployeeStatus = 3
+	EmployeeStatus_EMPLOYEE_STATUS_ON_LEAVE   EmployeeStatus = 4
+	EmployeeStatus_EMPLOYEE_STATUS_TERMINATED EmployeeStatus = 5
 )
 
 // Enum value maps for EmployeeStatus.
@@ -38,11 +45,17 @@ var (
 		0: "EMPLOYEE_STATUS_UNSPECIFIED",
 		1: "EMPLOYEE_STATUS_ACTIVE",
 		2: "EMPLOYEE_STATUS_INACTIVE",
+		3: "EMPLOYEE_STATUS_PENDING",
+		4: "EMPLOYEE_STATUS_ON_LEAVE",
+		5: "EMPLOYEE_STATUS_TERMINATED",
 	}
 	EmployeeStatus_value = map[string]int32{
 		"EMPLOYEE_STATUS_UNSPECIFIED": 0,
 		"EMPLOYEE_STATUS_ACTIVE":      1,
 		"EMPLOYEE_STATUS_INACTIVE":    2,
+		"EMPLOYEE_STATUS_PENDING":     3,
+		"EMPLOYEE_STATUS_ON_LEAVE":    4,
+		"EMPLOYEE_STATUS_TERMINATED":  5,
 	}
 )
 
@@ -74,17 +87,23 @@ func (EmployeeStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type Employee struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CompanyId     string                  `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	EmployeeCode  string                  `protobuf:"bytes,3,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
-	Status        EmployeeStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
-	HiredAt       *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
-	TerminatedAt  *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
-	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UserId        string                  `protobuf:"bytes,12,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	User          *UserSummary            `protobuf:"bytes,13,opt,name=user,proto3" json:"user,omitempty"`
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CompanyId         string                  `protobuf:"bytes,2,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeCode      string                  `protobuf:"bytes,3,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
+	Status            EmployeeStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	HiredAt           *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
+	TerminatedAt      *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
+	CreatedAt         *timestamppb.Timestamp  `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt         *timestamppb.Timestamp  `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId            string                  `protobuf:"bytes,12,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	User              *UserSummary            `protobuf:"bytes,13,opt,name=user,proto3" json:"user,omitempty"`
+	DepartmentId      *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	ManagerEmployeeId *wrapperspb.StringValue `protobuf:"bytes,15,opt,name=manager_employee_id,json=managerEmployeeId,proto3" json:"manager_employee_id,omitempty"`
+	// 転籍で作成された社員の場合、転籍元の社員 ID が入ります。
+	TransferredFromEmployeeId *wrapperspb.StringValue `protobuf:"bytes,16,opt,name=transferred_from_employee_id,json=transferredFromEmployeeId,proto3" json:"transferred_from_employee_id,omitempty"`
+	// 会社が定義した社員属性の値です。
+	Attributes    map[string]string `protobuf:"bytes,17,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -189,14 +208,45 @@ func (x *Employee) GetUser() *UserSummary {
 	return nil
 }
 
+func (x *Employee) GetDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return nil
+}
+
+func (x *Employee) GetManagerEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ManagerEmployeeId
+	}
+	return nil
+}
+
+func (x *Employee) GetTransferredFromEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TransferredFromEmployeeId
+	}
+	return nil
+}
+
+func (x *Employee) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type UserSummary struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Status        v1.UserStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email       string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name        string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status      v1.UserStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DisplayName string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// BCP 47 の言語タグです。未設定の場合は空文字です。
+	Locale        string `protobuf:"bytes,8,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -273,21 +323,171 @@ func (x *UserSummary) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *UserSummary) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *UserSummary) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type CompanySummary struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	Id              string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name            string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code            string                  `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Status          v11.CompanyStatus       `protobuf:"varint,4,opt,name=status,proto3,enum=company.v1.CompanyStatus" json:"status,omitempty"`
+	ParentCompanyId *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=parent_company_id,json=parentCompanyId,proto3" json:"parent_company_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompanySummary) Reset() {
+	*x = CompanySummary{}
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanySummary) ProtoMessage() {}
+
+func (x *CompanySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanySummary.ProtoReflect.Descriptor instead.
+func (*CompanySummary) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompanySummary) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CompanySummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CompanySummary) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CompanySummary) GetStatus() v11.CompanyStatus {
+	if x != nil {
+		return x.Status
+	}
+	return v11.CompanyStatus(0)
+}
+
+func (x *CompanySummary) GetParentCompanyId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ParentCompanyId
+	}
+	return nil
+}
+
+// Employment はユーザーの社員レコードと所属会社の概要の組です。
+type Employment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Company       *CompanySummary        `protobuf:"bytes,2,opt,name=company,proto3" json:"company,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Employment) Reset() {
+	*x = Employment{}
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Employment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Employment) ProtoMessage() {}
+
+func (x *Employment) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Employment.ProtoReflect.Descriptor instead.
+func (*Employment) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Employment) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *Employment) GetCompany() *CompanySummary {
+	if x != nil {
+		return x.Company
+	}
+	return nil
+}
+
 type CreateEmployeeRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	CompanyId     string                  `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	EmployeeCode  string                  `protobuf:"bytes,2,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
-	Status        EmployeeStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
-	HiredAt       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
-	TerminatedAt  *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
-	UserId        string                  `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	// 空文字の場合は会社の採番規則から払い出します（再雇用の場合は以前の社員コードを引き継ぎます）。
+	EmployeeCode string                  `protobuf:"bytes,2,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
+	Status       EmployeeStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	HiredAt      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
+	TerminatedAt *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
+	UserId       string                  `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 社員と同じ会社に属する部署のみ指定できます。
+	DepartmentId *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// 社員と同じ会社に属する社員のみ上長に指定できます。
+	ManagerEmployeeId *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=manager_employee_id,json=managerEmployeeId,proto3" json:"manager_employee_id,omitempty"`
+	// 会社が定義した社員属性の値です。再雇用で省略した場合は以前の値を引き継ぎます。
+	Attributes    map[string]string `protobuf:"bytes,12,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateEmployeeRequest) Reset() {
 	*x = CreateEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -299,7 +499,7 @@ func (x *CreateEmployeeRequest) String() string {
 func (*CreateEmployeeRequest) ProtoMessage() {}
 
 func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[2]
+	mi := &file_employee_v1_employee_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -312,7 +512,7 @@ func (x *CreateEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*CreateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{2}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateEmployeeRequest) GetCompanyId() string {
@@ -357,6 +557,27 @@ func (x *CreateEmployeeRequest) GetUserId() string {
 	return ""
 }
 
+func (x *CreateEmployeeRequest) GetDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetManagerEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ManagerEmployeeId
+	}
+	return nil
+}
+
+func (x *CreateEmployeeRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type CreateEmployeeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
@@ -366,7 +587,7 @@ type CreateEmployeeResponse struct {
 
 func (x *CreateEmployeeResponse) Reset() {
 	*x = CreateEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -378,7 +599,7 @@ func (x *CreateEmployeeResponse) String() string {
 func (*CreateEmployeeResponse) ProtoMessage() {}
 
 func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[3]
+	mi := &file_employee_v1_employee_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -391,7 +612,7 @@ func (x *CreateEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*CreateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{3}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateEmployeeResponse) GetEmployee() *Employee {
@@ -402,15 +623,17 @@ func (x *CreateEmployeeResponse) GetEmployee() *Employee {
 }
 
 type GetEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// YYYY-MM-DD を指定すると、その日付時点の社員レコードを返します。
+	AsOf          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetEmployeeRequest) Reset() {
 	*x = GetEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -422,7 +645,7 @@ func (x *GetEmployeeRequest) String() string {
 func (*GetEmployeeRequest) ProtoMessage() {}
 
 func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[4]
+	mi := &file_employee_v1_employee_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -435,7 +658,7 @@ func (x *GetEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*GetEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{4}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetEmployeeRequest) GetId() string {
@@ -445,6 +668,13 @@ func (x *GetEmployeeRequest) GetId() string {
 	return ""
 }
 
+func (x *GetEmployeeRequest) GetAsOf() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
 type GetEmployeeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
@@ -454,7 +684,7 @@ type GetEmployeeResponse struct {
 
 func (x *GetEmployeeResponse) Reset() {
 	*x = GetEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -466,7 +696,7 @@ func (x *GetEmployeeResponse) String() string {
 func (*GetEmployeeResponse) ProtoMessage() {}
 
 func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[5]
+	mi := &file_employee_v1_employee_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -479,7 +709,7 @@ func (x *GetEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*GetEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{5}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetEmployeeResponse) GetEmployee() *Employee {
@@ -490,18 +720,26 @@ func (x *GetEmployeeResponse) GetEmployee() *Employee {
 }
 
 type ListEmployeesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CompanyId     string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Status        EmployeeStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	CompanyId string                 `protobuf:"bytes,1,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	PageSize  int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status    EmployeeStatus         `protobuf:"varint,4,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	// true の場合は company_id 配下の子会社（孫会社以下を含む）の社員も返します。
+	IncludeSubsidiaries bool `protobuf:"varint,5,opt,name=include_subsidiaries,json=includeSubsidiaries,proto3" json:"include_subsidiaries,omitempty"`
+	// 指定した部署に所属する社員のみを返します。
+	DepartmentId string `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// true の場合は department_id 配下の部署に所属する社員も返します。
+	IncludeSubDepartments bool `protobuf:"varint,7,opt,name=include_sub_departments,json=includeSubDepartments,proto3" json:"include_sub_departments,omitempty"`
+	// 指定したすべての属性の値が一致する社員のみを返します。
+	Attributes    map[string]string `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListEmployeesRequest) Reset() {
 	*x = ListEmployeesRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -513,7 +751,7 @@ func (x *ListEmployeesRequest) String() string {
 func (*ListEmployeesRequest) ProtoMessage() {}
 
 func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[6]
+	mi := &file_employee_v1_employee_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -526,7 +764,7 @@ func (x *ListEmployeesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEmployeesRequest.ProtoReflect.Descriptor instead.
 func (*ListEmployeesRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{6}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListEmployeesRequest) GetCompanyId() string {
@@ -557,6 +795,34 @@ func (x *ListEmployeesRequest) GetStatus() EmployeeStatus {
 	return EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED
 }
 
+func (x *ListEmployeesRequest) GetIncludeSubsidiaries() bool {
+	if x != nil {
+		return x.IncludeSubsidiaries
+	}
+	return false
+}
+
+func (x *ListEmployeesRequest) GetDepartmentId() string {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return ""
+}
+
+func (x *ListEmployeesRequest) GetIncludeSubDepartments() bool {
+	if x != nil {
+		return x.IncludeSubDepartments
+	}
+	return false
+}
+
+func (x *ListEmployeesRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type ListEmployeesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
@@ -567,7 +833,7 @@ type ListEmployeesResponse struct {
 
 func (x *ListEmployeesResponse) Reset() {
 	*x = ListEmployeesResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -579,7 +845,7 @@ func (x *ListEmployeesResponse) String() string {
 func (*ListEmployeesResponse) ProtoMessage() {}
 
 func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[7]
+	mi := &file_employee_v1_employee_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -592,7 +858,7 @@ func (x *ListEmployeesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListEmployeesResponse.ProtoReflect.Descriptor instead.
 func (*ListEmployeesResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{7}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListEmployeesResponse) GetEmployees() []*Employee {
@@ -610,20 +876,29 @@ func (x *ListEmployeesResponse) GetNextPageToken() string {
 }
 
 type UpdateEmployeeRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	EmployeeCode  *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
-	Status        EmployeeStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
-	HiredAt       *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
-	TerminatedAt  *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
-	UserId        *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	Id           string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeCode *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
+	Status       EmployeeStatus          `protobuf:"varint,6,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	HiredAt      *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
+	TerminatedAt *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
+	UserId       *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// 空文字を指定すると部署への所属を解除します。
+	DepartmentId *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// 空文字を指定すると上長を解除します。報告ラインが循環する指定はできません。
+	ManagerEmployeeId *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=manager_employee_id,json=managerEmployeeId,proto3" json:"manager_employee_id,omitempty"`
+	// 直属の部下の上長をこの社員へ付け替えます。空文字の場合は上長を解除します。
+	// 直属の部下を持つ社員を退職させる場合は指定が必要です。
+	ReassignReportsTo *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=reassign_reports_to,json=reassignReportsTo,proto3" json:"reassign_reports_to,omitempty"`
+	// 指定したキーの属性のみ更新します。空文字を指定した属性は削除します。
+	Attributes    map[string]string `protobuf:"bytes,13,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateEmployeeRequest) Reset() {
 	*x = UpdateEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -635,7 +910,7 @@ func (x *UpdateEmployeeRequest) String() string {
 func (*UpdateEmployeeRequest) ProtoMessage() {}
 
 func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[8]
+	mi := &file_employee_v1_employee_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -648,7 +923,7 @@ func (x *UpdateEmployeeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEmployeeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{8}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UpdateEmployeeRequest) GetId() string {
@@ -693,6 +968,34 @@ func (x *UpdateEmployeeRequest) GetUserId() *wrapperspb.StringValue {
 	return nil
 }
 
+func (x *UpdateEmployeeRequest) GetDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetManagerEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ManagerEmployeeId
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetReassignReportsTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ReassignReportsTo
+	}
+	return nil
+}
+
+func (x *UpdateEmployeeRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
 type UpdateEmployeeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
@@ -702,7 +1005,7 @@ type UpdateEmployeeResponse struct {
 
 func (x *UpdateEmployeeResponse) Reset() {
 	*x = UpdateEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +1017,7 @@ func (x *UpdateEmployeeResponse) String() string {
 func (*UpdateEmployeeResponse) ProtoMessage() {}
 
 func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[9]
+	mi := &file_employee_v1_employee_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +1030,7 @@ func (x *UpdateEmployeeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateEmployeeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{9}
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
@@ -737,28 +1040,42 @@ func (x *UpdateEmployeeResponse) GetEmployee() *Employee {
 	return nil
 }
 
-type DeleteEmployeeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type TransferEmployeeRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetCompanyId string                 `protobuf:"bytes,2,opt,name=target_company_id,json=targetCompanyId,proto3" json:"target_company_id,omitempty"`
+	// 転籍先の会社で一意な社員コードです。空文字の場合は転籍先の採番規則から払い出します。
+	EmployeeCode string `protobuf:"bytes,3,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
+	// YYYY-MM-DD。転籍元はこの前日付で退職し、転籍先ではこの日付が入社日になります。
+	EffectiveDate string `protobuf:"bytes,4,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date,omitempty"`
+	// 転籍先の会社に属する部署のみ指定できます。
+	DepartmentId *wrapperspb.StringValue `protobuf:"bytes,5,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	// 転籍先の会社に属する社員のみ上長に指定できます。
+	ManagerEmployeeId *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=manager_employee_id,json=managerEmployeeId,proto3" json:"manager_employee_id,omitempty"`
+	// 転籍元の直属の部下の上長をこの社員へ付け替えます。空文字の場合は上長を解除します。
+	// 直属の部下を持つ社員を転籍させる場合は指定が必要です。
+	ReassignReportsTo *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=reassign_reports_to,json=reassignReportsTo,proto3" json:"reassign_reports_to,omitempty"`
+	// 転籍先の会社が定義した社員属性の値です。
+	Attributes    map[string]string `protobuf:"bytes,8,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteEmployeeRequest) Reset() {
-	*x = DeleteEmployeeRequest{}
-	mi := &file_employee_v1_employee_proto_msgTypes[10]
+func (x *TransferEmployeeRequest) Reset() {
+	*x = TransferEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeRequest) String() string {
+func (x *TransferEmployeeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeRequest) ProtoMessage() {}
+func (*TransferEmployeeRequest) ProtoMessage() {}
 
-func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[10]
+func (x *TransferEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -769,39 +1086,591 @@ func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use TransferEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*TransferEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *DeleteEmployeeRequest) GetId() string {
+func (x *TransferEmployeeRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteEmployeeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *TransferEmployeeRequest) GetTargetCompanyId() string {
+	if x != nil {
+		return x.TargetCompanyId
+	}
+	return ""
+}
+
+func (x *TransferEmployeeRequest) GetEmployeeCode() string {
+	if x != nil {
+		return x.EmployeeCode
+	}
+	return ""
+}
+
+func (x *TransferEmployeeRequest) GetEffectiveDate() string {
+	if x != nil {
+		return x.EffectiveDate
+	}
+	return ""
+}
+
+func (x *TransferEmployeeRequest) GetDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return nil
+}
+
+func (x *TransferEmployeeRequest) GetManagerEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ManagerEmployeeId
+	}
+	return nil
+}
+
+func (x *TransferEmployeeRequest) GetReassignReportsTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ReassignReportsTo
+	}
+	return nil
+}
+
+func (x *TransferEmployeeRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type TransferEmployeeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 退職させた転籍元の社員です。
+	Previous *Employee `protobuf:"bytes,1,opt,name=previous,proto3" json:"previous,omitempty"`
+	// 転籍先で作成した社員です。
+	Employee      *Employee `protobuf:"bytes,2,opt,name=employee,proto3" json:"employee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferEmployeeResponse) Reset() {
+	*x = TransferEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferEmployeeResponse) ProtoMessage() {}
+
+func (x *TransferEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*TransferEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TransferEmployeeResponse) GetPrevious() *Employee {
+	if x != nil {
+		return x.Previous
+	}
+	return nil
+}
+
+func (x *TransferEmployeeResponse) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+type DeleteEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeRequest) Reset() {
+	*x = DeleteEmployeeRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeRequest) ProtoMessage() {}
+
+func (x *DeleteEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeleteEmployeeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteEmployeeResponse) Reset() {
+	*x = DeleteEmployeeResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmployeeResponse) ProtoMessage() {}
+
+func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{15}
+}
+
+type EmployeeHistoryEntry struct {
+	state             protoimpl.MessageState  `protogen:"open.v1"`
+	Id                string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EmployeeId        string                  `protobuf:"bytes,2,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	CompanyId         string                  `protobuf:"bytes,3,opt,name=company_id,json=companyId,proto3" json:"company_id,omitempty"`
+	EmployeeCode      string                  `protobuf:"bytes,4,opt,name=employee_code,json=employeeCode,proto3" json:"employee_code,omitempty"`
+	UserId            string                  `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DepartmentId      *wrapperspb.StringValue `protobuf:"bytes,6,opt,name=department_id,json=departmentId,proto3" json:"department_id,omitempty"`
+	ManagerEmployeeId *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=manager_employee_id,json=managerEmployeeId,proto3" json:"manager_employee_id,omitempty"`
+	Status            EmployeeStatus          `protobuf:"varint,8,opt,name=status,proto3,enum=employee.v1.EmployeeStatus" json:"status,omitempty"`
+	HiredAt           *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=hired_at,json=hiredAt,proto3" json:"hired_at,omitempty"`
+	TerminatedAt      *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=terminated_at,json=terminatedAt,proto3" json:"terminated_at,omitempty"`
+	// 有効期間は effective_from 以上 effective_to 未満です（YYYY-MM-DD）。
+	EffectiveFrom string `protobuf:"bytes,11,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// 現在も有効な場合は未設定です。
+	EffectiveTo   *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=effective_to,json=effectiveTo,proto3" json:"effective_to,omitempty"`
+	CreatedAt     *timestamppb.Timestamp  `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EmployeeHistoryEntry) Reset() {
+	*x = EmployeeHistoryEntry{}
+	mi := &file_employee_v1_employee_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EmployeeHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmployeeHistoryEntry) ProtoMessage() {}
+
+func (x *EmployeeHistoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmployeeHistoryEntry.ProtoReflect.Descriptor instead.
+func (*EmployeeHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *EmployeeHistoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetCompanyId() string {
+	if x != nil {
+		return x.CompanyId
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetEmployeeCode() string {
+	if x != nil {
+		return x.EmployeeCode
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetDepartmentId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.DepartmentId
+	}
+	return nil
+}
+
+func (x *EmployeeHistoryEntry) GetManagerEmployeeId() *wrapperspb.StringValue {
+	if x != nil {
+		return x.ManagerEmployeeId
+	}
+	return nil
+}
+
+func (x *EmployeeHistoryEntry) GetStatus() EmployeeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED
+}
+
+func (x *EmployeeHistoryEntry) GetHiredAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.HiredAt
+	}
+	return nil
+}
+
+func (x *EmployeeHistoryEntry) GetTerminatedAt() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TerminatedAt
+	}
+	return nil
+}
+
+func (x *EmployeeHistoryEntry) GetEffectiveFrom() string {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return ""
+}
+
+func (x *EmployeeHistoryEntry) GetEffectiveTo() *wrapperspb.StringValue {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+func (x *EmployeeHistoryEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListEmployeeHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeeHistoryRequest) Reset() {
+	*x = ListEmployeeHistoryRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeeHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeeHistoryRequest) ProtoMessage() {}
+
+func (x *ListEmployeeHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeeHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEmployeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListEmployeeHistoryRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListEmployeeHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEmployeeHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListEmployeeHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*EmployeeHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextPageToken string                  `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEmployeeHistoryResponse) Reset() {
+	*x = ListEmployeeHistoryResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEmployeeHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmployeeHistoryResponse) ProtoMessage() {}
+
+func (x *ListEmployeeHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmployeeHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListEmployeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListEmployeeHistoryResponse) GetEntries() []*EmployeeHistoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListEmployeeHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListDirectReportsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmployeeId    string                 `protobuf:"bytes,1,opt,name=employee_id,json=employeeId,proto3" json:"employee_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectReportsRequest) Reset() {
+	*x = ListDirectReportsRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectReportsRequest) ProtoMessage() {}
+
+func (x *ListDirectReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectReportsRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectReportsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDirectReportsRequest) GetEmployeeId() string {
+	if x != nil {
+		return x.EmployeeId
+	}
+	return ""
+}
+
+func (x *ListDirectReportsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDirectReportsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListDirectReportsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employees     []*Employee            `protobuf:"bytes,1,rep,name=employees,proto3" json:"employees,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDirectReportsResponse) Reset() {
+	*x = ListDirectReportsResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDirectReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDirectReportsResponse) ProtoMessage() {}
+
+func (x *ListDirectReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDirectReportsResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectReportsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListDirectReportsResponse) GetEmployees() []*Employee {
+	if x != nil {
+		return x.Employees
+	}
+	return nil
 }
 
-func (x *DeleteEmployeeResponse) Reset() {
-	*x = DeleteEmployeeResponse{}
-	mi := &file_employee_v1_employee_proto_msgTypes[11]
+func (x *ListDirectReportsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListUserEmploymentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEmploymentsRequest) Reset() {
+	*x = ListUserEmploymentsRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteEmployeeResponse) String() string {
+func (x *ListUserEmploymentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteEmployeeResponse) ProtoMessage() {}
+func (*ListUserEmploymentsRequest) ProtoMessage() {}
 
-func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_employee_v1_employee_proto_msgTypes[11]
+func (x *ListUserEmploymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -812,16 +1681,328 @@ func (x *DeleteEmployeeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteEmployeeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteEmployeeResponse) Descriptor() ([]byte, []int) {
-	return file_employee_v1_employee_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListUserEmploymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListUserEmploymentsRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListUserEmploymentsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListUserEmploymentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserEmploymentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserEmploymentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 在籍中・退職済みを問わず、社員レコードの作成日時の降順で返します。
+	Employments   []*Employment `protobuf:"bytes,1,rep,name=employments,proto3" json:"employments,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserEmploymentsResponse) Reset() {
+	*x = ListUserEmploymentsResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserEmploymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserEmploymentsResponse) ProtoMessage() {}
+
+func (x *ListUserEmploymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserEmploymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListUserEmploymentsResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListUserEmploymentsResponse) GetEmployments() []*Employment {
+	if x != nil {
+		return x.Employments
+	}
+	return nil
+}
+
+func (x *ListUserEmploymentsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetReportingChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportingChainRequest) Reset() {
+	*x = GetReportingChainRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportingChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportingChainRequest) ProtoMessage() {}
+
+func (x *GetReportingChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportingChainRequest.ProtoReflect.Descriptor instead.
+func (*GetReportingChainRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReportingChainRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReportingChainResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 直近の上長から最上位の上長までの順に並びます。
+	Managers      []*Employee `protobuf:"bytes,1,rep,name=managers,proto3" json:"managers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReportingChainResponse) Reset() {
+	*x = GetReportingChainResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReportingChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReportingChainResponse) ProtoMessage() {}
+
+func (x *GetReportingChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReportingChainResponse.ProtoReflect.Descriptor instead.
+func (*GetReportingChainResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetReportingChainResponse) GetManagers() []*Employee {
+	if x != nil {
+		return x.Managers
+	}
+	return nil
+}
+
+type GetOrgChartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 取得する階層数です。0 の場合は 3 階層、最大 10 階層まで指定できます。
+	Depth         int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgChartRequest) Reset() {
+	*x = GetOrgChartRequest{}
+	mi := &file_employee_v1_employee_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgChartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgChartRequest) ProtoMessage() {}
+
+func (x *GetOrgChartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgChartRequest.ProtoReflect.Descriptor instead.
+func (*GetOrgChartRequest) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetOrgChartRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetOrgChartRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type OrgChartNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Employee      *Employee              `protobuf:"bytes,1,opt,name=employee,proto3" json:"employee,omitempty"`
+	Reports       []*OrgChartNode        `protobuf:"bytes,2,rep,name=reports,proto3" json:"reports,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrgChartNode) Reset() {
+	*x = OrgChartNode{}
+	mi := &file_employee_v1_employee_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrgChartNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrgChartNode) ProtoMessage() {}
+
+func (x *OrgChartNode) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrgChartNode.ProtoReflect.Descriptor instead.
+func (*OrgChartNode) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OrgChartNode) GetEmployee() *Employee {
+	if x != nil {
+		return x.Employee
+	}
+	return nil
+}
+
+func (x *OrgChartNode) GetReports() []*OrgChartNode {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+type GetOrgChartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Root          *OrgChartNode          `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrgChartResponse) Reset() {
+	*x = GetOrgChartResponse{}
+	mi := &file_employee_v1_employee_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrgChartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrgChartResponse) ProtoMessage() {}
+
+func (x *GetOrgChartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_employee_v1_employee_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrgChartResponse.ProtoReflect.Descriptor instead.
+func (*GetOrgChartResponse) Descriptor() ([]byte, []int) {
+	return file_employee_v1_employee_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetOrgChartResponse) GetRoot() *OrgChartNode {
+	if x != nil {
+		return x.Root
+	}
+	return nil
 }
 
 var File_employee_v1_employee_proto protoreflect.FileDescriptor
 
 const file_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
-	"\x1aemployee/v1/employee.proto\x12\vemployee.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x12user/v1/user.proto\"\xfc\x03\n" +
+	"\x1aemployee/v1/employee.proto\x12\vemployee.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18company/v1/company.proto\x1a\x1egoogle/protobuf/wrappers.proto\x1a\x12user/v1/user.proto\"\xf2\x06\n" +
 	"\bEmployee\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
 	"\n" +
@@ -836,8 +2017,17 @@ const file_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x17\n" +
 	"\auser_id\x18\f \x01(\tR\x06userId\x12,\n" +
-	"\x04user\x18\r \x01(\v2\x18.employee.v1.UserSummaryR\x04userJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x05emailR\tlast_nameR\n" +
-	"first_name\"\xea\x01\n" +
+	"\x04user\x18\r \x01(\v2\x18.employee.v1.UserSummaryR\x04user\x12A\n" +
+	"\rdepartment_id\x18\x0e \x01(\v2\x1c.google.protobuf.StringValueR\fdepartmentId\x12L\n" +
+	"\x13manager_employee_id\x18\x0f \x01(\v2\x1c.google.protobuf.StringValueR\x11managerEmployeeId\x12]\n" +
+	"\x1ctransferred_from_employee_id\x18\x10 \x01(\v2\x1c.google.protobuf.StringValueR\x19transferredFromEmployeeId\x12E\n" +
+	"\n" +
+	"attributes\x18\x11 \x03(\v2%.employee.v1.Employee.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06J\x04\b\x06\x10\aR\x05emailR\tlast_nameR\n" +
+	"first_name\"\xa5\x02\n" +
 	"\vUserSummary\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -846,7 +2036,19 @@ const file_employee_v1_employee_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xd5\x02\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12!\n" +
+	"\fdisplay_name\x18\a \x01(\tR\vdisplayName\x12\x16\n" +
+	"\x06locale\x18\b \x01(\tR\x06locale\"\xc5\x01\n" +
+	"\x0eCompanySummary\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x121\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x19.company.v1.CompanyStatusR\x06status\x12H\n" +
+	"\x11parent_company_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\x0fparentCompanyId\"v\n" +
+	"\n" +
+	"Employment\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x125\n" +
+	"\acompany\x18\x02 \x01(\v2\x1b.employee.v1.CompanySummaryR\acompany\"\xf9\x04\n" +
 	"\x15CreateEmployeeRequest\x12\x1d\n" +
 	"\n" +
 	"company_id\x18\x01 \x01(\tR\tcompanyId\x12#\n" +
@@ -854,47 +2056,158 @@ const file_employee_v1_employee_proto_rawDesc = "" +
 	"\x06status\x18\x06 \x01(\x0e2\x1b.employee.v1.EmployeeStatusR\x06status\x127\n" +
 	"\bhired_at\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\ahiredAt\x12A\n" +
 	"\rterminated_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fterminatedAt\x12\x17\n" +
-	"\auser_id\x18\t \x01(\tR\x06userIdJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x05emailR\tlast_nameR\n" +
+	"\auser_id\x18\t \x01(\tR\x06userId\x12A\n" +
+	"\rdepartment_id\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fdepartmentId\x12L\n" +
+	"\x13manager_employee_id\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x11managerEmployeeId\x12R\n" +
+	"\n" +
+	"attributes\x18\f \x03(\v22.employee.v1.CreateEmployeeRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x05emailR\tlast_nameR\n" +
 	"first_name\"K\n" +
 	"\x16CreateEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"$\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"W\n" +
 	"\x12GetEmployeeRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x121\n" +
+	"\x05as_of\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04asOf\"H\n" +
 	"\x13GetEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\xa6\x01\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\xc8\x03\n" +
 	"\x14ListEmployeesRequest\x12\x1d\n" +
 	"\n" +
 	"company_id\x18\x01 \x01(\tR\tcompanyId\x12\x1b\n" +
 	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x03 \x01(\tR\tpageToken\x123\n" +
-	"\x06status\x18\x04 \x01(\x0e2\x1b.employee.v1.EmployeeStatusR\x06status\"t\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x1b.employee.v1.EmployeeStatusR\x06status\x121\n" +
+	"\x14include_subsidiaries\x18\x05 \x01(\bR\x13includeSubsidiaries\x12#\n" +
+	"\rdepartment_id\x18\x06 \x01(\tR\fdepartmentId\x126\n" +
+	"\x17include_sub_departments\x18\a \x01(\bR\x15includeSubDepartments\x12Q\n" +
+	"\n" +
+	"attributes\x18\b \x03(\v21.employee.v1.ListEmployeesRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"t\n" +
 	"\x15ListEmployeesResponse\x123\n" +
 	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x82\x03\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xf4\x05\n" +
 	"\x15UpdateEmployeeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12A\n" +
 	"\remployee_code\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\femployeeCode\x123\n" +
 	"\x06status\x18\x06 \x01(\x0e2\x1b.employee.v1.EmployeeStatusR\x06status\x127\n" +
 	"\bhired_at\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\ahiredAt\x12A\n" +
 	"\rterminated_at\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\fterminatedAt\x125\n" +
-	"\auser_id\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x06userIdJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x05emailR\tlast_nameR\n" +
+	"\auser_id\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x06userId\x12A\n" +
+	"\rdepartment_id\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fdepartmentId\x12L\n" +
+	"\x13manager_employee_id\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x11managerEmployeeId\x12L\n" +
+	"\x13reassign_reports_to\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\x11reassignReportsTo\x12R\n" +
+	"\n" +
+	"attributes\x18\r \x03(\v22.employee.v1.UpdateEmployeeRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06R\x05emailR\tlast_nameR\n" +
 	"first_name\"K\n" +
 	"\x16UpdateEmployeeResponse\x121\n" +
-	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"'\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"\x95\x04\n" +
+	"\x17TransferEmployeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\x11target_company_id\x18\x02 \x01(\tR\x0ftargetCompanyId\x12#\n" +
+	"\remployee_code\x18\x03 \x01(\tR\femployeeCode\x12%\n" +
+	"\x0eeffective_date\x18\x04 \x01(\tR\reffectiveDate\x12A\n" +
+	"\rdepartment_id\x18\x05 \x01(\v2\x1c.google.protobuf.StringValueR\fdepartmentId\x12L\n" +
+	"\x13manager_employee_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\x11managerEmployeeId\x12L\n" +
+	"\x13reassign_reports_to\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x11reassignReportsTo\x12T\n" +
+	"\n" +
+	"attributes\x18\b \x03(\v24.employee.v1.TransferEmployeeRequest.AttributesEntryR\n" +
+	"attributes\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x80\x01\n" +
+	"\x18TransferEmployeeResponse\x121\n" +
+	"\bprevious\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bprevious\x121\n" +
+	"\bemployee\x18\x02 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\"'\n" +
 	"\x15DeleteEmployeeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x18\n" +
-	"\x16DeleteEmployeeResponse*k\n" +
+	"\x16DeleteEmployeeResponse\"\x89\x05\n" +
+	"\x14EmployeeHistoryEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vemployee_id\x18\x02 \x01(\tR\n" +
+	"employeeId\x12\x1d\n" +
+	"\n" +
+	"company_id\x18\x03 \x01(\tR\tcompanyId\x12#\n" +
+	"\remployee_code\x18\x04 \x01(\tR\femployeeCode\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\tR\x06userId\x12A\n" +
+	"\rdepartment_id\x18\x06 \x01(\v2\x1c.google.protobuf.StringValueR\fdepartmentId\x12L\n" +
+	"\x13manager_employee_id\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\x11managerEmployeeId\x123\n" +
+	"\x06status\x18\b \x01(\x0e2\x1b.employee.v1.EmployeeStatusR\x06status\x127\n" +
+	"\bhired_at\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\ahiredAt\x12A\n" +
+	"\rterminated_at\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\fterminatedAt\x12%\n" +
+	"\x0eeffective_from\x18\v \x01(\tR\reffectiveFrom\x12?\n" +
+	"\feffective_to\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\veffectiveTo\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"y\n" +
+	"\x1aListEmployeeHistoryRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x82\x01\n" +
+	"\x1bListEmployeeHistoryResponse\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.employee.v1.EmployeeHistoryEntryR\aentries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"w\n" +
+	"\x18ListDirectReportsRequest\x12\x1f\n" +
+	"\vemployee_id\x18\x01 \x01(\tR\n" +
+	"employeeId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"x\n" +
+	"\x19ListDirectReportsResponse\x123\n" +
+	"\temployees\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\temployees\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"q\n" +
+	"\x1aListUserEmploymentsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x80\x01\n" +
+	"\x1bListUserEmploymentsResponse\x129\n" +
+	"\vemployments\x18\x01 \x03(\v2\x17.employee.v1.EmploymentR\vemployments\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"*\n" +
+	"\x18GetReportingChainRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"N\n" +
+	"\x19GetReportingChainResponse\x121\n" +
+	"\bmanagers\x18\x01 \x03(\v2\x15.employee.v1.EmployeeR\bmanagers\":\n" +
+	"\x12GetOrgChartRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05depth\x18\x02 \x01(\x05R\x05depth\"v\n" +
+	"\fOrgChartNode\x121\n" +
+	"\bemployee\x18\x01 \x01(\v2\x15.employee.v1.EmployeeR\bemployee\x123\n" +
+	"\areports\x18\x02 \x03(\v2\x19.employee.v1.OrgChartNodeR\areports\"D\n" +
+	"\x13GetOrgChartResponse\x12-\n" +
+	"\x04root\x18\x01 \x01(\v2\x19.employee.v1.OrgChartNodeR\x04root*\xca\x01\n" +
 	"\x0eEmployeeStatus\x12\x1f\n" +
 	"\x1bEMPLOYEE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
-	"\x16EMPLOYEE_STATUS_ACTIVE\x10\x01\x12\x1c\n" +
-	"\x18EMPLOYEE_STATUS_INACTIVE\x10\x022\xcc\x03\n" +
+	"\x16EMPLOYEE_STATUS_ACTIVE\x10\x01\x12 \n" +
+	"\x18EMPLOYEE_STATUS_INACTIVE\x10\x02\x1a\x02\b\x01\x12\x1b\n" +
+	"\x17EMPLOYEE_STATUS_PENDING\x10\x03\x12\x1c\n" +
+	"\x18EMPLOYEE_STATUS_ON_LEAVE\x10\x04\x12\x1e\n" +
+	"\x1aEMPLOYEE_STATUS_TERMINATED\x10\x052\x9b\b\n" +
 	"\x0fEmployeeService\x12Y\n" +
 	"\x0eCreateEmployee\x12\".employee.v1.CreateEmployeeRequest\x1a#.employee.v1.CreateEmployeeResponse\x12P\n" +
 	"\vGetEmployee\x12\x1f.employee.v1.GetEmployeeRequest\x1a .employee.v1.GetEmployeeResponse\x12V\n" +
 	"\rListEmployees\x12!.employee.v1.ListEmployeesRequest\x1a\".employee.v1.ListEmployeesResponse\x12Y\n" +
 	"\x0eUpdateEmployee\x12\".employee.v1.UpdateEmployeeRequest\x1a#.employee.v1.UpdateEmployeeResponse\x12Y\n" +
-	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponseB`Z^github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/employee/v1;employeepbb\x06proto3"
+	"\x0eDeleteEmployee\x12\".employee.v1.DeleteEmployeeRequest\x1a#.employee.v1.DeleteEmployeeResponse\x12_\n" +
+	"\x10TransferEmployee\x12$.employee.v1.TransferEmployeeRequest\x1a%.employee.v1.TransferEmployeeResponse\x12h\n" +
+	"\x13ListEmployeeHistory\x12'.employee.v1.ListEmployeeHistoryRequest\x1a(.employee.v1.ListEmployeeHistoryResponse\x12b\n" +
+	"\x11ListDirectReports\x12%.employee.v1.ListDirectReportsRequest\x1a&.employee.v1.ListDirectReportsResponse\x12h\n" +
+	"\x13ListUserEmployments\x12'.employee.v1.ListUserEmploymentsRequest\x1a(.employee.v1.ListUserEmploymentsResponse\x12b\n" +
+	"\x11GetReportingChain\x12%.employee.v1.GetReportingChainRequest\x1a&.employee.v1.GetReportingChainResponse\x12P\n" +
+	"\vGetOrgChart\x12\x1f.employee.v1.GetOrgChartRequest\x1a .employee.v1.GetOrgChartResponseB`Z^github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/employee/v1;employeepbb\x06proto3"
 
 var (
 	file_employee_v1_employee_proto_rawDescOnce sync.Once
@@ -909,63 +2222,134 @@ func file_employee_v1_employee_proto_rawDescGZIP() []byte {
 }
 
 var file_employee_v1_employee_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_employee_v1_employee_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_employee_v1_employee_proto_goTypes = []any{
-	(EmployeeStatus)(0),            // 0: employee.v1.EmployeeStatus
-	(*Employee)(nil),               // 1: employee.v1.Employee
-	(*UserSummary)(nil),            // 2: employee.v1.UserSummary
-	(*CreateEmployeeRequest)(nil),  // 3: employee.v1.CreateEmployeeRequest
-	(*CreateEmployeeResponse)(nil), // 4: employee.v1.CreateEmployeeResponse
-	(*GetEmployeeRequest)(nil),     // 5: employee.v1.GetEmployeeRequest
-	(*GetEmployeeResponse)(nil),    // 6: employee.v1.GetEmployeeResponse
-	(*ListEmployeesRequest)(nil),   // 7: employee.v1.ListEmployeesRequest
-	(*ListEmployeesResponse)(nil),  // 8: employee.v1.ListEmployeesResponse
-	(*UpdateEmployeeRequest)(nil),  // 9: employee.v1.UpdateEmployeeRequest
-	(*UpdateEmployeeResponse)(nil), // 10: employee.v1.UpdateEmployeeResponse
-	(*DeleteEmployeeRequest)(nil),  // 11: employee.v1.DeleteEmployeeRequest
-	(*DeleteEmployeeResponse)(nil), // 12: employee.v1.DeleteEmployeeResponse
-	(*wrapperspb.StringValue)(nil), // 13: google.protobuf.StringValue
-	(*timestamppb.Timestamp)(nil),  // 14: google.protobuf.Timestamp
-	(v1.UserStatus)(0),             // 15: user.v1.UserStatus
+	(EmployeeStatus)(0),                 // 0: employee.v1.EmployeeStatus
+	(*Employee)(nil),                    // 1: employee.v1.Employee
+	(*UserSummary)(nil),                 // 2: employee.v1.UserSummary
+	(*CompanySummary)(nil),              // 3: employee.v1.CompanySummary
+	(*Employment)(nil),                  // 4: employee.v1.Employment
+	(*CreateEmployeeRequest)(nil),       // 5: employee.v1.CreateEmployeeRequest
+	(*CreateEmployeeResponse)(nil),      // 6: employee.v1.CreateEmployeeResponse
+	(*GetEmployeeRequest)(nil),          // 7: employee.v1.GetEmployeeRequest
+	(*GetEmployeeResponse)(nil),         // 8: employee.v1.GetEmployeeResponse
+	(*ListEmployeesRequest)(nil),        // 9: employee.v1.ListEmployeesRequest
+	(*ListEmployeesResponse)(nil),       // 10: employee.v1.ListEmployeesResponse
+	(*UpdateEmployeeRequest)(nil),       // 11: employee.v1.UpdateEmployeeRequest
+	(*UpdateEmployeeResponse)(nil),      // 12: employee.v1.UpdateEmployeeResponse
+	(*TransferEmployeeRequest)(nil),     // 13: employee.v1.TransferEmployeeRequest
+	(*TransferEmployeeResponse)(nil),    // 14: employee.v1.TransferEmployeeResponse
+	(*DeleteEmployeeRequest)(nil),       // 15: employee.v1.DeleteEmployeeRequest
+	(*DeleteEmployeeResponse)(nil),      // 16: employee.v1.DeleteEmployeeResponse
+	(*EmployeeHistoryEntry)(nil),        // 17: employee.v1.EmployeeHistoryEntry
+	(*ListEmployeeHistoryRequest)(nil),  // 18: employee.v1.ListEmployeeHistoryRequest
+	(*ListEmployeeHistoryResponse)(nil), // 19: employee.v1.ListEmployeeHistoryResponse
+	(*ListDirectReportsRequest)(nil),    // 20: employee.v1.ListDirectReportsRequest
+	(*ListDirectReportsResponse)(nil),   // 21: employee.v1.ListDirectReportsResponse
+	(*ListUserEmploymentsRequest)(nil),  // 22: employee.v1.ListUserEmploymentsRequest
+	(*ListUserEmploymentsResponse)(nil), // 23: employee.v1.ListUserEmploymentsResponse
+	(*GetReportingChainRequest)(nil),    // 24: employee.v1.GetReportingChainRequest
+	(*GetReportingChainResponse)(nil),   // 25: employee.v1.GetReportingChainResponse
+	(*GetOrgChartRequest)(nil),          // 26: employee.v1.GetOrgChartRequest
+	(*OrgChartNode)(nil),                // 27: employee.v1.OrgChartNode
+	(*GetOrgChartResponse)(nil),         // 28: employee.v1.GetOrgChartResponse
+	nil,                                 // 29: employee.v1.Employee.AttributesEntry
+	nil,                                 // 30: employee.v1.CreateEmployeeRequest.AttributesEntry
+	nil,                                 // 31: employee.v1.ListEmployeesRequest.AttributesEntry
+	nil,                                 // 32: employee.v1.UpdateEmployeeRequest.AttributesEntry
+	nil,                                 // 33: employee.v1.TransferEmployeeRequest.AttributesEntry
+	(*wrapperspb.StringValue)(nil),      // 34: google.protobuf.StringValue
+	(*timestamppb.Timestamp)(nil),       // 35: google.protobuf.Timestamp
+	(v1.UserStatus)(0),                  // 36: user.v1.UserStatus
+	(v11.CompanyStatus)(0),              // 37: company.v1.CompanyStatus
 }
 var file_employee_v1_employee_proto_depIdxs = []int32{
 	0,  // 0: employee.v1.Employee.status:type_name -> employee.v1.EmployeeStatus
-	13, // 1: employee.v1.Employee.hired_at:type_name -> google.protobuf.StringValue
-	13, // 2: employee.v1.Employee.terminated_at:type_name -> google.protobuf.StringValue
-	14, // 3: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
-	14, // 4: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
+	34, // 1: employee.v1.Employee.hired_at:type_name -> google.protobuf.StringValue
+	34, // 2: employee.v1.Employee.terminated_at:type_name -> google.protobuf.StringValue
+	35, // 3: employee.v1.Employee.created_at:type_name -> google.protobuf.Timestamp
+	35, // 4: employee.v1.Employee.updated_at:type_name -> google.protobuf.Timestamp
 	2,  // 5: employee.v1.Employee.user:type_name -> employee.v1.UserSummary
-	15, // 6: employee.v1.UserSummary.status:type_name -> user.v1.UserStatus
-	14, // 7: employee.v1.UserSummary.created_at:type_name -> google.protobuf.Timestamp
-	14, // 8: employee.v1.UserSummary.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 9: employee.v1.CreateEmployeeRequest.status:type_name -> employee.v1.EmployeeStatus
-	13, // 10: employee.v1.CreateEmployeeRequest.hired_at:type_name -> google.protobuf.StringValue
-	13, // 11: employee.v1.CreateEmployeeRequest.terminated_at:type_name -> google.protobuf.StringValue
-	1,  // 12: employee.v1.CreateEmployeeResponse.employee:type_name -> employee.v1.Employee
-	1,  // 13: employee.v1.GetEmployeeResponse.employee:type_name -> employee.v1.Employee
-	0,  // 14: employee.v1.ListEmployeesRequest.status:type_name -> employee.v1.EmployeeStatus
-	1,  // 15: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
-	13, // 16: employee.v1.UpdateEmployeeRequest.employee_code:type_name -> google.protobuf.StringValue
-	0,  // 17: employee.v1.UpdateEmployeeRequest.status:type_name -> employee.v1.EmployeeStatus
-	13, // 18: employee.v1.UpdateEmployeeRequest.hired_at:type_name -> google.protobuf.StringValue
-	13, // 19: employee.v1.UpdateEmployeeRequest.terminated_at:type_name -> google.protobuf.StringValue
-	13, // 20: employee.v1.UpdateEmployeeRequest.user_id:type_name -> google.protobuf.StringValue
-	1,  // 21: employee.v1.UpdateEmployeeResponse.employee:type_name -> employee.v1.Employee
-	3,  // 22: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
-	5,  // 23: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
-	7,  // 24: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
-	9,  // 25: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
-	11, // 26: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
-	4,  // 27: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.CreateEmployeeResponse
-	6,  // 28: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.GetEmployeeResponse
-	8,  // 29: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
-	10, // 30: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.UpdateEmployeeResponse
-	12, // 31: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
-	27, // [27:32] is the sub-list for method output_type
-	22, // [22:27] is the sub-list for method input_type
-	22, // [22:22] is the sub-list for extension type_name
-	22, // [22:22] is the sub-list for extension extendee
-	0,  // [0:22] is the sub-list for field type_name
+	34, // 6: employee.v1.Employee.department_id:type_name -> google.protobuf.StringValue
+	34, // 7: employee.v1.Employee.manager_employee_id:type_name -> google.protobuf.StringValue
+	34, // 8: employee.v1.Employee.transferred_from_employee_id:type_name -> google.protobuf.StringValue
+	29, // 9: employee.v1.Employee.attributes:type_name -> employee.v1.Employee.AttributesEntry
+	36, // 10: employee.v1.UserSummary.status:type_name -> user.v1.UserStatus
+	35, // 11: employee.v1.UserSummary.created_at:type_name -> google.protobuf.Timestamp
+	35, // 12: employee.v1.UserSummary.updated_at:type_name -> google.protobuf.Timestamp
+	37, // 13: employee.v1.CompanySummary.status:type_name -> company.v1.CompanyStatus
+	34, // 14: employee.v1.CompanySummary.parent_company_id:type_name -> google.protobuf.StringValue
+	1,  // 15: employee.v1.Employment.employee:type_name -> employee.v1.Employee
+	3,  // 16: employee.v1.Employment.company:type_name -> employee.v1.CompanySummary
+	0,  // 17: employee.v1.CreateEmployeeRequest.status:type_name -> employee.v1.EmployeeStatus
+	34, // 18: employee.v1.CreateEmployeeRequest.hired_at:type_name -> google.protobuf.StringValue
+	34, // 19: employee.v1.CreateEmployeeRequest.terminated_at:type_name -> google.protobuf.StringValue
+	34, // 20: employee.v1.CreateEmployeeRequest.department_id:type_name -> google.protobuf.StringValue
+	34, // 21: employee.v1.CreateEmployeeRequest.manager_employee_id:type_name -> google.protobuf.StringValue
+	30, // 22: employee.v1.CreateEmployeeRequest.attributes:type_name -> employee.v1.CreateEmployeeRequest.AttributesEntry
+	1,  // 23: employee.v1.CreateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	34, // 24: employee.v1.GetEmployeeRequest.as_of:type_name -> google.protobuf.StringValue
+	1,  // 25: employee.v1.GetEmployeeResponse.employee:type_name -> employee.v1.Employee
+	0,  // 26: employee.v1.ListEmployeesRequest.status:type_name -> employee.v1.EmployeeStatus
+	31, // 27: employee.v1.ListEmployeesRequest.attributes:type_name -> employee.v1.ListEmployeesRequest.AttributesEntry
+	1,  // 28: employee.v1.ListEmployeesResponse.employees:type_name -> employee.v1.Employee
+	34, // 29: employee.v1.UpdateEmployeeRequest.employee_code:type_name -> google.protobuf.StringValue
+	0,  // 30: employee.v1.UpdateEmployeeRequest.status:type_name -> employee.v1.EmployeeStatus
+	34, // 31: employee.v1.UpdateEmployeeRequest.hired_at:type_name -> google.protobuf.StringValue
+	34, // 32: employee.v1.UpdateEmployeeRequest.terminated_at:type_name -> google.protobuf.StringValue
+	34, // 33: employee.v1.UpdateEmployeeRequest.user_id:type_name -> google.protobuf.StringValue
+	34, // 34: employee.v1.UpdateEmployeeRequest.department_id:type_name -> google.protobuf.StringValue
+	34, // 35: employee.v1.UpdateEmployeeRequest.manager_employee_id:type_name -> google.protobuf.StringValue
+	34, // 36: employee.v1.UpdateEmployeeRequest.reassign_reports_to:type_name -> google.protobuf.StringValue
+	32, // 37: employee.v1.UpdateEmployeeRequest.attributes:type_name -> employee.v1.UpdateEmployeeRequest.AttributesEntry
+	1,  // 38: employee.v1.UpdateEmployeeResponse.employee:type_name -> employee.v1.Employee
+	34, // 39: employee.v1.TransferEmployeeRequest.department_id:type_name -> google.protobuf.StringValue
+	34, // 40: employee.v1.TransferEmployeeRequest.manager_employee_id:type_name -> google.protobuf.StringValue
+	34, // 41: employee.v1.TransferEmployeeRequest.reassign_reports_to:type_name -> google.protobuf.StringValue
+	33, // 42: employee.v1.TransferEmployeeRequest.attributes:type_name -> employee.v1.TransferEmployeeRequest.AttributesEntry
+	1,  // 43: employee.v1.TransferEmployeeResponse.previous:type_name -> employee.v1.Employee
+	1,  // 44: employee.v1.TransferEmployeeResponse.employee:type_name -> employee.v1.Employee
+	34, // 45: employee.v1.EmployeeHistoryEntry.department_id:type_name -> google.protobuf.StringValue
+	34, // 46: employee.v1.EmployeeHistoryEntry.manager_employee_id:type_name -> google.protobuf.StringValue
+	0,  // 47: employee.v1.EmployeeHistoryEntry.status:type_name -> employee.v1.EmployeeStatus
+	34, // 48: employee.v1.EmployeeHistoryEntry.hired_at:type_name -> google.protobuf.StringValue
+	34, // 49: employee.v1.EmployeeHistoryEntry.terminated_at:type_name -> google.protobuf.StringValue
+	34, // 50: employee.v1.EmployeeHistoryEntry.effective_to:type_name -> google.protobuf.StringValue
+	35, // 51: employee.v1.EmployeeHistoryEntry.created_at:type_name -> google.protobuf.Timestamp
+	17, // 52: employee.v1.ListEmployeeHistoryResponse.entries:type_name -> employee.v1.EmployeeHistoryEntry
+	1,  // 53: employee.v1.ListDirectReportsResponse.employees:type_name -> employee.v1.Employee
+	4,  // 54: employee.v1.ListUserEmploymentsResponse.employments:type_name -> employee.v1.Employment
+	1,  // 55: employee.v1.GetReportingChainResponse.managers:type_name -> employee.v1.Employee
+	1,  // 56: employee.v1.OrgChartNode.employee:type_name -> employee.v1.Employee
+	27, // 57: employee.v1.OrgChartNode.reports:type_name -> employee.v1.OrgChartNode
+	27, // 58: employee.v1.GetOrgChartResponse.root:type_name -> employee.v1.OrgChartNode
+	5,  // 59: employee.v1.EmployeeService.CreateEmployee:input_type -> employee.v1.CreateEmployeeRequest
+	7,  // 60: employee.v1.EmployeeService.GetEmployee:input_type -> employee.v1.GetEmployeeRequest
+	9,  // 61: employee.v1.EmployeeService.ListEmployees:input_type -> employee.v1.ListEmployeesRequest
+	11, // 62: employee.v1.EmployeeService.UpdateEmployee:input_type -> employee.v1.UpdateEmployeeRequest
+	15, // 63: employee.v1.EmployeeService.DeleteEmployee:input_type -> employee.v1.DeleteEmployeeRequest
+	13, // 64: employee.v1.EmployeeService.TransferEmployee:input_type -> employee.v1.TransferEmployeeRequest
+	18, // 65: employee.v1.EmployeeService.ListEmployeeHistory:input_type -> employee.v1.ListEmployeeHistoryRequest
+	20, // 66: employee.v1.EmployeeService.ListDirectReports:input_type -> employee.v1.ListDirectReportsRequest
+	22, // 67: employee.v1.EmployeeService.ListUserEmployments:input_type -> employee.v1.ListUserEmploymentsRequest
+	24, // 68: employee.v1.EmployeeService.GetReportingChain:input_type -> employee.v1.GetReportingChainRequest
+	26, // 69: employee.v1.EmployeeService.GetOrgChart:input_type -> employee.v1.GetOrgChartRequest
+	6,  // 70: employee.v1.EmployeeService.CreateEmployee:output_type -> employee.v1.CreateEmployeeResponse
+	8,  // 71: employee.v1.EmployeeService.GetEmployee:output_type -> employee.v1.GetEmployeeResponse
+	10, // 72: employee.v1.EmployeeService.ListEmployees:output_type -> employee.v1.ListEmployeesResponse
+	12, // 73: employee.v1.EmployeeService.UpdateEmployee:output_type -> employee.v1.UpdateEmployeeResponse
+	16, // 74: employee.v1.EmployeeService.DeleteEmployee:output_type -> employee.v1.DeleteEmployeeResponse
+	14, // 75: employee.v1.EmployeeService.TransferEmployee:output_type -> employee.v1.TransferEmployeeResponse
+	19, // 76: employee.v1.EmployeeService.ListEmployeeHistory:output_type -> employee.v1.ListEmployeeHistoryResponse
+	21, // 77: employee.v1.EmployeeService.ListDirectReports:output_type -> employee.v1.ListDirectReportsResponse
+	23, // 78: employee.v1.EmployeeService.ListUserEmployments:output_type -> employee.v1.ListUserEmploymentsResponse
+	25, // 79: employee.v1.EmployeeService.GetReportingChain:output_type -> employee.v1.GetReportingChainResponse
+	28, // 80: employee.v1.EmployeeService.GetOrgChart:output_type -> employee.v1.GetOrgChartResponse
+	70, // [70:81] is the sub-list for method output_type
+	59, // [59:70] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
 }
 
 func init() { file_employee_v1_employee_proto_init() }
@@ -979,7 +2363,7 @@ func file_employee_v1_employee_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_employee_v1_employee_proto_rawDesc), len(file_employee_v1_employee_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   12,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
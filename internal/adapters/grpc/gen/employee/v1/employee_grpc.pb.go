@@ -19,11 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	EmployeeService_CreateEmployee_FullMethodName = "/employee.v1.EmployeeService/CreateEmployee"
-	EmployeeService_GetEmployee_FullMethodName    = "/employee.v1.EmployeeService/GetEmployee"
-	EmployeeService_ListEmployees_FullMethodName  = "/employee.v1.EmployeeService/ListEmployees"
-	EmployeeService_UpdateEmployee_FullMethodName = "/employee.v1.EmployeeService/UpdateEmployee"
-	EmployeeService_DeleteEmployee_FullMethodName = "/employee.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_CreateEmployee_FullMethodName      = "/employee.v1.EmployeeService/CreateEmployee"
+	EmployeeService_GetEmployee_FullMethodName         = "/employee.v1.EmployeeService/GetEmployee"
+	EmployeeService_ListEmployees_FullMethodName       = "/employee.v1.EmployeeService/ListEmployees"
+	EmployeeService_UpdateEmployee_FullMethodName      = "/employee.v1.EmployeeService/UpdateEmployee"
+	EmployeeService_DeleteEmployee_FullMethodName      = "/employee.v1.EmployeeService/DeleteEmployee"
+	EmployeeService_TransferEmployee_FullMethodName    = "/employee.v1.EmployeeService/TransferEmployee"
+	EmployeeService_ListEmployeeHistory_FullMethodName = "/employee.v1.EmployeeService/ListEmployeeHistory"
+	EmployeeService_ListDirectReports_FullMethodName   = "/employee.v1.EmployeeService/ListDirectReports"
+	EmployeeService_ListUserEmployments_FullMethodName = "/employee.v1.EmployeeService/ListUserEmployments"
+	EmployeeService_GetReportingChain_FullMethodName   = "/employee.v1.EmployeeService/GetReportingChain"
+	EmployeeService_GetOrgChart_FullMethodName         = "/employee.v1.EmployeeService/GetOrgChart"
 )
 
 // EmployeeServiceClient is the client API for EmployeeService service.
@@ -35,6 +41,12 @@ type EmployeeServiceClient interface {
 	ListEmployees(ctx context.Context, in *ListEmployeesRequest, opts ...grpc.CallOption) (*ListEmployeesResponse, error)
 	UpdateEmployee(ctx context.Context, in *UpdateEmployeeRequest, opts ...grpc.CallOption) (*UpdateEmployeeResponse, error)
 	DeleteEmployee(ctx context.Context, in *DeleteEmployeeRequest, opts ...grpc.CallOption) (*DeleteEmployeeResponse, error)
+	TransferEmployee(ctx context.Context, in *TransferEmployeeRequest, opts ...grpc.CallOption) (*TransferEmployeeResponse, error)
+	ListEmployeeHistory(ctx context.Context, in *ListEmployeeHistoryRequest, opts ...grpc.CallOption) (*ListEmployeeHistoryResponse, error)
+	ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListDirectReportsResponse, error)
+	ListUserEmployments(ctx context.Context, in *ListUserEmploymentsRequest, opts ...grpc.CallOption) (*ListUserEmploymentsResponse, error)
+	GetReportingChain(ctx context.Context, in *GetReportingChainRequest, opts ...grpc.CallOption) (*GetReportingChainResponse, error)
+	GetOrgChart(ctx context.Context, in *GetOrgChartRequest, opts ...grpc.CallOption) (*GetOrgChartResponse, error)
 }
 
 type employeeServiceClient struct {
@@ -95,6 +107,66 @@ func (c *employeeServiceClient) DeleteEmployee(ctx context.Context, in *DeleteEm
 	return out, nil
 }
 
+func (c *employeeServiceClient) TransferEmployee(ctx context.Context, in *TransferEmployeeRequest, opts ...grpc.CallOption) (*TransferEmployeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferEmployeeResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_TransferEmployee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListEmployeeHistory(ctx context.Context, in *ListEmployeeHistoryRequest, opts ...grpc.CallOption) (*ListEmployeeHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEmployeeHistoryResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListEmployeeHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListDirectReports(ctx context.Context, in *ListDirectReportsRequest, opts ...grpc.CallOption) (*ListDirectReportsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDirectReportsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListDirectReports_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) ListUserEmployments(ctx context.Context, in *ListUserEmploymentsRequest, opts ...grpc.CallOption) (*ListUserEmploymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserEmploymentsResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_ListUserEmployments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetReportingChain(ctx context.Context, in *GetReportingChainRequest, opts ...grpc.CallOption) (*GetReportingChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReportingChainResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetReportingChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *employeeServiceClient) GetOrgChart(ctx context.Context, in *GetOrgChartRequest, opts ...grpc.CallOption) (*GetOrgChartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrgChartResponse)
+	err := c.cc.Invoke(ctx, EmployeeService_GetOrgChart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EmployeeServiceServer is the server API for EmployeeService service.
 // All implementations must embed UnimplementedEmployeeServiceServer
 // for forward compatibility.
@@ -104,6 +176,12 @@ type EmployeeServiceServer interface {
 	ListEmployees(context.Context, *ListEmployeesRequest) (*ListEmployeesResponse, error)
 	UpdateEmployee(context.Context, *UpdateEmployeeRequest) (*UpdateEmployeeResponse, error)
 	DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error)
+	TransferEmployee(context.Context, *TransferEmployeeRequest) (*TransferEmployeeResponse, error)
+	ListEmployeeHistory(context.Context, *ListEmployeeHistoryRequest) (*ListEmployeeHistoryResponse, error)
+	ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListDirectReportsResponse, error)
+	ListUserEmployments(context.Context, *ListUserEmploymentsRequest) (*ListUserEmploymentsResponse, error)
+	GetReportingChain(context.Context, *GetReportingChainRequest) (*GetReportingChainResponse, error)
+	GetOrgChart(context.Context, *GetOrgChartRequest) (*GetOrgChartResponse, error)
 	mustEmbedUnimplementedEmployeeServiceServer()
 }
 
@@ -129,6 +207,24 @@ func (UnimplementedEmployeeServiceServer) UpdateEmployee(context.Context, *Updat
 func (UnimplementedEmployeeServiceServer) DeleteEmployee(context.Context, *DeleteEmployeeRequest) (*DeleteEmployeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteEmployee not implemented")
 }
+func (UnimplementedEmployeeServiceServer) TransferEmployee(context.Context, *TransferEmployeeRequest) (*TransferEmployeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEmployee not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListEmployeeHistory(context.Context, *ListEmployeeHistoryRequest) (*ListEmployeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEmployeeHistory not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListDirectReports(context.Context, *ListDirectReportsRequest) (*ListDirectReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDirectReports not implemented")
+}
+func (UnimplementedEmployeeServiceServer) ListUserEmployments(context.Context, *ListUserEmploymentsRequest) (*ListUserEmploymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserEmployments not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetReportingChain(context.Context, *GetReportingChainRequest) (*GetReportingChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReportingChain not implemented")
+}
+func (UnimplementedEmployeeServiceServer) GetOrgChart(context.Context, *GetOrgChartRequest) (*GetOrgChartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgChart not implemented")
+}
 func (UnimplementedEmployeeServiceServer) mustEmbedUnimplementedEmployeeServiceServer() {}
 func (UnimplementedEmployeeServiceServer) testEmbeddedByValue()                         {}
 
@@ -240,6 +336,114 @@ func _EmployeeService_DeleteEmployee_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EmployeeService_TransferEmployee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferEmployeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).TransferEmployee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_TransferEmployee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).TransferEmployee(ctx, req.(*TransferEmployeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListEmployeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEmployeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListEmployeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListEmployeeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListEmployeeHistory(ctx, req.(*ListEmployeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListDirectReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDirectReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListDirectReports_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListDirectReports(ctx, req.(*ListDirectReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_ListUserEmployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserEmploymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).ListUserEmployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_ListUserEmployments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).ListUserEmployments(ctx, req.(*ListUserEmploymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetReportingChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReportingChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetReportingChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetReportingChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetReportingChain(ctx, req.(*GetReportingChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EmployeeService_GetOrgChart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrgChartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EmployeeServiceServer).GetOrgChart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EmployeeService_GetOrgChart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EmployeeServiceServer).GetOrgChart(ctx, req.(*GetOrgChartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EmployeeService_ServiceDesc is the grpc.ServiceDesc for EmployeeService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -267,6 +471,30 @@ var EmployeeService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteEmployee",
 			Handler:    _EmployeeService_DeleteEmployee_Handler,
 		},
+		{
+			MethodName: "TransferEmployee",
+			Handler:    _EmployeeService_TransferEmployee_Handler,
+		},
+		{
+			MethodName: "ListEmployeeHistory",
+			Handler:    _EmployeeService_ListEmployeeHistory_Handler,
+		},
+		{
+			MethodName: "ListDirectReports",
+			Handler:    _EmployeeService_ListDirectReports_Handler,
+		},
+		{
+			MethodName: "ListUserEmployments",
+			Handler:    _EmployeeService_ListUserEmployments_Handler,
+		},
+		{
+			MethodName: "GetReportingChain",
+			Handler:    _EmployeeService_GetReportingChain_Handler,
+		},
+		{
+			MethodName: "GetOrgChart",
+			Handler:    _EmployeeService_GetOrgChart_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "employee/v1/employee.proto",
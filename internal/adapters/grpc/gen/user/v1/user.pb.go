@@ -73,15 +73,29 @@ func (UserStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Status        UserStatus             `protobuf:"varint,4,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state      protoimpl.MessageState  `protogen:"open.v1"`
+	Id         string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email      string                  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Name       string                  `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Status     UserStatus              `protobuf:"varint,4,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	CreatedAt  *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt  *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Labels     map[string]string       `protobuf:"bytes,7,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	FamilyName *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	GivenName  *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	// 姓名とロケールから生成する表示名です。姓名が未設定の場合は name と同じです。
+	DisplayName string `protobuf:"bytes,10,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// BCP 47 の言語タグ（例: ja-JP）です。
+	Locale *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=locale,proto3" json:"locale,omitempty"`
+	// IANA タイムゾーン名（例: Asia/Tokyo）です。
+	TimeZone *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// E.164 形式（例: +819012345678）です。
+	Phone     *wrapperspb.StringValue `protobuf:"bytes,13,opt,name=phone,proto3" json:"phone,omitempty"`
+	AvatarUrl *wrapperspb.StringValue `protobuf:"bytes,14,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	// メールアドレスの所有を確認した日時です。未確認の場合は未設定です。
+	EmailVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=email_verified_at,json=emailVerifiedAt,proto3" json:"email_verified_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -156,10 +170,75 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *User) GetFamilyName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FamilyName
+	}
+	return nil
+}
+
+func (x *User) GetGivenName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.GivenName
+	}
+	return nil
+}
+
+func (x *User) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *User) GetLocale() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Locale
+	}
+	return nil
+}
+
+func (x *User) GetTimeZone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TimeZone
+	}
+	return nil
+}
+
+func (x *User) GetPhone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *User) GetAvatarUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return nil
+}
+
+func (x *User) GetEmailVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EmailVerifiedAt
+	}
+	return nil
+}
+
 type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Email string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// キーは [prefix/]name 形式、値は 63 文字以内です。最大 64 件まで指定できます。
+	Labels        map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -208,6 +287,13 @@ func (x *CreateUserRequest) GetName() string {
 	return ""
 }
 
+func (x *CreateUserRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
 type CreateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -253,10 +339,27 @@ func (x *CreateUserResponse) GetUser() *User {
 }
 
 type UpdateUserRequest struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Id            string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Status        UserStatus              `protobuf:"varint,3,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	state  protoimpl.MessageState  `protogen:"open.v1"`
+	Id     string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name   *wrapperspb.StringValue `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status UserStatus              `protobuf:"varint,3,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	// status を INACTIVE に変更する際に true を指定すると、在籍中の社員レコードも退職させます。
+	CascadeEmployments bool `protobuf:"varint,4,opt,name=cascade_employments,json=cascadeEmployments,proto3" json:"cascade_employments,omitempty"`
+	// 指定したラベルを追加・上書きします。
+	Labels map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// 指定したキーのラベルを削除します。
+	RemoveLabels []string `protobuf:"bytes,6,rep,name=remove_labels,json=removeLabels,proto3" json:"remove_labels,omitempty"`
+	// 以下のプロフィール項目は空文字を指定すると削除します。
+	FamilyName *wrapperspb.StringValue `protobuf:"bytes,7,opt,name=family_name,json=familyName,proto3" json:"family_name,omitempty"`
+	GivenName  *wrapperspb.StringValue `protobuf:"bytes,8,opt,name=given_name,json=givenName,proto3" json:"given_name,omitempty"`
+	// BCP 47 の言語タグです。正規化した表記（例: ja-jp は ja-JP）で保存します。
+	Locale *wrapperspb.StringValue `protobuf:"bytes,9,opt,name=locale,proto3" json:"locale,omitempty"`
+	// IANA タイムゾーン名です。
+	TimeZone *wrapperspb.StringValue `protobuf:"bytes,10,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	// E.164 形式です。空白/ハイフン/括弧/ピリオドの区切り文字は取り除きます。
+	Phone *wrapperspb.StringValue `protobuf:"bytes,11,opt,name=phone,proto3" json:"phone,omitempty"`
+	// http(s) の絶対 URL です。
+	AvatarUrl     *wrapperspb.StringValue `protobuf:"bytes,12,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -312,6 +415,69 @@ func (x *UpdateUserRequest) GetStatus() UserStatus {
 	return UserStatus_USER_STATUS_UNSPECIFIED
 }
 
+func (x *UpdateUserRequest) GetCascadeEmployments() bool {
+	if x != nil {
+		return x.CascadeEmployments
+	}
+	return false
+}
+
+func (x *UpdateUserRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetRemoveLabels() []string {
+	if x != nil {
+		return x.RemoveLabels
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetFamilyName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.FamilyName
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetGivenName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.GivenName
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetLocale() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Locale
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetTimeZone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.TimeZone
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPhone() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetAvatarUrl() *wrapperspb.StringValue {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return nil
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -357,8 +523,10 @@ func (x *UpdateUserResponse) GetUser() *User {
 }
 
 type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// true の場合、紐づく社員レコードを退職させたうえで削除してからユーザーを削除します。
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -400,6 +568,13 @@ func (x *DeleteUserRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteUserRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
 type DeleteUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -525,10 +700,12 @@ func (x *GetUserResponse) GetUser() *User {
 }
 
 type ListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken     string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
-	Status        UserStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	PageSize  int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	Status    UserStatus             `protobuf:"varint,3,opt,name=status,proto3,enum=user.v1.UserStatus" json:"status,omitempty"`
+	// ラベルセレクタです（例: region=apac,tier!=free）。key、!key による存在判定も指定できます。
+	LabelSelector string `protobuf:"bytes,4,opt,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -584,6 +761,13 @@ func (x *ListUsersRequest) GetStatus() UserStatus {
 	return UserStatus_USER_STATUS_UNSPECIFIED
 }
 
+func (x *ListUsersRequest) GetLabelSelector() string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return ""
+}
+
 type ListUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
@@ -636,11 +820,205 @@ func (x *ListUsersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type RequestEmailChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 現在のメールアドレスを指定した場合は、未確認のメールアドレスの確認として扱います。
+	NewEmail      string `protobuf:"bytes,2,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeRequest) Reset() {
+	*x = RequestEmailChangeRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeRequest) ProtoMessage() {}
+
+func (x *RequestEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RequestEmailChangeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeRequest) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+type RequestEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	NewEmail      string                 `protobuf:"bytes,1,opt,name=new_email,json=newEmail,proto3" json:"new_email,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestEmailChangeResponse) Reset() {
+	*x = RequestEmailChangeResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestEmailChangeResponse) ProtoMessage() {}
+
+func (x *RequestEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*RequestEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestEmailChangeResponse) GetNewEmail() string {
+	if x != nil {
+		return x.NewEmail
+	}
+	return ""
+}
+
+func (x *RequestEmailChangeResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type ConfirmEmailChangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// new_email 宛てに送信した確認コードです。
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeRequest) Reset() {
+	*x = ConfirmEmailChangeRequest{}
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeRequest) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeRequest) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ConfirmEmailChangeRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConfirmEmailChangeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmEmailChangeResponse) Reset() {
+	*x = ConfirmEmailChangeResponse{}
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmEmailChangeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmEmailChangeResponse) ProtoMessage() {}
+
+func (x *ConfirmEmailChangeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_v1_user_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmEmailChangeResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmEmailChangeResponse) Descriptor() ([]byte, []int) {
+	return file_user_v1_user_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ConfirmEmailChangeResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
 var File_user_v1_user_proto protoreflect.FileDescriptor
 
 const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
-	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xe3\x01\n" +
+	"\x12user/v1/user.proto\x12\auser.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\x9a\x06\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x12\n" +
@@ -649,38 +1027,87 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"=\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x121\n" +
+	"\x06labels\x18\a \x03(\v2\x19.user.v1.User.LabelsEntryR\x06labels\x12=\n" +
+	"\vfamily_name\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"familyName\x12;\n" +
+	"\n" +
+	"given_name\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\tgivenName\x12!\n" +
+	"\fdisplay_name\x18\n" +
+	" \x01(\tR\vdisplayName\x124\n" +
+	"\x06locale\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x06locale\x129\n" +
+	"\ttime_zone\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\btimeZone\x122\n" +
+	"\x05phone\x18\r \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x12;\n" +
+	"\n" +
+	"avatar_url\x18\x0e \x01(\v2\x1c.google.protobuf.StringValueR\tavatarUrl\x12F\n" +
+	"\x11email_verified_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x0femailVerifiedAt\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\x01\n" +
 	"\x11CreateUserRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\"7\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12>\n" +
+	"\x06labels\x18\x03 \x03(\v2&.user.v1.CreateUserRequest.LabelsEntryR\x06labels\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
 	"\x12CreateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\x82\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xb1\x05\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x120\n" +
 	"\x04name\x18\x02 \x01(\v2\x1c.google.protobuf.StringValueR\x04name\x12+\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x13.user.v1.UserStatusR\x06status\"7\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.user.v1.UserStatusR\x06status\x12/\n" +
+	"\x13cascade_employments\x18\x04 \x01(\bR\x12cascadeEmployments\x12>\n" +
+	"\x06labels\x18\x05 \x03(\v2&.user.v1.UpdateUserRequest.LabelsEntryR\x06labels\x12#\n" +
+	"\rremove_labels\x18\x06 \x03(\tR\fremoveLabels\x12=\n" +
+	"\vfamily_name\x18\a \x01(\v2\x1c.google.protobuf.StringValueR\n" +
+	"familyName\x12;\n" +
+	"\n" +
+	"given_name\x18\b \x01(\v2\x1c.google.protobuf.StringValueR\tgivenName\x124\n" +
+	"\x06locale\x18\t \x01(\v2\x1c.google.protobuf.StringValueR\x06locale\x129\n" +
+	"\ttime_zone\x18\n" +
+	" \x01(\v2\x1c.google.protobuf.StringValueR\btimeZone\x122\n" +
+	"\x05phone\x18\v \x01(\v2\x1c.google.protobuf.StringValueR\x05phone\x12;\n" +
+	"\n" +
+	"avatar_url\x18\f \x01(\v2\x1c.google.protobuf.StringValueR\tavatarUrl\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"7\n" +
 	"\x12UpdateUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"#\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"9\n" +
 	"\x11DeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x14\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"\x14\n" +
 	"\x12DeleteUserResponse\" \n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x0fGetUserResponse\x12!\n" +
-	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"{\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user\"\xa2\x01\n" +
 	"\x10ListUsersRequest\x12\x1b\n" +
 	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
 	"\n" +
 	"page_token\x18\x02 \x01(\tR\tpageToken\x12+\n" +
-	"\x06status\x18\x03 \x01(\x0e2\x13.user.v1.UserStatusR\x06status\"`\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x13.user.v1.UserStatusR\x06status\x12%\n" +
+	"\x0elabel_selector\x18\x04 \x01(\tR\rlabelSelector\"`\n" +
 	"\x11ListUsersResponse\x12#\n" +
 	"\x05users\x18\x01 \x03(\v2\r.user.v1.UserR\x05users\x12&\n" +
-	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken*[\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"H\n" +
+	"\x19RequestEmailChangeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1b\n" +
+	"\tnew_email\x18\x02 \x01(\tR\bnewEmail\"t\n" +
+	"\x1aRequestEmailChangeResponse\x12\x1b\n" +
+	"\tnew_email\x18\x01 \x01(\tR\bnewEmail\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"1\n" +
+	"\x19ConfirmEmailChangeRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"?\n" +
+	"\x1aConfirmEmailChangeResponse\x12!\n" +
+	"\x04user\x18\x01 \x01(\v2\r.user.v1.UserR\x04user*[\n" +
 	"\n" +
 	"UserStatus\x12\x1b\n" +
 	"\x17USER_STATUS_UNSPECIFIED\x10\x00\x12\x16\n" +
 	"\x12USER_STATUS_ACTIVE\x10\x01\x12\x18\n" +
-	"\x14USER_STATUS_INACTIVE\x10\x022\xe4\x02\n" +
+	"\x14USER_STATUS_INACTIVE\x10\x022\xa2\x04\n" +
 	"\vUserService\x12E\n" +
 	"\n" +
 	"CreateUser\x12\x1a.user.v1.CreateUserRequest\x1a\x1b.user.v1.CreateUserResponse\x12E\n" +
@@ -689,7 +1116,9 @@ const file_user_v1_user_proto_rawDesc = "" +
 	"\n" +
 	"DeleteUser\x12\x1a.user.v1.DeleteUserRequest\x1a\x1b.user.v1.DeleteUserResponse\x12<\n" +
 	"\aGetUser\x12\x17.user.v1.GetUserRequest\x1a\x18.user.v1.GetUserResponse\x12B\n" +
-	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponseBXZVgithub.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1;userpbb\x06proto3"
+	"\tListUsers\x12\x19.user.v1.ListUsersRequest\x1a\x1a.user.v1.ListUsersResponse\x12]\n" +
+	"\x12RequestEmailChange\x12\".user.v1.RequestEmailChangeRequest\x1a#.user.v1.RequestEmailChangeResponse\x12]\n" +
+	"\x12ConfirmEmailChange\x12\".user.v1.ConfirmEmailChangeRequest\x1a#.user.v1.ConfirmEmailChangeResponseBXZVgithub.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1;userpbb\x06proto3"
 
 var (
 	file_user_v1_user_proto_rawDescOnce sync.Once
@@ -704,49 +1133,78 @@ func file_user_v1_user_proto_rawDescGZIP() []byte {
 }
 
 var file_user_v1_user_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_user_v1_user_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_user_v1_user_proto_goTypes = []any{
-	(UserStatus)(0),                // 0: user.v1.UserStatus
-	(*User)(nil),                   // 1: user.v1.User
-	(*CreateUserRequest)(nil),      // 2: user.v1.CreateUserRequest
-	(*CreateUserResponse)(nil),     // 3: user.v1.CreateUserResponse
-	(*UpdateUserRequest)(nil),      // 4: user.v1.UpdateUserRequest
-	(*UpdateUserResponse)(nil),     // 5: user.v1.UpdateUserResponse
-	(*DeleteUserRequest)(nil),      // 6: user.v1.DeleteUserRequest
-	(*DeleteUserResponse)(nil),     // 7: user.v1.DeleteUserResponse
-	(*GetUserRequest)(nil),         // 8: user.v1.GetUserRequest
-	(*GetUserResponse)(nil),        // 9: user.v1.GetUserResponse
-	(*ListUsersRequest)(nil),       // 10: user.v1.ListUsersRequest
-	(*ListUsersResponse)(nil),      // 11: user.v1.ListUsersResponse
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
-	(*wrapperspb.StringValue)(nil), // 13: google.protobuf.StringValue
+	(UserStatus)(0),                    // 0: user.v1.UserStatus
+	(*User)(nil),                       // 1: user.v1.User
+	(*CreateUserRequest)(nil),          // 2: user.v1.CreateUserRequest
+	(*CreateUserResponse)(nil),         // 3: user.v1.CreateUserResponse
+	(*UpdateUserRequest)(nil),          // 4: user.v1.UpdateUserRequest
+	(*UpdateUserResponse)(nil),         // 5: user.v1.UpdateUserResponse
+	(*DeleteUserRequest)(nil),          // 6: user.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),         // 7: user.v1.DeleteUserResponse
+	(*GetUserRequest)(nil),             // 8: user.v1.GetUserRequest
+	(*GetUserResponse)(nil),            // 9: user.v1.GetUserResponse
+	(*ListUsersRequest)(nil),           // 10: user.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),          // 11: user.v1.ListUsersResponse
+	(*RequestEmailChangeRequest)(nil),  // 12: user.v1.RequestEmailChangeRequest
+	(*RequestEmailChangeResponse)(nil), // 13: user.v1.RequestEmailChangeResponse
+	(*ConfirmEmailChangeRequest)(nil),  // 14: user.v1.ConfirmEmailChangeRequest
+	(*ConfirmEmailChangeResponse)(nil), // 15: user.v1.ConfirmEmailChangeResponse
+	nil,                                // 16: user.v1.User.LabelsEntry
+	nil,                                // 17: user.v1.CreateUserRequest.LabelsEntry
+	nil,                                // 18: user.v1.UpdateUserRequest.LabelsEntry
+	(*timestamppb.Timestamp)(nil),      // 19: google.protobuf.Timestamp
+	(*wrapperspb.StringValue)(nil),     // 20: google.protobuf.StringValue
 }
 var file_user_v1_user_proto_depIdxs = []int32{
 	0,  // 0: user.v1.User.status:type_name -> user.v1.UserStatus
-	12, // 1: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
-	12, // 2: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: user.v1.CreateUserResponse.user:type_name -> user.v1.User
-	13, // 4: user.v1.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
-	0,  // 5: user.v1.UpdateUserRequest.status:type_name -> user.v1.UserStatus
-	1,  // 6: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
-	1,  // 7: user.v1.GetUserResponse.user:type_name -> user.v1.User
-	0,  // 8: user.v1.ListUsersRequest.status:type_name -> user.v1.UserStatus
-	1,  // 9: user.v1.ListUsersResponse.users:type_name -> user.v1.User
-	2,  // 10: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
-	4,  // 11: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
-	6,  // 12: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
-	8,  // 13: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
-	10, // 14: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
-	3,  // 15: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
-	5,  // 16: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
-	7,  // 17: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
-	9,  // 18: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
-	11, // 19: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
-	15, // [15:20] is the sub-list for method output_type
-	10, // [10:15] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	19, // 1: user.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	19, // 2: user.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 3: user.v1.User.labels:type_name -> user.v1.User.LabelsEntry
+	20, // 4: user.v1.User.family_name:type_name -> google.protobuf.StringValue
+	20, // 5: user.v1.User.given_name:type_name -> google.protobuf.StringValue
+	20, // 6: user.v1.User.locale:type_name -> google.protobuf.StringValue
+	20, // 7: user.v1.User.time_zone:type_name -> google.protobuf.StringValue
+	20, // 8: user.v1.User.phone:type_name -> google.protobuf.StringValue
+	20, // 9: user.v1.User.avatar_url:type_name -> google.protobuf.StringValue
+	19, // 10: user.v1.User.email_verified_at:type_name -> google.protobuf.Timestamp
+	17, // 11: user.v1.CreateUserRequest.labels:type_name -> user.v1.CreateUserRequest.LabelsEntry
+	1,  // 12: user.v1.CreateUserResponse.user:type_name -> user.v1.User
+	20, // 13: user.v1.UpdateUserRequest.name:type_name -> google.protobuf.StringValue
+	0,  // 14: user.v1.UpdateUserRequest.status:type_name -> user.v1.UserStatus
+	18, // 15: user.v1.UpdateUserRequest.labels:type_name -> user.v1.UpdateUserRequest.LabelsEntry
+	20, // 16: user.v1.UpdateUserRequest.family_name:type_name -> google.protobuf.StringValue
+	20, // 17: user.v1.UpdateUserRequest.given_name:type_name -> google.protobuf.StringValue
+	20, // 18: user.v1.UpdateUserRequest.locale:type_name -> google.protobuf.StringValue
+	20, // 19: user.v1.UpdateUserRequest.time_zone:type_name -> google.protobuf.StringValue
+	20, // 20: user.v1.UpdateUserRequest.phone:type_name -> google.protobuf.StringValue
+	20, // 21: user.v1.UpdateUserRequest.avatar_url:type_name -> google.protobuf.StringValue
+	1,  // 22: user.v1.UpdateUserResponse.user:type_name -> user.v1.User
+	1,  // 23: user.v1.GetUserResponse.user:type_name -> user.v1.User
+	0,  // 24: user.v1.ListUsersRequest.status:type_name -> user.v1.UserStatus
+	1,  // 25: user.v1.ListUsersResponse.users:type_name -> user.v1.User
+	19, // 26: user.v1.RequestEmailChangeResponse.expires_at:type_name -> google.protobuf.Timestamp
+	1,  // 27: user.v1.ConfirmEmailChangeResponse.user:type_name -> user.v1.User
+	2,  // 28: user.v1.UserService.CreateUser:input_type -> user.v1.CreateUserRequest
+	4,  // 29: user.v1.UserService.UpdateUser:input_type -> user.v1.UpdateUserRequest
+	6,  // 30: user.v1.UserService.DeleteUser:input_type -> user.v1.DeleteUserRequest
+	8,  // 31: user.v1.UserService.GetUser:input_type -> user.v1.GetUserRequest
+	10, // 32: user.v1.UserService.ListUsers:input_type -> user.v1.ListUsersRequest
+	12, // 33: user.v1.UserService.RequestEmailChange:input_type -> user.v1.RequestEmailChangeRequest
+	14, // 34: user.v1.UserService.ConfirmEmailChange:input_type -> user.v1.ConfirmEmailChangeRequest
+	3,  // 35: user.v1.UserService.CreateUser:output_type -> user.v1.CreateUserResponse
+	5,  // 36: user.v1.UserService.UpdateUser:output_type -> user.v1.UpdateUserResponse
+	7,  // 37: user.v1.UserService.DeleteUser:output_type -> user.v1.DeleteUserResponse
+	9,  // 38: user.v1.UserService.GetUser:output_type -> user.v1.GetUserResponse
+	11, // 39: user.v1.UserService.ListUsers:output_type -> user.v1.ListUsersResponse
+	13, // 40: user.v1.UserService.RequestEmailChange:output_type -> user.v1.RequestEmailChangeResponse
+	15, // 41: user.v1.UserService.ConfirmEmailChange:output_type -> user.v1.ConfirmEmailChangeResponse
+	35, // [35:42] is the sub-list for method output_type
+	28, // [28:35] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_user_v1_user_proto_init() }
@@ -760,7 +1218,7 @@ func file_user_v1_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_v1_user_proto_rawDesc), len(file_user_v1_user_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+
+	authpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/auth/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// bearerTokenType はアクセストークンの種類です。Authorization ヘッダーの認証スキームに対応します。
+const bearerTokenType = "Bearer"
+
+// AuthGrpcHandler は AuthService の gRPC 実装です。
+type AuthGrpcHandler struct {
+	svc auth.UseCase
+	authpb.UnimplementedAuthServiceServer
+}
+
+// NewAuthGrpcHandler は AuthGrpcHandler を生成します。
+func NewAuthGrpcHandler(svc auth.UseCase) *AuthGrpcHandler {
+	return &AuthGrpcHandler{svc: svc}
+}
+
+// RequestPasswordSetup はパスワードを設定していないユーザーへパスワード設定トークンを送信します。
+func (h *AuthGrpcHandler) RequestPasswordSetup(ctx context.Context, req *authpb.RequestPasswordSetupRequest) (*authpb.RequestPasswordSetupResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := h.svc.RequestPasswordSetup(ctx, auth.RequestPasswordSetupInput{Email: req.GetEmail()}); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &authpb.RequestPasswordSetupResponse{}, nil
+}
+
+// SetPassword はユーザーのパスワードを設定します。
+func (h *AuthGrpcHandler) SetPassword(ctx context.Context, req *authpb.SetPasswordRequest) (*authpb.SetPasswordResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := h.svc.SetPassword(ctx, auth.SetPasswordInput{
+		UserID:          req.GetUserId(),
+		SetupToken:      req.GetSetupToken(),
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+	}); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &authpb.SetPasswordResponse{}, nil
+}
+
+// Login はメールアドレスとパスワードでログインし、トークンを発行します。
+func (h *AuthGrpcHandler) Login(ctx context.Context, req *authpb.LoginRequest) (*authpb.LoginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	token, err := h.svc.Login(ctx, auth.LoginInput{
+		Email:    req.GetEmail(),
+		Password: req.GetPassword(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &authpb.LoginResponse{Token: toProtoToken(token)}, nil
+}
+
+// RefreshToken はリフレッシュトークンから新しいトークンを発行します。
+func (h *AuthGrpcHandler) RefreshToken(ctx context.Context, req *authpb.RefreshTokenRequest) (*authpb.RefreshTokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	token, err := h.svc.RefreshToken(ctx, auth.RefreshTokenInput{RefreshToken: req.GetRefreshToken()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &authpb.RefreshTokenResponse{Token: toProtoToken(token)}, nil
+}
+
+func toProtoToken(t *auth.Token) *authpb.Token {
+	if t == nil {
+		return nil
+	}
+	return &authpb.Token{
+		AccessToken:           t.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(t.AccessTokenExpiresAt),
+		RefreshToken:          t.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(t.RefreshTokenExpiresAt),
+		TokenType:             bearerTokenType,
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	authpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/auth/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type stubAuthUseCase struct {
+	requestSetupInput auth.RequestPasswordSetupInput
+	requestSetupErr   error
+
+	setPasswordInput auth.SetPasswordInput
+	setPasswordErr   error
+
+	loginInput auth.LoginInput
+	loginErr   error
+	loginOut   *auth.Token
+
+	refreshInput auth.RefreshTokenInput
+	refreshErr   error
+	refreshOut   *auth.Token
+}
+
+func (s *stubAuthUseCase) RequestPasswordSetup(ctx context.Context, in auth.RequestPasswordSetupInput) error {
+	s.requestSetupInput = in
+	return s.requestSetupErr
+}
+
+func (s *stubAuthUseCase) SetPassword(ctx context.Context, in auth.SetPasswordInput) error {
+	s.setPasswordInput = in
+	return s.setPasswordErr
+}
+
+func (s *stubAuthUseCase) Login(ctx context.Context, in auth.LoginInput) (*auth.Token, error) {
+	s.loginInput = in
+	return s.loginOut, s.loginErr
+}
+
+func (s *stubAuthUseCase) RefreshToken(ctx context.Context, in auth.RefreshTokenInput) (*auth.Token, error) {
+	s.refreshInput = in
+	return s.refreshOut, s.refreshErr
+}
+
+func TestAuthGrpcHandler_SetPassword(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubAuthUseCase{}
+	handler := NewAuthGrpcHandler(stub)
+
+	if _, err := handler.SetPassword(context.Background(), &authpb.SetPasswordRequest{
+		UserId:          "user-1",
+		SetupToken:      "setup",
+		CurrentPassword: "correct horse",
+		NewPassword:     "battery staple",
+	}); err != nil {
+		t.Fatalf("SetPassword returned error: %v", err)
+	}
+	want := auth.SetPasswordInput{UserID: "user-1", SetupToken: "setup", CurrentPassword: "correct horse", NewPassword: "battery staple"}
+	if stub.setPasswordInput != want {
+		t.Fatalf("unexpected input: %+v", stub.setPasswordInput)
+	}
+
+	if _, err := handler.SetPassword(context.Background(), nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for nil request, got %v", err)
+	}
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{fmt.Errorf("%w: too short", auth.ErrInvalidPassword), codes.InvalidArgument},
+		{auth.ErrUserNotFound, codes.NotFound},
+		{auth.ErrInvalidCredentials, codes.Unauthenticated},
+		{fmt.Errorf("%w: setup_token is required", auth.ErrInvalidSetupToken), codes.Unauthenticated},
+		{auth.ErrAccountLocked, codes.PermissionDenied},
+		{auth.ErrPasswordSetupUnavailable, codes.Unimplemented},
+	}
+	for _, tc := range cases {
+		stub.setPasswordErr = tc.err
+		if _, err := handler.SetPassword(context.Background(), &authpb.SetPasswordRequest{UserId: "user-1"}); status.Code(err) != tc.code {
+			t.Errorf("%v: expected %s, got %v", tc.err, tc.code, err)
+		}
+	}
+}
+
+func TestAuthGrpcHandler_RequestPasswordSetup(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubAuthUseCase{}
+	handler := NewAuthGrpcHandler(stub)
+
+	if _, err := handler.RequestPasswordSetup(context.Background(), &authpb.RequestPasswordSetupRequest{Email: "taro@example.com"}); err != nil {
+		t.Fatalf("RequestPasswordSetup returned error: %v", err)
+	}
+	if stub.requestSetupInput.Email != "taro@example.com" {
+		t.Fatalf("unexpected input: %+v", stub.requestSetupInput)
+	}
+
+	if _, err := handler.RequestPasswordSetup(context.Background(), nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for nil request, got %v", err)
+	}
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{auth.ErrInvalidEmail, codes.InvalidArgument},
+		{auth.ErrPasswordSetupUnavailable, codes.Unimplemented},
+	}
+	for _, tc := range cases {
+		stub.requestSetupErr = tc.err
+		if _, err := handler.RequestPasswordSetup(context.Background(), &authpb.RequestPasswordSetupRequest{}); status.Code(err) != tc.code {
+			t.Errorf("%v: expected %s, got %v", tc.err, tc.code, err)
+		}
+	}
+}
+
+func TestAuthGrpcHandler_LoginAndRefresh(t *testing.T) {
+	t.Parallel()
+
+	accessExpiresAt := time.Date(2026, 10, 1, 9, 15, 0, 0, time.UTC)
+	refreshExpiresAt := time.Date(2026, 10, 31, 9, 0, 0, 0, time.UTC)
+	token := &auth.Token{
+		AccessToken:           "access",
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          "refresh",
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}
+	stub := &stubAuthUseCase{loginOut: token, refreshOut: token}
+	handler := NewAuthGrpcHandler(stub)
+
+	loggedIn, err := handler.Login(context.Background(), &authpb.LoginRequest{Email: "taro@example.com", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("Login returned error: %v", err)
+	}
+	if stub.loginInput.Email != "taro@example.com" || stub.loginInput.Password != "correct horse" {
+		t.Fatalf("unexpected input: %+v", stub.loginInput)
+	}
+	got := loggedIn.GetToken()
+	if got.GetAccessToken() != "access" || got.GetRefreshToken() != "refresh" || got.GetTokenType() != "Bearer" {
+		t.Fatalf("unexpected token: %+v", got)
+	}
+	if !got.GetAccessTokenExpiresAt().AsTime().Equal(accessExpiresAt) || !got.GetRefreshTokenExpiresAt().AsTime().Equal(refreshExpiresAt) {
+		t.Fatalf("unexpected expirations: %+v", got)
+	}
+
+	refreshed, err := handler.RefreshToken(context.Background(), &authpb.RefreshTokenRequest{RefreshToken: "refresh"})
+	if err != nil {
+		t.Fatalf("RefreshToken returned error: %v", err)
+	}
+	if stub.refreshInput.RefreshToken != "refresh" || refreshed.GetToken().GetAccessToken() != "access" {
+		t.Fatalf("unexpected refresh: input=%+v response=%+v", stub.refreshInput, refreshed)
+	}
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{auth.ErrInvalidCredentials, codes.Unauthenticated},
+		{auth.ErrAccountLocked, codes.PermissionDenied},
+		{auth.ErrUserInactive, codes.PermissionDenied},
+		{auth.ErrAuthUnavailable, codes.Unimplemented},
+	}
+	for _, tc := range cases {
+		stub.loginErr = tc.err
+		if _, err := handler.Login(context.Background(), &authpb.LoginRequest{}); status.Code(err) != tc.code {
+			t.Errorf("%v: expected %s, got %v", tc.err, tc.code, err)
+		}
+	}
+
+	stub.refreshErr = fmt.Errorf("%w: expired", auth.ErrInvalidToken)
+	if _, err := handler.RefreshToken(context.Background(), &authpb.RefreshTokenRequest{RefreshToken: "refresh"}); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"fmt"
 
 	companypb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
@@ -34,10 +35,26 @@ func (h *CompanyGrpcHandler) CreateCompany(ctx context.Context, req *companypb.C
 		description = &value
 	}
 
+	var parentCompanyID *string
+	if req.GetParentCompanyId() != nil {
+		value := req.GetParentCompanyId().GetValue()
+		parentCompanyID = &value
+	}
+
+	var website *string
+	if req.GetWebsite() != nil {
+		value := req.GetWebsite().GetValue()
+		website = &value
+	}
+
 	created, err := h.svc.CreateCompany(ctx, company.CreateCompanyInput{
-		Name:        req.GetName(),
-		Code:        req.GetCode(),
-		Description: description,
+		Name:               req.GetName(),
+		Code:               req.GetCode(),
+		Description:        description,
+		ParentCompanyID:    parentCompanyID,
+		EmployeeCodePolicy: toDomainEmployeeCodePolicy(req.GetEmployeeCodePolicy()),
+		Labels:             req.GetLabels(),
+		Website:            website,
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -76,9 +93,10 @@ func (h *CompanyGrpcHandler) ListCompanies(ctx context.Context, req *companypb.L
 	}
 
 	result, err := h.svc.ListCompanies(ctx, company.ListCompaniesInput{
-		PageSize:  int(req.GetPageSize()),
-		PageToken: req.GetPageToken(),
-		Status:    statusPtr,
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		Status:        statusPtr,
+		LabelSelector: req.GetLabelSelector(),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -128,12 +146,36 @@ func (h *CompanyGrpcHandler) UpdateCompany(ctx context.Context, req *companypb.U
 		descriptionPtr = &value
 	}
 
+	var parentCompanyIDPtr *string
+	if req.GetParentCompanyId() != nil {
+		value := req.GetParentCompanyId().GetValue()
+		parentCompanyIDPtr = &value
+	}
+
+	var websitePtr *string
+	if req.GetWebsite() != nil {
+		value := req.GetWebsite().GetValue()
+		websitePtr = &value
+	}
+
+	employeesTerminatedAt, err := parseDateValue(req.GetEmployeesTerminatedAt())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("employees_terminated_at: %v", err))
+	}
+
 	updated, err := h.svc.UpdateCompany(ctx, company.UpdateCompanyInput{
-		ID:          req.GetId(),
-		Name:        namePtr,
-		Code:        codePtr,
-		Status:      statusPtr,
-		Description: descriptionPtr,
+		ID:                    req.GetId(),
+		Name:                  namePtr,
+		Code:                  codePtr,
+		Status:                statusPtr,
+		Description:           descriptionPtr,
+		ParentCompanyID:       parentCompanyIDPtr,
+		CascadeEmployees:      req.GetCascadeEmployees(),
+		EmployeesTerminatedAt: employeesTerminatedAt,
+		EmployeeCodePolicy:    toDomainEmployeeCodePolicy(req.GetEmployeeCodePolicy()),
+		Labels:                req.GetLabels(),
+		RemoveLabels:          req.GetRemoveLabels(),
+		Website:               websitePtr,
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -148,11 +190,223 @@ func (h *CompanyGrpcHandler) DeleteCompany(ctx context.Context, req *companypb.D
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	if err := h.svc.DeleteCompany(ctx, company.DeleteCompanyInput{ID: req.GetId()}); err != nil {
+	result, err := h.svc.DeleteCompany(ctx, company.DeleteCompanyInput{ID: req.GetId(), Force: req.GetForce()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.DeleteCompanyResponse{DeletedEmployees: int32(result.DeletedEmployees)}, nil
+}
+
+// ListSubsidiaries は子会社の一覧を取得します。
+func (h *CompanyGrpcHandler) ListSubsidiaries(ctx context.Context, req *companypb.ListSubsidiariesRequest) (*companypb.ListSubsidiariesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var statusPtr *company.Status
+	if req.GetStatus() != companypb.CompanyStatus_COMPANY_STATUS_UNSPECIFIED {
+		domainStatus, err := toDomainCompanyStatus(req.GetStatus())
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		statusPtr = &domainStatus
+	}
+
+	result, err := h.svc.ListSubsidiaries(ctx, company.ListSubsidiariesInput{
+		CompanyID: req.GetCompanyId(),
+		Recursive: req.GetRecursive(),
+		PageSize:  int(req.GetPageSize()),
+		PageToken: req.GetPageToken(),
+		Status:    statusPtr,
+	})
+	if err != nil {
 		return nil, toStatusError(err)
 	}
 
-	return &companypb.DeleteCompanyResponse{}, nil
+	protoCompanies := make([]*companypb.Company, 0, len(result.Companies))
+	for _, c := range result.Companies {
+		protoCompanies = append(protoCompanies, toProtoCompany(c))
+	}
+
+	return &companypb.ListSubsidiariesResponse{
+		Companies:     protoCompanies,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// GetCompanyAncestry は直近の親会社から最上位の会社までを取得します。
+func (h *CompanyGrpcHandler) GetCompanyAncestry(ctx context.Context, req *companypb.GetCompanyAncestryRequest) (*companypb.GetCompanyAncestryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	ancestors, err := h.svc.GetCompanyAncestry(ctx, company.GetCompanyAncestryInput{ID: req.GetId()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoCompanies := make([]*companypb.Company, 0, len(ancestors))
+	for _, c := range ancestors {
+		protoCompanies = append(protoCompanies, toProtoCompany(c))
+	}
+
+	return &companypb.GetCompanyAncestryResponse{Ancestors: protoCompanies}, nil
+}
+
+// CreateEmployeeAttribute は社員属性の定義を追加します。
+func (h *CompanyGrpcHandler) CreateEmployeeAttribute(ctx context.Context, req *companypb.CreateEmployeeAttributeRequest) (*companypb.CreateEmployeeAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	attributeType, err := toDomainAttributeType(req.GetType())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	attribute, err := h.svc.CreateEmployeeAttribute(ctx, company.CreateEmployeeAttributeInput{
+		CompanyID:     req.GetCompanyId(),
+		Key:           req.GetKey(),
+		Type:          attributeType,
+		Required:      req.GetRequired(),
+		AllowedValues: req.GetAllowedValues(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.CreateEmployeeAttributeResponse{Attribute: toProtoEmployeeAttribute(attribute)}, nil
+}
+
+// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+func (h *CompanyGrpcHandler) UpdateEmployeeAttribute(ctx context.Context, req *companypb.UpdateEmployeeAttributeRequest) (*companypb.UpdateEmployeeAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	attribute, err := h.svc.UpdateEmployeeAttribute(ctx, company.UpdateEmployeeAttributeInput{
+		CompanyID:     req.GetCompanyId(),
+		Key:           req.GetKey(),
+		Required:      req.GetRequired(),
+		AllowedValues: req.GetAllowedValues(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.UpdateEmployeeAttributeResponse{Attribute: toProtoEmployeeAttribute(attribute)}, nil
+}
+
+// DeleteEmployeeAttribute は社員属性の定義を削除します。
+func (h *CompanyGrpcHandler) DeleteEmployeeAttribute(ctx context.Context, req *companypb.DeleteEmployeeAttributeRequest) (*companypb.DeleteEmployeeAttributeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := h.svc.DeleteEmployeeAttribute(ctx, company.DeleteEmployeeAttributeInput{
+		CompanyID: req.GetCompanyId(),
+		Key:       req.GetKey(),
+	}); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.DeleteEmployeeAttributeResponse{}, nil
+}
+
+// ListEmployeeAttributes は会社の社員属性の定義を返します。
+func (h *CompanyGrpcHandler) ListEmployeeAttributes(ctx context.Context, req *companypb.ListEmployeeAttributesRequest) (*companypb.ListEmployeeAttributesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	attributes, err := h.svc.ListEmployeeAttributes(ctx, company.ListEmployeeAttributesInput{CompanyID: req.GetCompanyId()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoAttributes := make([]*companypb.EmployeeAttribute, 0, len(attributes))
+	for _, a := range attributes {
+		protoAttributes = append(protoAttributes, toProtoEmployeeAttribute(a))
+	}
+
+	return &companypb.ListEmployeeAttributesResponse{Attributes: protoAttributes}, nil
+}
+
+// AddCompanyAddress は会社に住所を追加します。
+func (h *CompanyGrpcHandler) AddCompanyAddress(ctx context.Context, req *companypb.AddCompanyAddressRequest) (*companypb.AddCompanyAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	addressType, err := toDomainAddressType(req.GetType())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	updated, err := h.svc.AddCompanyAddress(ctx, company.AddCompanyAddressInput{
+		CompanyID:  req.GetCompanyId(),
+		Type:       addressType,
+		Country:    req.GetCountry(),
+		PostalCode: req.GetPostalCode(),
+		Lines:      req.GetLines(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.AddCompanyAddressResponse{Company: toProtoCompany(updated)}, nil
+}
+
+// RemoveCompanyAddress は会社の住所を削除します。
+func (h *CompanyGrpcHandler) RemoveCompanyAddress(ctx context.Context, req *companypb.RemoveCompanyAddressRequest) (*companypb.RemoveCompanyAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	updated, err := h.svc.RemoveCompanyAddress(ctx, company.RemoveCompanyAddressInput{
+		CompanyID: req.GetCompanyId(),
+		AddressID: req.GetAddressId(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.RemoveCompanyAddressResponse{Company: toProtoCompany(updated)}, nil
+}
+
+// AddCompanyPhone は会社に電話番号を追加します。
+func (h *CompanyGrpcHandler) AddCompanyPhone(ctx context.Context, req *companypb.AddCompanyPhoneRequest) (*companypb.AddCompanyPhoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	updated, err := h.svc.AddCompanyPhone(ctx, company.AddCompanyPhoneInput{
+		CompanyID: req.GetCompanyId(),
+		Number:    req.GetNumber(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.AddCompanyPhoneResponse{Company: toProtoCompany(updated)}, nil
+}
+
+// RemoveCompanyPhone は会社の電話番号を削除します。
+func (h *CompanyGrpcHandler) RemoveCompanyPhone(ctx context.Context, req *companypb.RemoveCompanyPhoneRequest) (*companypb.RemoveCompanyPhoneResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	updated, err := h.svc.RemoveCompanyPhone(ctx, company.RemoveCompanyPhoneInput{
+		CompanyID: req.GetCompanyId(),
+		PhoneID:   req.GetPhoneId(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &companypb.RemoveCompanyPhoneResponse{Company: toProtoCompany(updated)}, nil
 }
 
 func toProtoCompany(c *company.Company) *companypb.Company {
@@ -165,14 +419,66 @@ func toProtoCompany(c *company.Company) *companypb.Company {
 		description = wrapperspb.String(*c.Description)
 	}
 
+	var parentCompanyID *wrapperspb.StringValue
+	if c.ParentCompanyID != nil {
+		parentCompanyID = wrapperspb.String(*c.ParentCompanyID)
+	}
+
+	var website *wrapperspb.StringValue
+	if c.Website != nil {
+		website = wrapperspb.String(*c.Website)
+	}
+
+	addresses := make([]*companypb.Address, 0, len(c.Addresses))
+	for _, a := range c.Addresses {
+		addresses = append(addresses, &companypb.Address{
+			Id:         a.ID,
+			Type:       toProtoAddressType(a.Type),
+			Country:    a.Country,
+			PostalCode: a.PostalCode,
+			Lines:      a.Lines,
+			CreatedAt:  timestamppb.New(a.CreatedAt),
+		})
+	}
+
+	phones := make([]*companypb.Phone, 0, len(c.Phones))
+	for _, p := range c.Phones {
+		phones = append(phones, &companypb.Phone{
+			Id:        p.ID,
+			Number:    p.Number,
+			CreatedAt: timestamppb.New(p.CreatedAt),
+		})
+	}
+
 	return &companypb.Company{
-		Id:          c.ID,
-		Name:        c.Name,
-		Code:        c.Code,
-		Status:      toProtoCompanyStatus(c.Status),
-		Description: description,
-		CreatedAt:   timestamppb.New(c.CreatedAt),
-		UpdatedAt:   timestamppb.New(c.UpdatedAt),
+		Id:              c.ID,
+		Name:            c.Name,
+		Code:            c.Code,
+		Status:          toProtoCompanyStatus(c.Status),
+		Description:     description,
+		ParentCompanyId: parentCompanyID,
+		CreatedAt:       timestamppb.New(c.CreatedAt),
+		UpdatedAt:       timestamppb.New(c.UpdatedAt),
+		EmployeeCodePolicy: &companypb.EmployeeCodePolicy{
+			Prefix:       c.EmployeeCodePolicy.Prefix,
+			Width:        int32(c.EmployeeCodePolicy.Width),
+			NextSequence: c.EmployeeCodePolicy.NextSequence,
+		},
+		Labels:    c.Labels,
+		Website:   website,
+		Addresses: addresses,
+		Phones:    phones,
+	}
+}
+
+func toDomainEmployeeCodePolicy(policy *companypb.EmployeeCodePolicy) *company.EmployeeCodePolicy {
+	if policy == nil {
+		return nil
+	}
+	return &company.EmployeeCodePolicy{
+		Prefix:       policy.GetPrefix(),
+		Width:        int(policy.GetWidth()),
+		NextSequence: policy.GetNextSequence(),
 	}
 }
 
@@ -199,3 +505,75 @@ func toDomainCompanyStatus(status companypb.CompanyStatus) (company.Status, erro
 		return "", company.ErrInvalidStatus
 	}
 }
+
+func toProtoEmployeeAttribute(a *company.EmployeeAttribute) *companypb.EmployeeAttribute {
+	if a == nil {
+		return nil
+	}
+
+	return &companypb.EmployeeAttribute{
+		CompanyId:     a.CompanyID,
+		Key:           a.Key,
+		Type:          toProtoAttributeType(a.Type),
+		Required:      a.Required,
+		AllowedValues: a.AllowedValues,
+		CreatedAt:     timestamppb.New(a.CreatedAt),
+		UpdatedAt:     timestamppb.New(a.UpdatedAt),
+	}
+}
+
+func toProtoAttributeType(t company.AttributeType) companypb.AttributeType {
+	switch t {
+	case company.AttributeTypeString:
+		return companypb.AttributeType_ATTRIBUTE_TYPE_STRING
+	case company.AttributeTypeNumber:
+		return companypb.AttributeType_ATTRIBUTE_TYPE_NUMBER
+	case company.AttributeTypeDate:
+		return companypb.AttributeType_ATTRIBUTE_TYPE_DATE
+	case company.AttributeTypeEnum:
+		return companypb.AttributeType_ATTRIBUTE_TYPE_ENUM
+	default:
+		return companypb.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+	}
+}
+
+func toDomainAttributeType(t companypb.AttributeType) (company.AttributeType, error) {
+	switch t {
+	case companypb.AttributeType_ATTRIBUTE_TYPE_STRING:
+		return company.AttributeTypeString, nil
+	case companypb.AttributeType_ATTRIBUTE_TYPE_NUMBER:
+		return company.AttributeTypeNumber, nil
+	case companypb.AttributeType_ATTRIBUTE_TYPE_DATE:
+		return company.AttributeTypeDate, nil
+	case companypb.AttributeType_ATTRIBUTE_TYPE_ENUM:
+		return company.AttributeTypeEnum, nil
+	default:
+		return "", company.ErrInvalidAttributeType
+	}
+}
+
+func toProtoAddressType(t company.AddressType) companypb.AddressType {
+	switch t {
+	case company.AddressTypeBilling:
+		return companypb.AddressType_ADDRESS_TYPE_BILLING
+	case company.AddressTypeRegistered:
+		return companypb.AddressType_ADDRESS_TYPE_REGISTERED
+	case company.AddressTypeOffice:
+		return companypb.AddressType_ADDRESS_TYPE_OFFICE
+	default:
+		return companypb.AddressType_ADDRESS_TYPE_UNSPECIFIED
+	}
+}
+
+func toDomainAddressType(t companypb.AddressType) (company.AddressType, error) {
+	switch t {
+	case companypb.AddressType_ADDRESS_TYPE_BILLING:
+		return company.AddressTypeBilling, nil
+	case companypb.AddressType_ADDRESS_TYPE_REGISTERED:
+		return company.AddressTypeRegistered, nil
+	case companypb.AddressType_ADDRESS_TYPE_OFFICE:
+		return company.AddressTypeOffice, nil
+	default:
+		return "", company.ErrInvalidAddressType
+	}
+}
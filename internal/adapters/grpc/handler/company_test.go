@@ -3,11 +3,13 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	companypb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -32,6 +34,46 @@ type stubCompanyUseCase struct {
 
 	deleteInput company.DeleteCompanyInput
 	deleteErr   error
+	deleteOut   *company.DeleteCompanyResult
+
+	subsidiariesInput company.ListSubsidiariesInput
+	subsidiariesErr   error
+	subsidiariesOut   *company.ListCompaniesResult
+
+	ancestryInput company.GetCompanyAncestryInput
+	ancestryErr   error
+	ancestryOut   []*company.Company
+
+	createAttributeInput company.CreateEmployeeAttributeInput
+	createAttributeErr   error
+	createAttributeOut   *company.EmployeeAttribute
+
+	updateAttributeInput company.UpdateEmployeeAttributeInput
+	updateAttributeErr   error
+	updateAttributeOut   *company.EmployeeAttribute
+
+	deleteAttributeInput company.DeleteEmployeeAttributeInput
+	deleteAttributeErr   error
+
+	listAttributesInput company.ListEmployeeAttributesInput
+	listAttributesErr   error
+	listAttributesOut   []*company.EmployeeAttribute
+
+	addAddressInput company.AddCompanyAddressInput
+	addAddressErr   error
+	addAddressOut   *company.Company
+
+	removeAddressInput company.RemoveCompanyAddressInput
+	removeAddressErr   error
+	removeAddressOut   *company.Company
+
+	addPhoneInput company.AddCompanyPhoneInput
+	addPhoneErr   error
+	addPhoneOut   *company.Company
+
+	removePhoneInput company.RemoveCompanyPhoneInput
+	removePhoneErr   error
+	removePhoneOut   *company.Company
 }
 
 func (s *stubCompanyUseCase) CreateCompany(ctx context.Context, in company.CreateCompanyInput) (*company.Company, error) {
@@ -54,9 +96,59 @@ func (s *stubCompanyUseCase) UpdateCompany(ctx context.Context, in company.Updat
 	return s.updateOut, s.updateErr
 }
 
-func (s *stubCompanyUseCase) DeleteCompany(ctx context.Context, in company.DeleteCompanyInput) error {
+func (s *stubCompanyUseCase) DeleteCompany(ctx context.Context, in company.DeleteCompanyInput) (*company.DeleteCompanyResult, error) {
 	s.deleteInput = in
-	return s.deleteErr
+	return s.deleteOut, s.deleteErr
+}
+
+func (s *stubCompanyUseCase) ListSubsidiaries(ctx context.Context, in company.ListSubsidiariesInput) (*company.ListCompaniesResult, error) {
+	s.subsidiariesInput = in
+	return s.subsidiariesOut, s.subsidiariesErr
+}
+
+func (s *stubCompanyUseCase) GetCompanyAncestry(ctx context.Context, in company.GetCompanyAncestryInput) ([]*company.Company, error) {
+	s.ancestryInput = in
+	return s.ancestryOut, s.ancestryErr
+}
+
+func (s *stubCompanyUseCase) CreateEmployeeAttribute(ctx context.Context, in company.CreateEmployeeAttributeInput) (*company.EmployeeAttribute, error) {
+	s.createAttributeInput = in
+	return s.createAttributeOut, s.createAttributeErr
+}
+
+func (s *stubCompanyUseCase) UpdateEmployeeAttribute(ctx context.Context, in company.UpdateEmployeeAttributeInput) (*company.EmployeeAttribute, error) {
+	s.updateAttributeInput = in
+	return s.updateAttributeOut, s.updateAttributeErr
+}
+
+func (s *stubCompanyUseCase) DeleteEmployeeAttribute(ctx context.Context, in company.DeleteEmployeeAttributeInput) error {
+	s.deleteAttributeInput = in
+	return s.deleteAttributeErr
+}
+
+func (s *stubCompanyUseCase) ListEmployeeAttributes(ctx context.Context, in company.ListEmployeeAttributesInput) ([]*company.EmployeeAttribute, error) {
+	s.listAttributesInput = in
+	return s.listAttributesOut, s.listAttributesErr
+}
+
+func (s *stubCompanyUseCase) AddCompanyAddress(ctx context.Context, in company.AddCompanyAddressInput) (*company.Company, error) {
+	s.addAddressInput = in
+	return s.addAddressOut, s.addAddressErr
+}
+
+func (s *stubCompanyUseCase) RemoveCompanyAddress(ctx context.Context, in company.RemoveCompanyAddressInput) (*company.Company, error) {
+	s.removeAddressInput = in
+	return s.removeAddressOut, s.removeAddressErr
+}
+
+func (s *stubCompanyUseCase) AddCompanyPhone(ctx context.Context, in company.AddCompanyPhoneInput) (*company.Company, error) {
+	s.addPhoneInput = in
+	return s.addPhoneOut, s.addPhoneErr
+}
+
+func (s *stubCompanyUseCase) RemoveCompanyPhone(ctx context.Context, in company.RemoveCompanyPhoneInput) (*company.Company, error) {
+	s.removePhoneInput = in
+	return s.removePhoneOut, s.removePhoneErr
 }
 
 func TestCompanyGrpcHandler_CreateCompany(t *testing.T) {
@@ -105,6 +197,113 @@ func TestCompanyGrpcHandler_CreateCompany_ErrorMapping(t *testing.T) {
 	}
 }
 
+func TestCompanyGrpcHandler_EmployeeCodePolicy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	policy := company.EmployeeCodePolicy{Prefix: "hq-", Width: 4, NextSequence: 12}
+	stub := &stubCompanyUseCase{
+		createOut: &company.Company{ID: "company-1", Status: company.StatusActive, EmployeeCodePolicy: policy, CreatedAt: now, UpdatedAt: now},
+		updateErr: fmt.Errorf("width: %w", company.ErrInvalidEmployeeCodePolicy),
+	}
+	handler := NewCompanyGrpcHandler(stub)
+
+	resp, err := handler.CreateCompany(context.Background(), &companypb.CreateCompanyRequest{
+		Name:               "Example",
+		Code:               "example",
+		EmployeeCodePolicy: &companypb.EmployeeCodePolicy{Prefix: "hq-", Width: 4},
+	})
+	if err != nil {
+		t.Fatalf("CreateCompany returned error: %v", err)
+	}
+	if in := stub.createInput.EmployeeCodePolicy; in == nil || in.Prefix != "hq-" || in.Width != 4 || in.NextSequence != 0 {
+		t.Fatalf("expected policy to be passed through, got %+v", in)
+	}
+	got := resp.GetCompany().GetEmployeeCodePolicy()
+	if got.GetPrefix() != "hq-" || got.GetWidth() != 4 || got.GetNextSequence() != 12 {
+		t.Fatalf("unexpected policy in response: %+v", got)
+	}
+
+	_, err = handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{Id: "company-1", EmployeeCodePolicy: &companypb.EmployeeCodePolicy{Width: 30}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if stub.updateInput.EmployeeCodePolicy == nil || stub.updateInput.EmployeeCodePolicy.Width != 30 {
+		t.Fatalf("expected policy to be passed through, got %+v", stub.updateInput.EmployeeCodePolicy)
+	}
+
+	if _, err := handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{Id: "company-1"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if stub.updateInput.EmployeeCodePolicy != nil {
+		t.Fatalf("expected omitted policy to stay nil, got %+v", stub.updateInput.EmployeeCodePolicy)
+	}
+}
+
+func TestCompanyGrpcHandler_EmployeeAttributes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	stub := &stubCompanyUseCase{
+		createAttributeOut: &company.EmployeeAttribute{
+			CompanyID:     "company-1",
+			Key:           "grade",
+			Type:          company.AttributeTypeEnum,
+			Required:      true,
+			AllowedValues: []string{"G1", "G2"},
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		},
+		updateAttributeErr: company.ErrAttributeNotFound,
+		deleteAttributeErr: company.ErrInvalidAttributeKey,
+		listAttributesOut: []*company.EmployeeAttribute{
+			{CompanyID: "company-1", Key: "band", Type: company.AttributeTypeNumber},
+			{CompanyID: "company-1", Key: "grade", Type: company.AttributeTypeEnum},
+		},
+	}
+	handler := NewCompanyGrpcHandler(stub)
+
+	resp, err := handler.CreateEmployeeAttribute(context.Background(), &companypb.CreateEmployeeAttributeRequest{
+		CompanyId:     "company-1",
+		Key:           "grade",
+		Type:          companypb.AttributeType_ATTRIBUTE_TYPE_ENUM,
+		Required:      true,
+		AllowedValues: []string{"G1", "G2"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEmployeeAttribute returned error: %v", err)
+	}
+	if in := stub.createAttributeInput; in.Type != company.AttributeTypeEnum || !in.Required || len(in.AllowedValues) != 2 {
+		t.Fatalf("expected input to be passed through, got %+v", in)
+	}
+	if got := resp.GetAttribute(); got.GetType() != companypb.AttributeType_ATTRIBUTE_TYPE_ENUM || got.GetKey() != "grade" || len(got.GetAllowedValues()) != 2 {
+		t.Fatalf("unexpected attribute in response: %+v", got)
+	}
+
+	if _, err := handler.CreateEmployeeAttribute(context.Background(), &companypb.CreateEmployeeAttributeRequest{CompanyId: "company-1", Key: "grade"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for unspecified type, got %v", err)
+	}
+
+	if _, err := handler.UpdateEmployeeAttribute(context.Background(), &companypb.UpdateEmployeeAttributeRequest{CompanyId: "company-1", Key: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", err)
+	}
+
+	if _, err := handler.DeleteEmployeeAttribute(context.Background(), &companypb.DeleteEmployeeAttributeRequest{CompanyId: "company-1", Key: "1x"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if stub.deleteAttributeInput.Key != "1x" {
+		t.Fatalf("expected key to be passed through, got %+v", stub.deleteAttributeInput)
+	}
+
+	listResp, err := handler.ListEmployeeAttributes(context.Background(), &companypb.ListEmployeeAttributesRequest{CompanyId: "company-1"})
+	if err != nil {
+		t.Fatalf("ListEmployeeAttributes returned error: %v", err)
+	}
+	if len(listResp.GetAttributes()) != 2 || listResp.GetAttributes()[0].GetType() != companypb.AttributeType_ATTRIBUTE_TYPE_NUMBER {
+		t.Fatalf("unexpected attributes: %+v", listResp.GetAttributes())
+	}
+}
+
 func TestCompanyGrpcHandler_GetCompany_Success(t *testing.T) {
 	t.Parallel()
 
@@ -235,6 +434,62 @@ func TestCompanyGrpcHandler_DeleteCompany_Error(t *testing.T) {
 	}
 }
 
+func TestCompanyGrpcHandler_DeleteCompany_Force(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubCompanyUseCase{deleteErr: fmt.Errorf("%w: 2 active employees", company.ErrCompanyHasActiveEmployees)}
+	handler := NewCompanyGrpcHandler(stub)
+
+	_, err := handler.DeleteCompany(context.Background(), &companypb.DeleteCompanyRequest{Id: "company-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+
+	stub.deleteErr = nil
+	stub.deleteOut = &company.DeleteCompanyResult{DeletedEmployees: 3}
+	resp, err := handler.DeleteCompany(context.Background(), &companypb.DeleteCompanyRequest{Id: "company-1", Force: true})
+	if err != nil {
+		t.Fatalf("DeleteCompany returned error: %v", err)
+	}
+	if !stub.deleteInput.Force {
+		t.Fatalf("expected force to be passed to the use case")
+	}
+	if resp.GetDeletedEmployees() != 3 {
+		t.Fatalf("expected 3 deleted employees, got %d", resp.GetDeletedEmployees())
+	}
+}
+
+func TestCompanyGrpcHandler_UpdateCompany_CascadeEmployees(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubCompanyUseCase{updateOut: &company.Company{ID: "company-1", Status: company.StatusInactive}}
+	handler := NewCompanyGrpcHandler(stub)
+
+	if _, err := handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{
+		Id:                    "company-1",
+		Status:                companypb.CompanyStatus_COMPANY_STATUS_INACTIVE,
+		CascadeEmployees:      true,
+		EmployeesTerminatedAt: wrapperspb.String("2025-03-31"),
+	}); err != nil {
+		t.Fatalf("UpdateCompany returned error: %v", err)
+	}
+	if !stub.updateInput.CascadeEmployees {
+		t.Fatalf("expected cascade employees to be passed to the use case")
+	}
+	want := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	if stub.updateInput.EmployeesTerminatedAt == nil || !stub.updateInput.EmployeesTerminatedAt.Equal(want) {
+		t.Fatalf("expected terminated date %v, got %v", want, stub.updateInput.EmployeesTerminatedAt)
+	}
+
+	_, err := handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{
+		Id:                    "company-1",
+		EmployeesTerminatedAt: wrapperspb.String("2025/03/31"),
+	})
+	if !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for malformed date, got %v", err)
+	}
+}
+
 func TestCompanyGrpcHandler_ValidatesNilRequest(t *testing.T) {
 	t.Parallel()
 
@@ -264,6 +519,77 @@ func TestCompanyGrpcHandler_ValidatesNilRequest(t *testing.T) {
 	if !isInvalidArgument(err) {
 		t.Fatalf("expected invalid argument for delete")
 	}
+
+	_, err = handler.ListSubsidiaries(context.Background(), nil)
+	if !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for list subsidiaries")
+	}
+
+	_, err = handler.GetCompanyAncestry(context.Background(), nil)
+	if !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for get ancestry")
+	}
+}
+
+func TestCompanyGrpcHandler_Hierarchy(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	parentID := "company-1"
+	stub := &stubCompanyUseCase{
+		subsidiariesOut: &company.ListCompaniesResult{
+			Companies: []*company.Company{
+				{ID: "company-2", Name: "Child", Code: "child", Status: company.StatusActive, ParentCompanyID: &parentID, CreatedAt: now, UpdatedAt: now},
+			},
+			NextPageToken: "1",
+		},
+		ancestryOut: []*company.Company{
+			{ID: parentID, Name: "Root", Code: "root", Status: company.StatusActive, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+	handler := NewCompanyGrpcHandler(stub)
+
+	listResp, err := handler.ListSubsidiaries(context.Background(), &companypb.ListSubsidiariesRequest{
+		CompanyId: parentID,
+		Recursive: true,
+		PageSize:  1,
+		Status:    companypb.CompanyStatus_COMPANY_STATUS_ACTIVE,
+	})
+	if err != nil {
+		t.Fatalf("ListSubsidiaries returned error: %v", err)
+	}
+	if stub.subsidiariesInput.CompanyID != parentID || !stub.subsidiariesInput.Recursive {
+		t.Fatalf("unexpected input: %+v", stub.subsidiariesInput)
+	}
+	if stub.subsidiariesInput.Status == nil || *stub.subsidiariesInput.Status != company.StatusActive {
+		t.Fatalf("expected active status filter")
+	}
+	if len(listResp.GetCompanies()) != 1 || listResp.GetCompanies()[0].GetParentCompanyId().GetValue() != parentID {
+		t.Fatalf("unexpected companies: %+v", listResp.GetCompanies())
+	}
+	if listResp.GetNextPageToken() != "1" {
+		t.Fatalf("expected next token 1, got %s", listResp.GetNextPageToken())
+	}
+
+	ancestryResp, err := handler.GetCompanyAncestry(context.Background(), &companypb.GetCompanyAncestryRequest{Id: "company-2"})
+	if err != nil {
+		t.Fatalf("GetCompanyAncestry returned error: %v", err)
+	}
+	if stub.ancestryInput.ID != "company-2" {
+		t.Fatalf("unexpected input: %+v", stub.ancestryInput)
+	}
+	if len(ancestryResp.GetAncestors()) != 1 || ancestryResp.GetAncestors()[0].GetParentCompanyId() != nil {
+		t.Fatalf("unexpected ancestors: %+v", ancestryResp.GetAncestors())
+	}
+
+	stub.updateErr = company.ErrHierarchyCycle
+	_, err = handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{Id: parentID, ParentCompanyId: wrapperspb.String("company-2")})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+	if stub.updateInput.ParentCompanyID == nil || *stub.updateInput.ParentCompanyID != "company-2" {
+		t.Fatalf("expected parent company id to be passed through")
+	}
 }
 
 func isInvalidArgument(err error) bool {
@@ -278,3 +604,133 @@ func TestToDomainCompanyStatus_Invalid(t *testing.T) {
 		t.Fatalf("expected ErrInvalidStatus, got %v", err)
 	}
 }
+
+func TestCompanyGrpcHandler_Labels(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubCompanyUseCase{
+		createOut: &company.Company{ID: "company-1", Labels: map[string]string{"region": "apac"}},
+		updateOut: &company.Company{ID: "company-1"},
+		listOut:   &company.ListCompaniesResult{Companies: []*company.Company{}},
+	}
+	handler := NewCompanyGrpcHandler(stub)
+
+	resp, err := handler.CreateCompany(context.Background(), &companypb.CreateCompanyRequest{Name: "Example", Code: "example", Labels: map[string]string{"region": "apac"}})
+	if err != nil {
+		t.Fatalf("CreateCompany returned error: %v", err)
+	}
+	if stub.createInput.Labels["region"] != "apac" {
+		t.Fatalf("expected labels passed through, got %+v", stub.createInput.Labels)
+	}
+	if resp.GetCompany().GetLabels()["region"] != "apac" {
+		t.Fatalf("expected labels in response, got %+v", resp.GetCompany().GetLabels())
+	}
+
+	if _, err := handler.UpdateCompany(context.Background(), &companypb.UpdateCompanyRequest{Id: "company-1", Labels: map[string]string{"tier": "gold"}, RemoveLabels: []string{"region"}}); err != nil {
+		t.Fatalf("UpdateCompany returned error: %v", err)
+	}
+	if stub.updateInput.Labels["tier"] != "gold" || len(stub.updateInput.RemoveLabels) != 1 || stub.updateInput.RemoveLabels[0] != "region" {
+		t.Fatalf("unexpected update input: %+v", stub.updateInput)
+	}
+
+	if _, err := handler.ListCompanies(context.Background(), &companypb.ListCompaniesRequest{LabelSelector: "region=apac,tier!=free"}); err != nil {
+		t.Fatalf("ListCompanies returned error: %v", err)
+	}
+	if stub.listInput.LabelSelector != "region=apac,tier!=free" {
+		t.Fatalf("expected label selector passed through, got %q", stub.listInput.LabelSelector)
+	}
+
+	stub.createErr = fmt.Errorf("wrap: %w", label.ErrTooManyLabels)
+	if _, err := handler.CreateCompany(context.Background(), &companypb.CreateCompanyRequest{Name: "Example", Code: "example"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+}
+
+func TestCompanyGrpcHandler_Contacts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	website := "https://example.com"
+	withContacts := &company.Company{
+		ID:        "company-1",
+		Website:   &website,
+		Addresses: []*company.Address{{ID: "address-1", CompanyID: "company-1", Type: company.AddressTypeRegistered, Country: "JP", PostalCode: "100-0001", Lines: []string{"東京都千代田区"}, CreatedAt: now}},
+		Phones:    []*company.Phone{{ID: "phone-1", CompanyID: "company-1", Number: "+81312345678", CreatedAt: now}},
+	}
+	stub := &stubCompanyUseCase{
+		createOut:        withContacts,
+		updateOut:        &company.Company{ID: "company-1"},
+		addAddressOut:    withContacts,
+		removeAddressOut: &company.Company{ID: "company-1"},
+		addPhoneOut:      withContacts,
+		removePhoneOut:   &company.Company{ID: "company-1"},
+	}
+	handler := NewCompanyGrpcHandler(stub)
+	ctx := context.Background()
+
+	resp, err := handler.CreateCompany(ctx, &companypb.CreateCompanyRequest{Name: "Example", Code: "example", Website: wrapperspb.String(website)})
+	if err != nil {
+		t.Fatalf("CreateCompany returned error: %v", err)
+	}
+	if stub.createInput.Website == nil || *stub.createInput.Website != website {
+		t.Fatalf("expected website passed through, got %+v", stub.createInput.Website)
+	}
+	got := resp.GetCompany()
+	if got.GetWebsite().GetValue() != website {
+		t.Fatalf("unexpected website: %v", got.GetWebsite())
+	}
+	if len(got.GetAddresses()) != 1 || got.GetAddresses()[0].GetType() != companypb.AddressType_ADDRESS_TYPE_REGISTERED || got.GetAddresses()[0].GetPostalCode() != "100-0001" {
+		t.Fatalf("unexpected addresses: %+v", got.GetAddresses())
+	}
+	if len(got.GetPhones()) != 1 || got.GetPhones()[0].GetNumber() != "+81312345678" {
+		t.Fatalf("unexpected phones: %+v", got.GetPhones())
+	}
+
+	if _, err := handler.UpdateCompany(ctx, &companypb.UpdateCompanyRequest{Id: "company-1", Website: wrapperspb.String("")}); err != nil {
+		t.Fatalf("UpdateCompany returned error: %v", err)
+	}
+	if stub.updateInput.Website == nil || *stub.updateInput.Website != "" {
+		t.Fatalf("expected empty website passed through, got %+v", stub.updateInput.Website)
+	}
+
+	if _, err := handler.AddCompanyAddress(ctx, &companypb.AddCompanyAddressRequest{
+		CompanyId: "company-1", Type: companypb.AddressType_ADDRESS_TYPE_BILLING, Country: "jp", PostalCode: "100-0001", Lines: []string{"line"},
+	}); err != nil {
+		t.Fatalf("AddCompanyAddress returned error: %v", err)
+	}
+	if stub.addAddressInput.Type != company.AddressTypeBilling || stub.addAddressInput.Country != "jp" || len(stub.addAddressInput.Lines) != 1 {
+		t.Fatalf("unexpected address input: %+v", stub.addAddressInput)
+	}
+	if _, err := handler.AddCompanyAddress(ctx, &companypb.AddCompanyAddressRequest{CompanyId: "company-1"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for unspecified type, got %v", status.Code(err))
+	}
+
+	if _, err := handler.RemoveCompanyAddress(ctx, &companypb.RemoveCompanyAddressRequest{CompanyId: "company-1", AddressId: "address-1"}); err != nil {
+		t.Fatalf("RemoveCompanyAddress returned error: %v", err)
+	}
+	if stub.removeAddressInput.AddressID != "address-1" {
+		t.Fatalf("unexpected remove address input: %+v", stub.removeAddressInput)
+	}
+
+	if _, err := handler.AddCompanyPhone(ctx, &companypb.AddCompanyPhoneRequest{CompanyId: "company-1", Number: "+81 3-1234-5678"}); err != nil {
+		t.Fatalf("AddCompanyPhone returned error: %v", err)
+	}
+	if stub.addPhoneInput.Number != "+81 3-1234-5678" {
+		t.Fatalf("unexpected phone input: %+v", stub.addPhoneInput)
+	}
+
+	stub.removePhoneErr = company.ErrPhoneNotFound
+	if _, err := handler.RemoveCompanyPhone(ctx, &companypb.RemoveCompanyPhoneRequest{CompanyId: "company-1", PhoneId: "phone-9"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+
+	stub.addPhoneErr = company.ErrPhoneAlreadyExists
+	if _, err := handler.AddCompanyPhone(ctx, &companypb.AddCompanyPhoneRequest{CompanyId: "company-1", Number: "+81312345678"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", status.Code(err))
+	}
+
+	stub.addAddressErr = fmt.Errorf("wrap: %w", company.ErrInvalidPostalCode)
+	if _, err := handler.AddCompanyAddress(ctx, &companypb.AddCompanyAddressRequest{CompanyId: "company-1", Type: companypb.AddressType_ADDRESS_TYPE_OFFICE}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+}
@@ -0,0 +1,182 @@
+package handler
+
+import (
+	"context"
+
+	departmentpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/department/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// DepartmentGrpcHandler は DepartmentService の gRPC 実装です。
+type DepartmentGrpcHandler struct {
+	svc department.UseCase
+	departmentpb.UnimplementedDepartmentServiceServer
+}
+
+// NewDepartmentGrpcHandler は DepartmentGrpcHandler を生成します。
+func NewDepartmentGrpcHandler(svc department.UseCase) *DepartmentGrpcHandler {
+	return &DepartmentGrpcHandler{svc: svc}
+}
+
+// CreateDepartment は部署を作成します。
+func (h *DepartmentGrpcHandler) CreateDepartment(ctx context.Context, req *departmentpb.CreateDepartmentRequest) (*departmentpb.CreateDepartmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var parentDepartmentID *string
+	if req.GetParentDepartmentId() != nil {
+		value := req.GetParentDepartmentId().GetValue()
+		parentDepartmentID = &value
+	}
+
+	created, err := h.svc.CreateDepartment(ctx, department.CreateDepartmentInput{
+		CompanyID:          req.GetCompanyId(),
+		Name:               req.GetName(),
+		Code:               req.GetCode(),
+		ParentDepartmentID: parentDepartmentID,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &departmentpb.CreateDepartmentResponse{Department: toProtoDepartment(created)}, nil
+}
+
+// GetDepartment は部署を取得します。
+func (h *DepartmentGrpcHandler) GetDepartment(ctx context.Context, req *departmentpb.GetDepartmentRequest) (*departmentpb.GetDepartmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	found, err := h.svc.GetDepartment(ctx, department.GetDepartmentInput{ID: req.GetId()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &departmentpb.GetDepartmentResponse{Department: toProtoDepartment(found)}, nil
+}
+
+// ListDepartments は部署の一覧を取得します。
+func (h *DepartmentGrpcHandler) ListDepartments(ctx context.Context, req *departmentpb.ListDepartmentsRequest) (*departmentpb.ListDepartmentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var parentDepartmentID *string
+	if req.GetParentDepartmentId() != nil {
+		value := req.GetParentDepartmentId().GetValue()
+		parentDepartmentID = &value
+	}
+
+	result, err := h.svc.ListDepartments(ctx, department.ListDepartmentsInput{
+		CompanyID:          req.GetCompanyId(),
+		ParentDepartmentID: parentDepartmentID,
+		PageSize:           int(req.GetPageSize()),
+		PageToken:          req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoDepartments := make([]*departmentpb.Department, 0, len(result.Departments))
+	for _, d := range result.Departments {
+		protoDepartments = append(protoDepartments, toProtoDepartment(d))
+	}
+
+	return &departmentpb.ListDepartmentsResponse{
+		Departments:   protoDepartments,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// UpdateDepartment は部署の名称・コードを更新します。
+func (h *DepartmentGrpcHandler) UpdateDepartment(ctx context.Context, req *departmentpb.UpdateDepartmentRequest) (*departmentpb.UpdateDepartmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var namePtr *string
+	if req.GetName() != nil {
+		value := req.GetName().GetValue()
+		namePtr = &value
+	}
+
+	var codePtr *string
+	if req.GetCode() != nil {
+		value := req.GetCode().GetValue()
+		codePtr = &value
+	}
+
+	updated, err := h.svc.UpdateDepartment(ctx, department.UpdateDepartmentInput{
+		ID:   req.GetId(),
+		Name: namePtr,
+		Code: codePtr,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &departmentpb.UpdateDepartmentResponse{Department: toProtoDepartment(updated)}, nil
+}
+
+// MoveDepartment は部署を別の親部署の配下へ移動します。
+func (h *DepartmentGrpcHandler) MoveDepartment(ctx context.Context, req *departmentpb.MoveDepartmentRequest) (*departmentpb.MoveDepartmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	var parentDepartmentID *string
+	if req.GetParentDepartmentId() != nil {
+		value := req.GetParentDepartmentId().GetValue()
+		parentDepartmentID = &value
+	}
+
+	moved, err := h.svc.MoveDepartment(ctx, department.MoveDepartmentInput{
+		ID:                 req.GetId(),
+		ParentDepartmentID: parentDepartmentID,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &departmentpb.MoveDepartmentResponse{Department: toProtoDepartment(moved)}, nil
+}
+
+// DeleteDepartment は部署を削除します。
+func (h *DepartmentGrpcHandler) DeleteDepartment(ctx context.Context, req *departmentpb.DeleteDepartmentRequest) (*departmentpb.DeleteDepartmentResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	if err := h.svc.DeleteDepartment(ctx, department.DeleteDepartmentInput{ID: req.GetId()}); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &departmentpb.DeleteDepartmentResponse{}, nil
+}
+
+func toProtoDepartment(d *department.Department) *departmentpb.Department {
+	if d == nil {
+		return nil
+	}
+
+	var parentDepartmentID *wrapperspb.StringValue
+	if d.ParentDepartmentID != nil {
+		parentDepartmentID = wrapperspb.String(*d.ParentDepartmentID)
+	}
+
+	return &departmentpb.Department{
+		Id:                 d.ID,
+		CompanyId:          d.CompanyID,
+		ParentDepartmentId: parentDepartmentID,
+		Name:               d.Name,
+		Code:               d.Code,
+		CreatedAt:          timestamppb.New(d.CreatedAt),
+		UpdatedAt:          timestamppb.New(d.UpdatedAt),
+	}
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	departmentpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/department/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type stubDepartmentUseCase struct {
+	createInput department.CreateDepartmentInput
+	createErr   error
+	createOut   *department.Department
+
+	getInput department.GetDepartmentInput
+	getErr   error
+	getOut   *department.Department
+
+	listInput department.ListDepartmentsInput
+	listErr   error
+	listOut   *department.ListDepartmentsResult
+
+	updateInput department.UpdateDepartmentInput
+	updateErr   error
+	updateOut   *department.Department
+
+	moveInput department.MoveDepartmentInput
+	moveErr   error
+	moveOut   *department.Department
+
+	deleteInput department.DeleteDepartmentInput
+	deleteErr   error
+}
+
+func (s *stubDepartmentUseCase) CreateDepartment(ctx context.Context, in department.CreateDepartmentInput) (*department.Department, error) {
+	s.createInput = in
+	return s.createOut, s.createErr
+}
+
+func (s *stubDepartmentUseCase) GetDepartment(ctx context.Context, in department.GetDepartmentInput) (*department.Department, error) {
+	s.getInput = in
+	return s.getOut, s.getErr
+}
+
+func (s *stubDepartmentUseCase) ListDepartments(ctx context.Context, in department.ListDepartmentsInput) (*department.ListDepartmentsResult, error) {
+	s.listInput = in
+	return s.listOut, s.listErr
+}
+
+func (s *stubDepartmentUseCase) UpdateDepartment(ctx context.Context, in department.UpdateDepartmentInput) (*department.Department, error) {
+	s.updateInput = in
+	return s.updateOut, s.updateErr
+}
+
+func (s *stubDepartmentUseCase) MoveDepartment(ctx context.Context, in department.MoveDepartmentInput) (*department.Department, error) {
+	s.moveInput = in
+	return s.moveOut, s.moveErr
+}
+
+func (s *stubDepartmentUseCase) DeleteDepartment(ctx context.Context, in department.DeleteDepartmentInput) error {
+	s.deleteInput = in
+	return s.deleteErr
+}
+
+func TestDepartmentGrpcHandler_CreateDepartment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	parentID := "dept-1"
+	stub := &stubDepartmentUseCase{
+		createOut: &department.Department{
+			ID:                 "dept-2",
+			CompanyID:          "company-1",
+			ParentDepartmentID: &parentID,
+			Name:               "Sales",
+			Code:               "sales",
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		},
+	}
+	handler := NewDepartmentGrpcHandler(stub)
+
+	resp, err := handler.CreateDepartment(context.Background(), &departmentpb.CreateDepartmentRequest{
+		CompanyId:          "company-1",
+		Name:               "Sales",
+		Code:               "sales",
+		ParentDepartmentId: wrapperspb.String(parentID),
+	})
+	if err != nil {
+		t.Fatalf("CreateDepartment returned error: %v", err)
+	}
+
+	if stub.createInput.CompanyID != "company-1" || stub.createInput.Code != "sales" {
+		t.Fatalf("expected inputs to be passed through, got %+v", stub.createInput)
+	}
+	if stub.createInput.ParentDepartmentID == nil || *stub.createInput.ParentDepartmentID != parentID {
+		t.Fatalf("expected parent department id to be passed through")
+	}
+	if resp.GetDepartment().GetId() != "dept-2" || resp.GetDepartment().GetParentDepartmentId().GetValue() != parentID {
+		t.Fatalf("unexpected response: %+v", resp.GetDepartment())
+	}
+}
+
+func TestDepartmentGrpcHandler_ListDepartments(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	stub := &stubDepartmentUseCase{
+		listOut: &department.ListDepartmentsResult{
+			Departments: []*department.Department{
+				{ID: "dept-1", CompanyID: "company-1", Name: "Sales", Code: "sales", CreatedAt: now, UpdatedAt: now},
+			},
+			NextPageToken: "1",
+		},
+	}
+	handler := NewDepartmentGrpcHandler(stub)
+
+	resp, err := handler.ListDepartments(context.Background(), &departmentpb.ListDepartmentsRequest{CompanyId: "company-1", PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListDepartments returned error: %v", err)
+	}
+	if stub.listInput.CompanyID != "company-1" || stub.listInput.PageSize != 1 || stub.listInput.ParentDepartmentID != nil {
+		t.Fatalf("unexpected input: %+v", stub.listInput)
+	}
+	if len(resp.GetDepartments()) != 1 || resp.GetDepartments()[0].GetParentDepartmentId() != nil {
+		t.Fatalf("unexpected departments: %+v", resp.GetDepartments())
+	}
+	if resp.GetNextPageToken() != "1" {
+		t.Fatalf("expected next token 1, got %s", resp.GetNextPageToken())
+	}
+}
+
+func TestDepartmentGrpcHandler_ErrorMapping(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubDepartmentUseCase{
+		createErr: department.ErrCodeAlreadyExists,
+		getErr:    department.ErrDepartmentNotFound,
+		moveErr:   department.ErrHierarchyCycle,
+		deleteErr: department.ErrDepartmentHasEmployees,
+	}
+	handler := NewDepartmentGrpcHandler(stub)
+
+	if _, err := handler.CreateDepartment(context.Background(), &departmentpb.CreateDepartmentRequest{}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", status.Code(err))
+	}
+	if _, err := handler.GetDepartment(context.Background(), &departmentpb.GetDepartmentRequest{Id: "missing"}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+
+	_, err := handler.MoveDepartment(context.Background(), &departmentpb.MoveDepartmentRequest{Id: "dept-1", ParentDepartmentId: wrapperspb.String("dept-2")})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+	if stub.moveInput.ParentDepartmentID == nil || *stub.moveInput.ParentDepartmentID != "dept-2" {
+		t.Fatalf("expected parent department id to be passed through")
+	}
+
+	if _, err := handler.DeleteDepartment(context.Background(), &departmentpb.DeleteDepartmentRequest{Id: "dept-1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+}
+
+func TestDepartmentGrpcHandler_UpdateDepartment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	stub := &stubDepartmentUseCase{
+		updateOut: &department.Department{ID: "dept-1", CompanyID: "company-1", Name: "Renamed", Code: "renamed", CreatedAt: now, UpdatedAt: now},
+	}
+	handler := NewDepartmentGrpcHandler(stub)
+
+	resp, err := handler.UpdateDepartment(context.Background(), &departmentpb.UpdateDepartmentRequest{
+		Id:   "dept-1",
+		Name: wrapperspb.String("Renamed"),
+	})
+	if err != nil {
+		t.Fatalf("UpdateDepartment returned error: %v", err)
+	}
+	if stub.updateInput.Name == nil || *stub.updateInput.Name != "Renamed" || stub.updateInput.Code != nil {
+		t.Fatalf("unexpected input: %+v", stub.updateInput)
+	}
+	if resp.GetDepartment().GetName() != "Renamed" {
+		t.Fatalf("expected updated name, got %s", resp.GetDepartment().GetName())
+	}
+}
+
+func TestDepartmentGrpcHandler_ValidatesNilRequest(t *testing.T) {
+	t.Parallel()
+
+	handler := NewDepartmentGrpcHandler(&stubDepartmentUseCase{})
+	ctx := context.Background()
+
+	if _, err := handler.CreateDepartment(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for create")
+	}
+	if _, err := handler.GetDepartment(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for get")
+	}
+	if _, err := handler.ListDepartments(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for list")
+	}
+	if _, err := handler.UpdateDepartment(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for update")
+	}
+	if _, err := handler.MoveDepartment(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for move")
+	}
+	if _, err := handler.DeleteDepartment(ctx, nil); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for delete")
+	}
+}
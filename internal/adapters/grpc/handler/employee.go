@@ -8,6 +8,7 @@ import (
 
 	employeepb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/employee/v1"
 	userpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -57,13 +58,28 @@ func (h *EmployeeGrpcHandler) CreateEmployee(ctx context.Context, req *employeep
 		statusPtr = &domainStatus
 	}
 
+	var departmentID *string
+	if req.GetDepartmentId() != nil {
+		value := req.GetDepartmentId().GetValue()
+		departmentID = &value
+	}
+
+	var managerEmployeeID *string
+	if req.GetManagerEmployeeId() != nil {
+		value := req.GetManagerEmployeeId().GetValue()
+		managerEmployeeID = &value
+	}
+
 	created, err := h.svc.CreateEmployee(ctx, employee.CreateEmployeeInput{
-		CompanyID:    req.GetCompanyId(),
-		EmployeeCode: req.GetEmployeeCode(),
-		UserID:       req.GetUserId(),
-		Status:       statusPtr,
-		HiredAt:      hiredAt,
-		TerminatedAt: terminatedAt,
+		CompanyID:         req.GetCompanyId(),
+		EmployeeCode:      req.GetEmployeeCode(),
+		UserID:            req.GetUserId(),
+		DepartmentID:      departmentID,
+		ManagerEmployeeID: managerEmployeeID,
+		Status:            statusPtr,
+		HiredAt:           hiredAt,
+		TerminatedAt:      terminatedAt,
+		Attributes:        toDomainAttributes(req.GetAttributes()),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -90,6 +106,24 @@ func (h *EmployeeGrpcHandler) UpdateEmployee(ctx context.Context, req *employeep
 		userIDPtr = &value
 	}
 
+	var departmentIDPtr *string
+	if req.DepartmentId != nil {
+		value := req.DepartmentId.GetValue()
+		departmentIDPtr = &value
+	}
+
+	var managerEmployeeIDPtr *string
+	if req.ManagerEmployeeId != nil {
+		value := req.ManagerEmployeeId.GetValue()
+		managerEmployeeIDPtr = &value
+	}
+
+	var reassignReportsToPtr *string
+	if req.ReassignReportsTo != nil {
+		value := req.ReassignReportsTo.GetValue()
+		reassignReportsToPtr = &value
+	}
+
 	var statusPtr *employee.Status
 	if req.GetStatus() != employeepb.EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED {
 		domainStatus, err := toEmployeeDomainStatus(req.GetStatus())
@@ -110,14 +144,18 @@ func (h *EmployeeGrpcHandler) UpdateEmployee(ctx context.Context, req *employeep
 	}
 
 	updated, err := h.svc.UpdateEmployee(ctx, employee.UpdateEmployeeInput{
-		ID:              req.GetId(),
-		EmployeeCode:    codePtr,
-		UserID:          userIDPtr,
-		Status:          statusPtr,
-		HiredAt:         hiredAt,
-		HiredAtSet:      hiredSet,
-		TerminatedAt:    terminatedAt,
-		TerminatedAtSet: terminatedSet,
+		ID:                req.GetId(),
+		EmployeeCode:      codePtr,
+		UserID:            userIDPtr,
+		DepartmentID:      departmentIDPtr,
+		ManagerEmployeeID: managerEmployeeIDPtr,
+		ReassignReportsTo: reassignReportsToPtr,
+		Status:            statusPtr,
+		HiredAt:           hiredAt,
+		HiredAtSet:        hiredSet,
+		TerminatedAt:      terminatedAt,
+		TerminatedAtSet:   terminatedSet,
+		Attributes:        toDomainAttributes(req.GetAttributes()),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -126,6 +164,59 @@ func (h *EmployeeGrpcHandler) UpdateEmployee(ctx context.Context, req *employeep
 	return &employeepb.UpdateEmployeeResponse{Employee: toProtoEmployee(updated)}, nil
 }
 
+// TransferEmployee は社員を別の会社へ転籍させます。
+func (h *EmployeeGrpcHandler) TransferEmployee(ctx context.Context, req *employeepb.TransferEmployeeRequest) (*employeepb.TransferEmployeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	effectiveDate, err := parseDateValue(wrapperspb.String(req.GetEffectiveDate()))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("effective_date: %v", err))
+	}
+
+	var departmentIDPtr *string
+	if req.DepartmentId != nil {
+		value := req.DepartmentId.GetValue()
+		departmentIDPtr = &value
+	}
+
+	var managerEmployeeIDPtr *string
+	if req.ManagerEmployeeId != nil {
+		value := req.ManagerEmployeeId.GetValue()
+		managerEmployeeIDPtr = &value
+	}
+
+	var reassignReportsToPtr *string
+	if req.ReassignReportsTo != nil {
+		value := req.ReassignReportsTo.GetValue()
+		reassignReportsToPtr = &value
+	}
+
+	in := employee.TransferEmployeeInput{
+		ID:                req.GetId(),
+		TargetCompanyID:   req.GetTargetCompanyId(),
+		EmployeeCode:      req.GetEmployeeCode(),
+		DepartmentID:      departmentIDPtr,
+		ManagerEmployeeID: managerEmployeeIDPtr,
+		ReassignReportsTo: reassignReportsToPtr,
+		Attributes:        toDomainAttributes(req.GetAttributes()),
+	}
+	if effectiveDate != nil {
+		in.EffectiveDate = *effectiveDate
+	}
+
+	result, err := h.svc.TransferEmployee(ctx, in)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &employeepb.TransferEmployeeResponse{
+		Previous: toProtoEmployee(result.Previous),
+		Employee: toProtoEmployee(result.Employee),
+	}, nil
+}
+
 // DeleteEmployee は社員を削除します。
 func (h *EmployeeGrpcHandler) DeleteEmployee(ctx context.Context, req *employeepb.DeleteEmployeeRequest) (*employeepb.DeleteEmployeeResponse, error) {
 	if req == nil {
@@ -145,7 +236,12 @@ func (h *EmployeeGrpcHandler) GetEmployee(ctx context.Context, req *employeepb.G
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	found, err := h.svc.GetEmployee(ctx, employee.GetEmployeeInput{ID: req.GetId()})
+	asOf, err := parseDateValue(req.AsOf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("as_of: %v", err))
+	}
+
+	found, err := h.svc.GetEmployee(ctx, employee.GetEmployeeInput{ID: req.GetId(), AsOf: asOf})
 	if err != nil {
 		return nil, toStatusError(err)
 	}
@@ -169,51 +265,241 @@ func (h *EmployeeGrpcHandler) ListEmployees(ctx context.Context, req *employeepb
 	}
 
 	result, err := h.svc.ListEmployees(ctx, employee.ListEmployeesInput{
-		CompanyID: req.GetCompanyId(),
+		CompanyID:             req.GetCompanyId(),
+		IncludeSubsidiaries:   req.GetIncludeSubsidiaries(),
+		DepartmentID:          req.GetDepartmentId(),
+		IncludeSubDepartments: req.GetIncludeSubDepartments(),
+		PageSize:              int(req.GetPageSize()),
+		PageToken:             req.GetPageToken(),
+		Status:                statusPtr,
+		Attributes:            toDomainAttributes(req.GetAttributes()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &employeepb.ListEmployeesResponse{
+		Employees:     toProtoEmployees(result.Employees),
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// ListEmployeeHistory は社員レコードの変更履歴を取得します。
+func (h *EmployeeGrpcHandler) ListEmployeeHistory(ctx context.Context, req *employeepb.ListEmployeeHistoryRequest) (*employeepb.ListEmployeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	result, err := h.svc.ListEmployeeHistory(ctx, employee.ListEmployeeHistoryInput{
+		EmployeeID: req.GetEmployeeId(),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	entries := make([]*employeepb.EmployeeHistoryEntry, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		entries = append(entries, toProtoHistoryEntry(entry))
+	}
+
+	return &employeepb.ListEmployeeHistoryResponse{
+		Entries:       entries,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// ListDirectReports は社員の直属の部下の一覧を取得します。
+func (h *EmployeeGrpcHandler) ListDirectReports(ctx context.Context, req *employeepb.ListDirectReportsRequest) (*employeepb.ListDirectReportsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	result, err := h.svc.ListDirectReports(ctx, employee.ListDirectReportsInput{
+		EmployeeID: req.GetEmployeeId(),
+		PageSize:   int(req.GetPageSize()),
+		PageToken:  req.GetPageToken(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &employeepb.ListDirectReportsResponse{
+		Employees:     toProtoEmployees(result.Employees),
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+// ListUserEmployments はユーザーの会社ごとの社員レコードを取得します。
+func (h *EmployeeGrpcHandler) ListUserEmployments(ctx context.Context, req *employeepb.ListUserEmploymentsRequest) (*employeepb.ListUserEmploymentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	result, err := h.svc.ListUserEmployments(ctx, employee.ListUserEmploymentsInput{
+		UserID:    req.GetUserId(),
 		PageSize:  int(req.GetPageSize()),
 		PageToken: req.GetPageToken(),
-		Status:    statusPtr,
 	})
 	if err != nil {
 		return nil, toStatusError(err)
 	}
 
-	protoEmployees := make([]*employeepb.Employee, 0, len(result.Employees))
-	for _, emp := range result.Employees {
-		protoEmployees = append(protoEmployees, toProtoEmployee(emp))
+	employments := make([]*employeepb.Employment, 0, len(result.Employments))
+	for _, e := range result.Employments {
+		employments = append(employments, &employeepb.Employment{
+			Employee: toProtoEmployee(e.Employee),
+			Company:  toProtoCompanySummary(e.Company),
+		})
 	}
 
-	return &employeepb.ListEmployeesResponse{
-		Employees:     protoEmployees,
+	return &employeepb.ListUserEmploymentsResponse{
+		Employments:   employments,
 		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
+// GetReportingChain は社員の直近の上長から最上位の上長までを取得します。
+func (h *EmployeeGrpcHandler) GetReportingChain(ctx context.Context, req *employeepb.GetReportingChainRequest) (*employeepb.GetReportingChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	chain, err := h.svc.GetReportingChain(ctx, employee.GetReportingChainInput{ID: req.GetId()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &employeepb.GetReportingChainResponse{Managers: toProtoEmployees(chain)}, nil
+}
+
+// GetOrgChart は社員を頂点とする組織図を取得します。
+func (h *EmployeeGrpcHandler) GetOrgChart(ctx context.Context, req *employeepb.GetOrgChartRequest) (*employeepb.GetOrgChartResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	chart, err := h.svc.GetOrgChart(ctx, employee.GetOrgChartInput{ID: req.GetId(), Depth: int(req.GetDepth())})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &employeepb.GetOrgChartResponse{Root: toProtoOrgChartNode(chart)}, nil
+}
+
+func toProtoHistoryEntry(entry *employee.HistoryEntry) *employeepb.EmployeeHistoryEntry {
+	if entry == nil {
+		return nil
+	}
+
+	var departmentID *wrapperspb.StringValue
+	if entry.DepartmentID != nil {
+		departmentID = wrapperspb.String(*entry.DepartmentID)
+	}
+
+	var managerEmployeeID *wrapperspb.StringValue
+	if entry.ManagerEmployeeID != nil {
+		managerEmployeeID = wrapperspb.String(*entry.ManagerEmployeeID)
+	}
+
+	return &employeepb.EmployeeHistoryEntry{
+		Id:                entry.ID,
+		EmployeeId:        entry.EmployeeID,
+		CompanyId:         entry.CompanyID,
+		EmployeeCode:      entry.EmployeeCode,
+		UserId:            entry.UserID,
+		DepartmentId:      departmentID,
+		ManagerEmployeeId: managerEmployeeID,
+		Status:            toEmployeeProtoStatus(entry.Status),
+		HiredAt:           timePointerToWrapper(entry.HiredAt),
+		TerminatedAt:      timePointerToWrapper(entry.TerminatedAt),
+		EffectiveFrom:     entry.EffectiveFrom.Format(dateLayout),
+		EffectiveTo:       timePointerToWrapper(entry.EffectiveTo),
+		CreatedAt:         timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func toProtoOrgChartNode(node *employee.OrgChartNode) *employeepb.OrgChartNode {
+	if node == nil {
+		return nil
+	}
+
+	reports := make([]*employeepb.OrgChartNode, 0, len(node.Reports))
+	for _, report := range node.Reports {
+		reports = append(reports, toProtoOrgChartNode(report))
+	}
+
+	return &employeepb.OrgChartNode{
+		Employee: toProtoEmployee(node.Employee),
+		Reports:  reports,
+	}
+}
+
+func toProtoEmployees(employees []*employee.Employee) []*employeepb.Employee {
+	protoEmployees := make([]*employeepb.Employee, 0, len(employees))
+	for _, emp := range employees {
+		protoEmployees = append(protoEmployees, toProtoEmployee(emp))
+	}
+	return protoEmployees
+}
+
 func toProtoEmployee(emp *employee.Employee) *employeepb.Employee {
 	if emp == nil {
 		return nil
 	}
 
+	var departmentID *wrapperspb.StringValue
+	if emp.DepartmentID != nil {
+		departmentID = wrapperspb.String(*emp.DepartmentID)
+	}
+
+	var managerEmployeeID *wrapperspb.StringValue
+	if emp.ManagerEmployeeID != nil {
+		managerEmployeeID = wrapperspb.String(*emp.ManagerEmployeeID)
+	}
+
+	var transferredFromEmployeeID *wrapperspb.StringValue
+	if emp.TransferredFromEmployeeID != nil {
+		transferredFromEmployeeID = wrapperspb.String(*emp.TransferredFromEmployeeID)
+	}
+
 	return &employeepb.Employee{
-		Id:           emp.ID,
-		CompanyId:    emp.CompanyID,
-		EmployeeCode: emp.EmployeeCode,
-		UserId:       emp.UserID,
-		Status:       toEmployeeProtoStatus(emp.Status),
-		HiredAt:      timePointerToWrapper(emp.HiredAt),
-		TerminatedAt: timePointerToWrapper(emp.TerminatedAt),
-		CreatedAt:    timestamppb.New(emp.CreatedAt),
-		UpdatedAt:    timestamppb.New(emp.UpdatedAt),
-		User:         toProtoUserSummary(emp.User),
+		Id:                        emp.ID,
+		CompanyId:                 emp.CompanyID,
+		EmployeeCode:              emp.EmployeeCode,
+		UserId:                    emp.UserID,
+		DepartmentId:              departmentID,
+		ManagerEmployeeId:         managerEmployeeID,
+		Status:                    toEmployeeProtoStatus(emp.Status),
+		HiredAt:                   timePointerToWrapper(emp.HiredAt),
+		TerminatedAt:              timePointerToWrapper(emp.TerminatedAt),
+		CreatedAt:                 timestamppb.New(emp.CreatedAt),
+		UpdatedAt:                 timestamppb.New(emp.UpdatedAt),
+		User:                      toProtoUserSummary(emp.User),
+		TransferredFromEmployeeId: transferredFromEmployeeID,
+		Attributes:                emp.Attributes,
 	}
 }
 
+// toDomainAttributes は空の属性指定を未指定として扱います。
+func toDomainAttributes(attributes map[string]string) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	return attributes
+}
+
 func toEmployeeProtoStatus(status employee.Status) employeepb.EmployeeStatus {
 	switch status {
+	case employee.StatusPending:
+		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_PENDING
 	case employee.StatusActive:
 		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_ACTIVE
-	case employee.StatusInactive:
-		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_INACTIVE
+	case employee.StatusOnLeave:
+		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_ON_LEAVE
+	case employee.StatusTerminated:
+		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_TERMINATED
 	default:
 		return employeepb.EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED
 	}
@@ -221,10 +507,14 @@ func toEmployeeProtoStatus(status employee.Status) employeepb.EmployeeStatus {
 
 func toEmployeeDomainStatus(status employeepb.EmployeeStatus) (employee.Status, error) {
 	switch status {
+	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_PENDING:
+		return employee.StatusPending, nil
 	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_ACTIVE:
 		return employee.StatusActive, nil
-	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_INACTIVE:
-		return employee.StatusInactive, nil
+	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_ON_LEAVE:
+		return employee.StatusOnLeave, nil
+	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_TERMINATED, employeepb.EmployeeStatus_EMPLOYEE_STATUS_INACTIVE:
+		return employee.StatusTerminated, nil
 	case employeepb.EmployeeStatus_EMPLOYEE_STATUS_UNSPECIFIED:
 		return "", nil
 	default:
@@ -238,12 +528,33 @@ func toProtoUserSummary(snapshot *employee.UserSnapshot) *employeepb.UserSummary
 	}
 
 	return &employeepb.UserSummary{
-		Id:        snapshot.ID,
-		Email:     snapshot.Email,
-		Name:      snapshot.Name,
-		Status:    toUserProtoStatus(snapshot.Status),
-		CreatedAt: timestamppb.New(snapshot.CreatedAt),
-		UpdatedAt: timestamppb.New(snapshot.UpdatedAt),
+		Id:          snapshot.ID,
+		Email:       snapshot.Email,
+		Name:        snapshot.Name,
+		DisplayName: snapshot.DisplayName,
+		Locale:      snapshot.Locale,
+		Status:      toUserProtoStatus(snapshot.Status),
+		CreatedAt:   timestamppb.New(snapshot.CreatedAt),
+		UpdatedAt:   timestamppb.New(snapshot.UpdatedAt),
+	}
+}
+
+func toProtoCompanySummary(snapshot *employee.CompanySnapshot) *employeepb.CompanySummary {
+	if snapshot == nil {
+		return nil
+	}
+
+	var parentCompanyID *wrapperspb.StringValue
+	if snapshot.ParentCompanyID != nil {
+		parentCompanyID = wrapperspb.String(*snapshot.ParentCompanyID)
+	}
+
+	return &employeepb.CompanySummary{
+		Id:              snapshot.ID,
+		Name:            snapshot.Name,
+		Code:            snapshot.Code,
+		Status:          toProtoCompanyStatus(company.Status(snapshot.Status)),
+		ParentCompanyId: parentCompanyID,
 	}
 }
 
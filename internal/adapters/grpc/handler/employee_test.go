@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	companypb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1"
 	employeepb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/employee/v1"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
 	"google.golang.org/grpc/codes"
@@ -30,6 +31,10 @@ type stubEmployeeUseCase struct {
 	deleteInput employee.DeleteEmployeeInput
 	deleteErr   error
 
+	transferInput employee.TransferEmployeeInput
+	transferOut   *employee.TransferEmployeeResult
+	transferErr   error
+
 	getInput employee.GetEmployeeInput
 	getOut   *employee.Employee
 	getErr   error
@@ -37,6 +42,26 @@ type stubEmployeeUseCase struct {
 	listInput employee.ListEmployeesInput
 	listOut   *employee.ListEmployeesResult
 	listErr   error
+
+	historyInput employee.ListEmployeeHistoryInput
+	historyOut   *employee.ListEmployeeHistoryResult
+	historyErr   error
+
+	directReportsInput employee.ListDirectReportsInput
+	directReportsOut   *employee.ListEmployeesResult
+	directReportsErr   error
+
+	employmentsInput employee.ListUserEmploymentsInput
+	employmentsOut   *employee.ListUserEmploymentsResult
+	employmentsErr   error
+
+	chainInput employee.GetReportingChainInput
+	chainOut   []*employee.Employee
+	chainErr   error
+
+	orgChartInput employee.GetOrgChartInput
+	orgChartOut   *employee.OrgChartNode
+	orgChartErr   error
 }
 
 func (s *stubEmployeeUseCase) CreateEmployee(ctx context.Context, in employee.CreateEmployeeInput) (*employee.Employee, error) {
@@ -59,11 +84,41 @@ func (s *stubEmployeeUseCase) UpdateEmployee(ctx context.Context, in employee.Up
 	return s.updateOut, s.updateErr
 }
 
+func (s *stubEmployeeUseCase) TransferEmployee(ctx context.Context, in employee.TransferEmployeeInput) (*employee.TransferEmployeeResult, error) {
+	s.transferInput = in
+	return s.transferOut, s.transferErr
+}
+
+func (s *stubEmployeeUseCase) ListUserEmployments(ctx context.Context, in employee.ListUserEmploymentsInput) (*employee.ListUserEmploymentsResult, error) {
+	s.employmentsInput = in
+	return s.employmentsOut, s.employmentsErr
+}
+
 func (s *stubEmployeeUseCase) DeleteEmployee(ctx context.Context, in employee.DeleteEmployeeInput) error {
 	s.deleteInput = in
 	return s.deleteErr
 }
 
+func (s *stubEmployeeUseCase) ListEmployeeHistory(ctx context.Context, in employee.ListEmployeeHistoryInput) (*employee.ListEmployeeHistoryResult, error) {
+	s.historyInput = in
+	return s.historyOut, s.historyErr
+}
+
+func (s *stubEmployeeUseCase) ListDirectReports(ctx context.Context, in employee.ListDirectReportsInput) (*employee.ListEmployeesResult, error) {
+	s.directReportsInput = in
+	return s.directReportsOut, s.directReportsErr
+}
+
+func (s *stubEmployeeUseCase) GetReportingChain(ctx context.Context, in employee.GetReportingChainInput) ([]*employee.Employee, error) {
+	s.chainInput = in
+	return s.chainOut, s.chainErr
+}
+
+func (s *stubEmployeeUseCase) GetOrgChart(ctx context.Context, in employee.GetOrgChartInput) (*employee.OrgChartNode, error) {
+	s.orgChartInput = in
+	return s.orgChartOut, s.orgChartErr
+}
+
 func TestEmployeeGrpcHandler_CreateEmployee_Success(t *testing.T) {
 	t.Parallel()
 
@@ -78,12 +133,14 @@ func TestEmployeeGrpcHandler_CreateEmployee_Success(t *testing.T) {
 			CreatedAt:    now,
 			UpdatedAt:    now,
 			User: &employee.UserSnapshot{
-				ID:        handlerUserID1,
-				Email:     "user@example.com",
-				Name:      "Taro Yamada",
-				Status:    "active",
-				CreatedAt: now,
-				UpdatedAt: now,
+				ID:          handlerUserID1,
+				Email:       "user@example.com",
+				Name:        "Taro Yamada",
+				DisplayName: "山田 太郎",
+				Locale:      "ja-JP",
+				Status:      "active",
+				CreatedAt:   now,
+				UpdatedAt:   now,
 			},
 		},
 	}
@@ -115,6 +172,9 @@ func TestEmployeeGrpcHandler_CreateEmployee_Success(t *testing.T) {
 	if resp.GetEmployee().GetUser().GetEmail() != "user@example.com" {
 		t.Fatalf("expected embedded user email, got %s", resp.GetEmployee().GetUser().GetEmail())
 	}
+	if resp.GetEmployee().GetUser().GetDisplayName() != "山田 太郎" || resp.GetEmployee().GetUser().GetLocale() != "ja-JP" {
+		t.Fatalf("expected embedded user profile, got %+v", resp.GetEmployee().GetUser())
+	}
 }
 
 func TestEmployeeGrpcHandler_CreateEmployee_InvalidDateFormat(t *testing.T) {
@@ -183,8 +243,8 @@ func TestEmployeeGrpcHandler_UpdateEmployee_SetsPointers(t *testing.T) {
 	if !stub.updateInput.TerminatedAtSet || stub.updateInput.TerminatedAt == nil {
 		t.Fatalf("expected terminated_at to be set")
 	}
-	if stub.updateInput.Status == nil || *stub.updateInput.Status != employee.StatusInactive {
-		t.Fatalf("expected status to be converted to inactive")
+	if stub.updateInput.Status == nil || *stub.updateInput.Status != employee.StatusTerminated {
+		t.Fatalf("expected status to be converted to terminated")
 	}
 
 	if resp.GetEmployee().GetStatus() != employeepb.EmployeeStatus_EMPLOYEE_STATUS_ACTIVE {
@@ -295,3 +355,403 @@ func TestEmployeeGrpcHandler_DeleteEmployee_ErrorMapping(t *testing.T) {
 		t.Fatalf("expected delete input to capture id")
 	}
 }
+
+func TestEmployeeGrpcHandler_DepartmentAssignment(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	departmentID := "dept-1"
+	stub := &stubEmployeeUseCase{
+		updateOut: &employee.Employee{
+			ID:           "emp-1",
+			CompanyID:    "company-1",
+			EmployeeCode: "emp-001",
+			UserID:       handlerUserID1,
+			DepartmentID: &departmentID,
+			Status:       employee.StatusActive,
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+		listOut: &employee.ListEmployeesResult{},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+
+	resp, err := handler.UpdateEmployee(context.Background(), &employeepb.UpdateEmployeeRequest{
+		Id:           "emp-1",
+		DepartmentId: wrapperspb.String(departmentID),
+	})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if stub.updateInput.DepartmentID == nil || *stub.updateInput.DepartmentID != departmentID {
+		t.Fatalf("expected department id pointer to be set")
+	}
+	if resp.GetEmployee().GetDepartmentId().GetValue() != departmentID {
+		t.Fatalf("expected department id in response, got %v", resp.GetEmployee().GetDepartmentId())
+	}
+
+	if _, err := handler.ListEmployees(context.Background(), &employeepb.ListEmployeesRequest{
+		CompanyId:             "company-1",
+		DepartmentId:          departmentID,
+		IncludeSubDepartments: true,
+	}); err != nil {
+		t.Fatalf("ListEmployees returned error: %v", err)
+	}
+	if stub.listInput.DepartmentID != departmentID || !stub.listInput.IncludeSubDepartments {
+		t.Fatalf("expected department filter to be passed through, got %+v", stub.listInput)
+	}
+
+	stub.createErr = employee.ErrDepartmentNotFound
+	_, err = handler.CreateEmployee(context.Background(), &employeepb.CreateEmployeeRequest{
+		CompanyId:    "company-1",
+		UserId:       handlerUserID1,
+		DepartmentId: wrapperspb.String("dept-other"),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+	if stub.createInput.DepartmentID == nil || *stub.createInput.DepartmentID != "dept-other" {
+		t.Fatalf("expected department id to be passed through on create")
+	}
+}
+
+func TestEmployeeGrpcHandler_Attributes(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	stub := &stubEmployeeUseCase{
+		updateOut: &employee.Employee{
+			ID:           "emp-1",
+			CompanyID:    "company-1",
+			EmployeeCode: "emp-001",
+			UserID:       handlerUserID1,
+			Status:       employee.StatusActive,
+			Attributes:   map[string]string{"grade": "G1"},
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		},
+		listOut: &employee.ListEmployeesResult{},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+
+	resp, err := handler.UpdateEmployee(context.Background(), &employeepb.UpdateEmployeeRequest{
+		Id:         "emp-1",
+		Attributes: map[string]string{"grade": "G1", "band": ""},
+	})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if len(stub.updateInput.Attributes) != 2 || stub.updateInput.Attributes["band"] != "" {
+		t.Fatalf("expected attributes to be passed through, got %+v", stub.updateInput.Attributes)
+	}
+	if resp.GetEmployee().GetAttributes()["grade"] != "G1" {
+		t.Fatalf("expected attributes in response, got %+v", resp.GetEmployee().GetAttributes())
+	}
+
+	if _, err := handler.ListEmployees(context.Background(), &employeepb.ListEmployeesRequest{
+		CompanyId:  "company-1",
+		Attributes: map[string]string{"grade": "G1"},
+	}); err != nil {
+		t.Fatalf("ListEmployees returned error: %v", err)
+	}
+	if stub.listInput.Attributes["grade"] != "G1" {
+		t.Fatalf("expected attribute filter to be passed through, got %+v", stub.listInput.Attributes)
+	}
+
+	stub.createErr = employee.ErrInvalidAttribute
+	_, err = handler.CreateEmployee(context.Background(), &employeepb.CreateEmployeeRequest{
+		CompanyId:  "company-1",
+		UserId:     handlerUserID1,
+		Attributes: map[string]string{},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+	if stub.createInput.Attributes != nil {
+		t.Fatalf("expected empty attributes to be treated as omitted, got %+v", stub.createInput.Attributes)
+	}
+}
+
+func TestEmployeeGrpcHandler_ReportingLines(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	ceoID := "emp-1"
+	ceo := &employee.Employee{ID: ceoID, CompanyID: "company-1", EmployeeCode: "ceo", UserID: handlerUserID1, Status: employee.StatusActive, CreatedAt: now, UpdatedAt: now}
+	vp := &employee.Employee{ID: "emp-2", CompanyID: "company-1", EmployeeCode: "vp", UserID: handlerUserID2, ManagerEmployeeID: &ceoID, Status: employee.StatusActive, CreatedAt: now, UpdatedAt: now}
+
+	stub := &stubEmployeeUseCase{
+		directReportsOut: &employee.ListEmployeesResult{Employees: []*employee.Employee{vp}, NextPageToken: "1"},
+		chainOut:         []*employee.Employee{ceo},
+		orgChartOut:      &employee.OrgChartNode{Employee: ceo, Reports: []*employee.OrgChartNode{{Employee: vp}}},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+	ctx := context.Background()
+
+	reports, err := handler.ListDirectReports(ctx, &employeepb.ListDirectReportsRequest{EmployeeId: ceoID, PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListDirectReports returned error: %v", err)
+	}
+	if stub.directReportsInput.EmployeeID != ceoID || stub.directReportsInput.PageSize != 1 {
+		t.Fatalf("unexpected input: %+v", stub.directReportsInput)
+	}
+	if len(reports.GetEmployees()) != 1 || reports.GetEmployees()[0].GetManagerEmployeeId().GetValue() != ceoID {
+		t.Fatalf("unexpected direct reports: %+v", reports.GetEmployees())
+	}
+	if reports.GetNextPageToken() != "1" {
+		t.Fatalf("expected next token 1, got %s", reports.GetNextPageToken())
+	}
+
+	chain, err := handler.GetReportingChain(ctx, &employeepb.GetReportingChainRequest{Id: vp.ID})
+	if err != nil {
+		t.Fatalf("GetReportingChain returned error: %v", err)
+	}
+	if stub.chainInput.ID != vp.ID || len(chain.GetManagers()) != 1 || chain.GetManagers()[0].GetId() != ceoID {
+		t.Fatalf("unexpected reporting chain: %+v", chain.GetManagers())
+	}
+
+	chart, err := handler.GetOrgChart(ctx, &employeepb.GetOrgChartRequest{Id: ceoID, Depth: 2})
+	if err != nil {
+		t.Fatalf("GetOrgChart returned error: %v", err)
+	}
+	if stub.orgChartInput.Depth != 2 {
+		t.Fatalf("expected depth 2, got %d", stub.orgChartInput.Depth)
+	}
+	if chart.GetRoot().GetEmployee().GetId() != ceoID || len(chart.GetRoot().GetReports()) != 1 || chart.GetRoot().GetReports()[0].GetEmployee().GetId() != vp.ID {
+		t.Fatalf("unexpected org chart: %+v", chart.GetRoot())
+	}
+}
+
+func TestEmployeeGrpcHandler_ReportingLines_ErrorMapping(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubEmployeeUseCase{
+		updateErr:   employee.ErrManagerHasReports,
+		createErr:   employee.ErrManagerNotFound,
+		orgChartErr: employee.ErrInvalidOrgChartDepth,
+		chainErr:    employee.ErrReportingCycle,
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+	ctx := context.Background()
+
+	_, err := handler.UpdateEmployee(ctx, &employeepb.UpdateEmployeeRequest{
+		Id:                "emp-1",
+		Status:            employeepb.EmployeeStatus_EMPLOYEE_STATUS_TERMINATED,
+		ManagerEmployeeId: wrapperspb.String("emp-3"),
+		ReassignReportsTo: wrapperspb.String("emp-2"),
+	})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+	if stub.updateInput.ReassignReportsTo == nil || *stub.updateInput.ReassignReportsTo != "emp-2" {
+		t.Fatalf("expected reassign_reports_to to be passed through")
+	}
+	if stub.updateInput.ManagerEmployeeID == nil || *stub.updateInput.ManagerEmployeeID != "emp-3" {
+		t.Fatalf("expected manager_employee_id to be passed through")
+	}
+
+	stub.updateErr = employee.ErrInvalidStatusTransition
+	if _, err := handler.UpdateEmployee(ctx, &employeepb.UpdateEmployeeRequest{Id: "emp-1", Status: employeepb.EmployeeStatus_EMPLOYEE_STATUS_ON_LEAVE}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition for invalid transition, got %v", status.Code(err))
+	}
+	if stub.updateInput.Status == nil || *stub.updateInput.Status != employee.StatusOnLeave {
+		t.Fatalf("expected status to be converted to on_leave")
+	}
+
+	_, err = handler.CreateEmployee(ctx, &employeepb.CreateEmployeeRequest{
+		CompanyId:         "company-1",
+		EmployeeCode:      "emp-1",
+		UserId:            handlerUserID1,
+		ManagerEmployeeId: wrapperspb.String("emp-9"),
+	})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+	if stub.createInput.ManagerEmployeeID == nil || *stub.createInput.ManagerEmployeeID != "emp-9" {
+		t.Fatalf("expected manager_employee_id to be passed through")
+	}
+
+	if _, err := handler.GetOrgChart(ctx, &employeepb.GetOrgChartRequest{Id: "emp-1", Depth: 99}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+	if _, err := handler.GetReportingChain(ctx, &employeepb.GetReportingChainRequest{Id: "emp-1"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+	if _, err := handler.ListDirectReports(ctx, nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for nil request, got %v", status.Code(err))
+	}
+}
+
+func TestEmployeeGrpcHandler_History(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	stub := &stubEmployeeUseCase{
+		getOut: &employee.Employee{ID: "emp-1", CompanyID: "company-1", EmployeeCode: "emp-001", UserID: handlerUserID1, Status: employee.StatusActive, CreatedAt: now, UpdatedAt: now},
+		historyOut: &employee.ListEmployeeHistoryResult{
+			Entries: []*employee.HistoryEntry{
+				{ID: "history-1", EmployeeID: "emp-1", CompanyID: "company-1", EmployeeCode: "emp-001", UserID: handlerUserID1, Status: employee.StatusActive, EffectiveFrom: from, CreatedAt: now},
+			},
+			NextPageToken: "1",
+		},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+	ctx := context.Background()
+
+	if _, err := handler.GetEmployee(ctx, &employeepb.GetEmployeeRequest{Id: "emp-1", AsOf: wrapperspb.String("2024-03-15")}); err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if stub.getInput.AsOf == nil || !stub.getInput.AsOf.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected as_of to be parsed, got %v", stub.getInput.AsOf)
+	}
+
+	if _, err := handler.GetEmployee(ctx, &employeepb.GetEmployeeRequest{Id: "emp-1", AsOf: wrapperspb.String("2024/03/15")}); !isInvalidArgument(err) {
+		t.Fatalf("expected invalid argument for malformed as_of, got %v", err)
+	}
+
+	resp, err := handler.ListEmployeeHistory(ctx, &employeepb.ListEmployeeHistoryRequest{EmployeeId: "emp-1", PageSize: 1})
+	if err != nil {
+		t.Fatalf("ListEmployeeHistory returned error: %v", err)
+	}
+	if stub.historyInput.EmployeeID != "emp-1" || stub.historyInput.PageSize != 1 {
+		t.Fatalf("unexpected input: %+v", stub.historyInput)
+	}
+	if len(resp.GetEntries()) != 1 || resp.GetEntries()[0].GetEffectiveFrom() != "2024-03-01" || resp.GetEntries()[0].GetEffectiveTo() != nil {
+		t.Fatalf("unexpected entries: %+v", resp.GetEntries())
+	}
+	if resp.GetNextPageToken() != "1" {
+		t.Fatalf("expected next token 1, got %s", resp.GetNextPageToken())
+	}
+
+	stub.getErr = employee.ErrHistoryNotFound
+	if _, err := handler.GetEmployee(ctx, &employeepb.GetEmployeeRequest{Id: "emp-1", AsOf: wrapperspb.String("2000-01-01")}); status.Code(err) != codes.NotFound {
+		t.Fatalf("expected NotFound, got %v", status.Code(err))
+	}
+}
+
+func TestEmployeeGrpcHandler_TransferEmployee(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	previousID := "emp-1"
+	stub := &stubEmployeeUseCase{
+		transferOut: &employee.TransferEmployeeResult{
+			Previous: &employee.Employee{ID: previousID, CompanyID: "company-1", EmployeeCode: "emp-1", UserID: handlerUserID1, Status: employee.StatusActive, CreatedAt: now, UpdatedAt: now},
+			Employee: &employee.Employee{ID: "emp-2", CompanyID: "company-2", EmployeeCode: "new-1", UserID: handlerUserID1, Status: employee.StatusPending, TransferredFromEmployeeID: &previousID, CreatedAt: now, UpdatedAt: now},
+		},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+	ctx := context.Background()
+
+	resp, err := handler.TransferEmployee(ctx, &employeepb.TransferEmployeeRequest{
+		Id:                previousID,
+		TargetCompanyId:   "company-2",
+		EmployeeCode:      "new-1",
+		EffectiveDate:     "2025-04-01",
+		DepartmentId:      wrapperspb.String("dept-1"),
+		ManagerEmployeeId: wrapperspb.String("emp-9"),
+		ReassignReportsTo: wrapperspb.String(""),
+	})
+	if err != nil {
+		t.Fatalf("TransferEmployee returned error: %v", err)
+	}
+
+	in := stub.transferInput
+	if in.ID != previousID || in.TargetCompanyID != "company-2" || in.EmployeeCode != "new-1" {
+		t.Fatalf("unexpected transfer input: %+v", in)
+	}
+	if !in.EffectiveDate.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("expected effective date to be parsed, got %s", in.EffectiveDate)
+	}
+	if in.DepartmentID == nil || *in.DepartmentID != "dept-1" || in.ManagerEmployeeID == nil || *in.ManagerEmployeeID != "emp-9" {
+		t.Fatalf("expected department and manager to be passed through")
+	}
+	if in.ReassignReportsTo == nil || *in.ReassignReportsTo != "" {
+		t.Fatalf("expected empty reassign_reports_to to be passed through")
+	}
+
+	if resp.GetPrevious().GetId() != previousID || resp.GetEmployee().GetId() != "emp-2" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if resp.GetEmployee().GetTransferredFromEmployeeId().GetValue() != previousID {
+		t.Fatalf("expected transferred_from_employee_id in response")
+	}
+	if resp.GetEmployee().GetStatus() != employeepb.EmployeeStatus_EMPLOYEE_STATUS_PENDING {
+		t.Fatalf("expected pending status, got %v", resp.GetEmployee().GetStatus())
+	}
+
+	if _, err := handler.TransferEmployee(ctx, &employeepb.TransferEmployeeRequest{Id: previousID, EffectiveDate: "2025/04/01"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for malformed date, got %v", status.Code(err))
+	}
+
+	stub.transferErr = employee.ErrTransferToSameCompany
+	if _, err := handler.TransferEmployee(ctx, &employeepb.TransferEmployeeRequest{Id: previousID, EffectiveDate: "2025-04-01"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+	stub.transferErr = employee.ErrEmployeeCodeAlreadyExists
+	if _, err := handler.TransferEmployee(ctx, &employeepb.TransferEmployeeRequest{Id: previousID, EffectiveDate: "2025-04-01"}); status.Code(err) != codes.AlreadyExists {
+		t.Fatalf("expected AlreadyExists, got %v", status.Code(err))
+	}
+	stub.transferErr = employee.ErrInvalidStatusTransition
+	if _, err := handler.TransferEmployee(ctx, &employeepb.TransferEmployeeRequest{Id: previousID, EffectiveDate: "2025-04-01"}); status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+}
+
+func TestEmployeeGrpcHandler_ListUserEmployments(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	parentID := "company-0"
+	stub := &stubEmployeeUseCase{
+		employmentsOut: &employee.ListUserEmploymentsResult{
+			Employments: []*employee.Employment{
+				{
+					Employee: &employee.Employee{ID: "emp-2", CompanyID: "company-2", EmployeeCode: "emp-2", UserID: handlerUserID1, Status: employee.StatusActive, CreatedAt: now, UpdatedAt: now},
+					Company:  &employee.CompanySnapshot{ID: "company-2", Name: "Subsidiary", Code: "sub", Status: "active", ParentCompanyID: &parentID},
+				},
+				{
+					Employee: &employee.Employee{ID: "emp-1", CompanyID: "company-1", EmployeeCode: "emp-1", UserID: handlerUserID1, Status: employee.StatusTerminated, CreatedAt: now, UpdatedAt: now},
+					Company:  &employee.CompanySnapshot{ID: "company-1", Name: "Former", Code: "former", Status: "inactive"},
+				},
+			},
+			NextPageToken: "2",
+		},
+	}
+	handler := NewEmployeeGrpcHandler(stub)
+	ctx := context.Background()
+
+	resp, err := handler.ListUserEmployments(ctx, &employeepb.ListUserEmploymentsRequest{UserId: handlerUserID1, PageSize: 2, PageToken: "0"})
+	if err != nil {
+		t.Fatalf("ListUserEmployments returned error: %v", err)
+	}
+	if stub.employmentsInput.UserID != handlerUserID1 || stub.employmentsInput.PageSize != 2 || stub.employmentsInput.PageToken != "0" {
+		t.Fatalf("unexpected input: %+v", stub.employmentsInput)
+	}
+	if len(resp.GetEmployments()) != 2 || resp.GetNextPageToken() != "2" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	current := resp.GetEmployments()[0]
+	if current.GetEmployee().GetId() != "emp-2" || current.GetCompany().GetName() != "Subsidiary" || current.GetCompany().GetParentCompanyId().GetValue() != parentID {
+		t.Fatalf("unexpected employment: %+v", current)
+	}
+	if current.GetCompany().GetStatus() != companypb.CompanyStatus_COMPANY_STATUS_ACTIVE {
+		t.Fatalf("expected active company status, got %v", current.GetCompany().GetStatus())
+	}
+	former := resp.GetEmployments()[1]
+	if former.GetEmployee().GetStatus() != employeepb.EmployeeStatus_EMPLOYEE_STATUS_TERMINATED || former.GetCompany().GetStatus() != companypb.CompanyStatus_COMPANY_STATUS_INACTIVE {
+		t.Fatalf("unexpected former employment: %+v", former)
+	}
+	if former.GetCompany().GetParentCompanyId() != nil {
+		t.Fatalf("expected no parent company")
+	}
+
+	stub.employmentsErr = employee.ErrInvalidUserID
+	if _, err := handler.ListUserEmployments(ctx, &employeepb.ListUserEmploymentsRequest{UserId: "bad"}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+	if _, err := handler.ListUserEmployments(ctx, nil); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument for nil request, got %v", status.Code(err))
+	}
+}
@@ -1,10 +1,14 @@
 package handler
 
 import (
+	"context"
 	"errors"
 
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -17,15 +21,31 @@ func toStatusError(err error) error {
 	case errors.Is(err, user.ErrInvalidEmail),
 		errors.Is(err, user.ErrInvalidName),
 		errors.Is(err, user.ErrInvalidStatus),
+		errors.Is(err, user.ErrInvalidLocale),
+		errors.Is(err, user.ErrInvalidTimeZone),
+		errors.Is(err, user.ErrInvalidPhone),
+		errors.Is(err, user.ErrInvalidAvatarURL),
 		errors.Is(err, user.ErrInvalidID),
 		errors.Is(err, user.ErrInvalidPageSize),
 		errors.Is(err, user.ErrInvalidPageToken),
+		errors.Is(err, user.ErrEmailUnchanged),
+		errors.Is(err, user.ErrInvalidEmailChangeToken),
 		errors.Is(err, company.ErrInvalidName),
 		errors.Is(err, company.ErrInvalidCode),
 		errors.Is(err, company.ErrInvalidStatus),
 		errors.Is(err, company.ErrInvalidID),
 		errors.Is(err, company.ErrInvalidPageSize),
 		errors.Is(err, company.ErrInvalidPageToken),
+		errors.Is(err, company.ErrInvalidEmployeeCodePolicy),
+		errors.Is(err, company.ErrInvalidAttributeKey),
+		errors.Is(err, company.ErrInvalidAttributeType),
+		errors.Is(err, company.ErrInvalidAllowedValues),
+		errors.Is(err, company.ErrInvalidWebsite),
+		errors.Is(err, company.ErrInvalidAddressType),
+		errors.Is(err, company.ErrInvalidCountry),
+		errors.Is(err, company.ErrInvalidPostalCode),
+		errors.Is(err, company.ErrInvalidAddressLines),
+		errors.Is(err, company.ErrInvalidPhoneNumber),
 		errors.Is(err, employee.ErrInvalidID),
 		errors.Is(err, employee.ErrInvalidCompanyID),
 		errors.Is(err, employee.ErrInvalidEmployeeCode),
@@ -33,18 +53,75 @@ func toStatusError(err error) error {
 		errors.Is(err, employee.ErrInvalidStatus),
 		errors.Is(err, employee.ErrInvalidPageSize),
 		errors.Is(err, employee.ErrInvalidPageToken),
-		errors.Is(err, employee.ErrInvalidDateRange):
+		errors.Is(err, employee.ErrInvalidDateRange),
+		errors.Is(err, employee.ErrInvalidOrgChartDepth),
+		errors.Is(err, employee.ErrInvalidEffectiveDate),
+		errors.Is(err, employee.ErrTransferToSameCompany),
+		errors.Is(err, employee.ErrInvalidAttribute),
+		errors.Is(err, department.ErrInvalidID),
+		errors.Is(err, department.ErrInvalidCompanyID),
+		errors.Is(err, department.ErrInvalidName),
+		errors.Is(err, department.ErrInvalidCode),
+		errors.Is(err, department.ErrInvalidPageSize),
+		errors.Is(err, department.ErrInvalidPageToken),
+		errors.Is(err, label.ErrInvalidLabel),
+		errors.Is(err, label.ErrTooManyLabels),
+		errors.Is(err, label.ErrInvalidSelector),
+		errors.Is(err, auth.ErrInvalidID),
+		errors.Is(err, auth.ErrInvalidEmail),
+		errors.Is(err, auth.ErrInvalidPassword):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, user.ErrEmailAlreadyExists),
 		errors.Is(err, company.ErrCodeAlreadyExists),
-		errors.Is(err, employee.ErrEmployeeCodeAlreadyExists):
+		errors.Is(err, company.ErrAttributeAlreadyExists),
+		errors.Is(err, company.ErrPhoneAlreadyExists),
+		errors.Is(err, employee.ErrEmployeeCodeAlreadyExists),
+		errors.Is(err, department.ErrCodeAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, user.ErrUserNotFound),
 		errors.Is(err, company.ErrCompanyNotFound),
+		errors.Is(err, company.ErrParentCompanyNotFound),
+		errors.Is(err, company.ErrAttributeNotFound),
+		errors.Is(err, company.ErrAddressNotFound),
+		errors.Is(err, company.ErrPhoneNotFound),
 		errors.Is(err, employee.ErrEmployeeNotFound),
 		errors.Is(err, employee.ErrCompanyNotFound),
-		errors.Is(err, employee.ErrUserNotFound):
+		errors.Is(err, employee.ErrUserNotFound),
+		errors.Is(err, employee.ErrDepartmentNotFound),
+		errors.Is(err, employee.ErrManagerNotFound),
+		errors.Is(err, employee.ErrHistoryNotFound),
+		errors.Is(err, department.ErrDepartmentNotFound),
+		errors.Is(err, department.ErrCompanyNotFound),
+		errors.Is(err, department.ErrParentDepartmentNotFound),
+		errors.Is(err, auth.ErrUserNotFound):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, user.ErrUserHasEmployments),
+		errors.Is(err, user.ErrEmailChangeTokenExpired),
+		errors.Is(err, company.ErrHierarchyCycle),
+		errors.Is(err, company.ErrCompanyHasSubsidiaries),
+		errors.Is(err, company.ErrCompanyHasActiveEmployees),
+		errors.Is(err, employee.ErrReportingCycle),
+		errors.Is(err, employee.ErrManagerHasReports),
+		errors.Is(err, employee.ErrInvalidStatusTransition),
+		errors.Is(err, department.ErrHierarchyCycle),
+		errors.Is(err, department.ErrDepartmentHasChildren),
+		errors.Is(err, department.ErrDepartmentHasEmployees):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, auth.ErrInvalidCredentials),
+		errors.Is(err, auth.ErrInvalidToken),
+		errors.Is(err, auth.ErrInvalidSetupToken):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, auth.ErrUserInactive),
+		errors.Is(err, auth.ErrAccountLocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, user.ErrEmailChangeUnavailable),
+		errors.Is(err, auth.ErrAuthUnavailable),
+		errors.Is(err, auth.ErrPasswordSetupUnavailable):
+		return status.Error(codes.Unimplemented, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
@@ -8,6 +8,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // UserGrpcHandler は UserService の gRPC 実装です。
@@ -28,8 +29,9 @@ func (h *UserGrpcHandler) CreateUser(ctx context.Context, req *userpb.CreateUser
 	}
 
 	created, err := h.svc.CreateUser(ctx, user.CreateUserInput{
-		Email: req.GetEmail(),
-		Name:  req.GetName(),
+		Email:  req.GetEmail(),
+		Name:   req.GetName(),
+		Labels: req.GetLabels(),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -60,9 +62,18 @@ func (h *UserGrpcHandler) UpdateUser(ctx context.Context, req *userpb.UpdateUser
 	}
 
 	updated, err := h.svc.UpdateUser(ctx, user.UpdateUserInput{
-		ID:     req.GetId(),
-		Name:   namePtr,
-		Status: statusPtr,
+		ID:                 req.GetId(),
+		Name:               namePtr,
+		Status:             statusPtr,
+		CascadeEmployments: req.GetCascadeEmployments(),
+		Labels:             req.GetLabels(),
+		RemoveLabels:       req.GetRemoveLabels(),
+		FamilyName:         wrapperToStringPointer(req.GetFamilyName()),
+		GivenName:          wrapperToStringPointer(req.GetGivenName()),
+		Locale:             wrapperToStringPointer(req.GetLocale()),
+		TimeZone:           wrapperToStringPointer(req.GetTimeZone()),
+		Phone:              wrapperToStringPointer(req.GetPhone()),
+		AvatarURL:          wrapperToStringPointer(req.GetAvatarUrl()),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -77,7 +88,7 @@ func (h *UserGrpcHandler) DeleteUser(ctx context.Context, req *userpb.DeleteUser
 		return nil, status.Error(codes.InvalidArgument, "request is required")
 	}
 
-	if err := h.svc.DeleteUser(ctx, user.DeleteUserInput{ID: req.GetId()}); err != nil {
+	if err := h.svc.DeleteUser(ctx, user.DeleteUserInput{ID: req.GetId(), Force: req.GetForce()}); err != nil {
 		return nil, toStatusError(err)
 	}
 
@@ -114,9 +125,10 @@ func (h *UserGrpcHandler) ListUsers(ctx context.Context, req *userpb.ListUsersRe
 	}
 
 	result, err := h.svc.ListUsers(ctx, user.ListUsersInput{
-		PageSize:  int(req.GetPageSize()),
-		PageToken: req.GetPageToken(),
-		Status:    statusPtr,
+		PageSize:      int(req.GetPageSize()),
+		PageToken:     req.GetPageToken(),
+		Status:        statusPtr,
+		LabelSelector: req.GetLabelSelector(),
 	})
 	if err != nil {
 		return nil, toStatusError(err)
@@ -133,19 +145,84 @@ func (h *UserGrpcHandler) ListUsers(ctx context.Context, req *userpb.ListUsersRe
 	}, nil
 }
 
+// RequestEmailChange は新しいメールアドレス宛てに確認コードを送信します。
+func (h *UserGrpcHandler) RequestEmailChange(ctx context.Context, req *userpb.RequestEmailChangeRequest) (*userpb.RequestEmailChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	token, err := h.svc.RequestEmailChange(ctx, user.RequestEmailChangeInput{
+		UserID:   req.GetId(),
+		NewEmail: req.GetNewEmail(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &userpb.RequestEmailChangeResponse{
+		NewEmail:  token.NewEmail,
+		ExpiresAt: timestamppb.New(token.ExpiresAt),
+	}, nil
+}
+
+// ConfirmEmailChange は確認コードを検証してメールアドレスを変更します。
+func (h *UserGrpcHandler) ConfirmEmailChange(ctx context.Context, req *userpb.ConfirmEmailChangeRequest) (*userpb.ConfirmEmailChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	updated, err := h.svc.ConfirmEmailChange(ctx, user.ConfirmEmailChangeInput{Token: req.GetToken()})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &userpb.ConfirmEmailChangeResponse{User: toProtoUser(updated)}, nil
+}
+
 func toProtoUser(u *user.User) *userpb.User {
 	if u == nil {
 		return nil
 	}
 
+	var emailVerifiedAt *timestamppb.Timestamp
+	if u.EmailVerifiedAt != nil {
+		emailVerifiedAt = timestamppb.New(*u.EmailVerifiedAt)
+	}
+
 	return &userpb.User{
-		Id:        u.ID,
-		Email:     u.Email,
-		Name:      u.Name,
-		Status:    toProtoStatus(u.Status),
-		CreatedAt: timestamppb.New(u.CreatedAt),
-		UpdatedAt: timestamppb.New(u.UpdatedAt),
+		Id:          u.ID,
+		Email:       u.Email,
+		Name:        u.Name,
+		FamilyName:  stringPointerToWrapper(u.FamilyName),
+		GivenName:   stringPointerToWrapper(u.GivenName),
+		DisplayName: u.DisplayName,
+		Locale:      stringPointerToWrapper(u.Locale),
+		TimeZone:    stringPointerToWrapper(u.TimeZone),
+		Phone:       stringPointerToWrapper(u.Phone),
+		AvatarUrl:   stringPointerToWrapper(u.AvatarURL),
+		Status:      toProtoStatus(u.Status),
+		CreatedAt:   timestamppb.New(u.CreatedAt),
+		UpdatedAt:   timestamppb.New(u.UpdatedAt),
+		Labels:      u.Labels,
+
+		EmailVerifiedAt: emailVerifiedAt,
+	}
+}
+
+// wrapperToStringPointer は未指定の場合に nil を返します。空文字の指定は空文字のまま返します。
+func wrapperToStringPointer(value *wrapperspb.StringValue) *string {
+	if value == nil {
+		return nil
+	}
+	v := value.GetValue()
+	return &v
+}
+
+func stringPointerToWrapper(value *string) *wrapperspb.StringValue {
+	if value == nil {
+		return nil
 	}
+	return wrapperspb.String(*value)
 }
 
 func toProtoStatus(status user.Status) userpb.UserStatus {
@@ -3,10 +3,12 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	userpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +34,14 @@ type stubUserUseCase struct {
 	listInput user.ListUsersInput
 	listErr   error
 	listOut   *user.ListUsersResult
+
+	requestEmailChangeInput user.RequestEmailChangeInput
+	requestEmailChangeErr   error
+	requestEmailChangeOut   *user.EmailChangeToken
+
+	confirmEmailChangeInput user.ConfirmEmailChangeInput
+	confirmEmailChangeErr   error
+	confirmEmailChangeOut   *user.User
 }
 
 func (s *stubUserUseCase) CreateUser(ctx context.Context, in user.CreateUserInput) (*user.User, error) {
@@ -59,6 +69,16 @@ func (s *stubUserUseCase) ListUsers(ctx context.Context, in user.ListUsersInput)
 	return s.listOut, s.listErr
 }
 
+func (s *stubUserUseCase) RequestEmailChange(ctx context.Context, in user.RequestEmailChangeInput) (*user.EmailChangeToken, error) {
+	s.requestEmailChangeInput = in
+	return s.requestEmailChangeOut, s.requestEmailChangeErr
+}
+
+func (s *stubUserUseCase) ConfirmEmailChange(ctx context.Context, in user.ConfirmEmailChangeInput) (*user.User, error) {
+	s.confirmEmailChangeInput = in
+	return s.confirmEmailChangeOut, s.confirmEmailChangeErr
+}
+
 func TestUserGrpcHandler_CreateUser(t *testing.T) {
 	t.Parallel()
 
@@ -138,6 +158,25 @@ func TestUserGrpcHandler_UpdateUser_StatusTranslation(t *testing.T) {
 	}
 }
 
+func TestUserGrpcHandler_UpdateUser_CascadeEmployments(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubUserUseCase{updateOut: &user.User{ID: "user-1", Status: user.StatusInactive}}
+	handler := NewUserGrpcHandler(stub)
+
+	if _, err := handler.UpdateUser(context.Background(), &userpb.UpdateUserRequest{
+		Id:                 "user-1",
+		Status:             userpb.UserStatus_USER_STATUS_INACTIVE,
+		CascadeEmployments: true,
+	}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+
+	if !stub.updateInput.CascadeEmployments {
+		t.Fatalf("expected cascade employments to be passed to the use case")
+	}
+}
+
 func TestUserGrpcHandler_DeleteUser_Error(t *testing.T) {
 	t.Parallel()
 
@@ -150,6 +189,26 @@ func TestUserGrpcHandler_DeleteUser_Error(t *testing.T) {
 	}
 }
 
+func TestUserGrpcHandler_DeleteUser_Force(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubUserUseCase{deleteErr: fmt.Errorf("%w: emp-1", user.ErrUserHasEmployments)}
+	handler := NewUserGrpcHandler(stub)
+
+	_, err := handler.DeleteUser(context.Background(), &userpb.DeleteUserRequest{Id: "user-1"})
+	if status.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expected FailedPrecondition, got %v", status.Code(err))
+	}
+
+	stub.deleteErr = nil
+	if _, err := handler.DeleteUser(context.Background(), &userpb.DeleteUserRequest{Id: "user-1", Force: true}); err != nil {
+		t.Fatalf("DeleteUser returned error: %v", err)
+	}
+	if !stub.deleteInput.Force {
+		t.Fatalf("expected force to be passed to the use case")
+	}
+}
+
 func TestUserGrpcHandler_DeleteUser_ValidatesRequest(t *testing.T) {
 	t.Parallel()
 
@@ -172,6 +231,16 @@ func TestToStatusError_DefaultsToInternal(t *testing.T) {
 	}
 }
 
+func TestToStatusError_DeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	err := toStatusError(fmt.Errorf("postgres: statement canceled: %w", context.DeadlineExceeded))
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded code, got %v", err)
+	}
+}
+
 func TestDeleteUserSuccessReturnsEmpty(t *testing.T) {
 	t.Parallel()
 
@@ -309,3 +378,139 @@ func TestUserGrpcHandler_ListUsers_ErrorMapping(t *testing.T) {
 		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
 	}
 }
+
+func TestUserGrpcHandler_Labels(t *testing.T) {
+	t.Parallel()
+
+	stub := &stubUserUseCase{
+		createOut: &user.User{ID: "user-1", Labels: map[string]string{"region": "apac"}},
+		updateOut: &user.User{ID: "user-1"},
+		listOut:   &user.ListUsersResult{Users: []*user.User{}},
+	}
+	handler := NewUserGrpcHandler(stub)
+
+	resp, err := handler.CreateUser(context.Background(), &userpb.CreateUserRequest{Email: "user@example.com", Name: "User", Labels: map[string]string{"region": "apac"}})
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	if stub.createInput.Labels["region"] != "apac" {
+		t.Fatalf("expected labels passed through, got %+v", stub.createInput.Labels)
+	}
+	if resp.GetUser().GetLabels()["region"] != "apac" {
+		t.Fatalf("expected labels in response, got %+v", resp.GetUser().GetLabels())
+	}
+
+	if _, err := handler.UpdateUser(context.Background(), &userpb.UpdateUserRequest{Id: "user-1", Labels: map[string]string{"tier": "gold"}, RemoveLabels: []string{"region"}}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if stub.updateInput.Labels["tier"] != "gold" || len(stub.updateInput.RemoveLabels) != 1 || stub.updateInput.RemoveLabels[0] != "region" {
+		t.Fatalf("unexpected update input: %+v", stub.updateInput)
+	}
+
+	if _, err := handler.ListUsers(context.Background(), &userpb.ListUsersRequest{LabelSelector: "region=apac"}); err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if stub.listInput.LabelSelector != "region=apac" {
+		t.Fatalf("expected label selector passed through, got %q", stub.listInput.LabelSelector)
+	}
+
+	stub.listErr = fmt.Errorf("wrap: %w", label.ErrInvalidSelector)
+	if _, err := handler.ListUsers(context.Background(), &userpb.ListUsersRequest{LabelSelector: "="}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", status.Code(err))
+	}
+}
+
+func TestUserGrpcHandler_UpdateUser_Profile(t *testing.T) {
+	t.Parallel()
+
+	locale, zone := "ja-JP", "Asia/Tokyo"
+	stub := &stubUserUseCase{updateOut: &user.User{ID: "user-1", Name: "Taro", DisplayName: "山田 太郎", Locale: &locale, TimeZone: &zone}}
+	handler := NewUserGrpcHandler(stub)
+
+	resp, err := handler.UpdateUser(context.Background(), &userpb.UpdateUserRequest{
+		Id:         "user-1",
+		FamilyName: wrapperspb.String("山田"),
+		GivenName:  wrapperspb.String("太郎"),
+		Locale:     wrapperspb.String("ja-JP"),
+		Phone:      wrapperspb.String(""),
+	})
+	if err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+
+	in := stub.updateInput
+	if in.FamilyName == nil || *in.FamilyName != "山田" || in.Locale == nil || *in.Locale != "ja-JP" {
+		t.Fatalf("expected profile to be passed to the use case: %+v", in)
+	}
+	if in.Phone == nil || *in.Phone != "" {
+		t.Fatalf("expected empty phone to request removal, got %v", in.Phone)
+	}
+	if in.TimeZone != nil || in.AvatarURL != nil {
+		t.Fatalf("expected unset fields to be nil: %+v", in)
+	}
+
+	got := resp.GetUser()
+	if got.GetDisplayName() != "山田 太郎" || got.GetLocale().GetValue() != "ja-JP" || got.GetTimeZone().GetValue() != "Asia/Tokyo" {
+		t.Fatalf("unexpected profile in response: %+v", got)
+	}
+	if got.GetPhone() != nil || got.GetFamilyName() != nil {
+		t.Fatalf("expected unset profile fields to be omitted: %+v", got)
+	}
+
+	stub.updateErr = fmt.Errorf("%w: %q", user.ErrInvalidTimeZone, "Mars/Olympus")
+	_, err = handler.UpdateUser(context.Background(), &userpb.UpdateUserRequest{Id: "user-1", TimeZone: wrapperspb.String("Mars/Olympus")})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+}
+
+func TestUserGrpcHandler_EmailChange(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2025, 3, 4, 9, 0, 0, 0, time.UTC)
+	verifiedAt := time.Date(2025, 3, 3, 10, 0, 0, 0, time.UTC)
+	stub := &stubUserUseCase{
+		requestEmailChangeOut: &user.EmailChangeToken{NewEmail: "new@example.com", ExpiresAt: expiresAt},
+		confirmEmailChangeOut: &user.User{ID: "user-1", Email: "new@example.com", EmailVerifiedAt: &verifiedAt},
+	}
+	handler := NewUserGrpcHandler(stub)
+
+	requested, err := handler.RequestEmailChange(context.Background(), &userpb.RequestEmailChangeRequest{Id: "user-1", NewEmail: "new@example.com"})
+	if err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	if stub.requestEmailChangeInput.UserID != "user-1" || stub.requestEmailChangeInput.NewEmail != "new@example.com" {
+		t.Fatalf("unexpected input: %+v", stub.requestEmailChangeInput)
+	}
+	if requested.GetNewEmail() != "new@example.com" || !requested.GetExpiresAt().AsTime().Equal(expiresAt) {
+		t.Fatalf("unexpected response: %+v", requested)
+	}
+
+	confirmed, err := handler.ConfirmEmailChange(context.Background(), &userpb.ConfirmEmailChangeRequest{Token: "token"})
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange returned error: %v", err)
+	}
+	if stub.confirmEmailChangeInput.Token != "token" {
+		t.Fatalf("unexpected input: %+v", stub.confirmEmailChangeInput)
+	}
+	if confirmed.GetUser().GetEmail() != "new@example.com" || !confirmed.GetUser().GetEmailVerifiedAt().AsTime().Equal(verifiedAt) {
+		t.Fatalf("unexpected response: %+v", confirmed)
+	}
+
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{user.ErrInvalidEmailChangeToken, codes.InvalidArgument},
+		{user.ErrEmailUnchanged, codes.InvalidArgument},
+		{user.ErrEmailChangeTokenExpired, codes.FailedPrecondition},
+		{user.ErrEmailAlreadyExists, codes.AlreadyExists},
+		{user.ErrEmailChangeUnavailable, codes.Unimplemented},
+	}
+	for _, tc := range cases {
+		stub.confirmEmailChangeErr = tc.err
+		if _, err := handler.ConfirmEmailChange(context.Background(), &userpb.ConfirmEmailChangeRequest{Token: "token"}); status.Code(err) != tc.code {
+			t.Errorf("%v: expected %s, got %v", tc.err, tc.code, err)
+		}
+	}
+}
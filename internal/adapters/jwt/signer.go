@@ -0,0 +1,131 @@
+// Package jwt は HS256 で署名する JWT の auth.TokenSigner 実装を提供します。
+package jwt
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+)
+
+// header は署名に使う固定の JOSE ヘッダーです。
+var header = base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+// Signer は共有鍵で JWT を署名・検証します。
+type Signer struct {
+	key    []byte
+	issuer string
+}
+
+var _ auth.TokenSigner = (*Signer)(nil)
+
+// NewSigner は Signer を生成します。issuer は iss クレームに設定し、検証時に一致を確認します。
+func NewSigner(key []byte, issuer string) *Signer {
+	return &Signer{key: bytes.Clone(key), issuer: issuer}
+}
+
+// payload は JWT のクレームです。
+type payload struct {
+	Issuer      string            `json:"iss,omitempty"`
+	Subject     string            `json:"sub"`
+	IssuedAt    int64             `json:"iat"`
+	ExpiresAt   int64             `json:"exp"`
+	ID          string            `json:"jti"`
+	TokenType   string            `json:"token_type"`
+	Employments []employmentClaim `json:"employments,omitempty"`
+}
+
+// employmentClaim は会社ごとの在籍情報のクレームです。
+type employmentClaim struct {
+	CompanyID    string `json:"company_id"`
+	EmployeeID   string `json:"employee_id"`
+	EmployeeCode string `json:"employee_code"`
+	Status       string `json:"status"`
+}
+
+// Sign はクレームを署名したトークンを返します。claims.ID が空の場合は jti に乱数を設定します。
+func (s *Signer) Sign(claims auth.Claims) (string, error) {
+	id := claims.ID
+	if id == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return "", err
+		}
+		id = base64.RawURLEncoding.EncodeToString(b)
+	}
+
+	p := payload{
+		Issuer:    s.issuer,
+		Subject:   claims.Subject,
+		IssuedAt:  claims.IssuedAt.Unix(),
+		ExpiresAt: claims.ExpiresAt.Unix(),
+		ID:        id,
+		TokenType: string(claims.TokenType),
+	}
+	for _, e := range claims.Employments {
+		p.Employments = append(p.Employments, employmentClaim(e))
+	}
+	body, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := header + "." + base64.RawURLEncoding.EncodeToString(body)
+	return signingInput + "." + s.signature(signingInput), nil
+}
+
+// Verify は署名と発行者を検証してクレームを返します。有効期限は検証しません。
+func (s *Signer) Verify(token string) (*auth.Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed", auth.ErrInvalidToken)
+	}
+
+	// alg の差し替え（none など）を防ぐため、ヘッダーは発行時と完全に一致するもののみ受け付けます。
+	if parts[0] != header {
+		return nil, fmt.Errorf("%w: unsupported header", auth.ErrInvalidToken)
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(s.signature(parts[0]+"."+parts[1]))) {
+		return nil, fmt.Errorf("%w: signature mismatch", auth.ErrInvalidToken)
+	}
+
+	body, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", auth.ErrInvalidToken)
+	}
+	var p payload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return nil, fmt.Errorf("%w: malformed payload", auth.ErrInvalidToken)
+	}
+	if p.Issuer != s.issuer {
+		return nil, fmt.Errorf("%w: issuer mismatch", auth.ErrInvalidToken)
+	}
+	if p.Subject == "" || p.ExpiresAt == 0 {
+		return nil, fmt.Errorf("%w: missing claims", auth.ErrInvalidToken)
+	}
+
+	claims := &auth.Claims{
+		ID:        p.ID,
+		Subject:   p.Subject,
+		TokenType: auth.TokenType(p.TokenType),
+		IssuedAt:  time.Unix(p.IssuedAt, 0).UTC(),
+		ExpiresAt: time.Unix(p.ExpiresAt, 0).UTC(),
+	}
+	for _, e := range p.Employments {
+		claims.Employments = append(claims.Employments, auth.Employment(e))
+	}
+	return claims, nil
+}
+
+func (s *Signer) signature(signingInput string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(signingInput))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
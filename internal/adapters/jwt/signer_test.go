@@ -0,0 +1,114 @@
+package jwt
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+)
+
+var testKey = []byte("0123456789abcdef0123456789abcdef")
+
+func TestSigner_SignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	s := NewSigner(testKey, "codex-test")
+	issuedAt := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	claims := auth.Claims{
+		Subject:   "user-1",
+		TokenType: auth.TokenTypeAccess,
+		Employments: []auth.Employment{
+			{CompanyID: "company-1", EmployeeID: "employee-1", EmployeeCode: "E001", Status: "active"},
+		},
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(15 * time.Minute),
+	}
+
+	token, err := s.Sign(claims)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := s.Verify(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Subject != "user-1" || got.TokenType != auth.TokenTypeAccess || !got.IssuedAt.Equal(claims.IssuedAt) || !got.ExpiresAt.Equal(claims.ExpiresAt) {
+		t.Fatalf("unexpected claims: %+v", got)
+	}
+	if len(got.Employments) != 1 || got.Employments[0] != claims.Employments[0] {
+		t.Fatalf("unexpected employments: %+v", got.Employments)
+	}
+	if got.ID == "" {
+		t.Fatalf("expected a random jti, got empty")
+	}
+
+	// ID を指定した場合はそのまま jti に設定します。
+	claims.ID = "refresh-1"
+	token, err = s.Sign(claims)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, err = s.Verify(token); err != nil || got.ID != "refresh-1" {
+		t.Fatalf("expected jti refresh-1, got %+v (%v)", got, err)
+	}
+
+	// 標準のクレーム名で JSON に含まれることを確認します。
+	body, err := base64.RawURLEncoding.DecodeString(strings.Split(token, ".")[1])
+	if err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(body, &raw); err != nil {
+		t.Fatalf("failed to unmarshal payload: %v", err)
+	}
+	for _, key := range []string{"iss", "sub", "iat", "exp", "jti", "token_type", "employments"} {
+		if _, ok := raw[key]; !ok {
+			t.Errorf("expected claim %q in payload %s", key, body)
+		}
+	}
+}
+
+func TestSigner_VerifyRejects(t *testing.T) {
+	t.Parallel()
+
+	s := NewSigner(testKey, "codex-test")
+	token, err := s.Sign(auth.Claims{
+		Subject:   "user-1",
+		TokenType: auth.TokenTypeRefresh,
+		IssuedAt:  time.Unix(1700000000, 0),
+		ExpiresAt: time.Unix(1700003600, 0),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parts := strings.Split(token, ".")
+
+	noneHeader := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","typ":"JWT"}`))
+	tampered := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"user-2","iat":1700000000,"exp":1700003600,"token_type":"refresh","iss":"codex-test"}`))
+
+	cases := map[string]struct {
+		signer *Signer
+		token  string
+	}{
+		"malformed":      {signer: s, token: "abc.def"},
+		"alg none":       {signer: s, token: noneHeader + "." + parts[1] + "."},
+		"tampered":       {signer: s, token: parts[0] + "." + tampered + "." + parts[2]},
+		"other key":      {signer: NewSigner([]byte("another-secret-another-secret-xx"), "codex-test"), token: token},
+		"other issuer":   {signer: NewSigner(testKey, "someone-else"), token: token},
+		"bad signature":  {signer: s, token: parts[0] + "." + parts[1] + ".AAAA"},
+		"empty token":    {signer: s, token: ""},
+		"bad base64 sig": {signer: s, token: parts[0] + "." + parts[1] + ".!!"},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := tc.signer.Verify(tc.token); !errors.Is(err, auth.ErrInvalidToken) {
+				t.Fatalf("expected ErrInvalidToken, got %v", err)
+			}
+		})
+	}
+}
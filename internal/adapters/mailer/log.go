@@ -0,0 +1,29 @@
+// Package mailer は verification.Mailer の実装を提供します。
+package mailer
+
+import (
+	"context"
+	"log"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+// LogMailer はメールを送信せず、内容をロガーへ出力する実装です。ローカル環境での確認に利用します。
+type LogMailer struct {
+	logger *log.Logger
+	from   string
+}
+
+// NewLogMailer は LogMailer を生成します。logger が nil の場合は標準のロガーへ出力します。
+func NewLogMailer(logger *log.Logger, from string) *LogMailer {
+	if logger == nil {
+		logger = log.Default()
+	}
+	return &LogMailer{logger: logger, from: from}
+}
+
+// Send はメールの内容をロガーへ出力します。
+func (m *LogMailer) Send(_ context.Context, mail verification.Mail) error {
+	m.logger.Printf("mail from=%s to=%s subject=%q\n%s", m.from, mail.To, mail.Subject, mail.Body)
+	return nil
+}
@@ -0,0 +1,192 @@
+package mailer
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime/quotedprintable"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+func TestLogMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	m := NewLogMailer(log.New(&buf, "", 0), "no-reply@example.com")
+
+	if err := m.Send(context.Background(), verification.Mail{To: "user@example.com", Subject: "確認", Body: "code: abc\n"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	got := buf.String()
+	for _, want := range []string{"from=no-reply@example.com", "to=user@example.com", `subject="確認"`, "code: abc"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("expected log output to contain %q, got %q", want, got)
+		}
+	}
+}
+
+func TestSMTPMailer_Compose(t *testing.T) {
+	t.Parallel()
+
+	m := NewSMTPMailer("smtp.example.com", 587, "", "", "no-reply@example.com")
+	m.now = func() time.Time { return time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC) }
+
+	msg, err := m.compose(verification.Mail{To: "user@example.com", Subject: "メールアドレスの確認", Body: "確認コード: abc\n"})
+	if err != nil {
+		t.Fatalf("compose returned error: %v", err)
+	}
+
+	header, body, ok := strings.Cut(string(msg), "\r\n\r\n")
+	if !ok {
+		t.Fatalf("expected header and body separator: %q", msg)
+	}
+	for _, want := range []string{
+		"From: no-reply@example.com\r\n",
+		"To: user@example.com\r\n",
+		"Subject: =?UTF-8?b?",
+		"Date: Mon, 03 Mar 2025 09:00:00 +0000\r\n",
+		"Content-Type: text/plain; charset=UTF-8\r\n",
+	} {
+		if !strings.Contains(header, want) {
+			t.Errorf("expected header to contain %q, got %q", want, header)
+		}
+	}
+
+	decoded, err := io.ReadAll(quotedprintable.NewReader(strings.NewReader(body)))
+	if err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if string(decoded) != "確認コード: abc\r\n" {
+		t.Fatalf("unexpected body: %q", decoded)
+	}
+}
+
+// startFakeSMTPServer は 1 接続だけ受け付ける最小限の SMTP サーバーを起動します。
+// silent を指定すると接続後に何も応答しません。受信した DATA の内容を返すチャネルを返します。
+func startFakeSMTPServer(t *testing.T, silent bool) (string, int, <-chan string) {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { _ = ln.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		if silent {
+			_, _ = io.Copy(io.Discard, conn)
+			return
+		}
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost ESMTP")
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			switch cmd := strings.ToUpper(strings.TrimSpace(line)); {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply("250 localhost")
+			case strings.HasPrefix(cmd, "DATA"):
+				reply("354 end data with <CR><LF>.<CR><LF>")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(l)
+				}
+				received <- data.String()
+				reply("250 queued")
+			case strings.HasPrefix(cmd, "QUIT"):
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(ln.Addr().String())
+	if err != nil {
+		t.Fatalf("failed to split address: %v", err)
+	}
+	p, err := strconv.Atoi(port)
+	if err != nil {
+		t.Fatalf("failed to parse port: %v", err)
+	}
+	return host, p, received
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	t.Parallel()
+
+	host, port, received := startFakeSMTPServer(t, false)
+	m := NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+
+	if err := m.Send(context.Background(), verification.Mail{To: "user@example.com", Subject: "確認", Body: "code: abc\n"}); err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	select {
+	case data := <-received:
+		if !strings.Contains(data, "To: user@example.com\r\n") {
+			t.Fatalf("unexpected message: %q", data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("server did not receive the message")
+	}
+}
+
+func TestSMTPMailer_SendHonorsContextDeadline(t *testing.T) {
+	t.Parallel()
+
+	host, port, _ := startFakeSMTPServer(t, true)
+	m := NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	err := m.Send(ctx, verification.Mail{To: "user@example.com", Subject: "確認", Body: "code: abc\n"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Fatalf("expected Send to give up at the deadline, took %s", elapsed)
+	}
+}
+
+func TestSMTPMailer_SendTimeout(t *testing.T) {
+	t.Parallel()
+
+	host, port, _ := startFakeSMTPServer(t, true)
+	m := NewSMTPMailer(host, port, "", "", "no-reply@example.com")
+	m.timeout = 100 * time.Millisecond
+
+	var netErr net.Error
+	if err := m.Send(context.Background(), verification.Mail{To: "user@example.com", Subject: "確認", Body: "code: abc\n"}); !errors.As(err, &netErr) || !netErr.Timeout() {
+		t.Fatalf("expected a timeout error, got %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+// defaultSMTPTimeout は接続から送信完了までの上限です。ctx の期限がより短い場合はそちらを優先します。
+const defaultSMTPTimeout = 30 * time.Second
+
+// SMTPMailer は SMTP サーバーからメールを送信する実装です。
+type SMTPMailer struct {
+	addr    string
+	host    string
+	auth    smtp.Auth
+	from    string
+	timeout time.Duration
+	dialer  net.Dialer
+	now     func() time.Time
+}
+
+// NewSMTPMailer は SMTPMailer を生成します。username を指定した場合は PLAIN 認証を行います。
+func NewSMTPMailer(host string, port int, username, password, from string) *SMTPMailer {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &SMTPMailer{
+		addr:    net.JoinHostPort(host, strconv.Itoa(port)),
+		host:    host,
+		auth:    auth,
+		from:    from,
+		timeout: defaultSMTPTimeout,
+		now:     time.Now,
+	}
+}
+
+// Send はメールを送信します。
+// 接続は ctx に従って確立し、ctx の期限または defaultSMTPTimeout を接続全体の期限とします。ctx がキャンセルされた場合は送信を中断します。
+func (m *SMTPMailer) Send(ctx context.Context, mail verification.Mail) error {
+	msg, err := m.compose(mail)
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.dialer.DialContext(ctx, "tcp", m.addr)
+	if err != nil {
+		return fmt.Errorf("smtp: dial %s: %w", m.addr, err)
+	}
+	deadline := time.Now().Add(m.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return fmt.Errorf("smtp: set deadline: %w", err)
+	}
+	stop := context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+	defer stop()
+
+	if err := m.send(conn, mail.To, msg); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			err = ctxErr
+		}
+		return fmt.Errorf("smtp: send mail to %s: %w", mail.To, err)
+	}
+	return nil
+}
+
+// send は smtp.SendMail と同じ手順（STARTTLS、認証、送信）で conn からメールを送信します。
+func (m *SMTPMailer) send(conn net.Conn, to string, msg []byte) error {
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		_ = conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+			return err
+		}
+	}
+	if m.auth != nil {
+		if ok, _ := c.Extension("AUTH"); !ok {
+			return errors.New("server doesn't support AUTH")
+		}
+		if err := c.Auth(m.auth); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.from); err != nil {
+		return err
+	}
+	if err := c.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// compose は UTF-8 のテキストメールを組み立てます。件名は MIME エンコードし、本文は quoted-printable で符号化します。
+func (m *SMTPMailer) compose(mail verification.Mail) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", m.from)
+	fmt.Fprintf(&buf, "To: %s\r\n", mail.To)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", mail.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", m.now().Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write(bytes.ReplaceAll([]byte(mail.Body), []byte("\n"), []byte("\r\n"))); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,124 @@
+// Package password は auth.PasswordHasher の実装を提供します。
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// AlgorithmArgon2id は argon2id でハッシュ化します（既定）。
+	AlgorithmArgon2id = "argon2id"
+	// AlgorithmBcrypt は bcrypt でハッシュ化します。
+	AlgorithmBcrypt = "bcrypt"
+)
+
+// argon2id のパラメータは OWASP の推奨値（メモリ 19 MiB・反復 2 回・並列度 1）です。
+const (
+	argon2Memory  = 19 * 1024
+	argon2Time    = 2
+	argon2Threads = 1
+	argon2KeyLen  = 32
+	argon2SaltLen = 16
+)
+
+// ErrUnsupportedHash はハッシュ値の形式を判別できない場合に返却されます。
+var ErrUnsupportedHash = errors.New("unsupported password hash")
+
+// Hasher は設定したアルゴリズムでハッシュ化し、argon2id・bcrypt のどちらのハッシュ値も照合します。
+// アルゴリズムを切り替えても、既存のパスワードでログインできます。
+type Hasher struct {
+	algorithm string
+}
+
+var _ auth.PasswordHasher = (*Hasher)(nil)
+
+// NewHasher は Hasher を生成します。algorithm が空の場合は argon2id を使います。
+func NewHasher(algorithm string) (*Hasher, error) {
+	switch algorithm {
+	case "":
+		algorithm = AlgorithmArgon2id
+	case AlgorithmArgon2id, AlgorithmBcrypt:
+	default:
+		return nil, fmt.Errorf("unsupported password hash algorithm %q", algorithm)
+	}
+	return &Hasher{algorithm: algorithm}, nil
+}
+
+// Hash はパスワードをハッシュ化します。argon2id は PHC 文字列形式で返します。
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+	}
+
+	salt := make([]byte, argon2SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, argon2Time, argon2Memory, argon2Threads, argon2KeyLen)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2Memory, argon2Time, argon2Threads,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify はパスワードがハッシュ値と一致するかを返します。ハッシュ値の形式は接頭辞で判別します。
+func (h *Hasher) Verify(hash, password string) (bool, error) {
+	switch {
+	case strings.HasPrefix(hash, "$argon2id$"):
+		return verifyArgon2id(hash, password)
+	case strings.HasPrefix(hash, "$2a$"), strings.HasPrefix(hash, "$2b$"), strings.HasPrefix(hash, "$2y$"):
+		err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, ErrUnsupportedHash
+	}
+}
+
+func verifyArgon2id(hash, password string) (bool, error) {
+	// $argon2id$v=19$m=19456,t=2,p=1$<salt>$<key>
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return false, ErrUnsupportedHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return false, ErrUnsupportedHash
+	}
+	var (
+		memory, time uint32
+		threads      uint8
+	)
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &memory, &time, &threads); err != nil || time == 0 || threads == 0 {
+		return false, ErrUnsupportedHash
+	}
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return false, ErrUnsupportedHash
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return false, ErrUnsupportedHash
+	}
+
+	derived := argon2.IDKey([]byte(password), salt, time, memory, threads, uint32(len(key)))
+	return subtle.ConstantTimeCompare(derived, key) == 1, nil
+}
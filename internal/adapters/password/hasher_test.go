@@ -0,0 +1,110 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestHasher_HashAndVerify(t *testing.T) {
+	t.Parallel()
+
+	for _, algorithm := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			t.Parallel()
+
+			h, err := NewHasher(algorithm)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			hash, err := h.Hash("correct horse")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			ok, err := h.Verify(hash, "correct horse")
+			if err != nil || !ok {
+				t.Fatalf("expected password to match, got ok=%v err=%v", ok, err)
+			}
+			ok, err = h.Verify(hash, "wrong horse")
+			if err != nil || ok {
+				t.Fatalf("expected password not to match, got ok=%v err=%v", ok, err)
+			}
+		})
+	}
+}
+
+func TestHasher_Argon2idFormat(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewHasher("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first, err := h.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	second, err := h.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !strings.HasPrefix(first, "$argon2id$v=19$m=19456,t=2,p=1$") {
+		t.Fatalf("unexpected hash format: %s", first)
+	}
+	if first == second {
+		t.Fatal("expected a random salt for each hash")
+	}
+}
+
+func TestHasher_VerifyOtherAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	argon, err := NewHasher(AlgorithmArgon2id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bcryptHasher, err := NewHasher(AlgorithmBcrypt)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash, err := bcryptHasher.Hash("correct horse")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ok, err := argon.Verify(hash, "correct horse"); err != nil || !ok {
+		t.Fatalf("expected bcrypt hash to be verified, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestHasher_VerifyMalformed(t *testing.T) {
+	t.Parallel()
+
+	h, err := NewHasher(AlgorithmArgon2id)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, hash := range []string{
+		"",
+		"plain-text",
+		"$argon2id$v=19$m=19456,t=2,p=1$salt",
+		"$argon2id$v=16$m=19456,t=2,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=19456,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=19456,t=2,p=1$!!!$a2V5",
+	} {
+		if _, err := h.Verify(hash, "correct horse"); !errors.Is(err, ErrUnsupportedHash) {
+			t.Errorf("hash %q: expected ErrUnsupportedHash, got %v", hash, err)
+		}
+	}
+}
+
+func TestNewHasher_UnsupportedAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewHasher("md5"); err == nil {
+		t.Fatal("expected error for unsupported algorithm")
+	}
+}
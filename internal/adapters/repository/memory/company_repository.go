@@ -0,0 +1,548 @@
+package memory
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+)
+
+// CompanyRepository はインメモリの会社永続化実装です。
+type CompanyRepository struct {
+	store *Store
+}
+
+// NewCompanyRepository は CompanyRepository を生成します。
+func NewCompanyRepository(store *Store) *CompanyRepository {
+	return &CompanyRepository{store: store}
+}
+
+// Create は会社を新規作成します。
+func (r *CompanyRepository) Create(_ context.Context, c *company.Company) (*company.Company, error) {
+	var created *company.Company
+	err := r.store.write(func(d *dataset) error {
+		if codeTaken(d, c.Code, "") {
+			return company.ErrCodeAlreadyExists
+		}
+		if err := validateParent(d, c.ParentCompanyID, ""); err != nil {
+			return err
+		}
+		if err := validateEmployeeCodePolicy(c.EmployeeCodePolicy); err != nil {
+			return err
+		}
+		clone := cloneCompany(c)
+		clone.ID = uuid.NewString()
+		d.companies[clone.ID] = clone
+		created = cloneCompany(clone)
+		return nil
+	})
+	return created, err
+}
+
+// Update は会社情報を更新します。社員コードの次の連番は NextCodeSequence と競合しないよう更新しません。
+func (r *CompanyRepository) Update(_ context.Context, c *company.Company) (*company.Company, error) {
+	var updated *company.Company
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.companies[c.ID]
+		if !ok {
+			return company.ErrCompanyNotFound
+		}
+		if codeTaken(d, c.Code, c.ID) {
+			return company.ErrCodeAlreadyExists
+		}
+		if err := validateParent(d, c.ParentCompanyID, c.ID); err != nil {
+			return err
+		}
+		policy := c.EmployeeCodePolicy
+		policy.NextSequence = existing.EmployeeCodePolicy.NextSequence
+		if err := validateEmployeeCodePolicy(policy); err != nil {
+			return err
+		}
+		existing.Name = c.Name
+		existing.Code = c.Code
+		existing.Status = c.Status
+		existing.Description = cloneString(c.Description)
+		existing.ParentCompanyID = cloneString(c.ParentCompanyID)
+		existing.EmployeeCodePolicy = policy
+		existing.Labels = label.Clone(c.Labels)
+		existing.Website = cloneString(c.Website)
+		existing.UpdatedAt = c.UpdatedAt
+		updated = cloneCompany(existing)
+		return nil
+	})
+	return updated, err
+}
+
+// SetEmployeeCodeNextSequence は社員コードの次の連番を設定します。
+func (r *CompanyRepository) SetEmployeeCodeNextSequence(_ context.Context, id string, next int64) error {
+	return r.store.write(func(d *dataset) error {
+		existing, ok := d.companies[id]
+		if !ok {
+			return company.ErrCompanyNotFound
+		}
+		policy := existing.EmployeeCodePolicy
+		policy.NextSequence = next
+		if err := validateEmployeeCodePolicy(policy); err != nil {
+			return err
+		}
+		existing.EmployeeCodePolicy = policy
+		return nil
+	})
+}
+
+// Delete は会社を削除します。所属する社員・部署も合わせて削除します（ON DELETE CASCADE 相当）。
+// 子会社が存在する場合は削除しません（ON DELETE RESTRICT 相当）。
+func (r *CompanyRepository) Delete(_ context.Context, id string) error {
+	return r.store.write(func(d *dataset) error {
+		if _, ok := d.companies[id]; !ok {
+			return company.ErrCompanyNotFound
+		}
+		if len(subsidiaryIDs(d, id, false)) > 0 {
+			return company.ErrCompanyHasSubsidiaries
+		}
+		for empID, emp := range d.employees {
+			if emp.CompanyID == id {
+				delete(d.employees, empID)
+				delete(d.employeeHistory, empID)
+			}
+		}
+		for depID, dep := range d.departments {
+			if dep.CompanyID == id {
+				delete(d.departments, depID)
+			}
+		}
+		for k := range d.employeeAttributes {
+			if k.companyID == id {
+				delete(d.employeeAttributes, k)
+			}
+		}
+		for addressID, a := range d.companyAddresses {
+			if a.CompanyID == id {
+				delete(d.companyAddresses, addressID)
+			}
+		}
+		for phoneID, p := range d.companyPhones {
+			if p.CompanyID == id {
+				delete(d.companyPhones, phoneID)
+			}
+		}
+		delete(d.companies, id)
+		return nil
+	})
+}
+
+// FindByID は ID で会社を取得します。
+func (r *CompanyRepository) FindByID(_ context.Context, id string) (*company.Company, error) {
+	var found *company.Company
+	err := r.store.read(func(d *dataset) error {
+		c, ok := d.companies[id]
+		if !ok {
+			return company.ErrCompanyNotFound
+		}
+		found = cloneCompany(c)
+		return nil
+	})
+	return found, err
+}
+
+// FindByCode はコードで会社を取得します。
+func (r *CompanyRepository) FindByCode(_ context.Context, code string) (*company.Company, error) {
+	var found *company.Company
+	err := r.store.read(func(d *dataset) error {
+		for _, c := range d.companies {
+			if c.Code == code {
+				found = cloneCompany(c)
+				return nil
+			}
+		}
+		return company.ErrCompanyNotFound
+	})
+	return found, err
+}
+
+// List は会社の一覧を作成日時の降順で取得します。
+func (r *CompanyRepository) List(_ context.Context, filter company.ListCompaniesFilter) ([]*company.Company, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", company.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", company.ErrInvalidPageToken
+	}
+
+	var matched []*company.Company
+	_ = r.store.read(func(d *dataset) error {
+		for _, c := range d.companies {
+			if filter.Status != nil && c.Status != *filter.Status {
+				continue
+			}
+			if !filter.LabelSelector.Matches(c.Labels) {
+				continue
+			}
+			matched = append(matched, cloneCompany(c))
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(c *company.Company) (time.Time, string) { return c.CreatedAt, c.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListSubsidiaries は子会社の一覧を作成日時の降順で取得します。Recursive の場合は孫会社以下も含めます。
+func (r *CompanyRepository) ListSubsidiaries(_ context.Context, filter company.ListSubsidiariesFilter) ([]*company.Company, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", company.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", company.ErrInvalidPageToken
+	}
+
+	var matched []*company.Company
+	_ = r.store.read(func(d *dataset) error {
+		for id := range subsidiaryIDs(d, filter.ParentID, filter.Recursive) {
+			c := d.companies[id]
+			if filter.Status != nil && c.Status != *filter.Status {
+				continue
+			}
+			matched = append(matched, cloneCompany(c))
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(c *company.Company) (time.Time, string) { return c.CreatedAt, c.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListAncestors は直近の親会社から最上位の会社までを順に返します。
+func (r *CompanyRepository) ListAncestors(_ context.Context, id string) ([]*company.Company, error) {
+	var ancestors []*company.Company
+	_ = r.store.read(func(d *dataset) error {
+		visited := map[string]bool{id: true}
+		current, ok := d.companies[id]
+		for ok && current.ParentCompanyID != nil && !visited[*current.ParentCompanyID] {
+			visited[*current.ParentCompanyID] = true
+			current, ok = d.companies[*current.ParentCompanyID]
+			if ok {
+				ancestors = append(ancestors, cloneCompany(current))
+			}
+		}
+		return nil
+	})
+	return ancestors, nil
+}
+
+// LockHierarchy は何もしません。書き込みトランザクションはストア全体で直列化されています。
+func (r *CompanyRepository) LockHierarchy(context.Context) error {
+	return nil
+}
+
+// CreateEmployeeAttribute は社員属性の定義を追加します。
+func (r *CompanyRepository) CreateEmployeeAttribute(_ context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	var created *company.EmployeeAttribute
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.companies[a.CompanyID]; !ok {
+			return company.ErrCompanyNotFound
+		}
+		k := attributeKey{companyID: a.CompanyID, key: a.Key}
+		if _, ok := d.employeeAttributes[k]; ok {
+			return company.ErrAttributeAlreadyExists
+		}
+		d.employeeAttributes[k] = cloneEmployeeAttribute(a)
+		created = cloneEmployeeAttribute(a)
+		return nil
+	})
+	return created, err
+}
+
+// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+func (r *CompanyRepository) UpdateEmployeeAttribute(_ context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	var updated *company.EmployeeAttribute
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.employeeAttributes[attributeKey{companyID: a.CompanyID, key: a.Key}]
+		if !ok {
+			return company.ErrAttributeNotFound
+		}
+		existing.Required = a.Required
+		existing.AllowedValues = append([]string(nil), a.AllowedValues...)
+		existing.UpdatedAt = a.UpdatedAt
+		updated = cloneEmployeeAttribute(existing)
+		return nil
+	})
+	return updated, err
+}
+
+// DeleteEmployeeAttribute は社員属性の定義を削除し、会社の社員からその属性の値を取り除きます。
+func (r *CompanyRepository) DeleteEmployeeAttribute(_ context.Context, companyID, key string) error {
+	return r.store.write(func(d *dataset) error {
+		k := attributeKey{companyID: companyID, key: key}
+		if _, ok := d.employeeAttributes[k]; !ok {
+			return company.ErrAttributeNotFound
+		}
+		delete(d.employeeAttributes, k)
+		for _, e := range d.employees {
+			if e.CompanyID == companyID {
+				delete(e.Attributes, key)
+			}
+		}
+		return nil
+	})
+}
+
+// FindEmployeeAttribute は会社の社員属性の定義をキーで取得します。
+func (r *CompanyRepository) FindEmployeeAttribute(_ context.Context, companyID, key string) (*company.EmployeeAttribute, error) {
+	var found *company.EmployeeAttribute
+	err := r.store.read(func(d *dataset) error {
+		a, ok := d.employeeAttributes[attributeKey{companyID: companyID, key: key}]
+		if !ok {
+			return company.ErrAttributeNotFound
+		}
+		found = cloneEmployeeAttribute(a)
+		return nil
+	})
+	return found, err
+}
+
+// ListEmployeeAttributes は会社の社員属性の定義をキーの昇順で返します。
+func (r *CompanyRepository) ListEmployeeAttributes(_ context.Context, companyID string) ([]*company.EmployeeAttribute, error) {
+	var attributes []*company.EmployeeAttribute
+	err := r.store.read(func(d *dataset) error {
+		for k, a := range d.employeeAttributes {
+			if k.companyID == companyID {
+				attributes = append(attributes, cloneEmployeeAttribute(a))
+			}
+		}
+		return nil
+	})
+	sort.Slice(attributes, func(i, j int) bool { return attributes[i].Key < attributes[j].Key })
+	return attributes, err
+}
+
+// AddAddress は会社に住所を追加します。
+func (r *CompanyRepository) AddAddress(_ context.Context, a *company.Address) (*company.Address, error) {
+	var created *company.Address
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.companies[a.CompanyID]; !ok {
+			return company.ErrCompanyNotFound
+		}
+		clone := cloneAddress(a)
+		clone.ID = uuid.NewString()
+		d.companyAddresses[clone.ID] = clone
+		created = cloneAddress(clone)
+		return nil
+	})
+	return created, err
+}
+
+// DeleteAddress は会社の住所を削除します。
+func (r *CompanyRepository) DeleteAddress(_ context.Context, companyID, id string) error {
+	return r.store.write(func(d *dataset) error {
+		a, ok := d.companyAddresses[id]
+		if !ok || a.CompanyID != companyID {
+			return company.ErrAddressNotFound
+		}
+		delete(d.companyAddresses, id)
+		return nil
+	})
+}
+
+// ListAddresses は指定した会社の住所を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListAddresses(_ context.Context, companyIDs []string) ([]*company.Address, error) {
+	ids := stringSet(companyIDs)
+	var addresses []*company.Address
+	_ = r.store.read(func(d *dataset) error {
+		for _, a := range d.companyAddresses {
+			if ids[a.CompanyID] {
+				addresses = append(addresses, cloneAddress(a))
+			}
+		}
+		return nil
+	})
+	sort.Slice(addresses, func(i, j int) bool {
+		return contactLess(addresses[i].CompanyID, addresses[i].CreatedAt, addresses[i].ID, addresses[j].CompanyID, addresses[j].CreatedAt, addresses[j].ID)
+	})
+	return addresses, nil
+}
+
+// AddPhone は会社に電話番号を追加します。
+func (r *CompanyRepository) AddPhone(_ context.Context, p *company.Phone) (*company.Phone, error) {
+	var created *company.Phone
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.companies[p.CompanyID]; !ok {
+			return company.ErrCompanyNotFound
+		}
+		for _, existing := range d.companyPhones {
+			if existing.CompanyID == p.CompanyID && existing.Number == p.Number {
+				return company.ErrPhoneAlreadyExists
+			}
+		}
+		clone := clonePhone(p)
+		clone.ID = uuid.NewString()
+		d.companyPhones[clone.ID] = clone
+		created = clonePhone(clone)
+		return nil
+	})
+	return created, err
+}
+
+// DeletePhone は会社の電話番号を削除します。
+func (r *CompanyRepository) DeletePhone(_ context.Context, companyID, id string) error {
+	return r.store.write(func(d *dataset) error {
+		p, ok := d.companyPhones[id]
+		if !ok || p.CompanyID != companyID {
+			return company.ErrPhoneNotFound
+		}
+		delete(d.companyPhones, id)
+		return nil
+	})
+}
+
+// ListPhones は指定した会社の電話番号を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListPhones(_ context.Context, companyIDs []string) ([]*company.Phone, error) {
+	ids := stringSet(companyIDs)
+	var phones []*company.Phone
+	_ = r.store.read(func(d *dataset) error {
+		for _, p := range d.companyPhones {
+			if ids[p.CompanyID] {
+				phones = append(phones, clonePhone(p))
+			}
+		}
+		return nil
+	})
+	sort.Slice(phones, func(i, j int) bool {
+		return contactLess(phones[i].CompanyID, phones[i].CreatedAt, phones[i].ID, phones[j].CompanyID, phones[j].CreatedAt, phones[j].ID)
+	})
+	return phones, nil
+}
+
+// contactLess は ORDER BY company_id, created_at, id と同じ順序で比較します。
+func contactLess(companyA string, createdA time.Time, idA string, companyB string, createdB time.Time, idB string) bool {
+	if companyA != companyB {
+		return companyA < companyB
+	}
+	if !createdA.Equal(createdB) {
+		return createdA.Before(createdB)
+	}
+	return idA < idB
+}
+
+func stringSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
+
+// subsidiaryIDs は parentID を親に持つ会社の ID を返します。recursive の場合は階層全体を辿ります。
+func subsidiaryIDs(d *dataset, parentID string, recursive bool) map[string]bool {
+	found := make(map[string]bool)
+	parents := []string{parentID}
+	for len(parents) > 0 {
+		var next []string
+		for _, parent := range parents {
+			for id, c := range d.companies {
+				if c.ParentCompanyID == nil || *c.ParentCompanyID != parent || found[id] {
+					continue
+				}
+				found[id] = true
+				next = append(next, id)
+			}
+		}
+		if !recursive {
+			break
+		}
+		parents = next
+	}
+	return found
+}
+
+// validateParent は PostgreSQL の親会社外部キー・自己参照禁止 CHECK 制約と同じ検証を行います。
+func validateParent(d *dataset, parentID *string, id string) error {
+	if parentID == nil {
+		return nil
+	}
+	if *parentID == id {
+		return company.ErrHierarchyCycle
+	}
+	if _, ok := d.companies[*parentID]; !ok {
+		return company.ErrParentCompanyNotFound
+	}
+	return nil
+}
+
+// validateEmployeeCodePolicy は companies_employee_code_policy_check 制約を再現します。
+func validateEmployeeCodePolicy(policy company.EmployeeCodePolicy) error {
+	if policy.Width < 0 || policy.Width > 18 || policy.NextSequence < 1 {
+		return company.ErrInvalidEmployeeCodePolicy
+	}
+	return nil
+}
+
+func codeTaken(d *dataset, code, exceptID string) bool {
+	for id, c := range d.companies {
+		if id != exceptID && c.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
+func cloneCompany(c *company.Company) *company.Company {
+	if c == nil {
+		return nil
+	}
+	clone := *c
+	clone.Description = cloneString(c.Description)
+	clone.ParentCompanyID = cloneString(c.ParentCompanyID)
+	clone.Labels = label.Clone(c.Labels)
+	clone.Website = cloneString(c.Website)
+	clone.Addresses = nil
+	for _, a := range c.Addresses {
+		clone.Addresses = append(clone.Addresses, cloneAddress(a))
+	}
+	clone.Phones = nil
+	for _, p := range c.Phones {
+		clone.Phones = append(clone.Phones, clonePhone(p))
+	}
+	return &clone
+}
+
+func cloneAddress(a *company.Address) *company.Address {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	clone.Lines = append([]string(nil), a.Lines...)
+	return &clone
+}
+
+func clonePhone(p *company.Phone) *company.Phone {
+	if p == nil {
+		return nil
+	}
+	clone := *p
+	return &clone
+}
+
+func cloneEmployeeAttribute(a *company.EmployeeAttribute) *company.EmployeeAttribute {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	if a.AllowedValues != nil {
+		clone.AllowedValues = append([]string(nil), a.AllowedValues...)
+	}
+	return &clone
+}
+
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	v := *s
+	return &v
+}
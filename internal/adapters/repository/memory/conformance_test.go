@@ -0,0 +1,54 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/repositorytest"
+)
+
+func newConformanceRepositories(t *testing.T) repositorytest.Repositories {
+	t.Helper()
+	store := NewStore()
+	return repositorytest.Repositories{
+		Users:               NewUserRepository(store),
+		Companies:           NewCompanyRepository(store),
+		Employees:           NewEmployeeRepository(store),
+		Departments:         NewDepartmentRepository(store),
+		JobRuns:             NewJobRunRepository(store),
+		EmailChangeTokens:   NewEmailChangeTokenRepository(store),
+		Credentials:         NewCredentialRepository(store),
+		PasswordSetupTokens: NewPasswordSetupTokenRepository(store),
+	}
+}
+
+func TestUserRepositoryConformance(t *testing.T) {
+	repositorytest.RunUserRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestCompanyRepositoryConformance(t *testing.T) {
+	repositorytest.RunCompanyRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestEmployeeRepositoryConformance(t *testing.T) {
+	repositorytest.RunEmployeeRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestDepartmentRepositoryConformance(t *testing.T) {
+	repositorytest.RunDepartmentRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestJobRunRepositoryConformance(t *testing.T) {
+	repositorytest.RunJobRunRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestEmailChangeTokenRepositoryConformance(t *testing.T) {
+	repositorytest.RunEmailChangeTokenRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestCredentialRepositoryConformance(t *testing.T) {
+	repositorytest.RunCredentialRepositorySuite(t, newConformanceRepositories)
+}
+
+func TestPasswordSetupTokenRepositoryConformance(t *testing.T) {
+	repositorytest.RunPasswordSetupTokenRepositorySuite(t, newConformanceRepositories)
+}
@@ -0,0 +1,121 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+)
+
+// CredentialRepository はインメモリのパスワード認証情報の実装です。
+type CredentialRepository struct {
+	store *Store
+}
+
+// NewCredentialRepository は CredentialRepository を生成します。
+func NewCredentialRepository(store *Store) *CredentialRepository {
+	return &CredentialRepository{store: store}
+}
+
+// FindByUserID はユーザーの認証情報を取得します。
+func (r *CredentialRepository) FindByUserID(_ context.Context, userID string) (*auth.Credential, error) {
+	var found *auth.Credential
+	err := r.store.read(func(d *dataset) error {
+		credential, ok := d.credentials[userID]
+		if !ok {
+			return auth.ErrCredentialNotFound
+		}
+		found = cloneCredential(credential)
+		return nil
+	})
+	return found, err
+}
+
+// Save は認証情報を作成または更新します。失敗回数とロックは解除してリフレッシュトークンを失効させ、作成日時と最終ログイン日時は維持します。
+func (r *CredentialRepository) Save(_ context.Context, credential *auth.Credential) (*auth.Credential, error) {
+	var saved *auth.Credential
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.users[credential.UserID]; !ok {
+			return auth.ErrUserNotFound
+		}
+		clone := cloneCredential(credential)
+		clone.FailedAttempts = 0
+		clone.LockedUntil = nil
+		clone.LastLoginAt = nil
+		clone.RefreshTokenID = ""
+		if existing, ok := d.credentials[credential.UserID]; ok {
+			clone.CreatedAt = existing.CreatedAt
+			clone.LastLoginAt = existing.LastLoginAt
+		}
+		d.credentials[clone.UserID] = clone
+		saved = cloneCredential(clone)
+		return nil
+	})
+	return saved, err
+}
+
+// IncrementFailedAttempts は失敗回数を 1 増やし、増やした後の回数を返します。
+func (r *CredentialRepository) IncrementFailedAttempts(_ context.Context, userID string, at time.Time) (int, error) {
+	var attempts int
+	err := r.store.write(func(d *dataset) error {
+		credential, ok := d.credentials[userID]
+		if !ok {
+			return auth.ErrCredentialNotFound
+		}
+		credential.FailedAttempts++
+		credential.UpdatedAt = at
+		attempts = credential.FailedAttempts
+		return nil
+	})
+	return attempts, err
+}
+
+// Lock は指定日時までロックし、失敗回数を 0 に戻します。
+func (r *CredentialRepository) Lock(_ context.Context, userID string, until time.Time, at time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		credential, ok := d.credentials[userID]
+		if !ok {
+			return auth.ErrCredentialNotFound
+		}
+		credential.FailedAttempts = 0
+		credential.LockedUntil = &until
+		credential.UpdatedAt = at
+		return nil
+	})
+}
+
+// RecordLogin はログインの成功を記録し、失敗回数とロックを解除してリフレッシュトークンの ID を置き換えます。
+func (r *CredentialRepository) RecordLogin(_ context.Context, userID, refreshTokenID string, at time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		credential, ok := d.credentials[userID]
+		if !ok {
+			return auth.ErrCredentialNotFound
+		}
+		credential.FailedAttempts = 0
+		credential.LockedUntil = nil
+		credential.LastLoginAt = &at
+		credential.RefreshTokenID = refreshTokenID
+		credential.UpdatedAt = at
+		return nil
+	})
+}
+
+// RotateRefreshToken はリフレッシュトークンの ID が currentID と一致する場合のみ nextID に置き換えます。
+func (r *CredentialRepository) RotateRefreshToken(_ context.Context, userID, currentID, nextID string, at time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		credential, ok := d.credentials[userID]
+		if !ok || credential.RefreshTokenID == "" || credential.RefreshTokenID != currentID {
+			return auth.ErrInvalidToken
+		}
+		credential.RefreshTokenID = nextID
+		credential.UpdatedAt = at
+		return nil
+	})
+}
+
+func cloneCredential(credential *auth.Credential) *auth.Credential {
+	clone := *credential
+	clone.LockedUntil = cloneTime(credential.LockedUntil)
+	clone.LastLoginAt = cloneTime(credential.LastLoginAt)
+	return &clone
+}
@@ -0,0 +1,215 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+)
+
+// DepartmentRepository はインメモリの部署永続化実装です。
+type DepartmentRepository struct {
+	store *Store
+}
+
+// NewDepartmentRepository は DepartmentRepository を生成します。
+func NewDepartmentRepository(store *Store) *DepartmentRepository {
+	return &DepartmentRepository{store: store}
+}
+
+// Create は部署を新規作成します。
+func (r *DepartmentRepository) Create(_ context.Context, dep *department.Department) (*department.Department, error) {
+	var created *department.Department
+	err := r.store.write(func(d *dataset) error {
+		clone := cloneDepartment(dep)
+		clone.ID = uuid.NewString()
+		if err := validateDepartment(d, clone); err != nil {
+			return err
+		}
+		d.departments[clone.ID] = clone
+		created = cloneDepartment(clone)
+		return nil
+	})
+	return created, err
+}
+
+// Update は部署情報を更新します。所属会社は変更できません。
+func (r *DepartmentRepository) Update(_ context.Context, dep *department.Department) (*department.Department, error) {
+	var updated *department.Department
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.departments[dep.ID]
+		if !ok {
+			return department.ErrDepartmentNotFound
+		}
+		next := cloneDepartment(existing)
+		next.ParentDepartmentID = cloneString(dep.ParentDepartmentID)
+		next.Name = dep.Name
+		next.Code = dep.Code
+		next.UpdatedAt = dep.UpdatedAt
+		if err := validateDepartment(d, next); err != nil {
+			return err
+		}
+		d.departments[next.ID] = next
+		updated = cloneDepartment(next)
+		return nil
+	})
+	return updated, err
+}
+
+// Delete は部署を削除します。子部署や所属社員が存在する場合は削除しません。
+func (r *DepartmentRepository) Delete(_ context.Context, id string) error {
+	return r.store.write(func(d *dataset) error {
+		if _, ok := d.departments[id]; !ok {
+			return department.ErrDepartmentNotFound
+		}
+		if len(childDepartmentIDs(d, id, false)) > 0 {
+			return department.ErrDepartmentHasChildren
+		}
+		for _, e := range d.employees {
+			if e.DepartmentID != nil && *e.DepartmentID == id {
+				return department.ErrDepartmentHasEmployees
+			}
+		}
+		delete(d.departments, id)
+		return nil
+	})
+}
+
+// FindByID は ID で部署を取得します。
+func (r *DepartmentRepository) FindByID(_ context.Context, id string) (*department.Department, error) {
+	var found *department.Department
+	err := r.store.read(func(d *dataset) error {
+		dep, ok := d.departments[id]
+		if !ok {
+			return department.ErrDepartmentNotFound
+		}
+		found = cloneDepartment(dep)
+		return nil
+	})
+	return found, err
+}
+
+// FindByCompanyAndCode は会社 ID と部署コードで検索します。
+func (r *DepartmentRepository) FindByCompanyAndCode(_ context.Context, companyID, code string) (*department.Department, error) {
+	var found *department.Department
+	err := r.store.read(func(d *dataset) error {
+		for _, dep := range d.departments {
+			if dep.CompanyID == companyID && dep.Code == code {
+				found = cloneDepartment(dep)
+				return nil
+			}
+		}
+		return department.ErrDepartmentNotFound
+	})
+	return found, err
+}
+
+// List は部署の一覧を作成日時の降順で取得します。
+func (r *DepartmentRepository) List(_ context.Context, filter department.ListDepartmentsFilter) ([]*department.Department, string, error) {
+	if strings.TrimSpace(filter.CompanyID) == "" {
+		return nil, "", department.ErrInvalidCompanyID
+	}
+	if filter.Limit <= 0 {
+		return nil, "", department.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", department.ErrInvalidPageToken
+	}
+
+	var matched []*department.Department
+	_ = r.store.read(func(d *dataset) error {
+		for _, dep := range d.departments {
+			if dep.CompanyID != filter.CompanyID {
+				continue
+			}
+			if filter.ParentID != nil && (dep.ParentDepartmentID == nil || *dep.ParentDepartmentID != *filter.ParentID) {
+				continue
+			}
+			matched = append(matched, cloneDepartment(dep))
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(dep *department.Department) (time.Time, string) { return dep.CreatedAt, dep.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListAncestors は直近の親部署から最上位の部署までを順に返します。
+func (r *DepartmentRepository) ListAncestors(_ context.Context, id string) ([]*department.Department, error) {
+	var ancestors []*department.Department
+	_ = r.store.read(func(d *dataset) error {
+		visited := map[string]bool{id: true}
+		current, ok := d.departments[id]
+		for ok && current.ParentDepartmentID != nil && !visited[*current.ParentDepartmentID] {
+			visited[*current.ParentDepartmentID] = true
+			current, ok = d.departments[*current.ParentDepartmentID]
+			if ok {
+				ancestors = append(ancestors, cloneDepartment(current))
+			}
+		}
+		return nil
+	})
+	return ancestors, nil
+}
+
+// LockHierarchy は何もしません。書き込みトランザクションはストア全体で直列化されています。
+func (r *DepartmentRepository) LockHierarchy(context.Context) error {
+	return nil
+}
+
+// validateDepartment は PostgreSQL の外部キー・一意制約・CHECK 制約と同じ検証を行います。
+func validateDepartment(d *dataset, dep *department.Department) error {
+	if _, ok := d.companies[dep.CompanyID]; !ok {
+		return department.ErrCompanyNotFound
+	}
+	if dep.ParentDepartmentID != nil {
+		if *dep.ParentDepartmentID == dep.ID {
+			return department.ErrHierarchyCycle
+		}
+		parent, ok := d.departments[*dep.ParentDepartmentID]
+		if !ok || parent.CompanyID != dep.CompanyID {
+			return department.ErrParentDepartmentNotFound
+		}
+	}
+	for id, other := range d.departments {
+		if id != dep.ID && other.CompanyID == dep.CompanyID && other.Code == dep.Code {
+			return department.ErrCodeAlreadyExists
+		}
+	}
+	return nil
+}
+
+// childDepartmentIDs は parentID を親に持つ部署の ID を返します。recursive の場合は配下全体を辿ります。
+func childDepartmentIDs(d *dataset, parentID string, recursive bool) map[string]bool {
+	found := make(map[string]bool)
+	parents := []string{parentID}
+	for len(parents) > 0 {
+		var next []string
+		for _, parent := range parents {
+			for id, dep := range d.departments {
+				if dep.ParentDepartmentID == nil || *dep.ParentDepartmentID != parent || found[id] {
+					continue
+				}
+				found[id] = true
+				next = append(next, id)
+			}
+		}
+		if !recursive {
+			break
+		}
+		parents = next
+	}
+	return found
+}
+
+func cloneDepartment(dep *department.Department) *department.Department {
+	if dep == nil {
+		return nil
+	}
+	clone := *dep
+	clone.ParentDepartmentID = cloneString(dep.ParentDepartmentID)
+	return &clone
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// EmailChangeTokenRepository はインメモリのメールアドレス変更トークンの実装です。
+type EmailChangeTokenRepository struct {
+	store *Store
+}
+
+// NewEmailChangeTokenRepository は EmailChangeTokenRepository を生成します。
+func NewEmailChangeTokenRepository(store *Store) *EmailChangeTokenRepository {
+	return &EmailChangeTokenRepository{store: store}
+}
+
+// Create はトークンを保存します。
+func (r *EmailChangeTokenRepository) Create(_ context.Context, token *user.EmailChangeToken) (*user.EmailChangeToken, error) {
+	var created *user.EmailChangeToken
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.users[token.UserID]; !ok {
+			return user.ErrUserNotFound
+		}
+		clone := cloneEmailChangeToken(token)
+		clone.ID = uuid.NewString()
+		d.emailChangeTokens[clone.ID] = clone
+		created = cloneEmailChangeToken(clone)
+		return nil
+	})
+	return created, err
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *EmailChangeTokenRepository) FindByHash(_ context.Context, tokenHash string) (*user.EmailChangeToken, error) {
+	var found *user.EmailChangeToken
+	err := r.store.read(func(d *dataset) error {
+		for _, token := range d.emailChangeTokens {
+			if token.TokenHash == tokenHash {
+				found = cloneEmailChangeToken(token)
+				return nil
+			}
+		}
+		return user.ErrInvalidEmailChangeToken
+	})
+	return found, err
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *EmailChangeTokenRepository) MarkUsed(_ context.Context, id string, usedAt time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		token, ok := d.emailChangeTokens[id]
+		if !ok || token.UsedAt != nil {
+			return user.ErrInvalidEmailChangeToken
+		}
+		token.UsedAt = &usedAt
+		return nil
+	})
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *EmailChangeTokenRepository) DeleteUnusedByUser(_ context.Context, userID string) error {
+	return r.store.write(func(d *dataset) error {
+		for id, token := range d.emailChangeTokens {
+			if token.UserID == userID && token.UsedAt == nil {
+				delete(d.emailChangeTokens, id)
+			}
+		}
+		return nil
+	})
+}
+
+func cloneEmailChangeToken(token *user.EmailChangeToken) *user.EmailChangeToken {
+	clone := *token
+	clone.UsedAt = cloneTime(token.UsedAt)
+	return &clone
+}
@@ -0,0 +1,596 @@
+package memory
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+)
+
+// EmployeeRepository はインメモリの社員永続化実装です。
+type EmployeeRepository struct {
+	store *Store
+}
+
+// NewEmployeeRepository は EmployeeRepository を生成します。
+func NewEmployeeRepository(store *Store) *EmployeeRepository {
+	return &EmployeeRepository{store: store}
+}
+
+// Create は社員を新規作成します。
+func (r *EmployeeRepository) Create(_ context.Context, e *employee.Employee) (*employee.Employee, error) {
+	var created *employee.Employee
+	err := r.store.write(func(d *dataset) error {
+		clone := cloneEmployee(e)
+		clone.ID = uuid.NewString()
+		clone.User = nil
+		truncateEmploymentDates(clone)
+		if err := validateEmployee(d, clone); err != nil {
+			return err
+		}
+		d.employees[clone.ID] = clone
+		created = withUser(d, clone)
+		return nil
+	})
+	return created, err
+}
+
+// Update は社員情報を更新します。
+func (r *EmployeeRepository) Update(_ context.Context, e *employee.Employee) (*employee.Employee, error) {
+	var updated *employee.Employee
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.employees[e.ID]
+		if !ok {
+			return employee.ErrEmployeeNotFound
+		}
+		next := cloneEmployee(existing)
+		next.EmployeeCode = e.EmployeeCode
+		next.UserID = e.UserID
+		next.DepartmentID = cloneString(e.DepartmentID)
+		next.ManagerEmployeeID = cloneString(e.ManagerEmployeeID)
+		next.Status = e.Status
+		next.HiredAt = cloneTime(e.HiredAt)
+		next.TerminatedAt = cloneTime(e.TerminatedAt)
+		next.Attributes = cloneAttributes(e.Attributes)
+		next.UpdatedAt = e.UpdatedAt
+		truncateEmploymentDates(next)
+		if err := validateEmployee(d, next); err != nil {
+			return err
+		}
+		d.employees[next.ID] = next
+		updated = withUser(d, next)
+		return nil
+	})
+	return updated, err
+}
+
+// Delete は社員を削除します。
+func (r *EmployeeRepository) Delete(_ context.Context, id string) error {
+	return r.store.write(func(d *dataset) error {
+		if _, ok := d.employees[id]; !ok {
+			return employee.ErrEmployeeNotFound
+		}
+		for _, other := range d.employees {
+			if other.ManagerEmployeeID != nil && *other.ManagerEmployeeID == id {
+				return employee.ErrManagerHasReports
+			}
+		}
+		for otherID, other := range d.employees {
+			if other.TransferredFromEmployeeID != nil && *other.TransferredFromEmployeeID == id {
+				next := cloneEmployee(other)
+				next.TransferredFromEmployeeID = nil
+				d.employees[otherID] = next
+			}
+		}
+		delete(d.employees, id)
+		delete(d.employeeHistory, id)
+		return nil
+	})
+}
+
+// FindByID は ID で社員を取得します。
+func (r *EmployeeRepository) FindByID(_ context.Context, id string) (*employee.Employee, error) {
+	var found *employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		e, ok := d.employees[id]
+		if !ok {
+			return employee.ErrEmployeeNotFound
+		}
+		found = withUser(d, e)
+		return nil
+	})
+	return found, err
+}
+
+// FindByCompanyAndCode は会社 ID と社員コードで検索します。
+func (r *EmployeeRepository) FindByCompanyAndCode(_ context.Context, companyID, employeeCode string) (*employee.Employee, error) {
+	var found *employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		for _, e := range d.employees {
+			if e.CompanyID == companyID && e.EmployeeCode == employeeCode {
+				found = withUser(d, e)
+				return nil
+			}
+		}
+		return employee.ErrEmployeeNotFound
+	})
+	return found, err
+}
+
+// List は社員の一覧を作成日時の降順で取得します。
+func (r *EmployeeRepository) List(_ context.Context, filter employee.ListEmployeesFilter) ([]*employee.Employee, string, error) {
+	if strings.TrimSpace(filter.CompanyID) == "" {
+		return nil, "", employee.ErrInvalidCompanyID
+	}
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	var matched []*employee.Employee
+	_ = r.store.read(func(d *dataset) error {
+		companyIDs := map[string]bool{filter.CompanyID: true}
+		if filter.IncludeSubsidiaries {
+			for id := range subsidiaryIDs(d, filter.CompanyID, true) {
+				companyIDs[id] = true
+			}
+		}
+		var departmentIDs map[string]bool
+		if filter.DepartmentID != "" {
+			departmentIDs = map[string]bool{filter.DepartmentID: true}
+			if filter.IncludeSubDepartments {
+				for id := range childDepartmentIDs(d, filter.DepartmentID, true) {
+					departmentIDs[id] = true
+				}
+			}
+		}
+		for _, e := range d.employees {
+			if !companyIDs[e.CompanyID] {
+				continue
+			}
+			if departmentIDs != nil && (e.DepartmentID == nil || !departmentIDs[*e.DepartmentID]) {
+				continue
+			}
+			if filter.Status != nil && e.Status != *filter.Status {
+				continue
+			}
+			if !hasAttributes(e.Attributes, filter.Attributes) {
+				continue
+			}
+			matched = append(matched, withUser(d, e))
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(e *employee.Employee) (time.Time, string) { return e.CreatedAt, e.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListDirectReports は上長に直属する社員の一覧を作成日時の降順で取得します。
+func (r *EmployeeRepository) ListDirectReports(_ context.Context, filter employee.ListDirectReportsFilter) ([]*employee.Employee, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	var matched []*employee.Employee
+	_ = r.store.read(func(d *dataset) error {
+		for _, e := range d.employees {
+			if e.ManagerEmployeeID != nil && *e.ManagerEmployeeID == filter.ManagerID {
+				matched = append(matched, withUser(d, e))
+			}
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(e *employee.Employee) (time.Time, string) { return e.CreatedAt, e.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListEmploymentsByUser はユーザーの社員レコードを所属会社の概要とあわせて取得します。
+func (r *EmployeeRepository) ListEmploymentsByUser(_ context.Context, filter employee.ListEmploymentsFilter) ([]*employee.Employment, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	var matched []*employee.Employment
+	_ = r.store.read(func(d *dataset) error {
+		for _, e := range d.employees {
+			if e.UserID != filter.UserID {
+				continue
+			}
+			c, ok := d.companies[e.CompanyID]
+			if !ok {
+				continue
+			}
+			matched = append(matched, &employee.Employment{
+				Employee: withUser(d, e),
+				Company: &employee.CompanySnapshot{
+					ID:              c.ID,
+					Name:            c.Name,
+					Code:            c.Code,
+					Status:          string(c.Status),
+					ParentCompanyID: cloneString(c.ParentCompanyID),
+				},
+			})
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(m *employee.Employment) (time.Time, string) { return m.Employee.CreatedAt, m.Employee.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// ListReportingChain は社員の上長を直近から最上位まで順に取得します。
+func (r *EmployeeRepository) ListReportingChain(_ context.Context, id string) ([]*employee.Employee, error) {
+	var chain []*employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		current, ok := d.employees[id]
+		if !ok {
+			return nil
+		}
+		visited := map[string]bool{id: true}
+		for current.ManagerEmployeeID != nil && !visited[*current.ManagerEmployeeID] {
+			manager, ok := d.employees[*current.ManagerEmployeeID]
+			if !ok {
+				break
+			}
+			visited[manager.ID] = true
+			chain = append(chain, withUser(d, manager))
+			current = manager
+		}
+		return nil
+	})
+	return chain, err
+}
+
+// LockReportingLines は何もしません。書き込みトランザクションはストア全体で直列化されています。
+func (r *EmployeeRepository) LockReportingLines(context.Context) error {
+	return nil
+}
+
+// ListSubordinates は社員の配下を maxDepth 階層まで浅い順に取得します。
+// 同じ階層の社員は作成日時の降順に並びます。
+func (r *EmployeeRepository) ListSubordinates(_ context.Context, id string, maxDepth int) ([]*employee.Employee, error) {
+	var result []*employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		managers := map[string]bool{id: true}
+		for depth := 0; depth < maxDepth && len(managers) > 0; depth++ {
+			var level []*employee.Employee
+			next := make(map[string]bool)
+			for _, e := range d.employees {
+				if e.ManagerEmployeeID != nil && managers[*e.ManagerEmployeeID] {
+					level = append(level, withUser(d, e))
+					next[e.ID] = true
+				}
+			}
+			sortNewestFirst(level, func(e *employee.Employee) (time.Time, string) { return e.CreatedAt, e.ID })
+			result = append(result, level...)
+			managers = next
+		}
+		return nil
+	})
+	return result, err
+}
+
+// ReassignDirectReports は直属の部下の上長をまとめて付け替えます。
+func (r *EmployeeRepository) ReassignDirectReports(_ context.Context, managerID string, newManagerID *string, updatedAt time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		if newManagerID != nil {
+			manager, ok := d.employees[*newManagerID]
+			if !ok {
+				return employee.ErrManagerNotFound
+			}
+			for _, e := range d.employees {
+				if e.ManagerEmployeeID != nil && *e.ManagerEmployeeID == managerID && e.CompanyID != manager.CompanyID {
+					return employee.ErrManagerNotFound
+				}
+			}
+		}
+		for id, e := range d.employees {
+			if e.ManagerEmployeeID == nil || *e.ManagerEmployeeID != managerID {
+				continue
+			}
+			next := cloneEmployee(e)
+			next.ManagerEmployeeID = cloneString(newManagerID)
+			next.UpdatedAt = updatedAt
+			d.employees[id] = next
+		}
+		return nil
+	})
+}
+
+// ListDueStatusTransitions は入社日・退職日を迎えたのに状態が追随していない社員を取得します。
+func (r *EmployeeRepository) ListDueStatusTransitions(_ context.Context, asOf time.Time) ([]*employee.Employee, error) {
+	var due []*employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		matched := make([]*employee.Employee, 0)
+		for _, e := range d.employees {
+			hired := e.Status == employee.StatusPending && (e.HiredAt == nil || !e.HiredAt.After(asOf))
+			terminated := e.Status != employee.StatusTerminated && e.TerminatedAt != nil && !e.TerminatedAt.After(asOf)
+			if hired || terminated {
+				matched = append(matched, e)
+			}
+		}
+		sortSlice(matched, func(a, b *employee.Employee) bool {
+			if a.CompanyID != b.CompanyID {
+				return a.CompanyID < b.CompanyID
+			}
+			return a.EmployeeCode < b.EmployeeCode
+		})
+		due = make([]*employee.Employee, 0, len(matched))
+		for _, e := range matched {
+			due = append(due, withUser(d, e))
+		}
+		return nil
+	})
+	return due, err
+}
+
+// FindTerminatedByCompanyAndUser は会社とユーザーに紐づく退職済みの社員のうち最後に更新されたものを取得します。
+func (r *EmployeeRepository) FindTerminatedByCompanyAndUser(_ context.Context, companyID, userID string) (*employee.Employee, error) {
+	var found *employee.Employee
+	err := r.store.read(func(d *dataset) error {
+		var latest *employee.Employee
+		for _, e := range d.employees {
+			if e.CompanyID != companyID || e.UserID != userID {
+				continue
+			}
+			if e.Status != employee.StatusTerminated {
+				continue
+			}
+			if latest == nil || e.UpdatedAt.After(latest.UpdatedAt) || (e.UpdatedAt.Equal(latest.UpdatedAt) && e.ID > latest.ID) {
+				latest = e
+			}
+		}
+		if latest == nil {
+			return employee.ErrEmployeeNotFound
+		}
+		found = withUser(d, latest)
+		return nil
+	})
+	return found, err
+}
+
+// NextCodeSequence は会社の次の連番を払い出します。
+func (r *EmployeeRepository) NextCodeSequence(_ context.Context, companyID string) (*employee.CodeSequence, error) {
+	var seq *employee.CodeSequence
+	err := r.store.write(func(d *dataset) error {
+		c, ok := d.companies[companyID]
+		if !ok {
+			return employee.ErrCompanyNotFound
+		}
+		seq = &employee.CodeSequence{
+			Prefix:   c.EmployeeCodePolicy.Prefix,
+			Width:    c.EmployeeCodePolicy.Width,
+			Sequence: c.EmployeeCodePolicy.NextSequence,
+		}
+		c.EmployeeCodePolicy.NextSequence++
+		return nil
+	})
+	return seq, err
+}
+
+// RecordHistory は有効中の履歴を閉じ、新しい履歴を保存します。
+func (r *EmployeeRepository) RecordHistory(_ context.Context, entry *employee.HistoryEntry) (*employee.HistoryEntry, error) {
+	var recorded *employee.HistoryEntry
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.employees[entry.EmployeeID]; !ok {
+			return employee.ErrEmployeeNotFound
+		}
+		from := *truncateDate(&entry.EffectiveFrom)
+
+		entries := d.employeeHistory[entry.EmployeeID]
+		kept := make([]*employee.HistoryEntry, 0, len(entries)+1)
+		for _, existing := range entries {
+			if existing.EffectiveTo == nil {
+				if !existing.EffectiveFrom.Before(from) {
+					continue
+				}
+				closed := cloneHistoryEntry(existing)
+				closed.EffectiveTo = &from
+				existing = closed
+			}
+			kept = append(kept, existing)
+		}
+
+		clone := cloneHistoryEntry(entry)
+		clone.ID = uuid.NewString()
+		clone.EffectiveFrom = from
+		clone.EffectiveTo = nil
+		clone.HiredAt = truncateDate(clone.HiredAt)
+		clone.TerminatedAt = truncateDate(clone.TerminatedAt)
+		d.employeeHistory[entry.EmployeeID] = append(kept, clone)
+		recorded = cloneHistoryEntry(clone)
+		return nil
+	})
+	return recorded, err
+}
+
+// ListHistory は社員の履歴を有効開始日の降順で取得します。
+func (r *EmployeeRepository) ListHistory(_ context.Context, filter employee.ListHistoryFilter) ([]*employee.HistoryEntry, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	var entries []*employee.HistoryEntry
+	_ = r.store.read(func(d *dataset) error {
+		for _, entry := range d.employeeHistory[filter.EmployeeID] {
+			entries = append(entries, cloneHistoryEntry(entry))
+		}
+		return nil
+	})
+
+	sortNewestFirst(entries, func(e *employee.HistoryEntry) (time.Time, string) { return e.EffectiveFrom, e.ID })
+	page, next := paginate(entries, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+// FindHistoryAsOf は指定日に有効だった履歴を取得します。
+func (r *EmployeeRepository) FindHistoryAsOf(_ context.Context, employeeID string, date time.Time) (*employee.HistoryEntry, error) {
+	var found *employee.HistoryEntry
+	err := r.store.read(func(d *dataset) error {
+		day := *truncateDate(&date)
+		for _, entry := range d.employeeHistory[employeeID] {
+			if entry.EffectiveFrom.After(day) {
+				continue
+			}
+			if entry.EffectiveTo == nil || entry.EffectiveTo.After(day) {
+				found = cloneHistoryEntry(entry)
+				return nil
+			}
+		}
+		return employee.ErrHistoryNotFound
+	})
+	return found, err
+}
+
+// validateEmployee は PostgreSQL の外部キー・一意制約・CHECK 制約と同じ検証を行います。
+func validateEmployee(d *dataset, e *employee.Employee) error {
+	if _, ok := d.companies[e.CompanyID]; !ok {
+		return employee.ErrCompanyNotFound
+	}
+	if _, ok := d.users[e.UserID]; !ok {
+		return employee.ErrUserNotFound
+	}
+	if e.DepartmentID != nil {
+		dep, ok := d.departments[*e.DepartmentID]
+		if !ok || dep.CompanyID != e.CompanyID {
+			return employee.ErrDepartmentNotFound
+		}
+	}
+	if e.ManagerEmployeeID != nil {
+		if *e.ManagerEmployeeID == e.ID {
+			return employee.ErrReportingCycle
+		}
+		manager, ok := d.employees[*e.ManagerEmployeeID]
+		if !ok || manager.CompanyID != e.CompanyID {
+			return employee.ErrManagerNotFound
+		}
+	}
+	if e.TransferredFromEmployeeID != nil {
+		if _, ok := d.employees[*e.TransferredFromEmployeeID]; !ok {
+			return employee.ErrEmployeeNotFound
+		}
+	}
+	for id, other := range d.employees {
+		if id != e.ID && other.CompanyID == e.CompanyID && other.EmployeeCode == e.EmployeeCode {
+			return employee.ErrEmployeeCodeAlreadyExists
+		}
+	}
+	if e.HiredAt != nil && e.TerminatedAt != nil && e.TerminatedAt.Before(*e.HiredAt) {
+		return employee.ErrInvalidDateRange
+	}
+	return nil
+}
+
+// withUser は社員の複製にユーザー情報のスナップショットを付与します。
+func withUser(d *dataset, e *employee.Employee) *employee.Employee {
+	clone := cloneEmployee(e)
+	if u, ok := d.users[e.UserID]; ok {
+		var locale string
+		if u.Locale != nil {
+			locale = *u.Locale
+		}
+		clone.User = &employee.UserSnapshot{
+			ID:          u.ID,
+			Email:       u.Email,
+			Name:        u.Name,
+			DisplayName: u.DisplayName,
+			Locale:      locale,
+			Status:      string(u.Status),
+			CreatedAt:   u.CreatedAt,
+			UpdatedAt:   u.UpdatedAt,
+		}
+	}
+	return clone
+}
+
+// truncateEmploymentDates は DATE 型の列と同様に日付のみを保持します。
+func truncateEmploymentDates(e *employee.Employee) {
+	e.HiredAt = truncateDate(e.HiredAt)
+	e.TerminatedAt = truncateDate(e.TerminatedAt)
+}
+
+func truncateDate(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+func cloneEmployee(e *employee.Employee) *employee.Employee {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	clone.HiredAt = cloneTime(e.HiredAt)
+	clone.TerminatedAt = cloneTime(e.TerminatedAt)
+	clone.DepartmentID = cloneString(e.DepartmentID)
+	clone.ManagerEmployeeID = cloneString(e.ManagerEmployeeID)
+	clone.TransferredFromEmployeeID = cloneString(e.TransferredFromEmployeeID)
+	clone.Attributes = cloneAttributes(e.Attributes)
+	if e.User != nil {
+		u := *e.User
+		clone.User = &u
+	}
+	return &clone
+}
+
+func cloneAttributes(attributes map[string]string) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	clone := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		clone[key] = value
+	}
+	return clone
+}
+
+// hasAttributes は attributes が want のすべての値を含むかを判定します（JSONB の @> 相当）。
+func hasAttributes(attributes, want map[string]string) bool {
+	for key, value := range want {
+		if v, ok := attributes[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}
+
+func cloneHistoryEntry(e *employee.HistoryEntry) *employee.HistoryEntry {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	clone.DepartmentID = cloneString(e.DepartmentID)
+	clone.ManagerEmployeeID = cloneString(e.ManagerEmployeeID)
+	clone.HiredAt = cloneTime(e.HiredAt)
+	clone.TerminatedAt = cloneTime(e.TerminatedAt)
+	clone.EffectiveTo = cloneTime(e.EffectiveTo)
+	return &clone
+}
+
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	v := *t
+	return &v
+}
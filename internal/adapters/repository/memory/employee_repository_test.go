@@ -0,0 +1,124 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+type fixture struct {
+	users     *UserRepository
+	companies *CompanyRepository
+	employees *EmployeeRepository
+}
+
+func newFixture() fixture {
+	store := NewStore()
+	return fixture{
+		users:     NewUserRepository(store),
+		companies: NewCompanyRepository(store),
+		employees: NewEmployeeRepository(store),
+	}
+}
+
+func newCompany(code string) *company.Company {
+	now := time.Now().UTC()
+	return &company.Company{Name: "Company " + code, Code: code, Status: company.StatusActive, EmployeeCodePolicy: company.DefaultEmployeeCodePolicy, CreatedAt: now, UpdatedAt: now}
+}
+
+func newEmployee(companyID, userID, code string) *employee.Employee {
+	now := time.Now().UTC()
+	return &employee.Employee{
+		CompanyID:    companyID,
+		UserID:       userID,
+		EmployeeCode: code,
+		Status:       employee.StatusActive,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+}
+
+func TestEmployeeRepository_Constraints(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture()
+	ctx := context.Background()
+
+	u, err := f.users.Create(ctx, newUser("emp@example.com"))
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	c, err := f.companies.Create(ctx, newCompany("acme"))
+	if err != nil {
+		t.Fatalf("create company: %v", err)
+	}
+
+	created, err := f.employees.Create(ctx, newEmployee(c.ID, u.ID, "E001"))
+	if err != nil {
+		t.Fatalf("create employee: %v", err)
+	}
+	if created.User == nil || created.User.Email != "emp@example.com" {
+		t.Fatalf("expected user snapshot, got %+v", created.User)
+	}
+
+	cases := []struct {
+		name string
+		emp  *employee.Employee
+		want error
+	}{
+		{name: "duplicate code", emp: newEmployee(c.ID, u.ID, "E001"), want: employee.ErrEmployeeCodeAlreadyExists},
+		{name: "missing company", emp: newEmployee("missing", u.ID, "E002"), want: employee.ErrCompanyNotFound},
+		{name: "missing user", emp: newEmployee(c.ID, "missing", "E003"), want: employee.ErrUserNotFound},
+	}
+	for _, tc := range cases {
+		if _, err := f.employees.Create(ctx, tc.emp); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, err)
+		}
+	}
+
+	hired := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	terminated := hired.AddDate(0, 0, -1)
+	invalid := newEmployee(c.ID, u.ID, "E004")
+	invalid.HiredAt = &hired
+	invalid.TerminatedAt = &terminated
+	if _, err := f.employees.Create(ctx, invalid); !errors.Is(err, employee.ErrInvalidDateRange) {
+		t.Errorf("expected ErrInvalidDateRange, got %v", err)
+	}
+
+	if err := f.users.Delete(ctx, u.ID); !errors.Is(err, user.ErrUserHasEmployments) {
+		t.Fatalf("expected ErrUserHasEmployments, got %v", err)
+	}
+}
+
+func TestCompanyRepository_DeleteCascadesEmployees(t *testing.T) {
+	t.Parallel()
+
+	f := newFixture()
+	ctx := context.Background()
+
+	u, _ := f.users.Create(ctx, newUser("cascade@example.com"))
+	c, _ := f.companies.Create(ctx, newCompany("cascade"))
+	emp, err := f.employees.Create(ctx, newEmployee(c.ID, u.ID, "E001"))
+	if err != nil {
+		t.Fatalf("create employee: %v", err)
+	}
+
+	if _, err := f.companies.Create(ctx, newCompany("cascade")); !errors.Is(err, company.ErrCodeAlreadyExists) {
+		t.Fatalf("expected ErrCodeAlreadyExists, got %v", err)
+	}
+
+	if err := f.companies.Delete(ctx, c.ID); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	if _, err := f.employees.FindByID(ctx, emp.ID); !errors.Is(err, employee.ErrEmployeeNotFound) {
+		t.Fatalf("expected employee to be cascaded, got %v", err)
+	}
+	if err := f.users.Delete(ctx, u.ID); err != nil {
+		t.Fatalf("expected user delete to succeed after cascade, got %v", err)
+	}
+}
@@ -0,0 +1,85 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// JobRunRepository はインメモリのジョブ実行記録の実装です。
+type JobRunRepository struct {
+	store *Store
+}
+
+// NewJobRunRepository は JobRunRepository を生成します。
+func NewJobRunRepository(store *Store) *JobRunRepository {
+	return &JobRunRepository{store: store}
+}
+
+// CreateRun はジョブの実行開始を記録します。
+func (r *JobRunRepository) CreateRun(_ context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	var created *scheduler.Run
+	err := r.store.write(func(d *dataset) error {
+		for _, existing := range d.jobRuns {
+			if existing.JobName == run.JobName && existing.ScheduledAt.Equal(run.ScheduledAt) {
+				return scheduler.ErrRunAlreadyExists
+			}
+		}
+		clone := cloneJobRun(run)
+		clone.ID = uuid.NewString()
+		d.jobRuns[clone.ID] = clone
+		created = cloneJobRun(clone)
+		return nil
+	})
+	return created, err
+}
+
+// FinishRun はジョブの実行結果を記録します。
+func (r *JobRunRepository) FinishRun(_ context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	var updated *scheduler.Run
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.jobRuns[run.ID]
+		if !ok {
+			return scheduler.ErrRunNotFound
+		}
+		next := cloneJobRun(existing)
+		next.Status = run.Status
+		next.FinishedAt = cloneTime(run.FinishedAt)
+		next.Error = cloneString(run.Error)
+		d.jobRuns[next.ID] = next
+		updated = cloneJobRun(next)
+		return nil
+	})
+	return updated, err
+}
+
+// ListRuns は jobName の実行記録を予定時刻の降順で返します。
+func (r *JobRunRepository) ListRuns(_ context.Context, jobName string, limit int) ([]*scheduler.Run, error) {
+	var runs []*scheduler.Run
+	err := r.store.read(func(d *dataset) error {
+		matched := make([]*scheduler.Run, 0)
+		for _, run := range d.jobRuns {
+			if run.JobName == jobName {
+				matched = append(matched, run)
+			}
+		}
+		sortSlice(matched, func(a, b *scheduler.Run) bool { return a.ScheduledAt.After(b.ScheduledAt) })
+		if len(matched) > limit {
+			matched = matched[:limit]
+		}
+		runs = make([]*scheduler.Run, 0, len(matched))
+		for _, run := range matched {
+			runs = append(runs, cloneJobRun(run))
+		}
+		return nil
+	})
+	return runs, err
+}
+
+func cloneJobRun(run *scheduler.Run) *scheduler.Run {
+	clone := *run
+	clone.FinishedAt = cloneTime(run.FinishedAt)
+	clone.Error = cloneString(run.Error)
+	return &clone
+}
@@ -0,0 +1,80 @@
+package memory
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+)
+
+// PasswordSetupTokenRepository はインメモリのパスワード設定トークンの実装です。
+type PasswordSetupTokenRepository struct {
+	store *Store
+}
+
+// NewPasswordSetupTokenRepository は PasswordSetupTokenRepository を生成します。
+func NewPasswordSetupTokenRepository(store *Store) *PasswordSetupTokenRepository {
+	return &PasswordSetupTokenRepository{store: store}
+}
+
+// Create はトークンを保存します。
+func (r *PasswordSetupTokenRepository) Create(_ context.Context, token *auth.PasswordSetupToken) (*auth.PasswordSetupToken, error) {
+	var created *auth.PasswordSetupToken
+	err := r.store.write(func(d *dataset) error {
+		if _, ok := d.users[token.UserID]; !ok {
+			return auth.ErrUserNotFound
+		}
+		clone := clonePasswordSetupToken(token)
+		clone.ID = uuid.NewString()
+		d.passwordSetupTokens[clone.ID] = clone
+		created = clonePasswordSetupToken(clone)
+		return nil
+	})
+	return created, err
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *PasswordSetupTokenRepository) FindByHash(_ context.Context, tokenHash string) (*auth.PasswordSetupToken, error) {
+	var found *auth.PasswordSetupToken
+	err := r.store.read(func(d *dataset) error {
+		for _, token := range d.passwordSetupTokens {
+			if token.TokenHash == tokenHash {
+				found = clonePasswordSetupToken(token)
+				return nil
+			}
+		}
+		return auth.ErrInvalidSetupToken
+	})
+	return found, err
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *PasswordSetupTokenRepository) MarkUsed(_ context.Context, id string, usedAt time.Time) error {
+	return r.store.write(func(d *dataset) error {
+		token, ok := d.passwordSetupTokens[id]
+		if !ok || token.UsedAt != nil {
+			return auth.ErrInvalidSetupToken
+		}
+		token.UsedAt = &usedAt
+		return nil
+	})
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *PasswordSetupTokenRepository) DeleteUnusedByUser(_ context.Context, userID string) error {
+	return r.store.write(func(d *dataset) error {
+		for id, token := range d.passwordSetupTokens {
+			if token.UserID == userID && token.UsedAt == nil {
+				delete(d.passwordSetupTokens, id)
+			}
+		}
+		return nil
+	})
+}
+
+func clonePasswordSetupToken(token *auth.PasswordSetupToken) *auth.PasswordSetupToken {
+	clone := *token
+	clone.UsedAt = cloneTime(token.UsedAt)
+	return &clone
+}
@@ -0,0 +1,221 @@
+// Package memory はプロセス内メモリで動作するリポジトリ実装を提供します。
+// PostgreSQL 実装と同じ一意制約・外部キー制約・ドメインエラーを再現し、
+// ローカル実行やテストでデータベースなしにサーバーを動かすために利用します。
+package memory
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// Store は全リポジトリで共有するインメモリのデータストアです。
+type Store struct {
+	mu   sync.RWMutex
+	txMu sync.RWMutex
+	data *dataset
+}
+
+type dataset struct {
+	users       map[string]*user.User
+	companies   map[string]*company.Company
+	departments map[string]*department.Department
+	employees   map[string]*employee.Employee
+	// employeeHistory は社員 ID ごとの履歴を保存順に保持します。
+	employeeHistory map[string][]*employee.HistoryEntry
+	// employeeAttributes は会社ごとの社員属性の定義を保持します。
+	employeeAttributes map[attributeKey]*company.EmployeeAttribute
+	// companyAddresses と companyPhones は ID ごとに会社の連絡先を保持します。
+	companyAddresses map[string]*company.Address
+	companyPhones    map[string]*company.Phone
+	jobRuns          map[string]*scheduler.Run
+	// emailChangeTokens は ID ごとにメールアドレス変更トークンを保持します。
+	emailChangeTokens map[string]*user.EmailChangeToken
+	// credentials はユーザー ID ごとにパスワード認証情報を保持します。
+	credentials map[string]*auth.Credential
+	// passwordSetupTokens は ID ごとにパスワード設定トークンを保持します。
+	passwordSetupTokens map[string]*auth.PasswordSetupToken
+}
+
+// attributeKey は社員属性の定義を会社 ID とキーの組で識別します。
+type attributeKey struct {
+	companyID string
+	key       string
+}
+
+// NewStore は空の Store を生成します。
+func NewStore() *Store {
+	return &Store{data: newDataset()}
+}
+
+func newDataset() *dataset {
+	return &dataset{
+		users:       make(map[string]*user.User),
+		companies:   make(map[string]*company.Company),
+		departments: make(map[string]*department.Department),
+		employees:   make(map[string]*employee.Employee),
+
+		employeeHistory:     make(map[string][]*employee.HistoryEntry),
+		employeeAttributes:  make(map[attributeKey]*company.EmployeeAttribute),
+		companyAddresses:    make(map[string]*company.Address),
+		companyPhones:       make(map[string]*company.Phone),
+		jobRuns:             make(map[string]*scheduler.Run),
+		emailChangeTokens:   make(map[string]*user.EmailChangeToken),
+		credentials:         make(map[string]*auth.Credential),
+		passwordSetupTokens: make(map[string]*auth.PasswordSetupToken),
+	}
+}
+
+func (d *dataset) clone() *dataset {
+	c := newDataset()
+	for id, u := range d.users {
+		c.users[id] = cloneUser(u)
+	}
+	for id, co := range d.companies {
+		c.companies[id] = cloneCompany(co)
+	}
+	for id, dep := range d.departments {
+		c.departments[id] = cloneDepartment(dep)
+	}
+	for id, e := range d.employees {
+		c.employees[id] = cloneEmployee(e)
+	}
+	for id, entries := range d.employeeHistory {
+		cloned := make([]*employee.HistoryEntry, 0, len(entries))
+		for _, entry := range entries {
+			cloned = append(cloned, cloneHistoryEntry(entry))
+		}
+		c.employeeHistory[id] = cloned
+	}
+	for k, a := range d.employeeAttributes {
+		c.employeeAttributes[k] = cloneEmployeeAttribute(a)
+	}
+	for id, a := range d.companyAddresses {
+		c.companyAddresses[id] = cloneAddress(a)
+	}
+	for id, p := range d.companyPhones {
+		c.companyPhones[id] = clonePhone(p)
+	}
+	for id, run := range d.jobRuns {
+		c.jobRuns[id] = cloneJobRun(run)
+	}
+	for id, token := range d.emailChangeTokens {
+		c.emailChangeTokens[id] = cloneEmailChangeToken(token)
+	}
+	for userID, credential := range d.credentials {
+		c.credentials[userID] = cloneCredential(credential)
+	}
+	for id, token := range d.passwordSetupTokens {
+		c.passwordSetupTokens[id] = clonePasswordSetupToken(token)
+	}
+	return c
+}
+
+func (s *Store) snapshot() *dataset {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.data.clone()
+}
+
+func (s *Store) restore(snapshot *dataset) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.data = snapshot
+}
+
+func (s *Store) read(fn func(*dataset) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(s.data)
+}
+
+func (s *Store) write(fn func(*dataset) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fn(s.data)
+}
+
+type transactionContextKey struct{}
+
+// transactionMode はコンテキストで実行中のトランザクションの種類です。
+type transactionMode int
+
+const (
+	noTransaction transactionMode = iota
+	readOnlyTransaction
+	readWriteTransaction
+)
+
+// ErrReadOnlyTransaction は読み取り専用トランザクションの中で読み書きトランザクションを開始しようとした場合に返却されます。
+// PostgreSQL では読み取り専用トランザクション内の書き込みが失敗するため、同様に拒否します。
+var ErrReadOnlyTransaction = errors.New("memory: read-write transaction inside a read-only transaction")
+
+// TransactionManager はスナップショットとロールバックによるトランザクションを提供します。
+// 読み書きトランザクションは直列に実行され、fn がエラーを返した場合は開始時点の状態へ戻します。
+type TransactionManager struct {
+	store *Store
+}
+
+// NewTransactionManager は TransactionManager を生成します。
+func NewTransactionManager(store *Store) *TransactionManager {
+	return &TransactionManager{store: store}
+}
+
+// WithinReadOnly は読み取り専用トランザクションとして fn を実行します。
+func (m *TransactionManager) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	if transactionModeFrom(ctx) != noTransaction {
+		return fn(ctx)
+	}
+
+	m.store.txMu.RLock()
+	defer m.store.txMu.RUnlock()
+
+	return fn(context.WithValue(ctx, transactionContextKey{}, readOnlyTransaction))
+}
+
+// WithinReadWrite は読み書きトランザクションとして fn を実行します。
+// 読み取り専用トランザクションの中では、スナップショットを取れず書き込みを戻せないため ErrReadOnlyTransaction を返します。
+func (m *TransactionManager) WithinReadWrite(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	switch transactionModeFrom(ctx) {
+	case readWriteTransaction:
+		return fn(ctx)
+	case readOnlyTransaction:
+		return ErrReadOnlyTransaction
+	}
+
+	m.store.txMu.Lock()
+	defer m.store.txMu.Unlock()
+
+	snapshot := m.store.snapshot()
+	if err := fn(context.WithValue(ctx, transactionContextKey{}, readWriteTransaction)); err != nil {
+		m.store.restore(snapshot)
+		return err
+	}
+	return nil
+}
+
+func transactionModeFrom(ctx context.Context) transactionMode {
+	if ctx == nil {
+		return noTransaction
+	}
+	mode, _ := ctx.Value(transactionContextKey{}).(transactionMode)
+	return mode
+}
+
+func sortSlice[T any](items []T, less func(a, b T) bool) {
+	sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
+}
@@ -0,0 +1,98 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+func TestTransactionManager_RollbackOnError(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore()
+	tm := NewTransactionManager(store)
+	repo := NewUserRepository(store)
+	ctx := context.Background()
+
+	errBoom := errors.New("boom")
+	err := tm.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if _, err := repo.Create(txCtx, newUser("rollback@example.com")); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+
+	if _, err := repo.FindByEmail(ctx, "rollback@example.com"); !errors.Is(err, user.ErrUserNotFound) {
+		t.Fatalf("expected rolled back user to be absent, got %v", err)
+	}
+}
+
+func TestTransactionManager_CommitAndNested(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore()
+	tm := NewTransactionManager(store)
+	repo := NewUserRepository(store)
+	ctx := context.Background()
+
+	err := tm.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if _, err := repo.Create(txCtx, newUser("commit@example.com")); err != nil {
+			return err
+		}
+		return tm.WithinReadOnly(txCtx, func(inner context.Context) error {
+			_, err := repo.FindByEmail(inner, "commit@example.com")
+			return err
+		})
+	})
+	if err != nil {
+		t.Fatalf("WithinReadWrite returned error: %v", err)
+	}
+
+	if _, err := repo.FindByEmail(ctx, "commit@example.com"); err != nil {
+		t.Fatalf("expected committed user, got %v", err)
+	}
+}
+
+func TestTransactionManager_ReadWriteInsideReadOnly(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore()
+	tm := NewTransactionManager(store)
+	repo := NewUserRepository(store)
+	ctx := context.Background()
+
+	called := false
+	err := tm.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		return tm.WithinReadWrite(txCtx, func(inner context.Context) error {
+			called = true
+			_, err := repo.Create(inner, newUser("nested@example.com"))
+			return err
+		})
+	})
+	if !errors.Is(err, ErrReadOnlyTransaction) {
+		t.Fatalf("expected ErrReadOnlyTransaction, got %v", err)
+	}
+	if called {
+		t.Fatal("expected the read-write function not to run inside a read-only transaction")
+	}
+	if _, err := repo.FindByEmail(ctx, "nested@example.com"); !errors.Is(err, user.ErrUserNotFound) {
+		t.Fatalf("expected no user to be written, got %v", err)
+	}
+}
+
+func newUser(email string) *user.User {
+	now := time.Now().UTC()
+	return &user.User{
+		Email:     email,
+		Name:      "Test",
+		Status:    user.StatusActive,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
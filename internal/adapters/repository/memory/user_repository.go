@@ -0,0 +1,201 @@
+package memory
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// UserRepository はインメモリのユーザー永続化実装です。
+type UserRepository struct {
+	store *Store
+}
+
+// NewUserRepository は UserRepository を生成します。
+func NewUserRepository(store *Store) *UserRepository {
+	return &UserRepository{store: store}
+}
+
+// Create はユーザーを新規作成します。
+func (r *UserRepository) Create(_ context.Context, u *user.User) (*user.User, error) {
+	var created *user.User
+	err := r.store.write(func(d *dataset) error {
+		for _, existing := range d.users {
+			if existing.Email == u.Email {
+				return user.ErrEmailAlreadyExists
+			}
+		}
+		clone := cloneUser(u)
+		clone.ID = uuid.NewString()
+		d.users[clone.ID] = clone
+		created = cloneUser(clone)
+		return nil
+	})
+	return created, err
+}
+
+// Update はユーザー情報を更新します。
+func (r *UserRepository) Update(_ context.Context, u *user.User) (*user.User, error) {
+	var updated *user.User
+	err := r.store.write(func(d *dataset) error {
+		existing, ok := d.users[u.ID]
+		if !ok {
+			return user.ErrUserNotFound
+		}
+		for id, other := range d.users {
+			if id != u.ID && other.Email == u.Email {
+				return user.ErrEmailAlreadyExists
+			}
+		}
+		existing.Email = u.Email
+		existing.EmailVerifiedAt = cloneTime(u.EmailVerifiedAt)
+		existing.Name = u.Name
+		existing.FamilyName = cloneString(u.FamilyName)
+		existing.GivenName = cloneString(u.GivenName)
+		existing.DisplayName = u.DisplayName
+		existing.Locale = cloneString(u.Locale)
+		existing.TimeZone = cloneString(u.TimeZone)
+		existing.Phone = cloneString(u.Phone)
+		existing.AvatarURL = cloneString(u.AvatarURL)
+		existing.Status = u.Status
+		existing.Labels = label.Clone(u.Labels)
+		existing.UpdatedAt = u.UpdatedAt
+		updated = cloneUser(existing)
+		return nil
+	})
+	return updated, err
+}
+
+// Delete はユーザーを削除します。社員から参照されている場合は削除しません。
+func (r *UserRepository) Delete(_ context.Context, id string) error {
+	return r.store.write(func(d *dataset) error {
+		if _, ok := d.users[id]; !ok {
+			return user.ErrUserNotFound
+		}
+		for _, emp := range d.employees {
+			if emp.UserID == id {
+				return user.ErrUserHasEmployments
+			}
+		}
+		delete(d.users, id)
+		// email_change_tokens.user_id の ON DELETE CASCADE を再現します。
+		for tokenID, token := range d.emailChangeTokens {
+			if token.UserID == id {
+				delete(d.emailChangeTokens, tokenID)
+			}
+		}
+		// user_credentials.user_id の ON DELETE CASCADE を再現します。
+		delete(d.credentials, id)
+		// password_setup_tokens.user_id の ON DELETE CASCADE を再現します。
+		for tokenID, token := range d.passwordSetupTokens {
+			if token.UserID == id {
+				delete(d.passwordSetupTokens, tokenID)
+			}
+		}
+		return nil
+	})
+}
+
+// FindByID はIDでユーザーを取得します。
+func (r *UserRepository) FindByID(_ context.Context, id string) (*user.User, error) {
+	var found *user.User
+	err := r.store.read(func(d *dataset) error {
+		u, ok := d.users[id]
+		if !ok {
+			return user.ErrUserNotFound
+		}
+		found = cloneUser(u)
+		return nil
+	})
+	return found, err
+}
+
+// FindByEmail はメールアドレスでユーザーを取得します。
+func (r *UserRepository) FindByEmail(_ context.Context, email string) (*user.User, error) {
+	var found *user.User
+	err := r.store.read(func(d *dataset) error {
+		for _, u := range d.users {
+			if u.Email == email {
+				found = cloneUser(u)
+				return nil
+			}
+		}
+		return user.ErrUserNotFound
+	})
+	return found, err
+}
+
+// List はユーザーの一覧を作成日時の降順で取得します。
+func (r *UserRepository) List(_ context.Context, filter user.ListUsersFilter) ([]*user.User, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", user.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", user.ErrInvalidPageToken
+	}
+
+	var matched []*user.User
+	_ = r.store.read(func(d *dataset) error {
+		for _, u := range d.users {
+			if filter.Status != nil && u.Status != *filter.Status {
+				continue
+			}
+			if !filter.LabelSelector.Matches(u.Labels) {
+				continue
+			}
+			matched = append(matched, cloneUser(u))
+		}
+		return nil
+	})
+
+	sortNewestFirst(matched, func(u *user.User) (time.Time, string) { return u.CreatedAt, u.ID })
+	page, next := paginate(matched, filter.Limit, filter.Offset)
+	return page, next, nil
+}
+
+func cloneUser(u *user.User) *user.User {
+	if u == nil {
+		return nil
+	}
+	clone := *u
+	clone.EmailVerifiedAt = cloneTime(u.EmailVerifiedAt)
+	clone.FamilyName = cloneString(u.FamilyName)
+	clone.GivenName = cloneString(u.GivenName)
+	clone.Locale = cloneString(u.Locale)
+	clone.TimeZone = cloneString(u.TimeZone)
+	clone.Phone = cloneString(u.Phone)
+	clone.AvatarURL = cloneString(u.AvatarURL)
+	clone.Labels = label.Clone(u.Labels)
+	return &clone
+}
+
+// sortNewestFirst は PostgreSQL 実装の ORDER BY created_at DESC, id DESC と同じ順序に並べ替えます。
+func sortNewestFirst[T any](items []T, key func(T) (time.Time, string)) {
+	sortSlice(items, func(a, b T) bool {
+		ac, aid := key(a)
+		bc, bid := key(b)
+		if !ac.Equal(bc) {
+			return ac.After(bc)
+		}
+		return aid > bid
+	})
+}
+
+// paginate は offset/limit でページを切り出し、続きがある場合は次ページトークンを返します。
+func paginate[T any](items []T, limit, offset int) ([]T, string) {
+	if offset >= len(items) {
+		return []T{}, ""
+	}
+	end := offset + limit
+	next := ""
+	if end < len(items) {
+		next = strconv.Itoa(end)
+	} else {
+		end = len(items)
+	}
+	return items[offset:end], next
+}
@@ -0,0 +1,102 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+func TestUserRepository_CreateDuplicateEmail(t *testing.T) {
+	t.Parallel()
+
+	repo := NewUserRepository(NewStore())
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, newUser("dup@example.com"))
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if created.ID == "" {
+		t.Fatal("expected generated id")
+	}
+
+	if _, err := repo.Create(ctx, newUser("dup@example.com")); !errors.Is(err, user.ErrEmailAlreadyExists) {
+		t.Fatalf("expected ErrEmailAlreadyExists, got %v", err)
+	}
+}
+
+func TestUserRepository_ReturnsCopies(t *testing.T) {
+	t.Parallel()
+
+	repo := NewUserRepository(NewStore())
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, newUser("copy@example.com"))
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	created.Name = "mutated"
+
+	found, err := repo.FindByID(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("FindByID returned error: %v", err)
+	}
+	if found.Name != "Test" {
+		t.Fatalf("expected stored user to be unaffected, got %q", found.Name)
+	}
+}
+
+func TestUserRepository_ListPagination(t *testing.T) {
+	t.Parallel()
+
+	repo := NewUserRepository(NewStore())
+	ctx := context.Background()
+
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	emails := []string{"a@example.com", "b@example.com", "c@example.com"}
+	for i, email := range emails {
+		u := newUser(email)
+		u.CreatedAt = base.Add(time.Duration(i) * time.Hour)
+		if i == 1 {
+			u.Status = user.StatusInactive
+		}
+		if _, err := repo.Create(ctx, u); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+	}
+
+	page, next, err := repo.List(ctx, user.ListUsersFilter{Limit: 2})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(page) != 2 || page[0].Email != "c@example.com" || page[1].Email != "b@example.com" {
+		t.Fatalf("unexpected first page: %+v", page)
+	}
+	if next != "2" {
+		t.Fatalf("expected next token 2, got %q", next)
+	}
+
+	page, next, err = repo.List(ctx, user.ListUsersFilter{Limit: 2, Offset: 2})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(page) != 1 || page[0].Email != "a@example.com" || next != "" {
+		t.Fatalf("unexpected second page: %+v next=%q", page, next)
+	}
+
+	active := user.StatusActive
+	page, _, err = repo.List(ctx, user.ListUsersFilter{Limit: 10, Status: &active})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(page) != 2 {
+		t.Fatalf("expected 2 active users, got %d", len(page))
+	}
+
+	if _, _, err := repo.List(ctx, user.ListUsersFilter{}); !errors.Is(err, user.ErrInvalidPageSize) {
+		t.Fatalf("expected ErrInvalidPageSize, got %v", err)
+	}
+}
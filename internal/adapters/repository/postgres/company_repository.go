@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"strconv"
 	"strings"
@@ -14,7 +15,18 @@ import (
 	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
 )
 
-const companyUniqueViolationCode = "23505"
+const (
+	companyUniqueViolationCode     = "23505"
+	companyForeignKeyViolationCode = "23503"
+	companyCheckViolationCode      = "23514"
+
+	companyParentForeignKey        = "companies_parent_company_id_fkey"
+	companyEmployeeCodePolicyCheck = "companies_employee_code_policy_check"
+	companyAddressCountryCheck     = "company_addresses_country_check"
+
+	// companyHierarchyLockKey は親会社の付け替えを直列化するアドバイザリロックキーです。
+	companyHierarchyLockKey int64 = 0x636f6d70616e79 // "company"
+)
 
 // CompanyRepository は PostgreSQL を利用した会社永続化の実装です。
 type CompanyRepository struct {
@@ -28,12 +40,18 @@ func NewCompanyRepository(pool pgdb.Queryer) *CompanyRepository {
 
 // Create は会社を新規作成します。
 func (r *CompanyRepository) Create(ctx context.Context, c *company.Company) (*company.Company, error) {
+	labels, err := marshalStringMap(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        INSERT INTO companies (name, code, status, description, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5, $6)
-        RETURNING id, name, code, status, description, created_at, updated_at
-    `, c.Name, c.Code, c.Status, nullableString(c.Description), c.CreatedAt, c.UpdatedAt)
+        INSERT INTO companies (name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9::jsonb, $10, $11, $12)
+        RETURNING id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+    `, c.Name, c.Code, c.Status, nullableString(c.Description), nullableString(c.ParentCompanyID),
+		c.EmployeeCodePolicy.Prefix, c.EmployeeCodePolicy.Width, c.EmployeeCodePolicy.NextSequence, labels, nullableString(c.Website), c.CreatedAt, c.UpdatedAt)
 
 	created, err := scanCompany(row)
 	if err != nil {
@@ -42,8 +60,13 @@ func (r *CompanyRepository) Create(ctx context.Context, c *company.Company) (*co
 	return created, nil
 }
 
-// Update は会社情報を更新します。
+// Update は会社情報を更新します。employee_code_next_sequence は NextCodeSequence と競合しないよう更新しません。
 func (r *CompanyRepository) Update(ctx context.Context, c *company.Company) (*company.Company, error) {
+	labels, err := marshalStringMap(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
         UPDATE companies
@@ -51,10 +74,16 @@ func (r *CompanyRepository) Update(ctx context.Context, c *company.Company) (*co
                code = $2,
                status = $3,
                description = $4,
-               updated_at = $5
-         WHERE id = $6
-        RETURNING id, name, code, status, description, created_at, updated_at
-    `, c.Name, c.Code, c.Status, nullableString(c.Description), c.UpdatedAt, c.ID)
+               parent_company_id = $5,
+               employee_code_prefix = $6,
+               employee_code_width = $7,
+               labels = $8::jsonb,
+               website = $9,
+               updated_at = $10
+         WHERE id = $11
+        RETURNING id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+    `, c.Name, c.Code, c.Status, nullableString(c.Description), nullableString(c.ParentCompanyID),
+		c.EmployeeCodePolicy.Prefix, c.EmployeeCodePolicy.Width, labels, nullableString(c.Website), c.UpdatedAt, c.ID)
 
 	updated, err := scanCompany(row)
 	if err != nil {
@@ -63,11 +92,28 @@ func (r *CompanyRepository) Update(ctx context.Context, c *company.Company) (*co
 	return updated, nil
 }
 
+// SetEmployeeCodeNextSequence は社員コードの次の連番を設定します。
+func (r *CompanyRepository) SetEmployeeCodeNextSequence(ctx context.Context, id string, next int64) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `UPDATE companies SET employee_code_next_sequence = $1 WHERE id = $2`, next, id)
+	if err != nil {
+		return translateCompanyPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return company.ErrCompanyNotFound
+	}
+	return nil
+}
+
 // Delete は会社を削除します。
 func (r *CompanyRepository) Delete(ctx context.Context, id string) error {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	tag, err := exec.Exec(ctx, `DELETE FROM companies WHERE id = $1`, id)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == companyForeignKeyViolationCode && pgErr.ConstraintName == companyParentForeignKey {
+			return company.ErrCompanyHasSubsidiaries
+		}
 		return translateCompanyPgError(err)
 	}
 	if tag.RowsAffected() == 0 {
@@ -80,7 +126,7 @@ func (r *CompanyRepository) Delete(ctx context.Context, id string) error {
 func (r *CompanyRepository) FindByID(ctx context.Context, id string) (*company.Company, error) {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        SELECT id, name, code, status, description, created_at, updated_at
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
           FROM companies
          WHERE id = $1
          LIMIT 1
@@ -97,7 +143,7 @@ func (r *CompanyRepository) FindByID(ctx context.Context, id string) (*company.C
 func (r *CompanyRepository) FindByCode(ctx context.Context, code string) (*company.Company, error) {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        SELECT id, name, code, status, description, created_at, updated_at
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
           FROM companies
          WHERE code = $1
          LIMIT 1
@@ -130,6 +176,12 @@ func (r *CompanyRepository) List(ctx context.Context, filter company.ListCompani
 		args = append(args, *filter.Status)
 	}
 
+	labelConditions, args, err := labelSelectorConditions("labels", filter.LabelSelector, args)
+	if err != nil {
+		return nil, "", err
+	}
+	conditions = append(conditions, labelConditions...)
+
 	whereClause := ""
 	if len(conditions) > 0 {
 		whereClause = " WHERE " + strings.Join(conditions, " AND ")
@@ -141,17 +193,121 @@ func (r *CompanyRepository) List(ctx context.Context, filter company.ListCompani
 	args = append(args, filter.Offset)
 
 	query := `
-        SELECT id, name, code, status, description, created_at, updated_at
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
           FROM companies` + whereClause + `
          ORDER BY created_at DESC, id DESC
          LIMIT ` + limitPlaceholder + `
         OFFSET ` + offsetPlaceholder + `
     `
 
+	companies, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(companies) > filter.Limit {
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+		companies = companies[:filter.Limit]
+	}
+
+	return companies, nextToken, nil
+}
+
+// ListSubsidiaries は子会社の一覧を取得します。Recursive の場合は再帰 CTE で孫会社以下も含めます。
+func (r *CompanyRepository) ListSubsidiaries(ctx context.Context, filter company.ListSubsidiariesFilter) ([]*company.Company, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", company.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", company.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := []any{filter.ParentID}
+	conditions := []string{"parent_company_id = $1"}
+	if filter.Recursive {
+		conditions = []string{`id IN (
+            WITH RECURSIVE subsidiaries AS (
+                SELECT id FROM companies WHERE parent_company_id = $1
+                UNION
+                SELECT c.id FROM companies c JOIN subsidiaries s ON c.parent_company_id = s.id
+            )
+            SELECT id FROM subsidiaries
+        )`}
+	}
+
+	if filter.Status != nil {
+		placeholder := "$" + strconv.Itoa(len(args)+1)
+		conditions = append(conditions, "status = "+placeholder)
+		args = append(args, *filter.Status)
+	}
+
+	limitPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	args = append(args, limitWithBuffer)
+	offsetPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	args = append(args, filter.Offset)
+
+	query := `
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+          FROM companies
+         WHERE ` + strings.Join(conditions, " AND ") + `
+         ORDER BY created_at DESC, id DESC
+         LIMIT ` + limitPlaceholder + `
+        OFFSET ` + offsetPlaceholder + `
+    `
+
+	companies, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(companies) > filter.Limit {
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+		companies = companies[:filter.Limit]
+	}
+
+	return companies, nextToken, nil
+}
+
+// ListAncestors は直近の親会社から最上位の会社までを順に返します。
+// 階層が循環している場合は、たどった会社（id 自身を含む）に戻った時点で打ち切ります。
+func (r *CompanyRepository) ListAncestors(ctx context.Context, id string) ([]*company.Company, error) {
+	return r.query(ctx, `
+        WITH RECURSIVE ancestors AS (
+            SELECT parent_company_id AS id, 1 AS depth
+              FROM companies
+             WHERE id = $1
+            UNION ALL
+            SELECT c.parent_company_id, a.depth + 1
+              FROM companies c
+              JOIN ancestors a ON c.id = a.id
+        ) CYCLE id SET is_cycle USING path
+        SELECT c.id, c.name, c.code, c.status, c.description, c.parent_company_id, c.employee_code_prefix, c.employee_code_width, c.employee_code_next_sequence, c.labels, c.website, c.created_at, c.updated_at
+          FROM ancestors a
+          JOIN companies c ON c.id = a.id
+         WHERE NOT a.is_cycle AND a.id <> $1
+         ORDER BY a.depth
+    `, id)
+}
+
+// LockHierarchy はトランザクション単位のアドバイザリロックを取得し、親会社の付け替えを直列化します。
+// ロックはトランザクションの終了時に解放されます。
+func (r *CompanyRepository) LockHierarchy(ctx context.Context) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	if _, err := exec.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, companyHierarchyLockKey); err != nil {
+		return translateCompanyPgError(err)
+	}
+	return nil
+}
+
+func (r *CompanyRepository) query(ctx context.Context, query string, args ...any) ([]*company.Company, error) {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	rows, err := exec.Query(ctx, query, args...)
 	if err != nil {
-		return nil, "", translateCompanyPgError(err)
+		return nil, translateCompanyPgError(err)
 	}
 	defer rows.Close()
 
@@ -159,22 +315,240 @@ func (r *CompanyRepository) List(ctx context.Context, filter company.ListCompani
 	for rows.Next() {
 		found, err := scanCompany(rows)
 		if err != nil {
-			return nil, "", translateCompanyPgError(err)
+			return nil, translateCompanyPgError(err)
 		}
 		companies = append(companies, found)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, "", translateCompanyPgError(err)
+		return nil, translateCompanyPgError(err)
 	}
 
-	var nextToken string
-	if len(companies) > filter.Limit {
-		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
-		companies = companies[:filter.Limit]
+	return companies, nil
+}
+
+// CreateEmployeeAttribute は社員属性の定義を追加します。
+func (r *CompanyRepository) CreateEmployeeAttribute(ctx context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	allowed, err := marshalAllowedValues(a.AllowedValues)
+	if err != nil {
+		return nil, err
 	}
 
-	return companies, nextToken, nil
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO company_employee_attributes (company_id, key, type, required, allowed_values, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5::jsonb, $6, $7)
+        RETURNING company_id, key, type, required, allowed_values, created_at, updated_at
+    `, a.CompanyID, a.Key, string(a.Type), a.Required, allowed, a.CreatedAt, a.UpdatedAt)
+
+	created, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributePgError(err)
+	}
+	return created, nil
+}
+
+// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+func (r *CompanyRepository) UpdateEmployeeAttribute(ctx context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	allowed, err := marshalAllowedValues(a.AllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        UPDATE company_employee_attributes
+           SET required = $3,
+               allowed_values = $4::jsonb,
+               updated_at = $5
+         WHERE company_id = $1 AND key = $2
+        RETURNING company_id, key, type, required, allowed_values, created_at, updated_at
+    `, a.CompanyID, a.Key, a.Required, allowed, a.UpdatedAt)
+
+	updated, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributePgError(err)
+	}
+	return updated, nil
+}
+
+// DeleteEmployeeAttribute は社員属性の定義を削除し、会社の社員からその属性の値を取り除きます。
+func (r *CompanyRepository) DeleteEmployeeAttribute(ctx context.Context, companyID, key string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `DELETE FROM company_employee_attributes WHERE company_id = $1 AND key = $2`, companyID, key)
+	if err != nil {
+		return translateEmployeeAttributePgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return company.ErrAttributeNotFound
+	}
+
+	if _, err := exec.Exec(ctx, `
+        UPDATE employees
+           SET attributes = attributes - $2::text
+         WHERE company_id = $1 AND attributes ? $2
+    `, companyID, key); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindEmployeeAttribute は会社の社員属性の定義をキーで取得します。
+func (r *CompanyRepository) FindEmployeeAttribute(ctx context.Context, companyID, key string) (*company.EmployeeAttribute, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT company_id, key, type, required, allowed_values, created_at, updated_at
+          FROM company_employee_attributes
+         WHERE company_id = $1 AND key = $2
+    `, companyID, key)
+
+	found, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributePgError(err)
+	}
+	return found, nil
+}
+
+// ListEmployeeAttributes は会社の社員属性の定義をキーの昇順で返します。
+func (r *CompanyRepository) ListEmployeeAttributes(ctx context.Context, companyID string) ([]*company.EmployeeAttribute, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT company_id, key, type, required, allowed_values, created_at, updated_at
+          FROM company_employee_attributes
+         WHERE company_id = $1
+         ORDER BY key
+    `, companyID)
+	if err != nil {
+		return nil, translateEmployeeAttributePgError(err)
+	}
+	defer rows.Close()
+
+	var attributes []*company.EmployeeAttribute
+	for rows.Next() {
+		a, err := scanEmployeeAttribute(rows)
+		if err != nil {
+			return nil, translateEmployeeAttributePgError(err)
+		}
+		attributes = append(attributes, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateEmployeeAttributePgError(err)
+	}
+	return attributes, nil
+}
+
+// AddAddress は会社に住所を追加します。
+func (r *CompanyRepository) AddAddress(ctx context.Context, a *company.Address) (*company.Address, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO company_addresses (company_id, type, country, postal_code, lines, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, company_id, type, country, postal_code, lines, created_at
+    `, a.CompanyID, string(a.Type), a.Country, a.PostalCode, a.Lines, a.CreatedAt)
+
+	created, err := scanAddress(row)
+	if err != nil {
+		return nil, translateAddressPgError(err)
+	}
+	return created, nil
+}
+
+// DeleteAddress は会社の住所を削除します。
+func (r *CompanyRepository) DeleteAddress(ctx context.Context, companyID, id string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `DELETE FROM company_addresses WHERE company_id = $1 AND id = $2`, companyID, id)
+	if err != nil {
+		return translateAddressPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return company.ErrAddressNotFound
+	}
+	return nil
+}
+
+// ListAddresses は指定した会社の住所を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListAddresses(ctx context.Context, companyIDs []string) ([]*company.Address, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT id, company_id, type, country, postal_code, lines, created_at
+          FROM company_addresses
+         WHERE company_id = ANY($1::uuid[])
+         ORDER BY company_id, created_at, id
+    `, companyIDs)
+	if err != nil {
+		return nil, translateAddressPgError(err)
+	}
+	defer rows.Close()
+
+	var addresses []*company.Address
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, translateAddressPgError(err)
+		}
+		addresses = append(addresses, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateAddressPgError(err)
+	}
+	return addresses, nil
+}
+
+// AddPhone は会社に電話番号を追加します。
+func (r *CompanyRepository) AddPhone(ctx context.Context, p *company.Phone) (*company.Phone, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO company_phones (company_id, number, created_at)
+        VALUES ($1, $2, $3)
+        RETURNING id, company_id, number, created_at
+    `, p.CompanyID, p.Number, p.CreatedAt)
+
+	created, err := scanPhone(row)
+	if err != nil {
+		return nil, translatePhonePgError(err)
+	}
+	return created, nil
+}
+
+// DeletePhone は会社の電話番号を削除します。
+func (r *CompanyRepository) DeletePhone(ctx context.Context, companyID, id string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `DELETE FROM company_phones WHERE company_id = $1 AND id = $2`, companyID, id)
+	if err != nil {
+		return translatePhonePgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return company.ErrPhoneNotFound
+	}
+	return nil
+}
+
+// ListPhones は指定した会社の電話番号を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListPhones(ctx context.Context, companyIDs []string) ([]*company.Phone, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT id, company_id, number, created_at
+          FROM company_phones
+         WHERE company_id = ANY($1::uuid[])
+         ORDER BY company_id, created_at, id
+    `, companyIDs)
+	if err != nil {
+		return nil, translatePhonePgError(err)
+	}
+	defer rows.Close()
+
+	var phones []*company.Phone
+	for rows.Next() {
+		p, err := scanPhone(rows)
+		if err != nil {
+			return nil, translatePhonePgError(err)
+		}
+		phones = append(phones, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translatePhonePgError(err)
+	}
+	return phones, nil
 }
 
 func scanCompany(row pgx.Row) (*company.Company, error) {
@@ -184,38 +558,122 @@ func scanCompany(row pgx.Row) (*company.Company, error) {
 		code                 string
 		status               string
 		description          sql.NullString
+		parentCompanyID      sql.NullString
+		policy               company.EmployeeCodePolicy
+		labels               []byte
+		website              sql.NullString
 		createdAt, updatedAt time.Time
 	)
 
-	if err := row.Scan(&id, &name, &code, &status, &description, &createdAt, &updatedAt); err != nil {
+	if err := row.Scan(&id, &name, &code, &status, &description, &parentCompanyID,
+		&policy.Prefix, &policy.Width, &policy.NextSequence, &labels, &website, &createdAt, &updatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, company.ErrCompanyNotFound
 		}
 		return nil, err
 	}
 
+	labelMap, err := unmarshalStringMap(labels)
+	if err != nil {
+		return nil, err
+	}
+
 	var descPtr *string
 	if description.Valid {
 		desc := description.String
 		descPtr = &desc
 	}
 
+	var parentPtr *string
+	if parentCompanyID.Valid {
+		parent := parentCompanyID.String
+		parentPtr = &parent
+	}
+
+	var websitePtr *string
+	if website.Valid {
+		w := website.String
+		websitePtr = &w
+	}
+
 	return &company.Company{
-		ID:          id,
-		Name:        name,
-		Code:        code,
-		Status:      company.Status(status),
-		Description: descPtr,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
+		ID:                 id,
+		Name:               name,
+		Code:               code,
+		Status:             company.Status(status),
+		Description:        descPtr,
+		ParentCompanyID:    parentPtr,
+		EmployeeCodePolicy: policy,
+		Labels:             labelMap,
+		Website:            websitePtr,
+		CreatedAt:          createdAt,
+		UpdatedAt:          updatedAt,
 	}, nil
 }
 
 func translateCompanyPgError(err error) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
-		if pgErr.Code == companyUniqueViolationCode {
+		switch pgErr.Code {
+		case companyUniqueViolationCode:
 			return company.ErrCodeAlreadyExists
+		case companyForeignKeyViolationCode:
+			if pgErr.ConstraintName == companyParentForeignKey {
+				return company.ErrParentCompanyNotFound
+			}
+		case companyCheckViolationCode:
+			if pgErr.ConstraintName == companyEmployeeCodePolicyCheck {
+				return company.ErrInvalidEmployeeCodePolicy
+			}
+			return company.ErrHierarchyCycle
+		}
+	}
+	return err
+}
+
+func scanEmployeeAttribute(row pgx.Row) (*company.EmployeeAttribute, error) {
+	var (
+		a       company.EmployeeAttribute
+		typ     string
+		allowed []byte
+	)
+	if err := row.Scan(&a.CompanyID, &a.Key, &typ, &a.Required, &allowed, &a.CreatedAt, &a.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, company.ErrAttributeNotFound
+		}
+		return nil, err
+	}
+	a.Type = company.AttributeType(typ)
+	if err := json.Unmarshal(allowed, &a.AllowedValues); err != nil {
+		return nil, err
+	}
+	if len(a.AllowedValues) == 0 {
+		a.AllowedValues = nil
+	}
+	return &a, nil
+}
+
+func marshalAllowedValues(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func translateEmployeeAttributePgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case companyUniqueViolationCode:
+			return company.ErrAttributeAlreadyExists
+		case companyForeignKeyViolationCode:
+			return company.ErrCompanyNotFound
+		case companyCheckViolationCode:
+			return company.ErrInvalidAttributeType
 		}
 	}
 	return err
@@ -227,3 +685,69 @@ func nullableString(value *string) any {
 	}
 	return *value
 }
+
+// stringPtr は NULL を nil として value を *string に変換します。
+func stringPtr(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	v := value.String
+	return &v
+}
+
+func scanAddress(row pgx.Row) (*company.Address, error) {
+	var (
+		a   company.Address
+		typ string
+	)
+	if err := row.Scan(&a.ID, &a.CompanyID, &typ, &a.Country, &a.PostalCode, &a.Lines, &a.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, company.ErrAddressNotFound
+		}
+		return nil, err
+	}
+	a.Type = company.AddressType(typ)
+	return &a, nil
+}
+
+func translateAddressPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case companyForeignKeyViolationCode:
+			return company.ErrCompanyNotFound
+		case companyCheckViolationCode:
+			if pgErr.ConstraintName == companyAddressCountryCheck {
+				return company.ErrInvalidCountry
+			}
+			return company.ErrInvalidAddressType
+		}
+	}
+	return err
+}
+
+func scanPhone(row pgx.Row) (*company.Phone, error) {
+	var p company.Phone
+	if err := row.Scan(&p.ID, &p.CompanyID, &p.Number, &p.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, company.ErrPhoneNotFound
+		}
+		return nil, err
+	}
+	return &p, nil
+}
+
+func translatePhonePgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case companyUniqueViolationCode:
+			return company.ErrPhoneAlreadyExists
+		case companyForeignKeyViolationCode:
+			return company.ErrCompanyNotFound
+		case companyCheckViolationCode:
+			return company.ErrInvalidPhoneNumber
+		}
+	}
+	return err
+}
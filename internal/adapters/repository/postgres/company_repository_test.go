@@ -30,7 +30,7 @@ func TestScanCompany_Success(t *testing.T) {
 	updatedAt := createdAt.Add(time.Minute)
 
 	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
-		if len(dest) != 7 {
+		if len(dest) != 13 {
 			return errors.New("unexpected dest length")
 		}
 		*(dest[0].(*string)) = "company-1"
@@ -42,8 +42,22 @@ func TestScanCompany_Success(t *testing.T) {
 		d.String = desc
 		d.Valid = true
 
-		*(dest[5].(*time.Time)) = createdAt
-		*(dest[6].(*time.Time)) = updatedAt
+		p := dest[5].(*sql.NullString)
+		p.String = "company-0"
+		p.Valid = true
+
+		*(dest[6].(*string)) = "EMP-"
+		*(dest[7].(*int)) = 5
+		*(dest[8].(*int64)) = 42
+
+		*(dest[9].(*[]byte)) = []byte(`{"tier":"enterprise"}`)
+
+		w := dest[10].(*sql.NullString)
+		w.String = "https://example.com"
+		w.Valid = true
+
+		*(dest[11].(*time.Time)) = createdAt
+		*(dest[12].(*time.Time)) = updatedAt
 		return nil
 	}}
 
@@ -55,6 +69,19 @@ func TestScanCompany_Success(t *testing.T) {
 	if c.Description == nil || *c.Description != desc {
 		t.Fatalf("expected description %s, got %+v", desc, c.Description)
 	}
+	if c.ParentCompanyID == nil || *c.ParentCompanyID != "company-0" {
+		t.Fatalf("expected parent company-0, got %+v", c.ParentCompanyID)
+	}
+	want := company.EmployeeCodePolicy{Prefix: "EMP-", Width: 5, NextSequence: 42}
+	if c.EmployeeCodePolicy != want {
+		t.Fatalf("expected policy %+v, got %+v", want, c.EmployeeCodePolicy)
+	}
+	if c.Labels["tier"] != "enterprise" {
+		t.Fatalf("expected label tier=enterprise, got %+v", c.Labels)
+	}
+	if c.Website == nil || *c.Website != "https://example.com" {
+		t.Fatalf("expected website, got %+v", c.Website)
+	}
 }
 
 func TestScanCompany_NoRows(t *testing.T) {
@@ -78,6 +105,21 @@ func TestTranslateCompanyPgError(t *testing.T) {
 		t.Fatalf("expected code already exists error mapping")
 	}
 
+	fkErr := &pgconn.PgError{Code: companyForeignKeyViolationCode, ConstraintName: companyParentForeignKey}
+	if !errors.Is(translateCompanyPgError(fkErr), company.ErrParentCompanyNotFound) {
+		t.Fatalf("expected parent company not found error mapping")
+	}
+
+	policyErr := &pgconn.PgError{Code: companyCheckViolationCode, ConstraintName: companyEmployeeCodePolicyCheck}
+	if !errors.Is(translateCompanyPgError(policyErr), company.ErrInvalidEmployeeCodePolicy) {
+		t.Fatalf("expected invalid employee code policy error mapping")
+	}
+
+	checkErr := &pgconn.PgError{Code: companyCheckViolationCode}
+	if !errors.Is(translateCompanyPgError(checkErr), company.ErrHierarchyCycle) {
+		t.Fatalf("expected hierarchy cycle error mapping")
+	}
+
 	otherErr := errors.New("random")
 	if translateCompanyPgError(otherErr) != otherErr {
 		t.Fatalf("unexpected translation for generic error")
@@ -96,7 +138,7 @@ func TestCompanyRepository_List_WithNextToken(t *testing.T) {
 	repo := NewCompanyRepository(mock)
 
 	query := regexp.QuoteMeta(`
-        SELECT id, name, code, status, description, created_at, updated_at
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
           FROM companies
          ORDER BY created_at DESC, id DESC
          LIMIT $1
@@ -104,10 +146,10 @@ func TestCompanyRepository_List_WithNextToken(t *testing.T) {
     `)
 
 	now := time.Now().UTC()
-	rows := pgxmock.NewRows([]string{"id", "name", "code", "status", "description", "created_at", "updated_at"}).
-		AddRow("company-1", "Company1", "company-1", string(company.StatusActive), nil, now, now).
-		AddRow("company-2", "Company2", "company-2", string(company.StatusActive), nil, now, now).
-		AddRow("company-3", "Company3", "company-3", string(company.StatusInactive), nil, now, now)
+	rows := pgxmock.NewRows([]string{"id", "name", "code", "status", "description", "parent_company_id", "employee_code_prefix", "employee_code_width", "employee_code_next_sequence", "labels", "website", "created_at", "updated_at"}).
+		AddRow("company-1", "Company1", "company-1", string(company.StatusActive), nil, nil, "", 0, int64(1), []byte("{}"), nil, now, now).
+		AddRow("company-2", "Company2", "company-2", string(company.StatusActive), nil, nil, "", 0, int64(1), []byte("{}"), nil, now, now).
+		AddRow("company-3", "Company3", "company-3", string(company.StatusInactive), nil, nil, "", 0, int64(1), []byte("{}"), nil, now, now)
 
 	mock.ExpectQuery(query).
 		WithArgs(3, 0).
@@ -144,7 +186,7 @@ func TestCompanyRepository_List_WithStatusFilter(t *testing.T) {
 	inactive := company.StatusInactive
 
 	query := regexp.QuoteMeta(`
-        SELECT id, name, code, status, description, created_at, updated_at
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
           FROM companies WHERE status = $1
          ORDER BY created_at DESC, id DESC
          LIMIT $2
@@ -152,8 +194,8 @@ func TestCompanyRepository_List_WithStatusFilter(t *testing.T) {
     `)
 
 	now := time.Now().UTC()
-	rows := pgxmock.NewRows([]string{"id", "name", "code", "status", "description", "created_at", "updated_at"}).
-		AddRow("company-5", "Inactive", "inactive", string(company.StatusInactive), nil, now, now)
+	rows := pgxmock.NewRows([]string{"id", "name", "code", "status", "description", "parent_company_id", "employee_code_prefix", "employee_code_width", "employee_code_next_sequence", "labels", "website", "created_at", "updated_at"}).
+		AddRow("company-5", "Inactive", "inactive", string(company.StatusInactive), nil, nil, "", 0, int64(1), []byte("{}"), nil, now, now)
 
 	mock.ExpectQuery(query).
 		WithArgs(inactive, 3, 0).
@@ -196,3 +238,159 @@ func TestCompanyRepository_List_InvalidArguments(t *testing.T) {
 		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
 	}
 }
+
+func TestCompanyRepository_ListSubsidiaries_Recursive(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCompanyRepository(mock)
+	parentID := "company-1"
+
+	now := time.Now().UTC()
+	rows := pgxmock.NewRows([]string{"id", "name", "code", "status", "description", "parent_company_id", "employee_code_prefix", "employee_code_width", "employee_code_next_sequence", "labels", "website", "created_at", "updated_at"}).
+		AddRow("company-3", "Grandchild", "grandchild", string(company.StatusActive), nil, "company-2", "", 0, int64(1), []byte("{}"), nil, now, now).
+		AddRow("company-2", "Child", "child", string(company.StatusActive), nil, parentID, "", 0, int64(1), []byte("{}"), nil, now, now)
+
+	mock.ExpectQuery(`WITH RECURSIVE subsidiaries AS`).
+		WithArgs(parentID, 11, 0).
+		WillReturnRows(rows)
+
+	companies, nextToken, err := repo.ListSubsidiaries(context.Background(), company.ListSubsidiariesFilter{ParentID: parentID, Recursive: true, Limit: 10})
+	if err != nil {
+		t.Fatalf("ListSubsidiaries returned error: %v", err)
+	}
+	if len(companies) != 2 || nextToken != "" {
+		t.Fatalf("unexpected result: %d companies, token %q", len(companies), nextToken)
+	}
+	if companies[0].ParentCompanyID == nil || *companies[0].ParentCompanyID != "company-2" {
+		t.Fatalf("expected parent company-2, got %+v", companies[0].ParentCompanyID)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCompanyRepository_Delete_WithSubsidiaries(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCompanyRepository(mock)
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM companies WHERE id = $1`)).
+		WithArgs("company-1").
+		WillReturnError(&pgconn.PgError{Code: companyForeignKeyViolationCode, ConstraintName: companyParentForeignKey})
+
+	if err := repo.Delete(context.Background(), "company-1"); !errors.Is(err, company.ErrCompanyHasSubsidiaries) {
+		t.Fatalf("expected ErrCompanyHasSubsidiaries, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCompanyRepository_ListAddresses(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCompanyRepository(mock)
+	now := time.Now().UTC()
+	rows := pgxmock.NewRows([]string{"id", "company_id", "type", "country", "postal_code", "lines", "created_at"}).
+		AddRow("address-1", "company-1", "registered", "JP", "100-0001", []string{"東京都千代田区", "千代田1-1"}, now)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`WHERE company_id = ANY($1::uuid[])`)).
+		WithArgs([]string{"company-1", "company-2"}).
+		WillReturnRows(rows)
+
+	addresses, err := repo.ListAddresses(context.Background(), []string{"company-1", "company-2"})
+	if err != nil {
+		t.Fatalf("ListAddresses returned error: %v", err)
+	}
+	if len(addresses) != 1 || addresses[0].Type != company.AddressTypeRegistered || len(addresses[0].Lines) != 2 {
+		t.Fatalf("unexpected addresses: %+v", addresses)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCompanyRepository_DeletePhone_NotFound(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCompanyRepository(mock)
+
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM company_phones WHERE company_id = $1 AND id = $2`)).
+		WithArgs("company-1", "phone-1").
+		WillReturnResult(pgxmock.NewResult("DELETE", 0))
+
+	if err := repo.DeletePhone(context.Background(), "company-1", "phone-1"); !errors.Is(err, company.ErrPhoneNotFound) {
+		t.Fatalf("expected ErrPhoneNotFound, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestTranslateContactPgErrors(t *testing.T) {
+	t.Parallel()
+
+	if !errors.Is(translatePhonePgError(&pgconn.PgError{Code: companyUniqueViolationCode}), company.ErrPhoneAlreadyExists) {
+		t.Fatalf("expected phone already exists error mapping")
+	}
+	if !errors.Is(translatePhonePgError(&pgconn.PgError{Code: companyForeignKeyViolationCode}), company.ErrCompanyNotFound) {
+		t.Fatalf("expected company not found error mapping")
+	}
+	if !errors.Is(translateAddressPgError(&pgconn.PgError{Code: companyCheckViolationCode, ConstraintName: companyAddressCountryCheck}), company.ErrInvalidCountry) {
+		t.Fatalf("expected invalid country error mapping")
+	}
+	if !errors.Is(translateAddressPgError(&pgconn.PgError{Code: companyCheckViolationCode}), company.ErrInvalidAddressType) {
+		t.Fatalf("expected invalid address type error mapping")
+	}
+}
+
+func TestCompanyRepository_LockHierarchy(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCompanyRepository(mock)
+
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock($1)`)).
+		WithArgs(companyHierarchyLockKey).
+		WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+	if err := repo.LockHierarchy(context.Background()); err != nil {
+		t.Fatalf("LockHierarchy returned error: %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+)
+
+const credentialColumns = `user_id, password_hash, failed_attempts, locked_until, last_login_at, refresh_token_id, password_updated_at, created_at, updated_at`
+
+// CredentialRepository は PostgreSQL を利用したパスワード認証情報の実装です。
+type CredentialRepository struct {
+	pool pgdb.Queryer
+}
+
+// NewCredentialRepository は CredentialRepository を生成します。
+func NewCredentialRepository(pool pgdb.Queryer) *CredentialRepository {
+	return &CredentialRepository{pool: pool}
+}
+
+// FindByUserID はユーザーの認証情報を取得します。
+func (r *CredentialRepository) FindByUserID(ctx context.Context, userID string) (*auth.Credential, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT `+credentialColumns+`
+          FROM user_credentials
+         WHERE user_id = $1
+    `, userID)
+
+	return scanCredential(row)
+}
+
+// Save は認証情報を作成または更新します。失敗回数とロックは解除してリフレッシュトークンを失効させ、最終ログイン日時は維持します。
+func (r *CredentialRepository) Save(ctx context.Context, credential *auth.Credential) (*auth.Credential, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO user_credentials (user_id, password_hash, failed_attempts, locked_until, password_updated_at, created_at, updated_at)
+        VALUES ($1, $2, 0, NULL, $3, $4, $5)
+        ON CONFLICT (user_id) DO UPDATE
+           SET password_hash = EXCLUDED.password_hash,
+               failed_attempts = 0,
+               locked_until = NULL,
+               refresh_token_id = NULL,
+               password_updated_at = EXCLUDED.password_updated_at,
+               updated_at = EXCLUDED.updated_at
+        RETURNING `+credentialColumns,
+		credential.UserID, credential.PasswordHash, credential.PasswordUpdatedAt, credential.CreatedAt, credential.UpdatedAt)
+
+	saved, err := scanCredential(row)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+			return nil, auth.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return saved, nil
+}
+
+// IncrementFailedAttempts は失敗回数を 1 増やし、増やした後の回数を返します。
+// 同時に失敗した場合も取りこぼさないよう、行の値を直接加算します。
+func (r *CredentialRepository) IncrementFailedAttempts(ctx context.Context, userID string, at time.Time) (int, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	var attempts int
+	err := exec.QueryRow(ctx, `
+        UPDATE user_credentials
+           SET failed_attempts = failed_attempts + 1,
+               updated_at = $1
+         WHERE user_id = $2
+        RETURNING failed_attempts
+    `, at, userID).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, auth.ErrCredentialNotFound
+		}
+		return 0, err
+	}
+	return attempts, nil
+}
+
+// Lock は指定日時までロックし、失敗回数を 0 に戻します。
+func (r *CredentialRepository) Lock(ctx context.Context, userID string, until time.Time, at time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	return execCredentialUpdate(ctx, exec, `
+        UPDATE user_credentials
+           SET failed_attempts = 0,
+               locked_until = $1,
+               updated_at = $2
+         WHERE user_id = $3
+    `, until, at, userID)
+}
+
+// RecordLogin はログインの成功を記録し、失敗回数とロックを解除してリフレッシュトークンの ID を置き換えます。
+func (r *CredentialRepository) RecordLogin(ctx context.Context, userID, refreshTokenID string, at time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	return execCredentialUpdate(ctx, exec, `
+        UPDATE user_credentials
+           SET failed_attempts = 0,
+               locked_until = NULL,
+               last_login_at = $1,
+               refresh_token_id = $2,
+               updated_at = $1
+         WHERE user_id = $3
+    `, at, refreshTokenID, userID)
+}
+
+// RotateRefreshToken はリフレッシュトークンの ID が currentID と一致する場合のみ nextID に置き換えます。
+// 同じトークンで同時に再発行した場合、後続の UPDATE は置き換え後の行を再評価するため一致しません。
+func (r *CredentialRepository) RotateRefreshToken(ctx context.Context, userID, currentID, nextID string, at time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `
+        UPDATE user_credentials
+           SET refresh_token_id = $1,
+               updated_at = $2
+         WHERE user_id = $3
+           AND refresh_token_id = $4
+    `, nextID, at, userID, currentID)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrInvalidToken
+	}
+	return nil
+}
+
+func execCredentialUpdate(ctx context.Context, exec pgdb.Queryer, query string, args ...any) error {
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrCredentialNotFound
+	}
+	return nil
+}
+
+func scanCredential(row pgx.Row) (*auth.Credential, error) {
+	var (
+		c              auth.Credential
+		refreshTokenID *string
+	)
+	if err := row.Scan(&c.UserID, &c.PasswordHash, &c.FailedAttempts, &c.LockedUntil, &c.LastLoginAt, &refreshTokenID, &c.PasswordUpdatedAt, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, auth.ErrCredentialNotFound
+		}
+		return nil, err
+	}
+	if refreshTokenID != nil {
+		c.RefreshTokenID = *refreshTokenID
+	}
+	return &c, nil
+}
@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestScanCredential_NoRows(t *testing.T) {
+	t.Parallel()
+
+	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
+		return pgx.ErrNoRows
+	}}
+
+	if _, err := scanCredential(row); !errors.Is(err, auth.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+}
+
+func TestCredentialRepository_Save_UnknownUser(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCredentialRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO user_credentials")).
+		WithArgs("user-1", "hash", now, now, now).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolationCode})
+
+	_, err = repo.Save(context.Background(), &auth.Credential{
+		UserID:            "user-1",
+		PasswordHash:      "hash",
+		PasswordUpdatedAt: now,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	})
+	if !errors.Is(err, auth.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCredentialRepository_IncrementFailedAttempts(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCredentialRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("SET failed_attempts = failed_attempts + 1")).
+		WithArgs(now, "user-1").
+		WillReturnRows(pgxmock.NewRows([]string{"failed_attempts"}).AddRow(3))
+	mock.ExpectQuery(regexp.QuoteMeta("SET failed_attempts = failed_attempts + 1")).
+		WithArgs(now, "user-2").
+		WillReturnError(pgx.ErrNoRows)
+
+	attempts, err := repo.IncrementFailedAttempts(context.Background(), "user-1", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+	if _, err := repo.IncrementFailedAttempts(context.Background(), "user-2", now); !errors.Is(err, auth.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCredentialRepository_Lock_NotFound(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCredentialRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE user_credentials")).
+		WithArgs(now.Add(time.Minute), now, "user-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	if err := repo.Lock(context.Background(), "user-1", now.Add(time.Minute), now); !errors.Is(err, auth.ErrCredentialNotFound) {
+		t.Fatalf("expected ErrCredentialNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestCredentialRepository_RotateRefreshToken(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewCredentialRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("AND refresh_token_id = $4")).
+		WithArgs("refresh-2", now, "user-1", "refresh-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+	mock.ExpectExec(regexp.QuoteMeta("AND refresh_token_id = $4")).
+		WithArgs("refresh-3", now, "user-1", "refresh-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	if err := repo.RotateRefreshToken(context.Background(), "user-1", "refresh-1", "refresh-2", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := repo.RotateRefreshToken(context.Background(), "user-1", "refresh-1", "refresh-3", now); !errors.Is(err, auth.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,290 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+)
+
+const (
+	departmentUniqueViolationCode     = "23505"
+	departmentForeignKeyViolationCode = "23503"
+	departmentCheckViolationCode      = "23514"
+
+	departmentCompanyForeignKey  = "departments_company_id_fkey"
+	departmentParentForeignKey   = "departments_parent_department_fkey"
+	employeeDepartmentForeignKey = "employees_department_fkey"
+
+	// departmentHierarchyLockKey は親部署の付け替えを直列化するアドバイザリロックキーです。
+	departmentHierarchyLockKey int64 = 0x6465707473 // "depts"
+)
+
+// DepartmentRepository は PostgreSQL を利用した部署永続化の実装です。
+type DepartmentRepository struct {
+	pool pgdb.Queryer
+}
+
+// NewDepartmentRepository は DepartmentRepository を生成します。
+func NewDepartmentRepository(pool pgdb.Queryer) *DepartmentRepository {
+	return &DepartmentRepository{pool: pool}
+}
+
+// Create は部署を新規作成します。
+func (r *DepartmentRepository) Create(ctx context.Context, d *department.Department) (*department.Department, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO departments (company_id, parent_department_id, name, code, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING id, company_id, parent_department_id, name, code, created_at, updated_at
+    `, d.CompanyID, nullableString(d.ParentDepartmentID), d.Name, d.Code, d.CreatedAt, d.UpdatedAt)
+
+	created, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+	return created, nil
+}
+
+// Update は部署情報を更新します。所属会社は変更できません。
+func (r *DepartmentRepository) Update(ctx context.Context, d *department.Department) (*department.Department, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        UPDATE departments
+           SET parent_department_id = $1,
+               name = $2,
+               code = $3,
+               updated_at = $4
+         WHERE id = $5
+        RETURNING id, company_id, parent_department_id, name, code, created_at, updated_at
+    `, nullableString(d.ParentDepartmentID), d.Name, d.Code, d.UpdatedAt, d.ID)
+
+	updated, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+	return updated, nil
+}
+
+// Delete は部署を削除します。子部署や所属社員が存在する場合は削除しません。
+func (r *DepartmentRepository) Delete(ctx context.Context, id string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `DELETE FROM departments WHERE id = $1`, id)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == departmentForeignKeyViolationCode {
+			switch pgErr.ConstraintName {
+			case departmentParentForeignKey:
+				return department.ErrDepartmentHasChildren
+			case employeeDepartmentForeignKey:
+				return department.ErrDepartmentHasEmployees
+			}
+		}
+		return translateDepartmentPgError(err)
+	}
+	if tag.RowsAffected() == 0 {
+		return department.ErrDepartmentNotFound
+	}
+	return nil
+}
+
+// FindByID は ID で部署を取得します。
+func (r *DepartmentRepository) FindByID(ctx context.Context, id string) (*department.Department, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE id = $1
+         LIMIT 1
+    `, id)
+
+	found, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+	return found, nil
+}
+
+// FindByCompanyAndCode は会社 ID と部署コードで検索します。
+func (r *DepartmentRepository) FindByCompanyAndCode(ctx context.Context, companyID, code string) (*department.Department, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE company_id = $1 AND code = $2
+         LIMIT 1
+    `, companyID, code)
+
+	found, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+	return found, nil
+}
+
+// List は部署の一覧を取得します。
+func (r *DepartmentRepository) List(ctx context.Context, filter department.ListDepartmentsFilter) ([]*department.Department, string, error) {
+	if strings.TrimSpace(filter.CompanyID) == "" {
+		return nil, "", department.ErrInvalidCompanyID
+	}
+	if filter.Limit <= 0 {
+		return nil, "", department.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", department.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := []any{filter.CompanyID}
+	conditions := []string{"company_id = $1"}
+
+	if filter.ParentID != nil {
+		placeholder := "$" + strconv.Itoa(len(args)+1)
+		conditions = append(conditions, "parent_department_id = "+placeholder)
+		args = append(args, *filter.ParentID)
+	}
+
+	limitPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	args = append(args, limitWithBuffer)
+	offsetPlaceholder := "$" + strconv.Itoa(len(args)+1)
+	args = append(args, filter.Offset)
+
+	query := `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE ` + strings.Join(conditions, " AND ") + `
+         ORDER BY created_at DESC, id DESC
+         LIMIT ` + limitPlaceholder + `
+        OFFSET ` + offsetPlaceholder + `
+    `
+
+	departments, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(departments) > filter.Limit {
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+		departments = departments[:filter.Limit]
+	}
+
+	return departments, nextToken, nil
+}
+
+// ListAncestors は直近の親部署から最上位の部署までを順に返します。
+// 階層が循環している場合は、たどった部署（id 自身を含む）に戻った時点で打ち切ります。
+func (r *DepartmentRepository) ListAncestors(ctx context.Context, id string) ([]*department.Department, error) {
+	return r.query(ctx, `
+        WITH RECURSIVE ancestors AS (
+            SELECT parent_department_id AS id, 1 AS depth
+              FROM departments
+             WHERE id = $1
+            UNION ALL
+            SELECT d.parent_department_id, a.depth + 1
+              FROM departments d
+              JOIN ancestors a ON d.id = a.id
+        ) CYCLE id SET is_cycle USING path
+        SELECT d.id, d.company_id, d.parent_department_id, d.name, d.code, d.created_at, d.updated_at
+          FROM ancestors a
+          JOIN departments d ON d.id = a.id
+         WHERE NOT a.is_cycle AND a.id <> $1
+         ORDER BY a.depth
+    `, id)
+}
+
+// LockHierarchy はトランザクション単位のアドバイザリロックを取得し、親部署の付け替えを直列化します。
+// ロックはトランザクションの終了時に解放されます。
+func (r *DepartmentRepository) LockHierarchy(ctx context.Context) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	if _, err := exec.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, departmentHierarchyLockKey); err != nil {
+		return translateDepartmentPgError(err)
+	}
+	return nil
+}
+
+func (r *DepartmentRepository) query(ctx context.Context, query string, args ...any) ([]*department.Department, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+	defer rows.Close()
+
+	var departments []*department.Department
+	for rows.Next() {
+		found, err := scanDepartment(rows)
+		if err != nil {
+			return nil, translateDepartmentPgError(err)
+		}
+		departments = append(departments, found)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, translateDepartmentPgError(err)
+	}
+
+	return departments, nil
+}
+
+func scanDepartment(row pgx.Row) (*department.Department, error) {
+	var (
+		id                   string
+		companyID            string
+		parentDepartmentID   sql.NullString
+		name                 string
+		code                 string
+		createdAt, updatedAt time.Time
+	)
+
+	if err := row.Scan(&id, &companyID, &parentDepartmentID, &name, &code, &createdAt, &updatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, department.ErrDepartmentNotFound
+		}
+		return nil, err
+	}
+
+	var parentPtr *string
+	if parentDepartmentID.Valid {
+		parent := parentDepartmentID.String
+		parentPtr = &parent
+	}
+
+	return &department.Department{
+		ID:                 id,
+		CompanyID:          companyID,
+		ParentDepartmentID: parentPtr,
+		Name:               name,
+		Code:               code,
+		CreatedAt:          createdAt,
+		UpdatedAt:          updatedAt,
+	}, nil
+}
+
+func translateDepartmentPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case departmentUniqueViolationCode:
+			return department.ErrCodeAlreadyExists
+		case departmentForeignKeyViolationCode:
+			switch pgErr.ConstraintName {
+			case departmentCompanyForeignKey:
+				return department.ErrCompanyNotFound
+			case departmentParentForeignKey:
+				return department.ErrParentDepartmentNotFound
+			}
+		case departmentCheckViolationCode:
+			return department.ErrHierarchyCycle
+		}
+	}
+	return err
+}
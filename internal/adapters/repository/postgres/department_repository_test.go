@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestScanDepartment_NoRows(t *testing.T) {
+	t.Parallel()
+
+	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
+		return pgx.ErrNoRows
+	}}
+
+	if _, err := scanDepartment(row); !errors.Is(err, department.ErrDepartmentNotFound) {
+		t.Fatalf("expected ErrDepartmentNotFound, got %v", err)
+	}
+}
+
+func TestTranslateDepartmentPgError(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name string
+		err  error
+		want error
+	}{
+		{"unique", &pgconn.PgError{Code: departmentUniqueViolationCode}, department.ErrCodeAlreadyExists},
+		{"company fk", &pgconn.PgError{Code: departmentForeignKeyViolationCode, ConstraintName: departmentCompanyForeignKey}, department.ErrCompanyNotFound},
+		{"parent fk", &pgconn.PgError{Code: departmentForeignKeyViolationCode, ConstraintName: departmentParentForeignKey}, department.ErrParentDepartmentNotFound},
+		{"check", &pgconn.PgError{Code: departmentCheckViolationCode}, department.ErrHierarchyCycle},
+	}
+	for _, tc := range cases {
+		if got := translateDepartmentPgError(tc.err); !errors.Is(got, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.want, got)
+		}
+	}
+
+	other := errors.New("other")
+	if translateDepartmentPgError(other) != other {
+		t.Fatalf("unexpected translation for generic error")
+	}
+}
+
+func TestDepartmentRepository_List_WithParent(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewDepartmentRepository(mock)
+	parentID := "dept-1"
+
+	query := regexp.QuoteMeta(`
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE company_id = $1 AND parent_department_id = $2
+         ORDER BY created_at DESC, id DESC
+         LIMIT $3
+        OFFSET $4
+    `)
+
+	now := time.Now().UTC()
+	rows := pgxmock.NewRows([]string{"id", "company_id", "parent_department_id", "name", "code", "created_at", "updated_at"}).
+		AddRow("dept-3", "company-1", parentID, "Team B", "team-b", now, now).
+		AddRow("dept-2", "company-1", parentID, "Team A", "team-a", now, now)
+
+	mock.ExpectQuery(query).
+		WithArgs("company-1", parentID, 2, 0).
+		WillReturnRows(rows)
+
+	departments, nextToken, err := repo.List(context.Background(), department.ListDepartmentsFilter{CompanyID: "company-1", ParentID: &parentID, Limit: 1})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(departments) != 1 || departments[0].ID != "dept-3" {
+		t.Fatalf("unexpected departments: %+v", departments)
+	}
+	if departments[0].ParentDepartmentID == nil || *departments[0].ParentDepartmentID != parentID {
+		t.Fatalf("expected parent %s, got %v", parentID, departments[0].ParentDepartmentID)
+	}
+	if nextToken != "1" {
+		t.Fatalf("expected next token '1', got %s", nextToken)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestDepartmentRepository_Delete_Referenced(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		constraint string
+		want       error
+	}{
+		{departmentParentForeignKey, department.ErrDepartmentHasChildren},
+		{employeeDepartmentForeignKey, department.ErrDepartmentHasEmployees},
+	}
+
+	for _, tc := range cases {
+		mock, err := pgxmock.NewPool()
+		if err != nil {
+			t.Fatalf("failed to create mock pool: %v", err)
+		}
+
+		mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM departments WHERE id = $1`)).
+			WithArgs("dept-1").
+			WillReturnError(&pgconn.PgError{Code: departmentForeignKeyViolationCode, ConstraintName: tc.constraint})
+
+		if err := NewDepartmentRepository(mock).Delete(context.Background(), "dept-1"); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", tc.constraint, tc.want, err)
+		}
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Errorf("unmet expectations: %v", err)
+		}
+		mock.Close()
+	}
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+)
+
+const emailChangeTokenColumns = `id, user_id, new_email, token_hash, expires_at, used_at, created_at`
+
+// EmailChangeTokenRepository は PostgreSQL を利用したメールアドレス変更トークンの実装です。
+type EmailChangeTokenRepository struct {
+	pool pgdb.Queryer
+}
+
+// NewEmailChangeTokenRepository は EmailChangeTokenRepository を生成します。
+func NewEmailChangeTokenRepository(pool pgdb.Queryer) *EmailChangeTokenRepository {
+	return &EmailChangeTokenRepository{pool: pool}
+}
+
+// Create はトークンを保存します。
+func (r *EmailChangeTokenRepository) Create(ctx context.Context, token *user.EmailChangeToken) (*user.EmailChangeToken, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO email_change_tokens (user_id, new_email, token_hash, expires_at, used_at, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING `+emailChangeTokenColumns,
+		token.UserID, token.NewEmail, token.TokenHash, token.ExpiresAt, token.UsedAt, token.CreatedAt)
+
+	created, err := scanEmailChangeToken(row)
+	if err != nil {
+		return nil, translateEmailChangeTokenPgError(err)
+	}
+	return created, nil
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *EmailChangeTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*user.EmailChangeToken, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT `+emailChangeTokenColumns+`
+          FROM email_change_tokens
+         WHERE token_hash = $1
+    `, tokenHash)
+
+	return scanEmailChangeToken(row)
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *EmailChangeTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `
+        UPDATE email_change_tokens
+           SET used_at = $1
+         WHERE id = $2
+           AND used_at IS NULL
+    `, usedAt, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return user.ErrInvalidEmailChangeToken
+	}
+	return nil
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *EmailChangeTokenRepository) DeleteUnusedByUser(ctx context.Context, userID string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	_, err := exec.Exec(ctx, `DELETE FROM email_change_tokens WHERE user_id = $1 AND used_at IS NULL`, userID)
+	return err
+}
+
+func scanEmailChangeToken(row pgx.Row) (*user.EmailChangeToken, error) {
+	var token user.EmailChangeToken
+	if err := row.Scan(&token.ID, &token.UserID, &token.NewEmail, &token.TokenHash, &token.ExpiresAt, &token.UsedAt, &token.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, user.ErrInvalidEmailChangeToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// translateEmailChangeTokenPgError は存在しないユーザーへのトークン発行を ErrUserNotFound に変換します。
+func translateEmailChangeTokenPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+		return user.ErrUserNotFound
+	}
+	return err
+}
@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestScanEmailChangeToken_NoRows(t *testing.T) {
+	t.Parallel()
+
+	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
+		return pgx.ErrNoRows
+	}}
+
+	if _, err := scanEmailChangeToken(row); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+		t.Fatalf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+}
+
+func TestEmailChangeTokenRepository_Create_UnknownUser(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewEmailChangeTokenRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO email_change_tokens")).
+		WithArgs("user-1", "new@example.com", "hash", now.Add(time.Hour), (*time.Time)(nil), now).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolationCode})
+
+	_, err = repo.Create(context.Background(), &user.EmailChangeToken{
+		UserID:    "user-1",
+		NewEmail:  "new@example.com",
+		TokenHash: "hash",
+		ExpiresAt: now.Add(time.Hour),
+		CreatedAt: now,
+	})
+	if !errors.Is(err, user.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestEmailChangeTokenRepository_MarkUsed_AlreadyUsed(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewEmailChangeTokenRepository(mock)
+	usedAt := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE email_change_tokens")).
+		WithArgs(usedAt, "token-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	if err := repo.MarkUsed(context.Background(), "token-1", usedAt); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+		t.Fatalf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"strconv"
 	"strings"
@@ -18,6 +19,9 @@ const (
 	employeeUniqueViolationCode     = "23505"
 	employeeForeignKeyViolationCode = "23503"
 	employeeCheckViolationCode      = "23514"
+
+	// reportingLinesLockKey は上長の付け替えを直列化するアドバイザリロックキーです。
+	reportingLinesLockKey int64 = 0x6d616e61676572 // "manager"
 )
 
 // EmployeeRepository は PostgreSQL を利用した社員永続化の実装です。
@@ -32,26 +36,35 @@ func NewEmployeeRepository(pool pgdb.Queryer) *EmployeeRepository {
 
 // Create は社員を新規作成します。
 func (r *EmployeeRepository) Create(ctx context.Context, e *employee.Employee) (*employee.Employee, error) {
+	attributes, err := marshalStringMap(e.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
         WITH inserted AS (
-            INSERT INTO employees (company_id, employee_code, user_id, status, hired_at, terminated_at, created_at, updated_at)
-            VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
-            RETURNING id, company_id, employee_code, user_id, status, hired_at, terminated_at, created_at, updated_at
+            INSERT INTO employees (company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, transferred_from_employee_id, created_at, updated_at, attributes)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12::jsonb)
+            RETURNING id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, transferred_from_employee_id, attributes, created_at, updated_at
         )
-        SELECT i.id, i.company_id, i.employee_code, i.user_id, i.status, i.hired_at, i.terminated_at, i.created_at, i.updated_at,
-               u.id, u.email, u.name, u.status, u.created_at, u.updated_at
+        SELECT i.id, i.company_id, i.employee_code, i.user_id, i.department_id, i.manager_employee_id, i.status, i.hired_at, i.terminated_at, i.transferred_from_employee_id, i.attributes, i.created_at, i.updated_at,
+               u.id, u.email, u.name, u.display_name, COALESCE(u.locale, ''), u.status, u.created_at, u.updated_at
           FROM inserted i
           JOIN users u ON u.id = i.user_id
     `,
 		e.CompanyID,
 		e.EmployeeCode,
 		e.UserID,
+		nullableString(e.DepartmentID),
+		nullableString(e.ManagerEmployeeID),
 		string(e.Status),
 		nullableTime(e.HiredAt),
 		nullableTime(e.TerminatedAt),
+		nullableString(e.TransferredFromEmployeeID),
 		e.CreatedAt,
 		e.UpdatedAt,
+		attributes,
 	)
 
 	created, err := scanEmployee(row)
@@ -63,31 +76,42 @@ func (r *EmployeeRepository) Create(ctx context.Context, e *employee.Employee) (
 
 // Update は社員情報を更新します。
 func (r *EmployeeRepository) Update(ctx context.Context, e *employee.Employee) (*employee.Employee, error) {
+	attributes, err := marshalStringMap(e.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
         WITH updated AS (
             UPDATE employees
                SET employee_code = $1,
                    user_id = $2,
-                   status = $3,
-                   hired_at = $4,
-                   terminated_at = $5,
-                   updated_at = $6
-             WHERE id = $7
-            RETURNING id, company_id, employee_code, user_id, status, hired_at, terminated_at, created_at, updated_at
+                   department_id = $3,
+                   manager_employee_id = $4,
+                   status = $5,
+                   hired_at = $6,
+                   terminated_at = $7,
+                   updated_at = $8,
+                   attributes = $10::jsonb
+             WHERE id = $9
+            RETURNING id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, transferred_from_employee_id, attributes, created_at, updated_at
         )
-        SELECT urow.id, urow.company_id, urow.employee_code, urow.user_id, urow.status, urow.hired_at, urow.terminated_at, urow.created_at, urow.updated_at,
-               usr.id, usr.email, usr.name, usr.status, usr.created_at, usr.updated_at
+        SELECT urow.id, urow.company_id, urow.employee_code, urow.user_id, urow.department_id, urow.manager_employee_id, urow.status, urow.hired_at, urow.terminated_at, urow.transferred_from_employee_id, urow.attributes, urow.created_at, urow.updated_at,
+               usr.id, usr.email, usr.name, usr.display_name, COALESCE(usr.locale, ''), usr.status, usr.created_at, usr.updated_at
           FROM updated urow
           JOIN users usr ON usr.id = urow.user_id
     `,
 		e.EmployeeCode,
 		e.UserID,
+		nullableString(e.DepartmentID),
+		nullableString(e.ManagerEmployeeID),
 		string(e.Status),
 		nullableTime(e.HiredAt),
 		nullableTime(e.TerminatedAt),
 		e.UpdatedAt,
 		e.ID,
+		attributes,
 	)
 
 	updated, err := scanEmployee(row)
@@ -102,6 +126,10 @@ func (r *EmployeeRepository) Delete(ctx context.Context, id string) error {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	tag, err := exec.Exec(ctx, `DELETE FROM employees WHERE id = $1`, id)
 	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == employeeForeignKeyViolationCode && pgErr.ConstraintName == "employees_manager_fkey" {
+			return employee.ErrManagerHasReports
+		}
 		return translateEmployeePgError(err)
 	}
 	if tag.RowsAffected() == 0 {
@@ -118,14 +146,20 @@ func (r *EmployeeRepository) FindByID(ctx context.Context, id string) (*employee
                e.company_id,
                e.employee_code,
                e.user_id,
+               e.department_id,
+               e.manager_employee_id,
                e.status,
                e.hired_at,
                e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
                e.created_at,
                e.updated_at,
                u.id,
                u.email,
                u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
                u.status,
                u.created_at,
                u.updated_at
@@ -150,14 +184,20 @@ func (r *EmployeeRepository) FindByCompanyAndCode(ctx context.Context, companyID
                e.company_id,
                e.employee_code,
                e.user_id,
+               e.department_id,
+               e.manager_employee_id,
                e.status,
                e.hired_at,
                e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
                e.created_at,
                e.updated_at,
                u.id,
                u.email,
                u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
                u.status,
                u.created_at,
                u.updated_at
@@ -192,15 +232,53 @@ func (r *EmployeeRepository) List(ctx context.Context, filter employee.ListEmplo
 	conditions := make([]string, 0, 2)
 
 	companyPlaceholder := "$" + strconv.Itoa(len(args)+1)
-	conditions = append(conditions, "e.company_id = "+companyPlaceholder)
+	if filter.IncludeSubsidiaries {
+		conditions = append(conditions, `e.company_id IN (
+            WITH RECURSIVE tree AS (
+                SELECT id FROM companies WHERE id = `+companyPlaceholder+`
+                UNION
+                SELECT c.id FROM companies c JOIN tree t ON c.parent_company_id = t.id
+            )
+            SELECT id FROM tree
+        )`)
+	} else {
+		conditions = append(conditions, "e.company_id = "+companyPlaceholder)
+	}
 	args = append(args, filter.CompanyID)
 
+	if filter.DepartmentID != "" {
+		placeholder := "$" + strconv.Itoa(len(args)+1)
+		if filter.IncludeSubDepartments {
+			conditions = append(conditions, `e.department_id IN (
+            WITH RECURSIVE subtree AS (
+                SELECT id FROM departments WHERE id = `+placeholder+`
+                UNION
+                SELECT d.id FROM departments d JOIN subtree s ON d.parent_department_id = s.id
+            )
+            SELECT id FROM subtree
+        )`)
+		} else {
+			conditions = append(conditions, "e.department_id = "+placeholder)
+		}
+		args = append(args, filter.DepartmentID)
+	}
+
 	if filter.Status != nil {
 		placeholder := "$" + strconv.Itoa(len(args)+1)
 		conditions = append(conditions, "e.status = "+placeholder)
 		args = append(args, string(*filter.Status))
 	}
 
+	if len(filter.Attributes) > 0 {
+		attributes, err := marshalStringMap(filter.Attributes)
+		if err != nil {
+			return nil, "", err
+		}
+		placeholder := "$" + strconv.Itoa(len(args)+1)
+		conditions = append(conditions, "e.attributes @> "+placeholder+"::jsonb")
+		args = append(args, attributes)
+	}
+
 	whereClause := ""
 	if len(conditions) > 0 {
 		whereClause = " WHERE " + strings.Join(conditions, " AND ")
@@ -216,14 +294,20 @@ func (r *EmployeeRepository) List(ctx context.Context, filter employee.ListEmplo
                e.company_id,
                e.employee_code,
                e.user_id,
+               e.department_id,
+               e.manager_employee_id,
                e.status,
                e.hired_at,
                e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
                e.created_at,
                e.updated_at,
                u.id,
                u.email,
                u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
                u.status,
                u.created_at,
                u.updated_at
@@ -263,42 +347,606 @@ func (r *EmployeeRepository) List(ctx context.Context, filter employee.ListEmplo
 	return employees, nextToken, nil
 }
 
+// ListDirectReports は上長に直属する社員の一覧を取得します。
+func (r *EmployeeRepository) ListDirectReports(ctx context.Context, filter employee.ListDirectReportsFilter) ([]*employee.Employee, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM employees e
+          JOIN users u ON u.id = e.user_id
+         WHERE e.manager_employee_id = $1
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT $2
+        OFFSET $3
+    `, filter.ManagerID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", translateEmployeePgError(err)
+	}
+
+	employees, err := collectEmployees(rows, limitWithBuffer)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(employees) == limitWithBuffer {
+		employees = employees[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return employees, nextToken, nil
+}
+
+// ListEmploymentsByUser はユーザーの社員レコードを所属会社の概要とあわせて取得します。
+func (r *EmployeeRepository) ListEmploymentsByUser(ctx context.Context, filter employee.ListEmploymentsFilter) ([]*employee.Employment, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at,
+               c.id,
+               c.name,
+               c.code,
+               c.status,
+               c.parent_company_id
+          FROM employees e
+          JOIN users u ON u.id = e.user_id
+          JOIN companies c ON c.id = e.company_id
+         WHERE e.user_id = $1
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT $2
+        OFFSET $3
+    `, filter.UserID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", translateEmployeePgError(err)
+	}
+	defer rows.Close()
+
+	employments := make([]*employee.Employment, 0, limitWithBuffer)
+	for rows.Next() {
+		var (
+			c        employee.CompanySnapshot
+			parentID sql.NullString
+		)
+		emp, err := scanEmployeeWith(rows, &c.ID, &c.Name, &c.Code, &c.Status, &parentID)
+		if err != nil {
+			return nil, "", translateEmployeePgError(err)
+		}
+		if parentID.Valid {
+			parent := parentID.String
+			c.ParentCompanyID = &parent
+		}
+		employments = append(employments, &employee.Employment{Employee: emp, Company: &c})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", translateEmployeePgError(err)
+	}
+
+	var nextToken string
+	if len(employments) == limitWithBuffer {
+		employments = employments[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return employments, nextToken, nil
+}
+
+// ListReportingChain は社員の上長を直近から最上位まで順に取得します。
+// 報告ラインが循環している場合は、たどった社員（id 自身を含む）に戻った時点で打ち切ります。
+func (r *EmployeeRepository) ListReportingChain(ctx context.Context, id string) ([]*employee.Employee, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        WITH RECURSIVE chain AS (
+            SELECT manager_employee_id AS id, 1 AS depth
+              FROM employees
+             WHERE id = $1 AND manager_employee_id IS NOT NULL
+            UNION ALL
+            SELECT m.manager_employee_id, c.depth + 1
+              FROM employees m
+              JOIN chain c ON m.id = c.id
+             WHERE m.manager_employee_id IS NOT NULL
+        ) CYCLE id SET is_cycle USING path
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM chain c
+          JOIN employees e ON e.id = c.id
+          JOIN users u ON u.id = e.user_id
+         WHERE NOT c.is_cycle AND c.id <> $1
+         ORDER BY c.depth
+    `, id)
+	if err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// ListSubordinates は社員の配下を maxDepth 階層まで浅い順に取得します。
+func (r *EmployeeRepository) ListSubordinates(ctx context.Context, id string, maxDepth int) ([]*employee.Employee, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        WITH RECURSIVE subordinates AS (
+            SELECT id, 1 AS depth
+              FROM employees
+             WHERE manager_employee_id = $1
+            UNION ALL
+            SELECT e.id, s.depth + 1
+              FROM employees e
+              JOIN subordinates s ON e.manager_employee_id = s.id
+             WHERE s.depth < $2
+        )
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM subordinates s
+          JOIN employees e ON e.id = s.id
+          JOIN users u ON u.id = e.user_id
+         ORDER BY s.depth, e.created_at DESC, e.id DESC
+    `, id, maxDepth)
+	if err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// LockReportingLines はトランザクション単位のアドバイザリロックを取得し、上長の付け替えを直列化します。
+// ロックはトランザクションの終了時に解放されます。
+func (r *EmployeeRepository) LockReportingLines(ctx context.Context) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	if _, err := exec.Exec(ctx, `SELECT pg_advisory_xact_lock($1)`, reportingLinesLockKey); err != nil {
+		return translateEmployeePgError(err)
+	}
+	return nil
+}
+
+// ReassignDirectReports は直属の部下の上長をまとめて付け替えます。
+func (r *EmployeeRepository) ReassignDirectReports(ctx context.Context, managerID string, newManagerID *string, updatedAt time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	if _, err := exec.Exec(ctx, `
+        UPDATE employees
+           SET manager_employee_id = $1,
+               updated_at = $2
+         WHERE manager_employee_id = $3
+    `, nullableString(newManagerID), updatedAt, managerID); err != nil {
+		return translateEmployeePgError(err)
+	}
+	return nil
+}
+
+// ListDueStatusTransitions は入社日・退職日を迎えたのに状態が追随していない社員を取得します。
+func (r *EmployeeRepository) ListDueStatusTransitions(ctx context.Context, asOf time.Time) ([]*employee.Employee, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM employees e
+          JOIN users u ON u.id = e.user_id
+         WHERE (e.status = $2 AND (e.hired_at IS NULL OR e.hired_at <= $1))
+            OR (e.status <> $3 AND e.terminated_at <= $1)
+         ORDER BY e.company_id, e.employee_code
+    `, asOf, string(employee.StatusPending), string(employee.StatusTerminated))
+	if err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// FindTerminatedByCompanyAndUser は会社とユーザーに紐づく退職済みの社員のうち最後に更新されたものを取得します。
+func (r *EmployeeRepository) FindTerminatedByCompanyAndUser(ctx context.Context, companyID, userID string) (*employee.Employee, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM employees e
+          JOIN users u ON u.id = e.user_id
+         WHERE e.company_id = $1
+           AND e.user_id = $2
+           AND e.status = $3
+         ORDER BY e.updated_at DESC, e.id DESC
+         LIMIT 1
+    `, companyID, userID, string(employee.StatusTerminated))
+
+	found, err := scanEmployee(row)
+	if err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+	return found, nil
+}
+
+// NextCodeSequence は会社の次の連番を払い出します。UPDATE による行ロックで同時実行時の重複を防ぎます。
+func (r *EmployeeRepository) NextCodeSequence(ctx context.Context, companyID string) (*employee.CodeSequence, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	var seq employee.CodeSequence
+	err := exec.QueryRow(ctx, `
+        UPDATE companies
+           SET employee_code_next_sequence = employee_code_next_sequence + 1
+         WHERE id = $1
+        RETURNING employee_code_prefix, employee_code_width, employee_code_next_sequence - 1
+    `, companyID).Scan(&seq.Prefix, &seq.Width, &seq.Sequence)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employee.ErrCompanyNotFound
+		}
+		return nil, translateEmployeePgError(err)
+	}
+	return &seq, nil
+}
+
+// RecordHistory は有効中の履歴を閉じ、新しい履歴を保存します。
+func (r *EmployeeRepository) RecordHistory(ctx context.Context, entry *employee.HistoryEntry) (*employee.HistoryEntry, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	effectiveFrom := nullableTime(&entry.EffectiveFrom)
+
+	if _, err := exec.Exec(ctx, `
+        DELETE FROM employee_history
+         WHERE employee_id = $1 AND effective_to IS NULL AND effective_from >= $2
+    `, entry.EmployeeID, effectiveFrom); err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+
+	if _, err := exec.Exec(ctx, `
+        UPDATE employee_history
+           SET effective_to = $2
+         WHERE employee_id = $1 AND effective_to IS NULL
+    `, entry.EmployeeID, effectiveFrom); err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+
+	row := exec.QueryRow(ctx, `
+        INSERT INTO employee_history (employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, effective_from, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING `+historyColumns,
+		entry.EmployeeID,
+		entry.CompanyID,
+		entry.EmployeeCode,
+		entry.UserID,
+		nullableString(entry.DepartmentID),
+		nullableString(entry.ManagerEmployeeID),
+		string(entry.Status),
+		nullableTime(entry.HiredAt),
+		nullableTime(entry.TerminatedAt),
+		effectiveFrom,
+		entry.CreatedAt,
+	)
+
+	recorded, err := scanHistoryEntry(row)
+	if err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+	return recorded, nil
+}
+
+// ListHistory は社員の履歴を有効開始日の降順で取得します。
+func (r *EmployeeRepository) ListHistory(ctx context.Context, filter employee.ListHistoryFilter) ([]*employee.HistoryEntry, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT `+historyColumns+`
+          FROM employee_history
+         WHERE employee_id = $1
+         ORDER BY effective_from DESC, id DESC
+         LIMIT $2
+        OFFSET $3
+    `, filter.EmployeeID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", translateEmployeePgError(err)
+	}
+	defer rows.Close()
+
+	entries := make([]*employee.HistoryEntry, 0, filter.Limit)
+	for rows.Next() {
+		entry, err := scanHistoryEntry(rows)
+		if err != nil {
+			return nil, "", translateEmployeePgError(err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", translateEmployeePgError(err)
+	}
+
+	var nextToken string
+	if len(entries) == limitWithBuffer {
+		entries = entries[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return entries, nextToken, nil
+}
+
+// FindHistoryAsOf は指定日に有効だった履歴を取得します。
+func (r *EmployeeRepository) FindHistoryAsOf(ctx context.Context, employeeID string, date time.Time) (*employee.HistoryEntry, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT `+historyColumns+`
+          FROM employee_history
+         WHERE employee_id = $1
+           AND effective_from <= $2
+           AND (effective_to IS NULL OR effective_to > $2)
+         LIMIT 1
+    `, employeeID, nullableTime(&date))
+
+	found, err := scanHistoryEntry(row)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, employee.ErrHistoryNotFound
+		}
+		return nil, translateEmployeePgError(err)
+	}
+	return found, nil
+}
+
+const historyColumns = `id, employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, effective_from, effective_to, created_at`
+
+func scanHistoryEntry(row pgx.Row) (*employee.HistoryEntry, error) {
+	var (
+		entry         employee.HistoryEntry
+		departmentID  sql.NullString
+		managerID     sql.NullString
+		status        string
+		hiredAt       sql.NullTime
+		terminatedAt  sql.NullTime
+		effectiveFrom time.Time
+		effectiveTo   sql.NullTime
+	)
+
+	if err := row.Scan(
+		&entry.ID,
+		&entry.EmployeeID,
+		&entry.CompanyID,
+		&entry.EmployeeCode,
+		&entry.UserID,
+		&departmentID,
+		&managerID,
+		&status,
+		&hiredAt,
+		&terminatedAt,
+		&effectiveFrom,
+		&effectiveTo,
+		&entry.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if departmentID.Valid {
+		department := departmentID.String
+		entry.DepartmentID = &department
+	}
+	if managerID.Valid {
+		manager := managerID.String
+		entry.ManagerEmployeeID = &manager
+	}
+	entry.Status = employee.Status(status)
+	entry.HiredAt = nullDate(hiredAt)
+	entry.TerminatedAt = nullDate(terminatedAt)
+	entry.EffectiveFrom = *nullDate(sql.NullTime{Time: effectiveFrom, Valid: true})
+	entry.EffectiveTo = nullDate(effectiveTo)
+	return &entry, nil
+}
+
+// nullDate は DATE 列の値を UTC の日付に揃えます。
+func nullDate(value sql.NullTime) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	t := value.Time.UTC()
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return &date
+}
+
+func collectEmployees(rows pgx.Rows, capacity int) ([]*employee.Employee, error) {
+	defer rows.Close()
+
+	employees := make([]*employee.Employee, 0, capacity)
+	for rows.Next() {
+		emp, err := scanEmployee(rows)
+		if err != nil {
+			return nil, translateEmployeePgError(err)
+		}
+		employees = append(employees, emp)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, translateEmployeePgError(err)
+	}
+	return employees, nil
+}
+
 func scanEmployee(row pgx.Row) (*employee.Employee, error) {
+	return scanEmployeeWith(row)
+}
+
+// scanEmployeeWith は社員とユーザーの列に続く列を extra へ読み取ります。
+func scanEmployeeWith(row pgx.Row, extra ...any) (*employee.Employee, error) {
 	var (
 		id           string
 		companyID    string
 		code         string
 		userID       string
+		departmentID sql.NullString
+		managerID    sql.NullString
 		status       string
 		hiredAt      sql.NullTime
 		terminatedAt sql.NullTime
+		transferred  sql.NullString
+		attributes   []byte
 		createdAt    time.Time
 		updatedAt    time.Time
 		userJoinedID string
 		userEmail    string
 		userName     string
+		userDisplay  string
+		userLocale   string
 		userStatus   string
 		userCreated  time.Time
 		userUpdated  time.Time
 	)
 
-	if err := row.Scan(
+	dest := []any{
 		&id,
 		&companyID,
 		&code,
 		&userID,
+		&departmentID,
+		&managerID,
 		&status,
 		&hiredAt,
 		&terminatedAt,
+		&transferred,
+		&attributes,
 		&createdAt,
 		&updatedAt,
 		&userJoinedID,
 		&userEmail,
 		&userName,
+		&userDisplay,
+		&userLocale,
 		&userStatus,
 		&userCreated,
 		&userUpdated,
-	); err != nil {
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, employee.ErrEmployeeNotFound
 		}
@@ -319,23 +967,52 @@ func scanEmployee(row pgx.Row) (*employee.Employee, error) {
 		terminatedPtr = &date
 	}
 
+	var departmentPtr *string
+	if departmentID.Valid {
+		department := departmentID.String
+		departmentPtr = &department
+	}
+
+	var managerPtr *string
+	if managerID.Valid {
+		manager := managerID.String
+		managerPtr = &manager
+	}
+
+	var transferredPtr *string
+	if transferred.Valid {
+		from := transferred.String
+		transferredPtr = &from
+	}
+
+	attributeMap, err := unmarshalStringMap(attributes)
+	if err != nil {
+		return nil, err
+	}
+
 	return &employee.Employee{
-		ID:           id,
-		CompanyID:    companyID,
-		EmployeeCode: code,
-		UserID:       userID,
-		Status:       employee.Status(status),
-		HiredAt:      hiredPtr,
-		TerminatedAt: terminatedPtr,
-		CreatedAt:    createdAt,
-		UpdatedAt:    updatedAt,
+		ID:                        id,
+		CompanyID:                 companyID,
+		EmployeeCode:              code,
+		UserID:                    userID,
+		DepartmentID:              departmentPtr,
+		ManagerEmployeeID:         managerPtr,
+		Status:                    employee.Status(status),
+		HiredAt:                   hiredPtr,
+		TerminatedAt:              terminatedPtr,
+		TransferredFromEmployeeID: transferredPtr,
+		Attributes:                attributeMap,
+		CreatedAt:                 createdAt,
+		UpdatedAt:                 updatedAt,
 		User: &employee.UserSnapshot{
-			ID:        userJoinedID,
-			Email:     userEmail,
-			Name:      userName,
-			Status:    userStatus,
-			CreatedAt: userCreated,
-			UpdatedAt: userUpdated,
+			ID:          userJoinedID,
+			Email:       userEmail,
+			Name:        userName,
+			DisplayName: userDisplay,
+			Locale:      userLocale,
+			Status:      userStatus,
+			CreatedAt:   userCreated,
+			UpdatedAt:   userUpdated,
 		},
 	}, nil
 }
@@ -359,10 +1036,22 @@ func translateEmployeePgError(err error) error {
 				return employee.ErrCompanyNotFound
 			case "employees_user_id_fkey":
 				return employee.ErrUserNotFound
+			case "employees_department_fkey":
+				return employee.ErrDepartmentNotFound
+			case "employees_manager_fkey":
+				return employee.ErrManagerNotFound
+			case "employee_history_employee_id_fkey", "employees_transferred_from_fkey":
+				return employee.ErrEmployeeNotFound
 			default:
 				return err
 			}
 		case employeeCheckViolationCode:
+			switch pgErr.ConstraintName {
+			case "employees_manager_not_self":
+				return employee.ErrReportingCycle
+			case "employees_status_valid":
+				return employee.ErrInvalidStatus
+			}
 			return employee.ErrInvalidDateRange
 		}
 	}
@@ -370,6 +1059,32 @@ func translateEmployeePgError(err error) error {
 	return err
 }
 
+// marshalStringMap は社員属性・ラベルを JSONB 用の文字列へ変換します。
+func marshalStringMap(values map[string]string) (string, error) {
+	if len(values) == 0 {
+		return "{}", nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalStringMap(raw []byte) (map[string]string, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
 func nullableTime(value *time.Time) any {
 	if value == nil {
 		return nil
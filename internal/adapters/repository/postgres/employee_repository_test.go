@@ -35,32 +35,47 @@ func TestScanEmployee_Success(t *testing.T) {
 	userUpdated := updatedAt
 
 	row := stubEmployeeRow{scanFn: func(dest ...interface{}) error {
-		if len(dest) != 15 {
+		if len(dest) != 21 {
 			return errors.New("unexpected dest length")
 		}
 		*(dest[0].(*string)) = "emp-1"
 		*(dest[1].(*string)) = "company-1"
 		*(dest[2].(*string)) = "emp-001"
 		*(dest[3].(*string)) = userID
-		*(dest[4].(*string)) = string(employee.StatusActive)
+		dept := dest[4].(*sql.NullString)
+		dept.String = "dept-1"
+		dept.Valid = true
+		manager := dest[5].(*sql.NullString)
+		manager.String = "emp-0"
+		manager.Valid = true
 
-		hiredDest := dest[5].(*sql.NullTime)
+		*(dest[6].(*string)) = string(employee.StatusActive)
+
+		hiredDest := dest[7].(*sql.NullTime)
 		hiredDest.Time = hired
 		hiredDest.Valid = true
 
-		termDest := dest[6].(*sql.NullTime)
+		termDest := dest[8].(*sql.NullTime)
 		termDest.Time = terminated
 		termDest.Valid = true
 
-		*(dest[7].(*time.Time)) = createdAt
-		*(dest[8].(*time.Time)) = updatedAt
+		transferred := dest[9].(*sql.NullString)
+		transferred.String = "emp-old"
+		transferred.Valid = true
+
+		*(dest[10].(*[]byte)) = []byte(`{"grade":"G3"}`)
 
-		*(dest[9].(*string)) = userID
-		*(dest[10].(*string)) = email
-		*(dest[11].(*string)) = "Taro Yamada"
-		*(dest[12].(*string)) = "active"
-		*(dest[13].(*time.Time)) = userCreated
-		*(dest[14].(*time.Time)) = userUpdated
+		*(dest[11].(*time.Time)) = createdAt
+		*(dest[12].(*time.Time)) = updatedAt
+
+		*(dest[13].(*string)) = userID
+		*(dest[14].(*string)) = email
+		*(dest[15].(*string)) = "Taro Yamada"
+		*(dest[16].(*string)) = "山田 太郎"
+		*(dest[17].(*string)) = "ja-JP"
+		*(dest[18].(*string)) = "active"
+		*(dest[19].(*time.Time)) = userCreated
+		*(dest[20].(*time.Time)) = userUpdated
 		return nil
 	}}
 
@@ -75,12 +90,27 @@ func TestScanEmployee_Success(t *testing.T) {
 	if emp.User == nil || emp.User.Email != email {
 		t.Fatalf("expected user snapshot email %s", email)
 	}
+	if emp.User.DisplayName != "山田 太郎" || emp.User.Locale != "ja-JP" {
+		t.Fatalf("unexpected user snapshot profile %+v", emp.User)
+	}
 	if emp.HiredAt == nil || !emp.HiredAt.Equal(hired) {
 		t.Fatalf("expected hired date, got %+v", emp.HiredAt)
 	}
 	if emp.TerminatedAt == nil || !emp.TerminatedAt.Equal(terminated) {
 		t.Fatalf("expected terminated date, got %+v", emp.TerminatedAt)
 	}
+	if emp.DepartmentID == nil || *emp.DepartmentID != "dept-1" {
+		t.Fatalf("expected department dept-1, got %+v", emp.DepartmentID)
+	}
+	if emp.ManagerEmployeeID == nil || *emp.ManagerEmployeeID != "emp-0" {
+		t.Fatalf("expected manager emp-0, got %+v", emp.ManagerEmployeeID)
+	}
+	if emp.TransferredFromEmployeeID == nil || *emp.TransferredFromEmployeeID != "emp-old" {
+		t.Fatalf("expected transferred from emp-old, got %+v", emp.TransferredFromEmployeeID)
+	}
+	if emp.Attributes["grade"] != "G3" {
+		t.Fatalf("expected attribute grade G3, got %+v", emp.Attributes)
+	}
 }
 
 func TestScanEmployee_NoRows(t *testing.T) {
@@ -114,6 +144,21 @@ func TestTranslateEmployeePgError(t *testing.T) {
 		t.Fatalf("expected user fk violation to map to ErrUserNotFound")
 	}
 
+	fkDepartmentErr := &pgconn.PgError{Code: employeeForeignKeyViolationCode, ConstraintName: "employees_department_fkey"}
+	if !errors.Is(translateEmployeePgError(fkDepartmentErr), employee.ErrDepartmentNotFound) {
+		t.Fatalf("expected department fk violation to map to ErrDepartmentNotFound")
+	}
+
+	fkManagerErr := &pgconn.PgError{Code: employeeForeignKeyViolationCode, ConstraintName: "employees_manager_fkey"}
+	if !errors.Is(translateEmployeePgError(fkManagerErr), employee.ErrManagerNotFound) {
+		t.Fatalf("expected manager fk violation to map to ErrManagerNotFound")
+	}
+
+	selfManagerErr := &pgconn.PgError{Code: employeeCheckViolationCode, ConstraintName: "employees_manager_not_self"}
+	if !errors.Is(translateEmployeePgError(selfManagerErr), employee.ErrReportingCycle) {
+		t.Fatalf("expected manager check violation to map to ErrReportingCycle")
+	}
+
 	checkErr := &pgconn.PgError{Code: employeeCheckViolationCode}
 	if !errors.Is(translateEmployeePgError(checkErr), employee.ErrInvalidDateRange) {
 		t.Fatalf("expected check violation to map to ErrInvalidDateRange")
@@ -142,14 +187,20 @@ func TestEmployeeRepository_List_WithFilters(t *testing.T) {
                e.company_id,
                e.employee_code,
                e.user_id,
+               e.department_id,
+               e.manager_employee_id,
                e.status,
                e.hired_at,
                e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
                e.created_at,
                e.updated_at,
                u.id,
                u.email,
                u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
                u.status,
                u.created_at,
                u.updated_at
@@ -166,10 +217,10 @@ func TestEmployeeRepository_List_WithFilters(t *testing.T) {
 		"22222222-2222-2222-2222-222222222222",
 		"33333333-3333-3333-3333-333333333333",
 	}
-	rows := pgxmock.NewRows([]string{"id", "company_id", "employee_code", "user_id", "status", "hired_at", "terminated_at", "created_at", "updated_at", "user_id_join", "user_email", "user_name", "user_status", "user_created_at", "user_updated_at"}).
-		AddRow("emp-1", "company-1", "emp-1", userIDs[0], string(employee.StatusActive), nil, nil, now, now, userIDs[0], "user1@example.com", "User One", "active", now, now).
-		AddRow("emp-2", "company-1", "emp-2", userIDs[1], string(employee.StatusActive), nil, nil, now, now, userIDs[1], "user2@example.com", "User Two", "active", now, now).
-		AddRow("emp-3", "company-1", "emp-3", userIDs[2], string(employee.StatusInactive), nil, nil, now, now, userIDs[2], "user3@example.com", "User Three", "inactive", now, now)
+	rows := pgxmock.NewRows([]string{"id", "company_id", "employee_code", "user_id", "department_id", "manager_employee_id", "status", "hired_at", "terminated_at", "transferred_from_employee_id", "attributes", "created_at", "updated_at", "user_id_join", "user_email", "user_name", "user_display_name", "user_locale", "user_status", "user_created_at", "user_updated_at"}).
+		AddRow("emp-1", "company-1", "emp-1", userIDs[0], nil, nil, string(employee.StatusActive), nil, nil, nil, []byte("{}"), now, now, userIDs[0], "user1@example.com", "User One", "User One", "", "active", now, now).
+		AddRow("emp-2", "company-1", "emp-2", userIDs[1], nil, nil, string(employee.StatusActive), nil, nil, nil, []byte("{}"), now, now, userIDs[1], "user2@example.com", "User Two", "User Two", "en-US", "active", now, now).
+		AddRow("emp-3", "company-1", "emp-3", userIDs[2], nil, nil, string(employee.StatusTerminated), nil, nil, nil, []byte("{}"), now, now, userIDs[2], "user3@example.com", "User Three", "User Three", "", "inactive", now, now)
 
 	mock.ExpectQuery(query).
 		WithArgs("company-1", string(status), 3, 0).
@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+const jobRunColumns = `id, job_name, status, scheduled_at, started_at, finished_at, error`
+
+// JobRunRepository は PostgreSQL を利用したジョブ実行記録の実装です。
+type JobRunRepository struct {
+	pool pgdb.Queryer
+}
+
+// NewJobRunRepository は JobRunRepository を生成します。
+func NewJobRunRepository(pool pgdb.Queryer) *JobRunRepository {
+	return &JobRunRepository{pool: pool}
+}
+
+// CreateRun はジョブの実行開始を記録します。
+func (r *JobRunRepository) CreateRun(ctx context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO job_runs (job_name, status, scheduled_at, started_at, finished_at, error)
+        VALUES ($1, $2, $3, $4, $5, $6)
+        RETURNING `+jobRunColumns,
+		run.JobName, string(run.Status), run.ScheduledAt, run.StartedAt, run.FinishedAt, run.Error)
+
+	created, err := scanJobRun(row)
+	if err != nil {
+		return nil, translateJobRunPgError(err)
+	}
+	return created, nil
+}
+
+// FinishRun はジョブの実行結果を記録します。
+func (r *JobRunRepository) FinishRun(ctx context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        UPDATE job_runs
+           SET status = $1,
+               finished_at = $2,
+               error = $3
+         WHERE id = $4
+        RETURNING `+jobRunColumns,
+		string(run.Status), run.FinishedAt, run.Error, run.ID)
+
+	updated, err := scanJobRun(row)
+	if err != nil {
+		return nil, translateJobRunPgError(err)
+	}
+	return updated, nil
+}
+
+// ListRuns は jobName の実行記録を予定時刻の降順で返します。
+func (r *JobRunRepository) ListRuns(ctx context.Context, jobName string, limit int) ([]*scheduler.Run, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	rows, err := exec.Query(ctx, `
+        SELECT `+jobRunColumns+`
+          FROM job_runs
+         WHERE job_name = $1
+         ORDER BY scheduled_at DESC
+         LIMIT $2
+    `, jobName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]*scheduler.Run, 0, limit)
+	for rows.Next() {
+		run, err := scanJobRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func scanJobRun(row pgx.Row) (*scheduler.Run, error) {
+	var (
+		run        scheduler.Run
+		status     string
+		finishedAt *time.Time
+		message    *string
+	)
+	if err := row.Scan(&run.ID, &run.JobName, &status, &run.ScheduledAt, &run.StartedAt, &finishedAt, &message); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, scheduler.ErrRunNotFound
+		}
+		return nil, err
+	}
+	run.Status = scheduler.RunStatus(status)
+	run.FinishedAt = finishedAt
+	run.Error = message
+	return &run, nil
+}
+
+func translateJobRunPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode {
+		return scheduler.ErrRunAlreadyExists
+	}
+	return err
+}
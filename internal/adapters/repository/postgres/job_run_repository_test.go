@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestScanJobRun_NoRows(t *testing.T) {
+	t.Parallel()
+
+	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
+		return pgx.ErrNoRows
+	}}
+
+	if _, err := scanJobRun(row); !errors.Is(err, scheduler.ErrRunNotFound) {
+		t.Fatalf("expected ErrRunNotFound, got %v", err)
+	}
+}
+
+func TestJobRunRepository_CreateRun_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewJobRunRepository(mock)
+	scheduledAt := time.Date(2025, 3, 3, 0, 5, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO job_runs")).
+		WithArgs("nightly", string(scheduler.RunStatusRunning), scheduledAt, scheduledAt, (*time.Time)(nil), (*string)(nil)).
+		WillReturnError(&pgconn.PgError{Code: uniqueViolationCode})
+
+	_, err = repo.CreateRun(context.Background(), &scheduler.Run{
+		JobName:     "nightly",
+		Status:      scheduler.RunStatusRunning,
+		ScheduledAt: scheduledAt,
+		StartedAt:   scheduledAt,
+	})
+	if !errors.Is(err, scheduler.ErrRunAlreadyExists) {
+		t.Fatalf("expected ErrRunAlreadyExists, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package postgres
+
+import (
+	"strconv"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+)
+
+// labelSelectorConditions はラベルセレクタを column の JSONB に対する条件式へ変換し、args にプレースホルダの値を追加します。
+// 一致は @>、存在は ? で表すため、GIN インデックス（jsonb_ops）を利用できます。
+func labelSelectorConditions(column string, selector label.Selector, args []any) ([]string, []any, error) {
+	conditions := make([]string, 0, len(selector))
+	for _, req := range selector {
+		placeholder := "$" + strconv.Itoa(len(args)+1)
+		switch req.Operator {
+		case label.OperatorEquals, label.OperatorNotEquals:
+			value, err := marshalStringMap(map[string]string{req.Key: req.Value})
+			if err != nil {
+				return nil, nil, err
+			}
+			condition := column + " @> " + placeholder + "::jsonb"
+			if req.Operator == label.OperatorNotEquals {
+				condition = "NOT (" + condition + ")"
+			}
+			conditions = append(conditions, condition)
+			args = append(args, value)
+		case label.OperatorExists:
+			conditions = append(conditions, column+" ? "+placeholder)
+			args = append(args, req.Key)
+		case label.OperatorDoesNotExist:
+			conditions = append(conditions, "NOT ("+column+" ? "+placeholder+")")
+			args = append(args, req.Key)
+		}
+	}
+	return conditions, args, nil
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+)
+
+const passwordSetupTokenColumns = `id, user_id, token_hash, expires_at, used_at, created_at`
+
+// PasswordSetupTokenRepository は PostgreSQL を利用したパスワード設定トークンの実装です。
+type PasswordSetupTokenRepository struct {
+	pool pgdb.Queryer
+}
+
+// NewPasswordSetupTokenRepository は PasswordSetupTokenRepository を生成します。
+func NewPasswordSetupTokenRepository(pool pgdb.Queryer) *PasswordSetupTokenRepository {
+	return &PasswordSetupTokenRepository{pool: pool}
+}
+
+// Create はトークンを保存します。
+func (r *PasswordSetupTokenRepository) Create(ctx context.Context, token *auth.PasswordSetupToken) (*auth.PasswordSetupToken, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        INSERT INTO password_setup_tokens (user_id, token_hash, expires_at, used_at, created_at)
+        VALUES ($1, $2, $3, $4, $5)
+        RETURNING `+passwordSetupTokenColumns,
+		token.UserID, token.TokenHash, token.ExpiresAt, token.UsedAt, token.CreatedAt)
+
+	created, err := scanPasswordSetupToken(row)
+	if err != nil {
+		return nil, translatePasswordSetupTokenPgError(err)
+	}
+	return created, nil
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *PasswordSetupTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*auth.PasswordSetupToken, error) {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	row := exec.QueryRow(ctx, `
+        SELECT `+passwordSetupTokenColumns+`
+          FROM password_setup_tokens
+         WHERE token_hash = $1
+    `, tokenHash)
+
+	return scanPasswordSetupToken(row)
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *PasswordSetupTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	tag, err := exec.Exec(ctx, `
+        UPDATE password_setup_tokens
+           SET used_at = $1
+         WHERE id = $2
+           AND used_at IS NULL
+    `, usedAt, id)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrInvalidSetupToken
+	}
+	return nil
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *PasswordSetupTokenRepository) DeleteUnusedByUser(ctx context.Context, userID string) error {
+	exec := pgdb.QueryerFromContext(ctx, r.pool)
+	_, err := exec.Exec(ctx, `DELETE FROM password_setup_tokens WHERE user_id = $1 AND used_at IS NULL`, userID)
+	return err
+}
+
+func scanPasswordSetupToken(row pgx.Row) (*auth.PasswordSetupToken, error) {
+	var token auth.PasswordSetupToken
+	if err := row.Scan(&token.ID, &token.UserID, &token.TokenHash, &token.ExpiresAt, &token.UsedAt, &token.CreatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, auth.ErrInvalidSetupToken
+		}
+		return nil, err
+	}
+	return &token, nil
+}
+
+// translatePasswordSetupTokenPgError は存在しないユーザーへのトークン発行を ErrUserNotFound に変換します。
+func translatePasswordSetupTokenPgError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolationCode {
+		return auth.ErrUserNotFound
+	}
+	return err
+}
@@ -0,0 +1,80 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestScanPasswordSetupToken_NoRows(t *testing.T) {
+	t.Parallel()
+
+	row := stubCompanyRow{scanFn: func(dest ...interface{}) error {
+		return pgx.ErrNoRows
+	}}
+
+	if _, err := scanPasswordSetupToken(row); !errors.Is(err, auth.ErrInvalidSetupToken) {
+		t.Fatalf("expected ErrInvalidSetupToken, got %v", err)
+	}
+}
+
+func TestPasswordSetupTokenRepository_Create_UnknownUser(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewPasswordSetupTokenRepository(mock)
+	now := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO password_setup_tokens")).
+		WithArgs("user-1", "hash", now.Add(time.Hour), (*time.Time)(nil), now).
+		WillReturnError(&pgconn.PgError{Code: foreignKeyViolationCode})
+
+	_, err = repo.Create(context.Background(), &auth.PasswordSetupToken{
+		UserID:    "user-1",
+		TokenHash: "hash",
+		ExpiresAt: now.Add(time.Hour),
+		CreatedAt: now,
+	})
+	if !errors.Is(err, auth.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestPasswordSetupTokenRepository_MarkUsed_AlreadyUsed(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewPasswordSetupTokenRepository(mock)
+	usedAt := time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE password_setup_tokens")).
+		WithArgs(usedAt, "token-1").
+		WillReturnResult(pgxmock.NewResult("UPDATE", 0))
+
+	if err := repo.MarkUsed(context.Background(), "token-1", usedAt); !errors.Is(err, auth.ErrInvalidSetupToken) {
+		t.Fatalf("expected ErrInvalidSetupToken, got %v", err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
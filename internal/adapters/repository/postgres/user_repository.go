@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strconv"
 	"strings"
@@ -13,7 +14,12 @@ import (
 	pgdb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
 )
 
-const uniqueViolationCode = "23505"
+const (
+	uniqueViolationCode     = "23505"
+	foreignKeyViolationCode = "23503"
+
+	employeeUserForeignKey = "employees_user_id_fkey"
+)
 
 // UserRepository は PostgreSQL を利用したユーザー永続化の実装です。
 type UserRepository struct {
@@ -27,12 +33,17 @@ func NewUserRepository(pool pgdb.Queryer) *UserRepository {
 
 // Create はユーザーを新規作成します。
 func (r *UserRepository) Create(ctx context.Context, u *user.User) (*user.User, error) {
+	labels, err := marshalStringMap(u.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        INSERT INTO users (email, name, status, created_at, updated_at)
-        VALUES ($1, $2, $3, $4, $5)
-        RETURNING id, email, name, status, created_at, updated_at
-    `, u.Email, u.Name, u.Status, u.CreatedAt, u.UpdatedAt)
+        INSERT INTO users (email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12::jsonb, $13, $14)
+        RETURNING id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+    `, u.Email, u.EmailVerifiedAt, u.Name, nullableString(u.FamilyName), nullableString(u.GivenName), u.DisplayName, nullableString(u.Locale), nullableString(u.TimeZone), nullableString(u.Phone), nullableString(u.AvatarURL), u.Status, labels, u.CreatedAt, u.UpdatedAt)
 
 	created, err := scanUser(row)
 	if err != nil {
@@ -43,15 +54,30 @@ func (r *UserRepository) Create(ctx context.Context, u *user.User) (*user.User,
 
 // Update はユーザー情報を更新します。
 func (r *UserRepository) Update(ctx context.Context, u *user.User) (*user.User, error) {
+	labels, err := marshalStringMap(u.Labels)
+	if err != nil {
+		return nil, err
+	}
+
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
         UPDATE users
-           SET name = $1,
-               status = $2,
-               updated_at = $3
-         WHERE id = $4
-        RETURNING id, email, name, status, created_at, updated_at
-    `, u.Name, u.Status, u.UpdatedAt, u.ID)
+           SET email = $1,
+               email_verified_at = $2,
+               name = $3,
+               family_name = $4,
+               given_name = $5,
+               display_name = $6,
+               locale = $7,
+               time_zone = $8,
+               phone = $9,
+               avatar_url = $10,
+               status = $11,
+               labels = $12::jsonb,
+               updated_at = $13
+         WHERE id = $14
+        RETURNING id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+    `, u.Email, u.EmailVerifiedAt, u.Name, nullableString(u.FamilyName), nullableString(u.GivenName), u.DisplayName, nullableString(u.Locale), nullableString(u.TimeZone), nullableString(u.Phone), nullableString(u.AvatarURL), u.Status, labels, u.UpdatedAt, u.ID)
 
 	updated, err := scanUser(row)
 	if err != nil {
@@ -60,7 +86,7 @@ func (r *UserRepository) Update(ctx context.Context, u *user.User) (*user.User,
 	return updated, nil
 }
 
-// Delete はユーザーを削除します。
+// Delete はユーザーを削除します。社員から参照されている場合は削除しません。
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	tag, err := exec.Exec(ctx, `DELETE FROM users WHERE id = $1`, id)
@@ -77,7 +103,7 @@ func (r *UserRepository) Delete(ctx context.Context, id string) error {
 func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        SELECT id, email, name, status, created_at, updated_at
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
           FROM users
          WHERE id = $1
          LIMIT 1
@@ -94,7 +120,7 @@ func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, e
 func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
 	exec := pgdb.QueryerFromContext(ctx, r.pool)
 	row := exec.QueryRow(ctx, `
-        SELECT id, email, name, status, created_at, updated_at
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
           FROM users
          WHERE email = $1
          LIMIT 1
@@ -127,6 +153,12 @@ func (r *UserRepository) List(ctx context.Context, filter user.ListUsersFilter)
 		args = append(args, *filter.Status)
 	}
 
+	labelConditions, args, err := labelSelectorConditions("labels", filter.LabelSelector, args)
+	if err != nil {
+		return nil, "", err
+	}
+	conditions = append(conditions, labelConditions...)
+
 	whereClause := ""
 	if len(conditions) > 0 {
 		whereClause = " WHERE " + strings.Join(conditions, " AND ")
@@ -138,7 +170,7 @@ func (r *UserRepository) List(ctx context.Context, filter user.ListUsersFilter)
 	args = append(args, filter.Offset)
 
 	query := `
-        SELECT id, email, name, status, created_at, updated_at
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
           FROM users` + whereClause + `
          ORDER BY created_at DESC, id DESC
          LIMIT ` + limitPlaceholder + `
@@ -178,33 +210,59 @@ func scanUser(row pgx.Row) (*user.User, error) {
 	var (
 		id                   string
 		email                string
+		emailVerifiedAt      *time.Time
 		name                 string
+		familyName           sql.NullString
+		givenName            sql.NullString
+		displayName          string
+		locale               sql.NullString
+		timeZone             sql.NullString
+		phone                sql.NullString
+		avatarURL            sql.NullString
 		status               string
+		labels               []byte
 		createdAt, updatedAt time.Time
 	)
 
-	if err := row.Scan(&id, &email, &name, &status, &createdAt, &updatedAt); err != nil {
+	if err := row.Scan(&id, &email, &emailVerifiedAt, &name, &familyName, &givenName, &displayName, &locale, &timeZone, &phone, &avatarURL, &status, &labels, &createdAt, &updatedAt); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, user.ErrUserNotFound
 		}
 		return nil, err
 	}
 
+	labelMap, err := unmarshalStringMap(labels)
+	if err != nil {
+		return nil, err
+	}
+
 	return &user.User{
-		ID:        id,
-		Email:     email,
-		Name:      name,
-		Status:    user.Status(status),
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		ID:              id,
+		Email:           email,
+		EmailVerifiedAt: emailVerifiedAt,
+		Name:            name,
+		FamilyName:      stringPtr(familyName),
+		GivenName:       stringPtr(givenName),
+		DisplayName:     displayName,
+		Locale:          stringPtr(locale),
+		TimeZone:        stringPtr(timeZone),
+		Phone:           stringPtr(phone),
+		AvatarURL:       stringPtr(avatarURL),
+		Status:          user.Status(status),
+		Labels:          labelMap,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
 	}, nil
 }
 
 func translatePgError(err error) error {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
-		if pgErr.Code == uniqueViolationCode {
+		switch {
+		case pgErr.Code == uniqueViolationCode:
 			return user.ErrEmailAlreadyExists
+		case pgErr.Code == foreignKeyViolationCode && pgErr.ConstraintName == employeeUserForeignKey:
+			return user.ErrUserHasEmployments
 		}
 	}
 	return err
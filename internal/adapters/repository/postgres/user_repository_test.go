@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"regexp"
 	"testing"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
 	pgxmock "github.com/pashagolub/pgxmock/v4"
 )
@@ -26,17 +28,27 @@ func TestScanUser_Success(t *testing.T) {
 
 	createdAt := time.Now().UTC()
 	updatedAt := createdAt.Add(time.Minute)
+	verifiedAt := createdAt.Add(time.Second)
 
 	row := stubRow{scanFn: func(dest ...interface{}) error {
-		if len(dest) != 6 {
+		if len(dest) != 15 {
 			return errors.New("unexpected dest length")
 		}
 		*(dest[0].(*string)) = "user-1"
 		*(dest[1].(*string)) = "user@example.com"
-		*(dest[2].(*string)) = "User"
-		*(dest[3].(*string)) = string(user.StatusActive)
-		*(dest[4].(*time.Time)) = createdAt
-		*(dest[5].(*time.Time)) = updatedAt
+		*(dest[2].(**time.Time)) = &verifiedAt
+		*(dest[3].(*string)) = "User"
+		*(dest[4].(*sql.NullString)) = sql.NullString{String: "山田", Valid: true}
+		*(dest[5].(*sql.NullString)) = sql.NullString{String: "太郎", Valid: true}
+		*(dest[6].(*string)) = "山田 太郎"
+		*(dest[7].(*sql.NullString)) = sql.NullString{String: "ja-JP", Valid: true}
+		*(dest[8].(*sql.NullString)) = sql.NullString{String: "Asia/Tokyo", Valid: true}
+		*(dest[9].(*sql.NullString)) = sql.NullString{}
+		*(dest[10].(*sql.NullString)) = sql.NullString{}
+		*(dest[11].(*string)) = string(user.StatusActive)
+		*(dest[12].(*[]byte)) = []byte(`{"region":"apac"}`)
+		*(dest[13].(*time.Time)) = createdAt
+		*(dest[14].(*time.Time)) = updatedAt
 		return nil
 	}}
 
@@ -45,9 +57,18 @@ func TestScanUser_Success(t *testing.T) {
 		t.Fatalf("scanUser returned error: %v", err)
 	}
 
-	if u.ID != "user-1" || u.Email != "user@example.com" {
+	if u.ID != "user-1" || u.Email != "user@example.com" || u.EmailVerifiedAt == nil || !u.EmailVerifiedAt.Equal(verifiedAt) {
 		t.Fatalf("unexpected user %+v", u)
 	}
+	if u.Labels["region"] != "apac" {
+		t.Fatalf("expected label region=apac, got %+v", u.Labels)
+	}
+	if u.FamilyName == nil || *u.FamilyName != "山田" || u.DisplayName != "山田 太郎" || u.TimeZone == nil || *u.TimeZone != "Asia/Tokyo" {
+		t.Fatalf("unexpected profile %+v", u)
+	}
+	if u.Phone != nil || u.AvatarURL != nil {
+		t.Fatalf("expected NULL columns to be nil, got phone=%v avatar=%v", u.Phone, u.AvatarURL)
+	}
 }
 
 func TestScanUser_NoRows(t *testing.T) {
@@ -71,6 +92,11 @@ func TestTranslatePgError(t *testing.T) {
 		t.Fatalf("expected email exists error mapping")
 	}
 
+	fkErr := &pgconn.PgError{Code: foreignKeyViolationCode, ConstraintName: employeeUserForeignKey}
+	if !errors.Is(translatePgError(fkErr), user.ErrUserHasEmployments) {
+		t.Fatalf("expected employee fk violation to map to ErrUserHasEmployments")
+	}
+
 	otherErr := errors.New("random")
 	if translatePgError(otherErr) != otherErr {
 		t.Fatalf("unexpected translation for generic error")
@@ -89,7 +115,7 @@ func TestUserRepository_List_WithNextToken(t *testing.T) {
 	repo := NewUserRepository(mock)
 
 	query := regexp.QuoteMeta(`
-        SELECT id, email, name, status, created_at, updated_at
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
           FROM users
          ORDER BY created_at DESC, id DESC
          LIMIT $1
@@ -97,10 +123,10 @@ func TestUserRepository_List_WithNextToken(t *testing.T) {
     `)
 
 	now := time.Now().UTC()
-	rows := pgxmock.NewRows([]string{"id", "email", "name", "status", "created_at", "updated_at"}).
-		AddRow("user-1", "user1@example.com", "User1", string(user.StatusActive), now, now).
-		AddRow("user-2", "user2@example.com", "User2", string(user.StatusActive), now, now).
-		AddRow("user-3", "user3@example.com", "User3", string(user.StatusInactive), now, now)
+	rows := pgxmock.NewRows([]string{"id", "email", "email_verified_at", "name", "family_name", "given_name", "display_name", "locale", "time_zone", "phone", "avatar_url", "status", "labels", "created_at", "updated_at"}).
+		AddRow("user-1", "user1@example.com", nil, "User1", nil, nil, "User1", nil, nil, nil, nil, string(user.StatusActive), []byte("{}"), now, now).
+		AddRow("user-2", "user2@example.com", nil, "User2", nil, nil, "User2", nil, nil, nil, nil, string(user.StatusActive), []byte("{}"), now, now).
+		AddRow("user-3", "user3@example.com", nil, "User3", nil, nil, "User3", nil, nil, nil, nil, string(user.StatusInactive), []byte("{}"), now, now)
 
 	mock.ExpectQuery(query).
 		WithArgs(3, 0).
@@ -136,7 +162,7 @@ func TestUserRepository_List_WithStatusFilter(t *testing.T) {
 	repo := NewUserRepository(mock)
 	inactive := user.StatusInactive
 	query := regexp.QuoteMeta(`
-        SELECT id, email, name, status, created_at, updated_at
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
           FROM users WHERE status = $1
          ORDER BY created_at DESC, id DESC
          LIMIT $2
@@ -144,8 +170,8 @@ func TestUserRepository_List_WithStatusFilter(t *testing.T) {
     `)
 
 	now := time.Now().UTC()
-	rows := pgxmock.NewRows([]string{"id", "email", "name", "status", "created_at", "updated_at"}).
-		AddRow("user-5", "inactive@example.com", "Inactive", string(user.StatusInactive), now, now)
+	rows := pgxmock.NewRows([]string{"id", "email", "email_verified_at", "name", "family_name", "given_name", "display_name", "locale", "time_zone", "phone", "avatar_url", "status", "labels", "created_at", "updated_at"}).
+		AddRow("user-5", "inactive@example.com", nil, "Inactive", nil, nil, "Inactive", nil, nil, nil, nil, string(user.StatusInactive), []byte("{}"), now, now)
 
 	mock.ExpectQuery(query).
 		WithArgs(inactive, 3, 0).
@@ -169,6 +195,49 @@ func TestUserRepository_List_WithStatusFilter(t *testing.T) {
 	}
 }
 
+func TestUserRepository_List_WithLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	repo := NewUserRepository(mock)
+	selector, err := label.ParseSelector("region=apac,tier!=free,team,!legacy")
+	if err != nil {
+		t.Fatalf("ParseSelector returned error: %v", err)
+	}
+	query := regexp.QuoteMeta(`
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+          FROM users WHERE labels @> $1::jsonb AND NOT (labels @> $2::jsonb) AND labels ? $3 AND NOT (labels ? $4)
+         ORDER BY created_at DESC, id DESC
+         LIMIT $5
+        OFFSET $6
+    `)
+
+	now := time.Now().UTC()
+	rows := pgxmock.NewRows([]string{"id", "email", "email_verified_at", "name", "family_name", "given_name", "display_name", "locale", "time_zone", "phone", "avatar_url", "status", "labels", "created_at", "updated_at"}).
+		AddRow("user-6", "apac@example.com", nil, "Apac", nil, nil, "Apac", nil, nil, nil, nil, string(user.StatusActive), []byte(`{"region":"apac","team":"core"}`), now, now)
+
+	mock.ExpectQuery(query).
+		WithArgs(`{"region":"apac"}`, `{"tier":"free"}`, "team", "legacy", 11, 0).
+		WillReturnRows(rows)
+
+	users, _, err := repo.List(context.Background(), user.ListUsersFilter{Limit: 10, LabelSelector: selector})
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(users) != 1 || users[0].Labels["region"] != "apac" {
+		t.Fatalf("unexpected users: %+v", users)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
 func TestUserRepository_List_InvalidArguments(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,551 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+)
+
+// RunCompanyRepositorySuite は company.Repository の適合テストを実行します。
+func RunCompanyRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("CRUD", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		desc := "conformance"
+		c := newCompany("crud", at(0))
+		c.Description = &desc
+		created, err := repos.Companies.Create(ctx, c)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.ID == "" || created.Description == nil || *created.Description != desc {
+			t.Fatalf("unexpected created company: %+v", created)
+		}
+
+		byID, err := repos.Companies.FindByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if byID.Code != "crud" {
+			t.Fatalf("FindByID returned %+v", byID)
+		}
+		byCode, err := repos.Companies.FindByCode(ctx, "crud")
+		if err != nil {
+			t.Fatalf("FindByCode returned error: %v", err)
+		}
+		if byCode.ID != created.ID {
+			t.Fatalf("FindByCode returned %+v", byCode)
+		}
+
+		created.Name = "Renamed"
+		created.Code = "renamed"
+		created.Status = company.StatusInactive
+		created.Description = nil
+		created.UpdatedAt = at(1)
+		updated, err := repos.Companies.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.Name != "Renamed" || updated.Code != "renamed" || updated.Status != company.StatusInactive || updated.Description != nil {
+			t.Fatalf("update not applied: %+v", updated)
+		}
+
+		if err := repos.Companies.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		if _, err := repos.Companies.FindByID(ctx, created.ID); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Fatalf("expected ErrCompanyNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("DuplicateCode", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		if _, err := repos.Companies.Create(ctx, newCompany("dup", at(0))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Companies.Create(ctx, newCompany("dup", at(1))); !errors.Is(err, company.ErrCodeAlreadyExists) {
+			t.Fatalf("Create: expected ErrCodeAlreadyExists, got %v", err)
+		}
+
+		other, err := repos.Companies.Create(ctx, newCompany("other", at(2)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		other.Code = "dup"
+		if _, err := repos.Companies.Update(ctx, other); !errors.Is(err, company.ErrCodeAlreadyExists) {
+			t.Fatalf("Update: expected ErrCodeAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("EmployeeCodePolicy", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		policy := company.EmployeeCodePolicy{Prefix: "hq-", Width: 4, NextSequence: 100}
+		c := newCompany("policy", at(0))
+		c.EmployeeCodePolicy = policy
+		created, err := repos.Companies.Create(ctx, c)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.EmployeeCodePolicy != policy {
+			t.Fatalf("expected policy %+v, got %+v", policy, created.EmployeeCodePolicy)
+		}
+
+		created.EmployeeCodePolicy.Width = 19
+		if _, err := repos.Companies.Update(ctx, created); !errors.Is(err, company.ErrInvalidEmployeeCodePolicy) {
+			t.Fatalf("expected ErrInvalidEmployeeCodePolicy for width, got %v", err)
+		}
+		if err := repos.Companies.SetEmployeeCodeNextSequence(ctx, created.ID, 0); !errors.Is(err, company.ErrInvalidEmployeeCodePolicy) {
+			t.Fatalf("expected ErrInvalidEmployeeCodePolicy for next sequence, got %v", err)
+		}
+		if err := repos.Companies.SetEmployeeCodeNextSequence(ctx, uuid.NewString(), 1); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Fatalf("expected ErrCompanyNotFound, got %v", err)
+		}
+
+		// Update は連番を更新せず、古い値を持つ会社で更新しても連番は戻りません。
+		if err := repos.Companies.SetEmployeeCodeNextSequence(ctx, created.ID, 120); err != nil {
+			t.Fatalf("SetEmployeeCodeNextSequence returned error: %v", err)
+		}
+		created.EmployeeCodePolicy = company.EmployeeCodePolicy{Prefix: "hq-", Width: 5, NextSequence: 100}
+		updated, err := repos.Companies.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		want := company.EmployeeCodePolicy{Prefix: "hq-", Width: 5, NextSequence: 120}
+		if updated.EmployeeCodePolicy != want {
+			t.Fatalf("expected policy %+v, got %+v", want, updated.EmployeeCodePolicy)
+		}
+	})
+
+	t.Run("EmployeeAttributes", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		c, err := repos.Companies.Create(ctx, newCompany("attributes", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		grade := &company.EmployeeAttribute{
+			CompanyID:     c.ID,
+			Key:           "grade",
+			Type:          company.AttributeTypeEnum,
+			Required:      true,
+			AllowedValues: []string{"G1", "G2"},
+			CreatedAt:     at(0),
+			UpdatedAt:     at(0),
+		}
+		created, err := repos.Companies.CreateEmployeeAttribute(ctx, grade)
+		if err != nil {
+			t.Fatalf("CreateEmployeeAttribute returned error: %v", err)
+		}
+		if created.Type != company.AttributeTypeEnum || !created.Required || len(created.AllowedValues) != 2 || !created.CreatedAt.Equal(at(0)) {
+			t.Fatalf("unexpected attribute: %+v", created)
+		}
+		if _, err := repos.Companies.CreateEmployeeAttribute(ctx, grade); !errors.Is(err, company.ErrAttributeAlreadyExists) {
+			t.Fatalf("expected ErrAttributeAlreadyExists, got %v", err)
+		}
+		if _, err := repos.Companies.CreateEmployeeAttribute(ctx, &company.EmployeeAttribute{
+			CompanyID: c.ID,
+			Key:       "band",
+			Type:      company.AttributeTypeNumber,
+			CreatedAt: at(1),
+			UpdatedAt: at(1),
+		}); err != nil {
+			t.Fatalf("CreateEmployeeAttribute returned error: %v", err)
+		}
+		missingCompany := *grade
+		missingCompany.CompanyID = uuid.NewString()
+		if _, err := repos.Companies.CreateEmployeeAttribute(ctx, &missingCompany); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Fatalf("expected ErrCompanyNotFound, got %v", err)
+		}
+
+		created.Required = false
+		created.AllowedValues = []string{"G1", "G2", "G3"}
+		created.UpdatedAt = at(2)
+		updated, err := repos.Companies.UpdateEmployeeAttribute(ctx, created)
+		if err != nil {
+			t.Fatalf("UpdateEmployeeAttribute returned error: %v", err)
+		}
+		if updated.Required || len(updated.AllowedValues) != 3 || !updated.UpdatedAt.Equal(at(2)) {
+			t.Fatalf("unexpected updated attribute: %+v", updated)
+		}
+
+		attributes, err := repos.Companies.ListEmployeeAttributes(ctx, c.ID)
+		if err != nil {
+			t.Fatalf("ListEmployeeAttributes returned error: %v", err)
+		}
+		if len(attributes) != 2 || attributes[0].Key != "band" || attributes[1].Key != "grade" {
+			t.Fatalf("expected attributes ordered by key, got %+v", attributes)
+		}
+
+		if err := repos.Companies.DeleteEmployeeAttribute(ctx, c.ID, "band"); err != nil {
+			t.Fatalf("DeleteEmployeeAttribute returned error: %v", err)
+		}
+		if _, err := repos.Companies.FindEmployeeAttribute(ctx, c.ID, "band"); !errors.Is(err, company.ErrAttributeNotFound) {
+			t.Fatalf("expected ErrAttributeNotFound, got %v", err)
+		}
+		if err := repos.Companies.DeleteEmployeeAttribute(ctx, c.ID, "band"); !errors.Is(err, company.ErrAttributeNotFound) {
+			t.Fatalf("expected ErrAttributeNotFound on second delete, got %v", err)
+		}
+		missing := *created
+		missing.Key = "missing"
+		if _, err := repos.Companies.UpdateEmployeeAttribute(ctx, &missing); !errors.Is(err, company.ErrAttributeNotFound) {
+			t.Fatalf("expected ErrAttributeNotFound on update, got %v", err)
+		}
+
+		if err := repos.Companies.Delete(ctx, c.ID); err != nil {
+			t.Fatalf("delete company: %v", err)
+		}
+		if attributes, err := repos.Companies.ListEmployeeAttributes(ctx, c.ID); err != nil || len(attributes) != 0 {
+			t.Fatalf("expected attributes to be removed with the company, got %+v (%v)", attributes, err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		missing := uuid.NewString()
+
+		if _, err := repos.Companies.FindByID(ctx, missing); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Errorf("FindByID: expected ErrCompanyNotFound, got %v", err)
+		}
+		if _, err := repos.Companies.FindByCode(ctx, "missing"); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Errorf("FindByCode: expected ErrCompanyNotFound, got %v", err)
+		}
+		c := newCompany("missing", at(0))
+		c.ID = missing
+		if _, err := repos.Companies.Update(ctx, c); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Errorf("Update: expected ErrCompanyNotFound, got %v", err)
+		}
+		if err := repos.Companies.Delete(ctx, missing); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Errorf("Delete: expected ErrCompanyNotFound, got %v", err)
+		}
+	})
+
+	t.Run("ListOrderingAndPagination", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		for i, code := range []string{"alpha", "beta", "gamma"} {
+			c := newCompany(code, at(i))
+			if i == 1 {
+				c.Status = company.StatusInactive
+			}
+			if _, err := repos.Companies.Create(ctx, c); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+
+		page, next, err := repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertCompanyCodes(t, page, "gamma", "beta")
+		if next != "2" {
+			t.Fatalf("expected next token 2, got %q", next)
+		}
+
+		page, next, err = repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertCompanyCodes(t, page, "alpha")
+		if next != "" {
+			t.Fatalf("expected empty next token on last page, got %q", next)
+		}
+
+		active := company.StatusActive
+		page, _, err = repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 10, Status: &active})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertCompanyCodes(t, page, "gamma", "alpha")
+
+		if _, _, err := repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 0}); !errors.Is(err, company.ErrInvalidPageSize) {
+			t.Errorf("expected ErrInvalidPageSize, got %v", err)
+		}
+		if _, _, err := repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 1, Offset: -1}); !errors.Is(err, company.ErrInvalidPageToken) {
+			t.Errorf("expected ErrInvalidPageToken, got %v", err)
+		}
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		labelSets := map[string]map[string]string{
+			"apac": {"region": "apac", "tier": "gold"},
+			"free": {"region": "apac", "tier": "free"},
+			"emea": {"region": "emea"},
+			"none": nil,
+		}
+		for i, code := range []string{"apac", "free", "emea", "none"} {
+			c := newCompany(code, at(i))
+			c.Labels = labelSets[code]
+			if _, err := repos.Companies.Create(ctx, c); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+
+		found, err := repos.Companies.FindByCode(ctx, "apac")
+		if err != nil {
+			t.Fatalf("FindByCode returned error: %v", err)
+		}
+		if found.Labels["region"] != "apac" || found.Labels["tier"] != "gold" {
+			t.Fatalf("labels not persisted: %+v", found.Labels)
+		}
+
+		cases := []struct {
+			selector string
+			want     []string
+		}{
+			{selector: "region=apac", want: []string{"free", "apac"}},
+			{selector: "region=apac,tier!=free", want: []string{"apac"}},
+			{selector: "tier!=free", want: []string{"none", "emea", "apac"}},
+			{selector: "tier", want: []string{"free", "apac"}},
+			{selector: "!region", want: []string{"none"}},
+		}
+		for _, tc := range cases {
+			selector, err := label.ParseSelector(tc.selector)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) returned error: %v", tc.selector, err)
+			}
+			page, _, err := repos.Companies.List(ctx, company.ListCompaniesFilter{Limit: 10, LabelSelector: selector})
+			if err != nil {
+				t.Fatalf("List(%q) returned error: %v", tc.selector, err)
+			}
+			assertCompanyCodes(t, page, tc.want...)
+		}
+
+		found.Labels = nil
+		found.UpdatedAt = at(10)
+		updated, err := repos.Companies.Update(ctx, found)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if len(updated.Labels) != 0 {
+			t.Fatalf("labels not cleared: %+v", updated.Labels)
+		}
+	})
+
+	t.Run("Contacts", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		website := "https://example.com"
+		c := newCompany("contacts", at(0))
+		c.Website = &website
+		created, err := repos.Companies.Create(ctx, c)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.Website == nil || *created.Website != website {
+			t.Fatalf("website not persisted: %+v", created.Website)
+		}
+		other, err := repos.Companies.Create(ctx, newCompany("other", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		registered, err := repos.Companies.AddAddress(ctx, &company.Address{
+			CompanyID: created.ID, Type: company.AddressTypeRegistered, Country: "JP", PostalCode: "100-0001",
+			Lines: []string{"東京都千代田区", "千代田1-1"}, CreatedAt: at(1),
+		})
+		if err != nil {
+			t.Fatalf("AddAddress returned error: %v", err)
+		}
+		if registered.ID == "" || len(registered.Lines) != 2 || registered.Lines[1] != "千代田1-1" {
+			t.Fatalf("unexpected address: %+v", registered)
+		}
+		if _, err := repos.Companies.AddAddress(ctx, &company.Address{
+			CompanyID: created.ID, Type: company.AddressTypeBilling, Country: "US", PostalCode: "94105",
+			Lines: []string{"1 Market St"}, CreatedAt: at(2),
+		}); err != nil {
+			t.Fatalf("AddAddress returned error: %v", err)
+		}
+		if _, err := repos.Companies.AddAddress(ctx, &company.Address{
+			CompanyID: uuid.NewString(), Type: company.AddressTypeOffice, Country: "JP", Lines: []string{"x"}, CreatedAt: at(3),
+		}); !errors.Is(err, company.ErrCompanyNotFound) {
+			t.Fatalf("expected ErrCompanyNotFound, got %v", err)
+		}
+
+		phone, err := repos.Companies.AddPhone(ctx, &company.Phone{CompanyID: created.ID, Number: "+81312345678", CreatedAt: at(1)})
+		if err != nil {
+			t.Fatalf("AddPhone returned error: %v", err)
+		}
+		if _, err := repos.Companies.AddPhone(ctx, &company.Phone{CompanyID: created.ID, Number: "+81312345678", CreatedAt: at(2)}); !errors.Is(err, company.ErrPhoneAlreadyExists) {
+			t.Fatalf("expected ErrPhoneAlreadyExists, got %v", err)
+		}
+		if _, err := repos.Companies.AddPhone(ctx, &company.Phone{CompanyID: other.ID, Number: "+81312345678", CreatedAt: at(2)}); err != nil {
+			t.Fatalf("AddPhone for another company returned error: %v", err)
+		}
+
+		addresses, err := repos.Companies.ListAddresses(ctx, []string{created.ID, other.ID})
+		if err != nil {
+			t.Fatalf("ListAddresses returned error: %v", err)
+		}
+		if len(addresses) != 2 || addresses[0].ID != registered.ID || addresses[1].Country != "US" {
+			t.Fatalf("unexpected addresses: %+v", addresses)
+		}
+		phones, err := repos.Companies.ListPhones(ctx, []string{created.ID})
+		if err != nil {
+			t.Fatalf("ListPhones returned error: %v", err)
+		}
+		if len(phones) != 1 || phones[0].ID != phone.ID {
+			t.Fatalf("unexpected phones: %+v", phones)
+		}
+
+		if err := repos.Companies.DeleteAddress(ctx, other.ID, registered.ID); !errors.Is(err, company.ErrAddressNotFound) {
+			t.Fatalf("expected ErrAddressNotFound for another company, got %v", err)
+		}
+		if err := repos.Companies.DeleteAddress(ctx, created.ID, registered.ID); err != nil {
+			t.Fatalf("DeleteAddress returned error: %v", err)
+		}
+		if err := repos.Companies.DeletePhone(ctx, created.ID, phone.ID); err != nil {
+			t.Fatalf("DeletePhone returned error: %v", err)
+		}
+		if err := repos.Companies.DeletePhone(ctx, created.ID, phone.ID); !errors.Is(err, company.ErrPhoneNotFound) {
+			t.Fatalf("expected ErrPhoneNotFound, got %v", err)
+		}
+
+		created.Website = nil
+		created.UpdatedAt = at(5)
+		updated, err := repos.Companies.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.Website != nil {
+			t.Fatalf("website not cleared: %+v", updated.Website)
+		}
+
+		if err := repos.Companies.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		addresses, err = repos.Companies.ListAddresses(ctx, []string{created.ID})
+		if err != nil {
+			t.Fatalf("ListAddresses returned error: %v", err)
+		}
+		if len(addresses) != 0 {
+			t.Fatalf("expected addresses to be deleted with the company, got %+v", addresses)
+		}
+	})
+
+	t.Run("Hierarchy", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		root, err := repos.Companies.Create(ctx, newCompany("root", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		create := func(code string, i int, parentID string) *company.Company {
+			t.Helper()
+			c := newCompany(code, at(i))
+			c.ParentCompanyID = &parentID
+			created, err := repos.Companies.Create(ctx, c)
+			if err != nil {
+				t.Fatalf("Create %s returned error: %v", code, err)
+			}
+			if created.ParentCompanyID == nil || *created.ParentCompanyID != parentID {
+				t.Fatalf("Create %s: expected parent %s, got %v", code, parentID, created.ParentCompanyID)
+			}
+			return created
+		}
+		childA := create("child-a", 1, root.ID)
+		childB := create("child-b", 2, root.ID)
+		grandchild := create("grandchild", 3, childA.ID)
+
+		direct, next, err := repos.Companies.ListSubsidiaries(ctx, company.ListSubsidiariesFilter{ParentID: root.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("ListSubsidiaries returned error: %v", err)
+		}
+		assertCompanyCodes(t, direct, "child-b", "child-a")
+		if next != "" {
+			t.Fatalf("expected empty next token, got %q", next)
+		}
+
+		all, next, err := repos.Companies.ListSubsidiaries(ctx, company.ListSubsidiariesFilter{ParentID: root.ID, Recursive: true, Limit: 2})
+		if err != nil {
+			t.Fatalf("ListSubsidiaries returned error: %v", err)
+		}
+		assertCompanyCodes(t, all, "grandchild", "child-b")
+		if next != "2" {
+			t.Fatalf("expected next token 2, got %q", next)
+		}
+
+		ancestors, err := repos.Companies.ListAncestors(ctx, grandchild.ID)
+		if err != nil {
+			t.Fatalf("ListAncestors returned error: %v", err)
+		}
+		assertCompanyCodes(t, ancestors, "child-a", "root")
+
+		ancestors, err = repos.Companies.ListAncestors(ctx, root.ID)
+		if err != nil {
+			t.Fatalf("ListAncestors returned error: %v", err)
+		}
+		assertCompanyCodes(t, ancestors)
+
+		// 同時の付け替えなどで循環した階層でも、たどった会社に戻った時点で打ち切られることを確認します。
+		root.ParentCompanyID = &grandchild.ID
+		if _, err := repos.Companies.Update(ctx, root); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		ancestors, err = repos.Companies.ListAncestors(ctx, root.ID)
+		if err != nil {
+			t.Fatalf("ListAncestors returned error: %v", err)
+		}
+		assertCompanyCodes(t, ancestors, "grandchild", "child-a")
+		root.ParentCompanyID = nil
+		if _, err := repos.Companies.Update(ctx, root); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+
+		missing := uuid.NewString()
+		orphan := newCompany("orphan", at(4))
+		orphan.ParentCompanyID = &missing
+		if _, err := repos.Companies.Create(ctx, orphan); !errors.Is(err, company.ErrParentCompanyNotFound) {
+			t.Errorf("Create: expected ErrParentCompanyNotFound, got %v", err)
+		}
+
+		childB.ParentCompanyID = &childB.ID
+		if _, err := repos.Companies.Update(ctx, childB); !errors.Is(err, company.ErrHierarchyCycle) {
+			t.Errorf("Update: expected ErrHierarchyCycle for self parent, got %v", err)
+		}
+
+		if err := repos.Companies.Delete(ctx, childA.ID); !errors.Is(err, company.ErrCompanyHasSubsidiaries) {
+			t.Fatalf("Delete: expected ErrCompanyHasSubsidiaries, got %v", err)
+		}
+
+		grandchild.ParentCompanyID = nil
+		grandchild.UpdatedAt = at(5)
+		if _, err := repos.Companies.Update(ctx, grandchild); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if err := repos.Companies.Delete(ctx, childA.ID); err != nil {
+			t.Fatalf("Delete after detaching returned error: %v", err)
+		}
+	})
+}
+
+func assertCompanyCodes(t *testing.T, companies []*company.Company, want ...string) {
+	t.Helper()
+	if len(companies) != len(want) {
+		t.Fatalf("expected %d companies, got %d", len(want), len(companies))
+	}
+	for i, c := range companies {
+		if c.Code != want[i] {
+			t.Fatalf("companies[%d]: expected %s, got %s", i, want[i], c.Code)
+		}
+	}
+}
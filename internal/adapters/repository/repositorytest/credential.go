@@ -0,0 +1,197 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+)
+
+// RunCredentialRepositorySuite は auth.CredentialRepository の適合テストを実行します。
+func RunCredentialRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("Save", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("credential@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+		if _, err := repos.Credentials.FindByUserID(ctx, u.ID); !errors.Is(err, auth.ErrCredentialNotFound) {
+			t.Errorf("expected ErrCredentialNotFound, got %v", err)
+		}
+
+		created, err := repos.Credentials.Save(ctx, &auth.Credential{
+			UserID:            u.ID,
+			PasswordHash:      "hash-1",
+			PasswordUpdatedAt: at(0),
+			CreatedAt:         at(0),
+			UpdatedAt:         at(0),
+		})
+		if err != nil {
+			t.Fatalf("Save returned error: %v", err)
+		}
+		if created.UserID != u.ID || created.PasswordHash != "hash-1" || created.FailedAttempts != 0 || created.LockedUntil != nil || created.LastLoginAt != nil {
+			t.Fatalf("unexpected created credential: %+v", created)
+		}
+
+		if err := repos.Credentials.RecordLogin(ctx, u.ID, "refresh-1", at(1)); err != nil {
+			t.Fatalf("RecordLogin returned error: %v", err)
+		}
+		if _, err := repos.Credentials.IncrementFailedAttempts(ctx, u.ID, at(2)); err != nil {
+			t.Fatalf("IncrementFailedAttempts returned error: %v", err)
+		}
+
+		// 更新時は作成日時と最終ログイン日時を維持し、失敗回数とリフレッシュトークンの ID を戻します。
+		updated, err := repos.Credentials.Save(ctx, &auth.Credential{
+			UserID:            u.ID,
+			PasswordHash:      "hash-2",
+			PasswordUpdatedAt: at(3),
+			CreatedAt:         at(3),
+			UpdatedAt:         at(3),
+		})
+		if err != nil {
+			t.Fatalf("Save returned error: %v", err)
+		}
+		if updated.PasswordHash != "hash-2" || updated.FailedAttempts != 0 || !updated.PasswordUpdatedAt.Equal(at(3)) || !updated.UpdatedAt.Equal(at(3)) {
+			t.Fatalf("unexpected updated credential: %+v", updated)
+		}
+		if !updated.CreatedAt.Equal(at(0)) {
+			t.Errorf("expected created_at to be kept, got %v", updated.CreatedAt)
+		}
+		if updated.LastLoginAt == nil || !updated.LastLoginAt.Equal(at(1)) {
+			t.Errorf("expected last_login_at to be kept, got %v", updated.LastLoginAt)
+		}
+		if updated.RefreshTokenID != "" {
+			t.Errorf("expected refresh_token_id to be cleared, got %q", updated.RefreshTokenID)
+		}
+
+		if _, err := repos.Credentials.Save(ctx, &auth.Credential{
+			UserID:            uuid.NewString(),
+			PasswordHash:      "hash",
+			PasswordUpdatedAt: at(0),
+			CreatedAt:         at(0),
+			UpdatedAt:         at(0),
+		}); !errors.Is(err, auth.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+
+		if err := repos.Users.Delete(ctx, u.ID); err != nil {
+			t.Fatalf("Delete user returned error: %v", err)
+		}
+		if _, err := repos.Credentials.FindByUserID(ctx, u.ID); !errors.Is(err, auth.ErrCredentialNotFound) {
+			t.Errorf("expected credential to be deleted with the user, got %v", err)
+		}
+	})
+
+	t.Run("Lockout", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("lockout@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+		if _, err := repos.Credentials.Save(ctx, &auth.Credential{
+			UserID:            u.ID,
+			PasswordHash:      "hash",
+			PasswordUpdatedAt: at(0),
+			CreatedAt:         at(0),
+			UpdatedAt:         at(0),
+		}); err != nil {
+			t.Fatalf("Save returned error: %v", err)
+		}
+
+		for want := 1; want <= 3; want++ {
+			got, err := repos.Credentials.IncrementFailedAttempts(ctx, u.ID, at(want))
+			if err != nil {
+				t.Fatalf("IncrementFailedAttempts returned error: %v", err)
+			}
+			if got != want {
+				t.Fatalf("expected %d failed attempts, got %d", want, got)
+			}
+		}
+
+		if err := repos.Credentials.Lock(ctx, u.ID, at(20), at(4)); err != nil {
+			t.Fatalf("Lock returned error: %v", err)
+		}
+		locked, err := repos.Credentials.FindByUserID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("FindByUserID returned error: %v", err)
+		}
+		if locked.FailedAttempts != 0 || locked.LockedUntil == nil || !locked.LockedUntil.Equal(at(20)) || !locked.UpdatedAt.Equal(at(4)) {
+			t.Fatalf("unexpected locked credential: %+v", locked)
+		}
+
+		if err := repos.Credentials.RecordLogin(ctx, u.ID, "refresh-1", at(30)); err != nil {
+			t.Fatalf("RecordLogin returned error: %v", err)
+		}
+		loggedIn, err := repos.Credentials.FindByUserID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("FindByUserID returned error: %v", err)
+		}
+		if loggedIn.LockedUntil != nil || loggedIn.LastLoginAt == nil || !loggedIn.LastLoginAt.Equal(at(30)) || loggedIn.RefreshTokenID != "refresh-1" {
+			t.Fatalf("unexpected credential after login: %+v", loggedIn)
+		}
+
+		missing := uuid.NewString()
+		if _, err := repos.Credentials.IncrementFailedAttempts(ctx, missing, at(0)); !errors.Is(err, auth.ErrCredentialNotFound) {
+			t.Errorf("expected ErrCredentialNotFound from IncrementFailedAttempts, got %v", err)
+		}
+		if err := repos.Credentials.Lock(ctx, missing, at(1), at(0)); !errors.Is(err, auth.ErrCredentialNotFound) {
+			t.Errorf("expected ErrCredentialNotFound from Lock, got %v", err)
+		}
+		if err := repos.Credentials.RecordLogin(ctx, missing, "refresh-1", at(0)); !errors.Is(err, auth.ErrCredentialNotFound) {
+			t.Errorf("expected ErrCredentialNotFound from RecordLogin, got %v", err)
+		}
+	})
+	t.Run("RotateRefreshToken", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("rotate@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+		if _, err := repos.Credentials.Save(ctx, &auth.Credential{
+			UserID:            u.ID,
+			PasswordHash:      "hash",
+			PasswordUpdatedAt: at(0),
+			CreatedAt:         at(0),
+			UpdatedAt:         at(0),
+		}); err != nil {
+			t.Fatalf("Save returned error: %v", err)
+		}
+
+		// ログイン前は置き換える ID がありません。
+		if err := repos.Credentials.RotateRefreshToken(ctx, u.ID, "", "refresh-1", at(1)); !errors.Is(err, auth.ErrInvalidToken) {
+			t.Errorf("expected ErrInvalidToken before login, got %v", err)
+		}
+
+		if err := repos.Credentials.RecordLogin(ctx, u.ID, "refresh-1", at(1)); err != nil {
+			t.Fatalf("RecordLogin returned error: %v", err)
+		}
+		if err := repos.Credentials.RotateRefreshToken(ctx, u.ID, "refresh-1", "refresh-2", at(2)); err != nil {
+			t.Fatalf("RotateRefreshToken returned error: %v", err)
+		}
+		rotated, err := repos.Credentials.FindByUserID(ctx, u.ID)
+		if err != nil {
+			t.Fatalf("FindByUserID returned error: %v", err)
+		}
+		if rotated.RefreshTokenID != "refresh-2" || !rotated.UpdatedAt.Equal(at(2)) {
+			t.Fatalf("unexpected rotated credential: %+v", rotated)
+		}
+
+		// 置き換え済みの ID は再利用できません。
+		if err := repos.Credentials.RotateRefreshToken(ctx, u.ID, "refresh-1", "refresh-3", at(3)); !errors.Is(err, auth.ErrInvalidToken) {
+			t.Errorf("expected ErrInvalidToken for a rotated id, got %v", err)
+		}
+		if err := repos.Credentials.RotateRefreshToken(ctx, uuid.NewString(), "refresh-2", "refresh-3", at(3)); !errors.Is(err, auth.ErrInvalidToken) {
+			t.Errorf("expected ErrInvalidToken for a missing credential, got %v", err)
+		}
+	})
+}
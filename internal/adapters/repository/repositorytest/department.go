@@ -0,0 +1,263 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+)
+
+// RunDepartmentRepositorySuite は department.Repository の適合テストを実行します。
+func RunDepartmentRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("CRUD", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "dept-crud")
+
+		created, err := repos.Departments.Create(ctx, newDepartment(c.ID, "sales", nil, at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.ID == "" || created.CompanyID != c.ID || created.ParentDepartmentID != nil {
+			t.Fatalf("unexpected created department: %+v", created)
+		}
+
+		byID, err := repos.Departments.FindByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if byID.Code != "sales" {
+			t.Fatalf("FindByID returned %+v", byID)
+		}
+		byCode, err := repos.Departments.FindByCompanyAndCode(ctx, c.ID, "sales")
+		if err != nil {
+			t.Fatalf("FindByCompanyAndCode returned error: %v", err)
+		}
+		if byCode.ID != created.ID {
+			t.Fatalf("FindByCompanyAndCode returned %+v", byCode)
+		}
+
+		created.Name = "Renamed"
+		created.Code = "renamed"
+		created.UpdatedAt = at(1)
+		updated, err := repos.Departments.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.Name != "Renamed" || updated.Code != "renamed" || !updated.UpdatedAt.Equal(at(1)) {
+			t.Fatalf("update not applied: %+v", updated)
+		}
+
+		if err := repos.Departments.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		if _, err := repos.Departments.FindByID(ctx, created.ID); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Fatalf("expected ErrDepartmentNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("DuplicateCode", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "dept-dup")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("dept-dup-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		if _, err := repos.Departments.Create(ctx, newDepartment(c.ID, "sales", nil, at(0))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Departments.Create(ctx, newDepartment(c.ID, "sales", nil, at(1))); !errors.Is(err, department.ErrCodeAlreadyExists) {
+			t.Fatalf("expected ErrCodeAlreadyExists, got %v", err)
+		}
+		if _, err := repos.Departments.Create(ctx, newDepartment(otherCompany.ID, "sales", nil, at(2))); err != nil {
+			t.Fatalf("expected same code in another company to succeed, got %v", err)
+		}
+	})
+
+	t.Run("ForeignKeys", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "dept-fk")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("dept-fk-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+		foreign, err := repos.Departments.Create(ctx, newDepartment(otherCompany.ID, "foreign", nil, at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		if _, err := repos.Departments.Create(ctx, newDepartment(uuid.NewString(), "sales", nil, at(1))); !errors.Is(err, department.ErrCompanyNotFound) {
+			t.Errorf("expected ErrCompanyNotFound, got %v", err)
+		}
+		missing := uuid.NewString()
+		if _, err := repos.Departments.Create(ctx, newDepartment(c.ID, "orphan", &missing, at(1))); !errors.Is(err, department.ErrParentDepartmentNotFound) {
+			t.Errorf("expected ErrParentDepartmentNotFound for missing parent, got %v", err)
+		}
+		if _, err := repos.Departments.Create(ctx, newDepartment(c.ID, "cross", &foreign.ID, at(1))); !errors.Is(err, department.ErrParentDepartmentNotFound) {
+			t.Errorf("expected ErrParentDepartmentNotFound for parent in another company, got %v", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "dept-missing")
+		missing := uuid.NewString()
+
+		if _, err := repos.Departments.FindByID(ctx, missing); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Errorf("FindByID: expected ErrDepartmentNotFound, got %v", err)
+		}
+		if _, err := repos.Departments.FindByCompanyAndCode(ctx, c.ID, "missing"); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Errorf("FindByCompanyAndCode: expected ErrDepartmentNotFound, got %v", err)
+		}
+		d := newDepartment(c.ID, "missing", nil, at(0))
+		d.ID = missing
+		if _, err := repos.Departments.Update(ctx, d); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Errorf("Update: expected ErrDepartmentNotFound, got %v", err)
+		}
+		if err := repos.Departments.Delete(ctx, missing); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Errorf("Delete: expected ErrDepartmentNotFound, got %v", err)
+		}
+	})
+
+	t.Run("ListOrderingAndPagination", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "dept-list")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("dept-list-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		root, err := repos.Departments.Create(ctx, newDepartment(c.ID, "root", nil, at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		for i, code := range []string{"team-a", "team-b"} {
+			if _, err := repos.Departments.Create(ctx, newDepartment(c.ID, code, &root.ID, at(i+1))); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+		if _, err := repos.Departments.Create(ctx, newDepartment(otherCompany.ID, "other", nil, at(10))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		page, next, err := repos.Departments.List(ctx, department.ListDepartmentsFilter{CompanyID: c.ID, Limit: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertDepartmentCodes(t, page, "team-b", "team-a")
+		if next != "2" {
+			t.Fatalf("expected next token 2, got %q", next)
+		}
+
+		page, next, err = repos.Departments.List(ctx, department.ListDepartmentsFilter{CompanyID: c.ID, Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertDepartmentCodes(t, page, "root")
+		if next != "" {
+			t.Fatalf("expected empty next token on last page, got %q", next)
+		}
+
+		page, _, err = repos.Departments.List(ctx, department.ListDepartmentsFilter{CompanyID: c.ID, ParentID: &root.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertDepartmentCodes(t, page, "team-b", "team-a")
+
+		if _, _, err := repos.Departments.List(ctx, department.ListDepartmentsFilter{Limit: 1}); !errors.Is(err, department.ErrInvalidCompanyID) {
+			t.Errorf("expected ErrInvalidCompanyID, got %v", err)
+		}
+		if _, _, err := repos.Departments.List(ctx, department.ListDepartmentsFilter{CompanyID: c.ID}); !errors.Is(err, department.ErrInvalidPageSize) {
+			t.Errorf("expected ErrInvalidPageSize, got %v", err)
+		}
+		if _, _, err := repos.Departments.List(ctx, department.ListDepartmentsFilter{CompanyID: c.ID, Limit: 1, Offset: -1}); !errors.Is(err, department.ErrInvalidPageToken) {
+			t.Errorf("expected ErrInvalidPageToken, got %v", err)
+		}
+	})
+
+	t.Run("Hierarchy", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "dept-tree")
+
+		root, err := repos.Departments.Create(ctx, newDepartment(c.ID, "root", nil, at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		child, err := repos.Departments.Create(ctx, newDepartment(c.ID, "child", &root.ID, at(1)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		leaf, err := repos.Departments.Create(ctx, newDepartment(c.ID, "leaf", &child.ID, at(2)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		ancestors, err := repos.Departments.ListAncestors(ctx, leaf.ID)
+		if err != nil {
+			t.Fatalf("ListAncestors returned error: %v", err)
+		}
+		assertDepartmentCodes(t, ancestors, "child", "root")
+
+		// 同時の付け替えなどで循環した階層でも、たどった部署に戻った時点で打ち切られることを確認します。
+		root.ParentDepartmentID = &leaf.ID
+		if _, err := repos.Departments.Update(ctx, root); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		ancestors, err = repos.Departments.ListAncestors(ctx, root.ID)
+		if err != nil {
+			t.Fatalf("ListAncestors returned error: %v", err)
+		}
+		assertDepartmentCodes(t, ancestors, "leaf", "child")
+		root.ParentDepartmentID = nil
+		if _, err := repos.Departments.Update(ctx, root); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+
+		leaf.ParentDepartmentID = &leaf.ID
+		if _, err := repos.Departments.Update(ctx, leaf); !errors.Is(err, department.ErrHierarchyCycle) {
+			t.Errorf("Update: expected ErrHierarchyCycle for self parent, got %v", err)
+		}
+
+		if err := repos.Departments.Delete(ctx, child.ID); !errors.Is(err, department.ErrDepartmentHasChildren) {
+			t.Fatalf("Delete: expected ErrDepartmentHasChildren, got %v", err)
+		}
+
+		e := newEmployee(c.ID, u.ID, "E001", at(3))
+		e.DepartmentID = &leaf.ID
+		if _, err := repos.Employees.Create(ctx, e); err != nil {
+			t.Fatalf("create employee: %v", err)
+		}
+		if err := repos.Departments.Delete(ctx, leaf.ID); !errors.Is(err, department.ErrDepartmentHasEmployees) {
+			t.Fatalf("Delete: expected ErrDepartmentHasEmployees, got %v", err)
+		}
+
+		if err := repos.Companies.Delete(ctx, c.ID); err != nil {
+			t.Fatalf("delete company: %v", err)
+		}
+		if _, err := repos.Departments.FindByID(ctx, root.ID); !errors.Is(err, department.ErrDepartmentNotFound) {
+			t.Fatalf("expected department to be removed with its company, got %v", err)
+		}
+	})
+}
+
+func assertDepartmentCodes(t *testing.T, departments []*department.Department, want ...string) {
+	t.Helper()
+	if len(departments) != len(want) {
+		t.Fatalf("expected %d departments, got %d", len(want), len(departments))
+	}
+	for i, d := range departments {
+		if d.Code != want[i] {
+			t.Fatalf("departments[%d]: expected %s, got %s", i, want[i], d.Code)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// RunEmailChangeTokenRepositorySuite は user.EmailChangeTokenRepository の適合テストを実行します。
+func RunEmailChangeTokenRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("token@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+
+		created, err := repos.EmailChangeTokens.Create(ctx, &user.EmailChangeToken{
+			UserID:    u.ID,
+			NewEmail:  "new@example.com",
+			TokenHash: "hash-1",
+			ExpiresAt: at(60),
+			CreatedAt: at(0),
+		})
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.ID == "" || created.UserID != u.ID || created.NewEmail != "new@example.com" || !created.ExpiresAt.Equal(at(60)) || created.UsedAt != nil {
+			t.Fatalf("unexpected created token: %+v", created)
+		}
+
+		found, err := repos.EmailChangeTokens.FindByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("FindByHash returned error: %v", err)
+		}
+		if found.ID != created.ID || !found.CreatedAt.Equal(at(0)) {
+			t.Fatalf("unexpected found token: %+v", found)
+		}
+		if _, err := repos.EmailChangeTokens.FindByHash(ctx, "missing"); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+			t.Errorf("expected ErrInvalidEmailChangeToken, got %v", err)
+		}
+
+		if err := repos.EmailChangeTokens.MarkUsed(ctx, created.ID, at(1)); err != nil {
+			t.Fatalf("MarkUsed returned error: %v", err)
+		}
+		if err := repos.EmailChangeTokens.MarkUsed(ctx, created.ID, at(2)); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+			t.Errorf("expected ErrInvalidEmailChangeToken for a used token, got %v", err)
+		}
+		used, err := repos.EmailChangeTokens.FindByHash(ctx, "hash-1")
+		if err != nil {
+			t.Fatalf("FindByHash returned error: %v", err)
+		}
+		if used.UsedAt == nil || !used.UsedAt.Equal(at(1)) {
+			t.Fatalf("expected used_at to be recorded: %+v", used)
+		}
+
+		if _, err := repos.EmailChangeTokens.Create(ctx, &user.EmailChangeToken{
+			UserID:    uuid.NewString(),
+			NewEmail:  "new@example.com",
+			TokenHash: "hash-2",
+			ExpiresAt: at(60),
+			CreatedAt: at(0),
+		}); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("DeleteUnusedByUser", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("token@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+		other, err := repos.Users.Create(ctx, newUser("other@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create user returned error: %v", err)
+		}
+
+		tokens := map[string]*user.EmailChangeToken{}
+		for _, tc := range []struct{ hash, userID string }{{"used", u.ID}, {"unused", u.ID}, {"other", other.ID}} {
+			created, err := repos.EmailChangeTokens.Create(ctx, &user.EmailChangeToken{
+				UserID:    tc.userID,
+				NewEmail:  "new@example.com",
+				TokenHash: tc.hash,
+				ExpiresAt: at(60),
+				CreatedAt: at(0),
+			})
+			if err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+			tokens[tc.hash] = created
+		}
+		if err := repos.EmailChangeTokens.MarkUsed(ctx, tokens["used"].ID, at(1)); err != nil {
+			t.Fatalf("MarkUsed returned error: %v", err)
+		}
+
+		if err := repos.EmailChangeTokens.DeleteUnusedByUser(ctx, u.ID); err != nil {
+			t.Fatalf("DeleteUnusedByUser returned error: %v", err)
+		}
+		if _, err := repos.EmailChangeTokens.FindByHash(ctx, "unused"); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+			t.Errorf("expected unused token to be deleted, got %v", err)
+		}
+		for _, hash := range []string{"used", "other"} {
+			if _, err := repos.EmailChangeTokens.FindByHash(ctx, hash); err != nil {
+				t.Errorf("expected token %s to remain, got %v", hash, err)
+			}
+		}
+
+		if err := repos.Users.Delete(ctx, u.ID); err != nil {
+			t.Fatalf("Delete user returned error: %v", err)
+		}
+		if _, err := repos.EmailChangeTokens.FindByHash(ctx, "used"); !errors.Is(err, user.ErrInvalidEmailChangeToken) {
+			t.Errorf("expected tokens to be deleted with the user, got %v", err)
+		}
+	})
+}
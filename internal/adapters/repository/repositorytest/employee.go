@@ -0,0 +1,957 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// RunEmployeeRepositorySuite は employee.Repository の適合テストを実行します。
+func RunEmployeeRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("CRUD", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "crud")
+
+		hired := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		e := newEmployee(c.ID, u.ID, "E001", at(0))
+		e.HiredAt = &hired
+		created, err := repos.Employees.Create(ctx, e)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.ID == "" || created.HiredAt == nil || !created.HiredAt.Equal(hired) {
+			t.Fatalf("unexpected created employee: %+v", created)
+		}
+		if created.User == nil || created.User.ID != u.ID || created.User.Email != u.Email {
+			t.Fatalf("expected user snapshot, got %+v", created.User)
+		}
+		if created.User.DisplayName != u.DisplayName || created.User.Locale != "" {
+			t.Fatalf("expected display name %q without locale, got %+v", u.DisplayName, created.User)
+		}
+
+		byID, err := repos.Employees.FindByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if byID.EmployeeCode != "E001" || byID.User == nil {
+			t.Fatalf("FindByID returned %+v", byID)
+		}
+		byCode, err := repos.Employees.FindByCompanyAndCode(ctx, c.ID, "E001")
+		if err != nil {
+			t.Fatalf("FindByCompanyAndCode returned error: %v", err)
+		}
+		if byCode.ID != created.ID {
+			t.Fatalf("FindByCompanyAndCode returned %+v", byCode)
+		}
+
+		terminated := hired.AddDate(1, 0, 0)
+		created.EmployeeCode = "E002"
+		created.Status = employee.StatusTerminated
+		created.TerminatedAt = &terminated
+		created.UpdatedAt = at(1)
+		updated, err := repos.Employees.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.EmployeeCode != "E002" || updated.Status != employee.StatusTerminated || updated.TerminatedAt == nil || !updated.TerminatedAt.Equal(terminated) {
+			t.Fatalf("update not applied: %+v", updated)
+		}
+
+		if err := repos.Employees.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		if _, err := repos.Employees.FindByID(ctx, created.ID); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Fatalf("expected ErrEmployeeNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("DuplicateCode", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "dup")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("dup-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		if _, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(0))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(1))); !errors.Is(err, employee.ErrEmployeeCodeAlreadyExists) {
+			t.Fatalf("expected ErrEmployeeCodeAlreadyExists, got %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(otherCompany.ID, u.ID, "E001", at(2))); err != nil {
+			t.Fatalf("expected same code in another company to succeed, got %v", err)
+		}
+	})
+
+	t.Run("ForeignKeys", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "fk")
+
+		if _, err := repos.Employees.Create(ctx, newEmployee(uuid.NewString(), u.ID, "E001", at(0))); !errors.Is(err, employee.ErrCompanyNotFound) {
+			t.Errorf("expected ErrCompanyNotFound, got %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(c.ID, uuid.NewString(), "E001", at(0))); !errors.Is(err, employee.ErrUserNotFound) {
+			t.Errorf("expected ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("InvalidDateRange", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "range")
+
+		hired := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		terminated := hired.AddDate(0, 0, -1)
+		e := newEmployee(c.ID, u.ID, "E001", at(0))
+		e.HiredAt = &hired
+		e.TerminatedAt = &terminated
+		if _, err := repos.Employees.Create(ctx, e); !errors.Is(err, employee.ErrInvalidDateRange) {
+			t.Fatalf("expected ErrInvalidDateRange, got %v", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "missing")
+		missing := uuid.NewString()
+
+		if _, err := repos.Employees.FindByID(ctx, missing); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("FindByID: expected ErrEmployeeNotFound, got %v", err)
+		}
+		if _, err := repos.Employees.FindByCompanyAndCode(ctx, c.ID, "missing"); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("FindByCompanyAndCode: expected ErrEmployeeNotFound, got %v", err)
+		}
+		e := newEmployee(c.ID, u.ID, "E001", at(0))
+		e.ID = missing
+		if _, err := repos.Employees.Update(ctx, e); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("Update: expected ErrEmployeeNotFound, got %v", err)
+		}
+		if err := repos.Employees.Delete(ctx, missing); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("Delete: expected ErrEmployeeNotFound, got %v", err)
+		}
+	})
+
+	t.Run("NextCodeSequence", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "sequence")
+
+		c.EmployeeCodePolicy = company.EmployeeCodePolicy{Prefix: "seq-", Width: 3}
+		c.UpdatedAt = at(1)
+		if _, err := repos.Companies.Update(ctx, c); err != nil {
+			t.Fatalf("update company: %v", err)
+		}
+		if err := repos.Companies.SetEmployeeCodeNextSequence(ctx, c.ID, 9); err != nil {
+			t.Fatalf("set next sequence: %v", err)
+		}
+
+		for _, want := range []string{"seq-009", "seq-010"} {
+			seq, err := repos.Employees.NextCodeSequence(ctx, c.ID)
+			if err != nil {
+				t.Fatalf("NextCodeSequence returned error: %v", err)
+			}
+			if seq.Code() != want {
+				t.Fatalf("expected %s, got %s (%+v)", want, seq.Code(), seq)
+			}
+		}
+
+		found, err := repos.Companies.FindByID(ctx, c.ID)
+		if err != nil {
+			t.Fatalf("find company: %v", err)
+		}
+		if found.EmployeeCodePolicy.NextSequence != 11 {
+			t.Fatalf("expected next sequence 11, got %+v", found.EmployeeCodePolicy)
+		}
+
+		if _, err := repos.Employees.NextCodeSequence(ctx, uuid.NewString()); !errors.Is(err, employee.ErrCompanyNotFound) {
+			t.Fatalf("expected ErrCompanyNotFound, got %v", err)
+		}
+	})
+
+	t.Run("NextCodeSequenceWithConcurrentCompanyUpdates", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		_, c := seedUserAndCompany(t, repos, "sequence-race")
+
+		// 採番と並行して、採番前に読み込んだ古い会社情報で更新しても連番が戻らないことを確認します。
+		const (
+			allocators  = 4
+			allocations = 10
+			updaters    = 2
+		)
+		stale, err := repos.Companies.FindByID(ctx, c.ID)
+		if err != nil {
+			t.Fatalf("find company: %v", err)
+		}
+		start := stale.EmployeeCodePolicy.NextSequence
+
+		var (
+			wg   sync.WaitGroup
+			mu   sync.Mutex
+			seen = make(map[int64]bool)
+		)
+		for i := 0; i < allocators; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < allocations; j++ {
+					seq, err := repos.Employees.NextCodeSequence(ctx, c.ID)
+					if err != nil {
+						t.Errorf("NextCodeSequence returned error: %v", err)
+						return
+					}
+					mu.Lock()
+					if seen[seq.Sequence] {
+						t.Errorf("sequence %d allocated twice", seq.Sequence)
+					}
+					seen[seq.Sequence] = true
+					mu.Unlock()
+				}
+			}()
+		}
+		for i := 0; i < updaters; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for j := 0; j < allocations; j++ {
+					update := *stale
+					update.UpdatedAt = at(1)
+					if _, err := repos.Companies.Update(ctx, &update); err != nil {
+						t.Errorf("Update returned error: %v", err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		found, err := repos.Companies.FindByID(ctx, c.ID)
+		if err != nil {
+			t.Fatalf("find company: %v", err)
+		}
+		if want := start + allocators*allocations; found.EmployeeCodePolicy.NextSequence != want {
+			t.Fatalf("expected next sequence %d, got %d", want, found.EmployeeCodePolicy.NextSequence)
+		}
+	})
+
+	t.Run("Attributes", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "attributes")
+		other, err := repos.Users.Create(ctx, newUser("attributes-other@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		for _, key := range []string{"grade", "site"} {
+			if _, err := repos.Companies.CreateEmployeeAttribute(ctx, &company.EmployeeAttribute{
+				CompanyID: c.ID,
+				Key:       key,
+				Type:      company.AttributeTypeString,
+				CreatedAt: at(0),
+				UpdatedAt: at(0),
+			}); err != nil {
+				t.Fatalf("create attribute: %v", err)
+			}
+		}
+
+		first := newEmployee(c.ID, u.ID, "E001", at(0))
+		first.Attributes = map[string]string{"grade": "G1", "site": "tokyo"}
+		created, err := repos.Employees.Create(ctx, first)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.Attributes["grade"] != "G1" || created.Attributes["site"] != "tokyo" {
+			t.Fatalf("expected attributes to round-trip, got %+v", created.Attributes)
+		}
+		second := newEmployee(c.ID, other.ID, "E002", at(1))
+		second.Attributes = map[string]string{"grade": "G1", "site": "osaka"}
+		if _, err := repos.Employees.Create(ctx, second); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		list := func(attrs map[string]string) []*employee.Employee {
+			t.Helper()
+			employees, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, Limit: 10, Attributes: attrs})
+			if err != nil {
+				t.Fatalf("List returned error: %v", err)
+			}
+			return employees
+		}
+		assertEmployeeCodes(t, list(map[string]string{"grade": "G1"}), "E002", "E001")
+		assertEmployeeCodes(t, list(map[string]string{"grade": "G1", "site": "tokyo"}), "E001")
+		assertEmployeeCodes(t, list(map[string]string{"grade": "G2"}))
+
+		update := cloneForUpdate(created, at(2))
+		update.Attributes = map[string]string{"grade": "G2"}
+		updated, err := repos.Employees.Update(ctx, update)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if len(updated.Attributes) != 1 || updated.Attributes["grade"] != "G2" {
+			t.Fatalf("expected attributes to be replaced, got %+v", updated.Attributes)
+		}
+
+		if err := repos.Companies.DeleteEmployeeAttribute(ctx, c.ID, "site"); err != nil {
+			t.Fatalf("delete attribute: %v", err)
+		}
+		assertEmployeeCodes(t, list(map[string]string{"site": "osaka"}))
+		found, err := repos.Employees.FindByCompanyAndCode(ctx, c.ID, "E002")
+		if err != nil {
+			t.Fatalf("FindByCompanyAndCode returned error: %v", err)
+		}
+		if len(found.Attributes) != 1 || found.Attributes["grade"] != "G1" {
+			t.Fatalf("expected site to be removed from employee attributes, got %+v", found.Attributes)
+		}
+	})
+
+	t.Run("CompanyDeleteCascades", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "cascade")
+
+		created, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if err := repos.Companies.Delete(ctx, c.ID); err != nil {
+			t.Fatalf("delete company: %v", err)
+		}
+		if _, err := repos.Employees.FindByID(ctx, created.ID); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Fatalf("expected employee to be removed with its company, got %v", err)
+		}
+	})
+
+	t.Run("ListOrderingAndPagination", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "list")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("list-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		for i, code := range []string{"E001", "E002", "E003"} {
+			e := newEmployee(c.ID, u.ID, code, at(i))
+			if i == 1 {
+				e.Status = employee.StatusTerminated
+			}
+			if _, err := repos.Employees.Create(ctx, e); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(otherCompany.ID, u.ID, "X001", at(10))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		page, next, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, Limit: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "E003", "E002")
+		if next != "2" {
+			t.Fatalf("expected next token 2, got %q", next)
+		}
+
+		page, next, err = repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "E001")
+		if next != "" {
+			t.Fatalf("expected empty next token on last page, got %q", next)
+		}
+
+		terminated := employee.StatusTerminated
+		page, _, err = repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, Limit: 10, Status: &terminated})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "E002")
+
+		if _, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{Limit: 1}); !errors.Is(err, employee.ErrInvalidCompanyID) {
+			t.Errorf("expected ErrInvalidCompanyID, got %v", err)
+		}
+		if _, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID}); !errors.Is(err, employee.ErrInvalidPageSize) {
+			t.Errorf("expected ErrInvalidPageSize, got %v", err)
+		}
+		if _, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, Limit: 1, Offset: -1}); !errors.Is(err, employee.ErrInvalidPageToken) {
+			t.Errorf("expected ErrInvalidPageToken, got %v", err)
+		}
+	})
+
+	t.Run("ListIncludeSubsidiaries", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, root := seedUserAndCompany(t, repos, "group")
+
+		child := newCompany("group-child", at(1))
+		child.ParentCompanyID = &root.ID
+		child, err := repos.Companies.Create(ctx, child)
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+		grandchild := newCompany("group-grandchild", at(2))
+		grandchild.ParentCompanyID = &child.ID
+		grandchild, err = repos.Companies.Create(ctx, grandchild)
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+		unrelated, err := repos.Companies.Create(ctx, newCompany("unrelated", at(3)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		for i, e := range []*employee.Employee{
+			newEmployee(root.ID, u.ID, "R001", at(0)),
+			newEmployee(child.ID, u.ID, "C001", at(1)),
+			newEmployee(grandchild.ID, u.ID, "G001", at(2)),
+			newEmployee(unrelated.ID, u.ID, "U001", at(3)),
+		} {
+			if _, err := repos.Employees.Create(ctx, e); err != nil {
+				t.Fatalf("Create[%d] returned error: %v", i, err)
+			}
+		}
+
+		page, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: root.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "R001")
+
+		page, _, err = repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: root.ID, IncludeSubsidiaries: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "G001", "C001", "R001")
+
+		page, _, err = repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: child.ID, IncludeSubsidiaries: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "G001", "C001")
+	})
+
+	t.Run("Departments", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "org")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("org-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		root, err := repos.Departments.Create(ctx, newDepartment(c.ID, "root", nil, at(0)))
+		if err != nil {
+			t.Fatalf("create department: %v", err)
+		}
+		child, err := repos.Departments.Create(ctx, newDepartment(c.ID, "child", &root.ID, at(1)))
+		if err != nil {
+			t.Fatalf("create department: %v", err)
+		}
+		foreign, err := repos.Departments.Create(ctx, newDepartment(otherCompany.ID, "foreign", nil, at(2)))
+		if err != nil {
+			t.Fatalf("create department: %v", err)
+		}
+
+		for i, tc := range []struct {
+			code         string
+			departmentID *string
+		}{
+			{"R001", &root.ID},
+			{"C001", &child.ID},
+			{"N001", nil},
+		} {
+			e := newEmployee(c.ID, u.ID, tc.code, at(i))
+			e.DepartmentID = tc.departmentID
+			created, err := repos.Employees.Create(ctx, e)
+			if err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+			if (created.DepartmentID == nil) != (tc.departmentID == nil) || (created.DepartmentID != nil && *created.DepartmentID != *tc.departmentID) {
+				t.Fatalf("Create %s: expected department %v, got %v", tc.code, tc.departmentID, created.DepartmentID)
+			}
+		}
+
+		page, _, err := repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, DepartmentID: root.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "R001")
+
+		page, _, err = repos.Employees.List(ctx, employee.ListEmployeesFilter{CompanyID: c.ID, DepartmentID: root.ID, IncludeSubDepartments: true, Limit: 10})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "C001", "R001")
+
+		e := newEmployee(c.ID, u.ID, "X001", at(5))
+		e.DepartmentID = &foreign.ID
+		if _, err := repos.Employees.Create(ctx, e); !errors.Is(err, employee.ErrDepartmentNotFound) {
+			t.Errorf("expected ErrDepartmentNotFound for department in another company, got %v", err)
+		}
+
+		moved, err := repos.Employees.FindByCompanyAndCode(ctx, c.ID, "C001")
+		if err != nil {
+			t.Fatalf("FindByCompanyAndCode returned error: %v", err)
+		}
+		moved.DepartmentID = nil
+		moved.UpdatedAt = at(6)
+		updated, err := repos.Employees.Update(ctx, moved)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.DepartmentID != nil {
+			t.Fatalf("expected department to be cleared, got %v", *updated.DepartmentID)
+		}
+	})
+
+	t.Run("ReportingLines", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "org")
+		otherCompany, err := repos.Companies.Create(ctx, newCompany("org-other", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		create := func(companyID, code string, managerID *string, i int) *employee.Employee {
+			t.Helper()
+			e := newEmployee(companyID, u.ID, code, at(i))
+			e.ManagerEmployeeID = managerID
+			created, err := repos.Employees.Create(ctx, e)
+			if err != nil {
+				t.Fatalf("Create %s returned error: %v", code, err)
+			}
+			return created
+		}
+
+		ceo := create(c.ID, "CEO", nil, 0)
+		vp := create(c.ID, "VP", &ceo.ID, 1)
+		leadA := create(c.ID, "LEADA", &vp.ID, 2)
+		create(c.ID, "LEADB", &vp.ID, 3)
+		create(c.ID, "MEMBER", &leadA.ID, 4)
+		outsider := create(otherCompany.ID, "OUT", nil, 5)
+
+		if vp.ManagerEmployeeID == nil || *vp.ManagerEmployeeID != ceo.ID {
+			t.Fatalf("expected manager %s, got %v", ceo.ID, vp.ManagerEmployeeID)
+		}
+
+		page, next, err := repos.Employees.ListDirectReports(ctx, employee.ListDirectReportsFilter{ManagerID: vp.ID, Limit: 1})
+		if err != nil {
+			t.Fatalf("ListDirectReports returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "LEADB")
+		if next != "1" {
+			t.Fatalf("expected next token 1, got %q", next)
+		}
+
+		member, err := repos.Employees.FindByCompanyAndCode(ctx, c.ID, "MEMBER")
+		if err != nil {
+			t.Fatalf("FindByCompanyAndCode returned error: %v", err)
+		}
+		chain, err := repos.Employees.ListReportingChain(ctx, member.ID)
+		if err != nil {
+			t.Fatalf("ListReportingChain returned error: %v", err)
+		}
+		assertEmployeeCodes(t, chain, "LEADA", "VP", "CEO")
+
+		chain, err = repos.Employees.ListReportingChain(ctx, ceo.ID)
+		if err != nil {
+			t.Fatalf("ListReportingChain returned error: %v", err)
+		}
+		assertEmployeeCodes(t, chain)
+
+		subordinates, err := repos.Employees.ListSubordinates(ctx, ceo.ID, 2)
+		if err != nil {
+			t.Fatalf("ListSubordinates returned error: %v", err)
+		}
+		assertEmployeeCodes(t, subordinates, "VP", "LEADB", "LEADA")
+
+		subordinates, err = repos.Employees.ListSubordinates(ctx, ceo.ID, 10)
+		if err != nil {
+			t.Fatalf("ListSubordinates returned error: %v", err)
+		}
+		assertEmployeeCodes(t, subordinates, "VP", "LEADB", "LEADA", "MEMBER")
+
+		// 同時の付け替えなどで循環した報告ラインでも、たどった社員に戻った時点で打ち切られることを確認します。
+		looped := cloneForUpdate(ceo, at(6))
+		looped.ManagerEmployeeID = &member.ID
+		if _, err := repos.Employees.Update(ctx, looped); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		chain, err = repos.Employees.ListReportingChain(ctx, ceo.ID)
+		if err != nil {
+			t.Fatalf("ListReportingChain returned error: %v", err)
+		}
+		assertEmployeeCodes(t, chain, "MEMBER", "LEADA", "VP")
+		looped.ManagerEmployeeID = nil
+		if _, err := repos.Employees.Update(ctx, looped); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+
+		e := newEmployee(c.ID, u.ID, "X001", at(6))
+		e.ManagerEmployeeID = &outsider.ID
+		if _, err := repos.Employees.Create(ctx, e); !errors.Is(err, employee.ErrManagerNotFound) {
+			t.Errorf("expected ErrManagerNotFound for manager in another company, got %v", err)
+		}
+
+		self := cloneForUpdate(ceo, at(7))
+		self.ManagerEmployeeID = &ceo.ID
+		if _, err := repos.Employees.Update(ctx, self); !errors.Is(err, employee.ErrReportingCycle) {
+			t.Errorf("expected ErrReportingCycle for self manager, got %v", err)
+		}
+
+		if err := repos.Employees.Delete(ctx, leadA.ID); !errors.Is(err, employee.ErrManagerHasReports) {
+			t.Errorf("expected ErrManagerHasReports, got %v", err)
+		}
+
+		if err := repos.Employees.ReassignDirectReports(ctx, vp.ID, &ceo.ID, at(8)); err != nil {
+			t.Fatalf("ReassignDirectReports returned error: %v", err)
+		}
+		page, _, err = repos.Employees.ListDirectReports(ctx, employee.ListDirectReportsFilter{ManagerID: ceo.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("ListDirectReports returned error: %v", err)
+		}
+		assertEmployeeCodes(t, page, "LEADB", "LEADA", "VP")
+		for _, report := range page {
+			if report.ID != vp.ID && !report.UpdatedAt.Equal(at(8)) {
+				t.Fatalf("expected %s updated_at to be %v, got %v", report.EmployeeCode, at(8), report.UpdatedAt)
+			}
+		}
+
+		if err := repos.Employees.ReassignDirectReports(ctx, leadA.ID, nil, at(9)); err != nil {
+			t.Fatalf("ReassignDirectReports returned error: %v", err)
+		}
+		if err := repos.Employees.Delete(ctx, leadA.ID); err != nil {
+			t.Fatalf("Delete returned error after reassigning reports: %v", err)
+		}
+	})
+
+	t.Run("TransferLink", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "transfer")
+		target, err := repos.Companies.Create(ctx, newCompany("transfer-target", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+
+		source, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(1)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if source.TransferredFromEmployeeID != nil {
+			t.Fatalf("expected no transfer link, got %v", *source.TransferredFromEmployeeID)
+		}
+
+		e := newEmployee(target.ID, u.ID, "E001", at(2))
+		e.TransferredFromEmployeeID = &source.ID
+		transferred, err := repos.Employees.Create(ctx, e)
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if transferred.TransferredFromEmployeeID == nil || *transferred.TransferredFromEmployeeID != source.ID {
+			t.Fatalf("expected link to %s, got %v", source.ID, transferred.TransferredFromEmployeeID)
+		}
+
+		missing := uuid.NewString()
+		dangling := newEmployee(target.ID, u.ID, "E002", at(3))
+		dangling.TransferredFromEmployeeID = &missing
+		if _, err := repos.Employees.Create(ctx, dangling); err == nil {
+			t.Fatalf("expected link to a missing employee to be rejected")
+		}
+
+		if err := repos.Employees.Delete(ctx, source.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		found, err := repos.Employees.FindByID(ctx, transferred.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if found.TransferredFromEmployeeID != nil {
+			t.Fatalf("expected link to be cleared after source deletion, got %v", *found.TransferredFromEmployeeID)
+		}
+	})
+
+	t.Run("EmploymentsByUser", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, parent := seedUserAndCompany(t, repos, "employments")
+		child := newCompany("employments-child", at(0))
+		child.ParentCompanyID = &parent.ID
+		child, err := repos.Companies.Create(ctx, child)
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+		other, err := repos.Users.Create(ctx, newUser("employments-other@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+
+		former := newEmployee(parent.ID, u.ID, "E001", at(1))
+		former.Status = employee.StatusTerminated
+		if _, err := repos.Employees.Create(ctx, former); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(child.ID, u.ID, "E001", at(2))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(parent.ID, other.ID, "E002", at(3))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		first, next, err := repos.Employees.ListEmploymentsByUser(ctx, employee.ListEmploymentsFilter{UserID: u.ID, Limit: 1})
+		if err != nil {
+			t.Fatalf("ListEmploymentsByUser returned error: %v", err)
+		}
+		if len(first) != 1 || next != "1" {
+			t.Fatalf("unexpected first page: %d employments, token %q", len(first), next)
+		}
+		current := first[0]
+		if current.Employee.CompanyID != child.ID || current.Employee.User == nil || current.Employee.User.ID != u.ID {
+			t.Fatalf("expected newest employment first, got %+v", current.Employee)
+		}
+		if current.Company == nil || current.Company.ID != child.ID || current.Company.Name != child.Name || current.Company.Code != child.Code || current.Company.Status != string(company.StatusActive) {
+			t.Fatalf("unexpected company snapshot: %+v", current.Company)
+		}
+		if current.Company.ParentCompanyID == nil || *current.Company.ParentCompanyID != parent.ID {
+			t.Fatalf("expected parent company %s, got %v", parent.ID, current.Company.ParentCompanyID)
+		}
+
+		second, next, err := repos.Employees.ListEmploymentsByUser(ctx, employee.ListEmploymentsFilter{UserID: u.ID, Limit: 1, Offset: 1})
+		if err != nil {
+			t.Fatalf("ListEmploymentsByUser returned error: %v", err)
+		}
+		if len(second) != 1 || next != "" {
+			t.Fatalf("unexpected second page: %d employments, token %q", len(second), next)
+		}
+		if second[0].Employee.Status != employee.StatusTerminated || second[0].Company.ID != parent.ID || second[0].Company.ParentCompanyID != nil {
+			t.Fatalf("unexpected former employment: %+v / %+v", second[0].Employee, second[0].Company)
+		}
+
+		none, _, err := repos.Employees.ListEmploymentsByUser(ctx, employee.ListEmploymentsFilter{UserID: uuid.NewString(), Limit: 10})
+		if err != nil {
+			t.Fatalf("ListEmploymentsByUser returned error: %v", err)
+		}
+		if len(none) != 0 {
+			t.Fatalf("expected no employments for unknown user, got %d", len(none))
+		}
+	})
+
+	t.Run("DueStatusTransitions", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "emp-due")
+
+		asOf := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+		past := asOf.AddDate(0, 0, -1)
+		future := asOf.AddDate(0, 0, 1)
+		seed := func(code string, status employee.Status, hiredAt, terminatedAt *time.Time, i int) {
+			t.Helper()
+			otherUser, err := repos.Users.Create(ctx, newUser(code+"@example.com", at(i)))
+			if err != nil {
+				t.Fatalf("create user: %v", err)
+			}
+			e := newEmployee(c.ID, otherUser.ID, code, at(i))
+			e.Status = status
+			e.HiredAt = hiredAt
+			e.TerminatedAt = terminatedAt
+			if _, err := repos.Employees.Create(ctx, e); err != nil {
+				t.Fatalf("Create %s returned error: %v", code, err)
+			}
+		}
+
+		seed("E001", employee.StatusPending, &asOf, nil, 1)
+		seed("E002", employee.StatusPending, &future, nil, 2)
+		seed("E003", employee.StatusActive, &past, &asOf, 3)
+		seed("E004", employee.StatusOnLeave, &past, &future, 4)
+		seed("E005", employee.StatusTerminated, &past, &past, 5)
+		seed("E006", employee.StatusOnLeave, nil, &past, 6)
+		if _, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E007", at(7))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		due, err := repos.Employees.ListDueStatusTransitions(ctx, asOf)
+		if err != nil {
+			t.Fatalf("ListDueStatusTransitions returned error: %v", err)
+		}
+		assertEmployeeCodes(t, due, "E001", "E003", "E006")
+		if due[0].User == nil {
+			t.Fatalf("expected user snapshot to be attached")
+		}
+	})
+
+	t.Run("History", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		u, c := seedUserAndCompany(t, repos, "org")
+
+		created, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		day := func(month, d int) time.Time { return time.Date(2024, time.Month(month), d, 0, 0, 0, 0, time.UTC) }
+		record := func(code string, status employee.Status, from time.Time, i int) {
+			t.Helper()
+			if _, err := repos.Employees.RecordHistory(ctx, &employee.HistoryEntry{
+				EmployeeID:    created.ID,
+				CompanyID:     c.ID,
+				EmployeeCode:  code,
+				UserID:        u.ID,
+				Status:        status,
+				EffectiveFrom: from,
+				CreatedAt:     at(i),
+			}); err != nil {
+				t.Fatalf("RecordHistory %s returned error: %v", code, err)
+			}
+		}
+
+		record("E001", employee.StatusActive, day(1, 10), 1)
+		record("E100", employee.StatusActive, day(3, 1), 2)
+		record("E100", employee.StatusTerminated, day(3, 1), 3)
+		record("E100", employee.StatusActive, day(5, 1), 4)
+
+		entries, next, err := repos.Employees.ListHistory(ctx, employee.ListHistoryFilter{EmployeeID: created.ID, Limit: 2})
+		if err != nil {
+			t.Fatalf("ListHistory returned error: %v", err)
+		}
+		if next != "2" || len(entries) != 2 {
+			t.Fatalf("expected 2 entries with next token 2, got %d entries and %q", len(entries), next)
+		}
+		if entries[0].EffectiveTo != nil || !entries[0].EffectiveFrom.Equal(day(5, 1)) {
+			t.Fatalf("unexpected open entry: %+v", entries[0])
+		}
+		if entries[1].Status != employee.StatusTerminated || entries[1].EffectiveTo == nil || !entries[1].EffectiveTo.Equal(day(5, 1)) {
+			t.Fatalf("expected same-day entry to be replaced and closed at 05-01, got %+v", entries[1])
+		}
+
+		entries, next, err = repos.Employees.ListHistory(ctx, employee.ListHistoryFilter{EmployeeID: created.ID, Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("ListHistory returned error: %v", err)
+		}
+		if next != "" || len(entries) != 1 || entries[0].EmployeeCode != "E001" {
+			t.Fatalf("unexpected last page: %+v (next %q)", entries, next)
+		}
+
+		for _, tc := range []struct {
+			date time.Time
+			code string
+			want employee.Status
+		}{
+			{day(1, 10), "E001", employee.StatusActive},
+			{day(2, 29), "E001", employee.StatusActive},
+			{day(3, 1), "E100", employee.StatusTerminated},
+			{day(12, 31), "E100", employee.StatusActive},
+		} {
+			entry, err := repos.Employees.FindHistoryAsOf(ctx, created.ID, tc.date)
+			if err != nil {
+				t.Fatalf("FindHistoryAsOf(%v) returned error: %v", tc.date, err)
+			}
+			if entry.EmployeeCode != tc.code || entry.Status != tc.want {
+				t.Fatalf("FindHistoryAsOf(%v): expected %s/%s, got %s/%s", tc.date, tc.code, tc.want, entry.EmployeeCode, entry.Status)
+			}
+		}
+
+		if _, err := repos.Employees.FindHistoryAsOf(ctx, created.ID, day(1, 9)); !errors.Is(err, employee.ErrHistoryNotFound) {
+			t.Errorf("expected ErrHistoryNotFound before the first entry, got %v", err)
+		}
+
+		if _, err := repos.Employees.RecordHistory(ctx, &employee.HistoryEntry{
+			EmployeeID:    uuid.NewString(),
+			CompanyID:     c.ID,
+			EmployeeCode:  "E999",
+			UserID:        u.ID,
+			Status:        employee.StatusActive,
+			EffectiveFrom: day(1, 1),
+			CreatedAt:     at(5),
+		}); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("expected ErrEmployeeNotFound for unknown employee, got %v", err)
+		}
+
+		leaving := cloneForUpdate(created, at(6))
+		scheduled := day(12, 31)
+		leaving.TerminatedAt = &scheduled
+		if _, err := repos.Employees.Update(ctx, leaving); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if _, err := repos.Employees.FindTerminatedByCompanyAndUser(ctx, c.ID, u.ID); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("expected ErrEmployeeNotFound before status is terminated, got %v", err)
+		}
+
+		leaving = cloneForUpdate(leaving, at(7))
+		leaving.Status = employee.StatusTerminated
+		if _, err := repos.Employees.Update(ctx, leaving); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		terminated, err := repos.Employees.FindTerminatedByCompanyAndUser(ctx, c.ID, u.ID)
+		if err != nil {
+			t.Fatalf("FindTerminatedByCompanyAndUser returned error: %v", err)
+		}
+		if terminated.ID != created.ID {
+			t.Fatalf("expected %s, got %s", created.ID, terminated.ID)
+		}
+		if _, err := repos.Employees.FindTerminatedByCompanyAndUser(ctx, uuid.NewString(), u.ID); !errors.Is(err, employee.ErrEmployeeNotFound) {
+			t.Errorf("expected ErrEmployeeNotFound, got %v", err)
+		}
+
+		if err := repos.Employees.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		entries, _, err = repos.Employees.ListHistory(ctx, employee.ListHistoryFilter{EmployeeID: created.ID, Limit: 10})
+		if err != nil {
+			t.Fatalf("ListHistory returned error: %v", err)
+		}
+		if len(entries) != 0 {
+			t.Fatalf("expected history to be deleted with the employee, got %d entries", len(entries))
+		}
+	})
+}
+
+// cloneForUpdate は更新用に社員を複製します。
+func cloneForUpdate(e *employee.Employee, updatedAt time.Time) *employee.Employee {
+	clone := *e
+	clone.UpdatedAt = updatedAt
+	return &clone
+}
+
+func seedUserAndCompany(t *testing.T, repos Repositories, code string) (*user.User, *company.Company) {
+	t.Helper()
+	ctx := context.Background()
+
+	u, err := repos.Users.Create(ctx, newUser(code+"@example.com", at(0)))
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+	c, err := repos.Companies.Create(ctx, newCompany(code, at(0)))
+	if err != nil {
+		t.Fatalf("create company: %v", err)
+	}
+	return u, c
+}
+
+func assertEmployeeCodes(t *testing.T, employees []*employee.Employee, want ...string) {
+	t.Helper()
+	if len(employees) != len(want) {
+		t.Fatalf("expected %d employees, got %d", len(want), len(employees))
+	}
+	for i, e := range employees {
+		if e.EmployeeCode != want[i] {
+			t.Fatalf("employees[%d]: expected %s, got %s", i, want[i], e.EmployeeCode)
+		}
+	}
+}
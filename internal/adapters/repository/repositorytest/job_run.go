@@ -0,0 +1,91 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// RunJobRunRepositorySuite は scheduler.RunRepository の適合テストを実行します。
+func RunJobRunRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("Lifecycle", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		created, err := repos.JobRuns.CreateRun(ctx, &scheduler.Run{
+			JobName:     "nightly",
+			Status:      scheduler.RunStatusRunning,
+			ScheduledAt: at(0),
+			StartedAt:   at(0),
+		})
+		if err != nil {
+			t.Fatalf("CreateRun returned error: %v", err)
+		}
+		if created.ID == "" || created.Status != scheduler.RunStatusRunning || !created.ScheduledAt.Equal(at(0)) || created.FinishedAt != nil || created.Error != nil {
+			t.Fatalf("unexpected created run: %+v", created)
+		}
+
+		if _, err := repos.JobRuns.CreateRun(ctx, &scheduler.Run{
+			JobName:     "nightly",
+			Status:      scheduler.RunStatusRunning,
+			ScheduledAt: at(0),
+			StartedAt:   at(0),
+		}); !errors.Is(err, scheduler.ErrRunAlreadyExists) {
+			t.Errorf("expected ErrRunAlreadyExists, got %v", err)
+		}
+
+		finishedAt := at(1)
+		message := "boom"
+		created.Status = scheduler.RunStatusFailed
+		created.FinishedAt = &finishedAt
+		created.Error = &message
+		finished, err := repos.JobRuns.FinishRun(ctx, created)
+		if err != nil {
+			t.Fatalf("FinishRun returned error: %v", err)
+		}
+		if finished.Status != scheduler.RunStatusFailed || finished.FinishedAt == nil || !finished.FinishedAt.Equal(finishedAt) || finished.Error == nil || *finished.Error != message {
+			t.Fatalf("unexpected finished run: %+v", finished)
+		}
+
+		if _, err := repos.JobRuns.FinishRun(ctx, &scheduler.Run{ID: uuid.NewString(), Status: scheduler.RunStatusSucceeded}); !errors.Is(err, scheduler.ErrRunNotFound) {
+			t.Errorf("expected ErrRunNotFound, got %v", err)
+		}
+	})
+
+	t.Run("ListRuns", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		for i, name := range []string{"nightly", "nightly", "other", "nightly"} {
+			if _, err := repos.JobRuns.CreateRun(ctx, &scheduler.Run{
+				JobName:     name,
+				Status:      scheduler.RunStatusSucceeded,
+				ScheduledAt: at(i),
+				StartedAt:   at(i),
+			}); err != nil {
+				t.Fatalf("CreateRun returned error: %v", err)
+			}
+		}
+
+		runs, err := repos.JobRuns.ListRuns(ctx, "nightly", 2)
+		if err != nil {
+			t.Fatalf("ListRuns returned error: %v", err)
+		}
+		if len(runs) != 2 || !runs[0].ScheduledAt.Equal(at(3)) || !runs[1].ScheduledAt.Equal(at(1)) {
+			t.Fatalf("expected latest nightly runs first, got %+v", runs)
+		}
+
+		runs, err = repos.JobRuns.ListRuns(ctx, "missing", 10)
+		if err != nil {
+			t.Fatalf("ListRuns returned error: %v", err)
+		}
+		if len(runs) != 0 {
+			t.Fatalf("expected no runs, got %+v", runs)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+// Package repositorytest は Repository インターフェースの実装が満たすべき振る舞いを検証する
+// 共通の適合テストスイートを提供します。PostgreSQL 実装とインメモリ実装の双方で同じスイートを実行し、
+// 並び順・ページング・ドメインエラーの差異を検出します。
+package repositorytest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+// Repositories はスイートが利用するリポジトリの組です。
+// 社員・部署リポジトリの検証では外部キーを満たすためにユーザー・会社リポジトリも利用します。
+type Repositories struct {
+	Users               user.Repository
+	Companies           company.Repository
+	Employees           employee.Repository
+	Departments         department.Repository
+	JobRuns             scheduler.RunRepository
+	EmailChangeTokens   user.EmailChangeTokenRepository
+	Credentials         auth.CredentialRepository
+	PasswordSetupTokens auth.PasswordSetupTokenRepository
+}
+
+// Factory は空のデータストアに接続したリポジトリを返します。各サブテストの開始時に呼び出されます。
+type Factory func(t *testing.T) Repositories
+
+// baseTime はテストデータの作成日時の基準です。PostgreSQL の精度に合わせて秒単位にしています。
+var baseTime = time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func newUser(email string, createdAt time.Time) *user.User {
+	return &user.User{
+		Email:       email,
+		Name:        "Conformance",
+		DisplayName: "Conformance",
+		Status:      user.StatusActive,
+		CreatedAt:   createdAt,
+		UpdatedAt:   createdAt,
+	}
+}
+
+func newCompany(code string, createdAt time.Time) *company.Company {
+	return &company.Company{
+		Name:               "Company " + code,
+		Code:               code,
+		Status:             company.StatusActive,
+		EmployeeCodePolicy: company.DefaultEmployeeCodePolicy,
+		CreatedAt:          createdAt,
+		UpdatedAt:          createdAt,
+	}
+}
+
+func newEmployee(companyID, userID, code string, createdAt time.Time) *employee.Employee {
+	return &employee.Employee{
+		CompanyID:    companyID,
+		UserID:       userID,
+		EmployeeCode: code,
+		Status:       employee.StatusActive,
+		CreatedAt:    createdAt,
+		UpdatedAt:    createdAt,
+	}
+}
+
+func newDepartment(companyID, code string, parentID *string, createdAt time.Time) *department.Department {
+	return &department.Department{
+		CompanyID:          companyID,
+		ParentDepartmentID: parentID,
+		Name:               "Department " + code,
+		Code:               code,
+		CreatedAt:          createdAt,
+		UpdatedAt:          createdAt,
+	}
+}
+
+func at(i int) time.Time {
+	return baseTime.Add(time.Duration(i) * time.Minute)
+}
@@ -0,0 +1,325 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+)
+
+// RunUserRepositorySuite は user.Repository の適合テストを実行します。
+func RunUserRepositorySuite(t *testing.T, factory Factory) {
+	t.Helper()
+
+	t.Run("CRUD", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		created, err := repos.Users.Create(ctx, newUser("crud@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.ID == "" {
+			t.Fatal("expected generated id")
+		}
+		if created.Email != "crud@example.com" || created.Status != user.StatusActive {
+			t.Fatalf("unexpected created user: %+v", created)
+		}
+
+		byID, err := repos.Users.FindByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if byID.Email != created.Email {
+			t.Fatalf("FindByID returned %+v", byID)
+		}
+		byEmail, err := repos.Users.FindByEmail(ctx, created.Email)
+		if err != nil {
+			t.Fatalf("FindByEmail returned error: %v", err)
+		}
+		if byEmail.ID != created.ID {
+			t.Fatalf("FindByEmail returned %+v", byEmail)
+		}
+
+		created.Name = "Renamed"
+		created.Status = user.StatusInactive
+		created.UpdatedAt = at(1)
+		updated, err := repos.Users.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.Name != "Renamed" || updated.Status != user.StatusInactive || !updated.UpdatedAt.Equal(at(1)) {
+			t.Fatalf("update not applied: %+v", updated)
+		}
+
+		if err := repos.Users.Delete(ctx, created.ID); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		if _, err := repos.Users.FindByID(ctx, created.ID); !errors.Is(err, user.ErrUserNotFound) {
+			t.Fatalf("expected ErrUserNotFound after delete, got %v", err)
+		}
+	})
+
+	t.Run("DuplicateEmail", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		if _, err := repos.Users.Create(ctx, newUser("dup@example.com", at(0))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if _, err := repos.Users.Create(ctx, newUser("dup@example.com", at(1))); !errors.Is(err, user.ErrEmailAlreadyExists) {
+			t.Fatalf("expected ErrEmailAlreadyExists, got %v", err)
+		}
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+		missing := uuid.NewString()
+
+		if _, err := repos.Users.FindByID(ctx, missing); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("FindByID: expected ErrUserNotFound, got %v", err)
+		}
+		if _, err := repos.Users.FindByEmail(ctx, "missing@example.com"); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("FindByEmail: expected ErrUserNotFound, got %v", err)
+		}
+		u := newUser("missing@example.com", at(0))
+		u.ID = missing
+		if _, err := repos.Users.Update(ctx, u); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("Update: expected ErrUserNotFound, got %v", err)
+		}
+		if err := repos.Users.Delete(ctx, missing); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("Delete: expected ErrUserNotFound, got %v", err)
+		}
+	})
+
+	t.Run("DeleteReferencedByEmployee", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		u, err := repos.Users.Create(ctx, newUser("referenced@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("create user: %v", err)
+		}
+		c, err := repos.Companies.Create(ctx, newCompany("referenced", at(0)))
+		if err != nil {
+			t.Fatalf("create company: %v", err)
+		}
+		if _, err := repos.Employees.Create(ctx, newEmployee(c.ID, u.ID, "E001", at(0))); err != nil {
+			t.Fatalf("create employee: %v", err)
+		}
+
+		if err := repos.Users.Delete(ctx, u.ID); !errors.Is(err, user.ErrUserHasEmployments) {
+			t.Fatalf("expected ErrUserHasEmployments, got %v", err)
+		}
+		if _, err := repos.Users.FindByID(ctx, u.ID); err != nil {
+			t.Fatalf("expected referenced user to remain, got %v", err)
+		}
+	})
+
+	t.Run("ListOrderingAndPagination", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		emails := []string{"a@example.com", "b@example.com", "c@example.com"}
+		for i, email := range emails {
+			u := newUser(email, at(i))
+			if i == 1 {
+				u.Status = user.StatusInactive
+			}
+			if _, err := repos.Users.Create(ctx, u); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+
+		page, next, err := repos.Users.List(ctx, user.ListUsersFilter{Limit: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertUserEmails(t, page, "c@example.com", "b@example.com")
+		if next != "2" {
+			t.Fatalf("expected next token 2, got %q", next)
+		}
+
+		page, next, err = repos.Users.List(ctx, user.ListUsersFilter{Limit: 2, Offset: 2})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertUserEmails(t, page, "a@example.com")
+		if next != "" {
+			t.Fatalf("expected empty next token on last page, got %q", next)
+		}
+
+		inactive := user.StatusInactive
+		page, _, err = repos.Users.List(ctx, user.ListUsersFilter{Limit: 10, Status: &inactive})
+		if err != nil {
+			t.Fatalf("List returned error: %v", err)
+		}
+		assertUserEmails(t, page, "b@example.com")
+
+		if _, _, err := repos.Users.List(ctx, user.ListUsersFilter{Limit: 0}); !errors.Is(err, user.ErrInvalidPageSize) {
+			t.Errorf("expected ErrInvalidPageSize, got %v", err)
+		}
+		if _, _, err := repos.Users.List(ctx, user.ListUsersFilter{Limit: 1, Offset: -1}); !errors.Is(err, user.ErrInvalidPageToken) {
+			t.Errorf("expected ErrInvalidPageToken, got %v", err)
+		}
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		labelSets := map[string]map[string]string{
+			"apac@example.com": {"region": "apac", "tier": "gold"},
+			"free@example.com": {"region": "apac", "tier": "free"},
+			"emea@example.com": {"region": "emea"},
+			"none@example.com": nil,
+		}
+		for i, email := range []string{"apac@example.com", "free@example.com", "emea@example.com", "none@example.com"} {
+			u := newUser(email, at(i))
+			u.Labels = labelSets[email]
+			if _, err := repos.Users.Create(ctx, u); err != nil {
+				t.Fatalf("Create returned error: %v", err)
+			}
+		}
+
+		found, err := repos.Users.FindByEmail(ctx, "apac@example.com")
+		if err != nil {
+			t.Fatalf("FindByEmail returned error: %v", err)
+		}
+		if found.Labels["region"] != "apac" || found.Labels["tier"] != "gold" {
+			t.Fatalf("labels not persisted: %+v", found.Labels)
+		}
+
+		cases := []struct {
+			selector string
+			want     []string
+		}{
+			{selector: "region=apac", want: []string{"free@example.com", "apac@example.com"}},
+			{selector: "region=apac,tier!=free", want: []string{"apac@example.com"}},
+			{selector: "tier!=free", want: []string{"none@example.com", "emea@example.com", "apac@example.com"}},
+			{selector: "tier", want: []string{"free@example.com", "apac@example.com"}},
+			{selector: "!region", want: []string{"none@example.com"}},
+		}
+		for _, tc := range cases {
+			selector, err := label.ParseSelector(tc.selector)
+			if err != nil {
+				t.Fatalf("ParseSelector(%q) returned error: %v", tc.selector, err)
+			}
+			page, _, err := repos.Users.List(ctx, user.ListUsersFilter{Limit: 10, LabelSelector: selector})
+			if err != nil {
+				t.Fatalf("List(%q) returned error: %v", tc.selector, err)
+			}
+			assertUserEmails(t, page, tc.want...)
+		}
+
+		found.Labels = map[string]string{"region": "emea"}
+		found.UpdatedAt = at(10)
+		updated, err := repos.Users.Update(ctx, found)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if len(updated.Labels) != 1 || updated.Labels["region"] != "emea" {
+			t.Fatalf("labels not updated: %+v", updated.Labels)
+		}
+	})
+
+	t.Run("Profile", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		created, err := repos.Users.Create(ctx, newUser("profile@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.DisplayName != "Conformance" || created.FamilyName != nil || created.Locale != nil || created.AvatarURL != nil {
+			t.Fatalf("unexpected profile on create: %+v", created)
+		}
+
+		family, given, locale, zone, phone, avatar := "山田", "太郎", "ja-JP", "Asia/Tokyo", "+819012345678", "https://example.com/a.png"
+		created.FamilyName = &family
+		created.GivenName = &given
+		created.DisplayName = "山田 太郎"
+		created.Locale = &locale
+		created.TimeZone = &zone
+		created.Phone = &phone
+		created.AvatarURL = &avatar
+		created.UpdatedAt = at(1)
+		if _, err := repos.Users.Update(ctx, created); err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+
+		found, err := repos.Users.FindByID(ctx, created.ID)
+		if err != nil {
+			t.Fatalf("FindByID returned error: %v", err)
+		}
+		if found.FamilyName == nil || *found.FamilyName != family || found.GivenName == nil || *found.GivenName != given ||
+			found.DisplayName != "山田 太郎" || found.Locale == nil || *found.Locale != locale ||
+			found.TimeZone == nil || *found.TimeZone != zone || found.Phone == nil || *found.Phone != phone ||
+			found.AvatarURL == nil || *found.AvatarURL != avatar {
+			t.Fatalf("profile not persisted: %+v", found)
+		}
+
+		found.Phone = nil
+		found.AvatarURL = nil
+		found.UpdatedAt = at(2)
+		cleared, err := repos.Users.Update(ctx, found)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if cleared.Phone != nil || cleared.AvatarURL != nil || cleared.Locale == nil {
+			t.Fatalf("expected phone and avatar to be cleared: %+v", cleared)
+		}
+	})
+
+	t.Run("Email", func(t *testing.T) {
+		repos := factory(t)
+		ctx := context.Background()
+
+		created, err := repos.Users.Create(ctx, newUser("before@example.com", at(0)))
+		if err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+		if created.EmailVerifiedAt != nil {
+			t.Fatalf("expected email to be unverified on create: %+v", created)
+		}
+		if _, err := repos.Users.Create(ctx, newUser("taken@example.com", at(1))); err != nil {
+			t.Fatalf("Create returned error: %v", err)
+		}
+
+		verifiedAt := at(2)
+		created.Email = "after@example.com"
+		created.EmailVerifiedAt = &verifiedAt
+		created.UpdatedAt = at(2)
+		updated, err := repos.Users.Update(ctx, created)
+		if err != nil {
+			t.Fatalf("Update returned error: %v", err)
+		}
+		if updated.Email != "after@example.com" || updated.EmailVerifiedAt == nil || !updated.EmailVerifiedAt.Equal(verifiedAt) {
+			t.Fatalf("email not persisted: %+v", updated)
+		}
+		if _, err := repos.Users.FindByEmail(ctx, "before@example.com"); !errors.Is(err, user.ErrUserNotFound) {
+			t.Errorf("expected old email to be released, got %v", err)
+		}
+
+		updated.Email = "taken@example.com"
+		if _, err := repos.Users.Update(ctx, updated); !errors.Is(err, user.ErrEmailAlreadyExists) {
+			t.Errorf("expected ErrEmailAlreadyExists, got %v", err)
+		}
+	})
+}
+
+func assertUserEmails(t *testing.T, users []*user.User, want ...string) {
+	t.Helper()
+	if len(users) != len(want) {
+		t.Fatalf("expected %d users, got %d", len(want), len(users))
+	}
+	for i, u := range users {
+		if u.Email != want[i] {
+			t.Fatalf("users[%d]: expected %s, got %s", i, want[i], u.Email)
+		}
+	}
+}
@@ -0,0 +1,735 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+// CompanyRepository は SQLite を利用した会社リポジトリ実装です。
+type CompanyRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewCompanyRepository は CompanyRepository を生成します。
+func NewCompanyRepository(db sqlitedb.Queryer) *CompanyRepository {
+	return &CompanyRepository{db: db}
+}
+
+// Create は会社を新規作成します。
+func (r *CompanyRepository) Create(ctx context.Context, c *company.Company) (*company.Company, error) {
+	labels, err := marshalStringMap(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO companies (id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        RETURNING id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+    `, uuid.NewString(), c.Name, c.Code, string(c.Status), nullableString(c.Description), nullableString(c.ParentCompanyID), c.EmployeeCodePolicy.Prefix, c.EmployeeCodePolicy.Width, c.EmployeeCodePolicy.NextSequence, labels, nullableString(c.Website), formatTimestamp(c.CreatedAt), formatTimestamp(c.UpdatedAt))
+
+	created, err := scanCompany(row)
+	if err != nil {
+		return nil, translateCompanyError(err)
+	}
+	return created, nil
+}
+
+// Update は会社情報を更新します。employee_code_next_sequence は NextCodeSequence と競合しないよう更新しません。
+func (r *CompanyRepository) Update(ctx context.Context, c *company.Company) (*company.Company, error) {
+	labels, err := marshalStringMap(c.Labels)
+	if err != nil {
+		return nil, err
+	}
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        UPDATE companies
+           SET name = ?,
+               code = ?,
+               status = ?,
+               description = ?,
+               parent_company_id = ?,
+               employee_code_prefix = ?,
+               employee_code_width = ?,
+               labels = ?,
+               website = ?,
+               updated_at = ?
+         WHERE id = ?
+        RETURNING id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+    `, c.Name, c.Code, string(c.Status), nullableString(c.Description), nullableString(c.ParentCompanyID), c.EmployeeCodePolicy.Prefix, c.EmployeeCodePolicy.Width, labels, nullableString(c.Website), formatTimestamp(c.UpdatedAt), c.ID)
+
+	updated, err := scanCompany(row)
+	if err != nil {
+		return nil, translateCompanyError(err)
+	}
+	return updated, nil
+}
+
+// SetEmployeeCodeNextSequence は社員コードの次の連番を設定します。
+func (r *CompanyRepository) SetEmployeeCodeNextSequence(ctx context.Context, id string, next int64) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `UPDATE companies SET employee_code_next_sequence = ? WHERE id = ?`, next, id)
+	if err != nil {
+		return translateCompanyError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return company.ErrCompanyNotFound
+	}
+	return nil
+}
+
+// Delete は会社を削除します。
+func (r *CompanyRepository) Delete(ctx context.Context, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM companies WHERE id = ?`, id)
+	if err != nil {
+		if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+			return company.ErrCompanyHasSubsidiaries
+		}
+		return translateCompanyError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return company.ErrCompanyNotFound
+	}
+	return nil
+}
+
+// FindByID は ID で会社を取得します。
+func (r *CompanyRepository) FindByID(ctx context.Context, id string) (*company.Company, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+          FROM companies
+         WHERE id = ?
+    `, id)
+
+	found, err := scanCompany(row)
+	if err != nil {
+		return nil, translateCompanyError(err)
+	}
+	return found, nil
+}
+
+// FindByCode はコードで会社を取得します。
+func (r *CompanyRepository) FindByCode(ctx context.Context, code string) (*company.Company, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+          FROM companies
+         WHERE code = ?
+    `, code)
+
+	found, err := scanCompany(row)
+	if err != nil {
+		return nil, translateCompanyError(err)
+	}
+	return found, nil
+}
+
+// List は会社の一覧を取得します。
+func (r *CompanyRepository) List(ctx context.Context, filter company.ListCompaniesFilter) ([]*company.Company, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", company.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", company.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := make([]any, 0, 3)
+	conditions := make([]string, 0, 1)
+
+	if filter.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(*filter.Status))
+	}
+
+	labelConditions, args := labelSelectorConditions("labels", filter.LabelSelector, args)
+	conditions = append(conditions, labelConditions...)
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limitWithBuffer, filter.Offset)
+
+	companies, err := r.query(ctx, `
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+          FROM companies`+whereClause+`
+         ORDER BY created_at DESC, id DESC
+         LIMIT ? OFFSET ?
+    `, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(companies) == limitWithBuffer {
+		companies = companies[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return companies, nextToken, nil
+}
+
+// ListSubsidiaries は子会社の一覧を取得します。Recursive の場合は再帰 CTE で孫会社以下も含めます。
+func (r *CompanyRepository) ListSubsidiaries(ctx context.Context, filter company.ListSubsidiariesFilter) ([]*company.Company, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", company.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", company.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := []any{filter.ParentID}
+	conditions := []string{"parent_company_id = ?"}
+	if filter.Recursive {
+		conditions = []string{`id IN (
+            WITH RECURSIVE subsidiaries AS (
+                SELECT id FROM companies WHERE parent_company_id = ?
+                UNION
+                SELECT c.id FROM companies c JOIN subsidiaries s ON c.parent_company_id = s.id
+            )
+            SELECT id FROM subsidiaries
+        )`}
+	}
+
+	if filter.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(*filter.Status))
+	}
+	args = append(args, limitWithBuffer, filter.Offset)
+
+	companies, err := r.query(ctx, `
+        SELECT id, name, code, status, description, parent_company_id, employee_code_prefix, employee_code_width, employee_code_next_sequence, labels, website, created_at, updated_at
+          FROM companies
+         WHERE `+strings.Join(conditions, " AND ")+`
+         ORDER BY created_at DESC, id DESC
+         LIMIT ? OFFSET ?
+    `, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(companies) == limitWithBuffer {
+		companies = companies[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return companies, nextToken, nil
+}
+
+// ListAncestors は直近の親会社から最上位の会社までを順に返します。
+// 階層が循環している場合は、たどった会社（id 自身を含む）に戻った時点で打ち切ります。
+func (r *CompanyRepository) ListAncestors(ctx context.Context, id string) ([]*company.Company, error) {
+	return r.query(ctx, `
+        WITH RECURSIVE ancestors AS (
+            SELECT parent_company_id AS id, 1 AS depth, '/' || id || '/' AS path
+              FROM companies
+             WHERE id = ?
+            UNION ALL
+            SELECT c.parent_company_id, a.depth + 1, a.path || c.id || '/'
+              FROM companies c
+              JOIN ancestors a ON c.id = a.id
+             WHERE instr(a.path, '/' || c.id || '/') = 0
+        )
+        SELECT c.id, c.name, c.code, c.status, c.description, c.parent_company_id, c.employee_code_prefix, c.employee_code_width, c.employee_code_next_sequence, c.labels, c.website, c.created_at, c.updated_at
+          FROM ancestors a
+          JOIN companies c ON c.id = a.id
+         WHERE instr(a.path, '/' || a.id || '/') = 0
+         ORDER BY a.depth
+    `, id)
+}
+
+// LockHierarchy は何もしません。書き込みトランザクションは BEGIN IMMEDIATE で開始するため、付け替えは既に直列化されています。
+func (r *CompanyRepository) LockHierarchy(context.Context) error {
+	return nil
+}
+
+func (r *CompanyRepository) query(ctx context.Context, query string, args ...any) ([]*company.Company, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, translateCompanyError(err)
+	}
+	defer rows.Close()
+
+	var companies []*company.Company
+	for rows.Next() {
+		c, err := scanCompany(rows)
+		if err != nil {
+			return nil, translateCompanyError(err)
+		}
+		companies = append(companies, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateCompanyError(err)
+	}
+	return companies, nil
+}
+
+// CreateEmployeeAttribute は社員属性の定義を追加します。
+func (r *CompanyRepository) CreateEmployeeAttribute(ctx context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	allowed, err := marshalAllowedValues(a.AllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO company_employee_attributes (company_id, key, type, required, allowed_values, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING company_id, key, type, required, allowed_values, created_at, updated_at
+    `, a.CompanyID, a.Key, string(a.Type), a.Required, allowed, formatTimestamp(a.CreatedAt), formatTimestamp(a.UpdatedAt))
+
+	created, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributeError(err)
+	}
+	return created, nil
+}
+
+// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+func (r *CompanyRepository) UpdateEmployeeAttribute(ctx context.Context, a *company.EmployeeAttribute) (*company.EmployeeAttribute, error) {
+	allowed, err := marshalAllowedValues(a.AllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        UPDATE company_employee_attributes
+           SET required = ?,
+               allowed_values = ?,
+               updated_at = ?
+         WHERE company_id = ? AND key = ?
+        RETURNING company_id, key, type, required, allowed_values, created_at, updated_at
+    `, a.Required, allowed, formatTimestamp(a.UpdatedAt), a.CompanyID, a.Key)
+
+	updated, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributeError(err)
+	}
+	return updated, nil
+}
+
+// DeleteEmployeeAttribute は社員属性の定義を削除し、会社の社員からその属性の値を取り除きます。
+func (r *CompanyRepository) DeleteEmployeeAttribute(ctx context.Context, companyID, key string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM company_employee_attributes WHERE company_id = ? AND key = ?`, companyID, key)
+	if err != nil {
+		return translateEmployeeAttributeError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return company.ErrAttributeNotFound
+	}
+
+	path := attributePath(key)
+	if _, err := exec.ExecContext(ctx, `
+        UPDATE employees
+           SET attributes = json_remove(attributes, ?)
+         WHERE company_id = ? AND json_type(attributes, ?) IS NOT NULL
+    `, path, companyID, path); err != nil {
+		return err
+	}
+	return nil
+}
+
+// FindEmployeeAttribute は会社の社員属性の定義をキーで取得します。
+func (r *CompanyRepository) FindEmployeeAttribute(ctx context.Context, companyID, key string) (*company.EmployeeAttribute, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT company_id, key, type, required, allowed_values, created_at, updated_at
+          FROM company_employee_attributes
+         WHERE company_id = ? AND key = ?
+    `, companyID, key)
+
+	found, err := scanEmployeeAttribute(row)
+	if err != nil {
+		return nil, translateEmployeeAttributeError(err)
+	}
+	return found, nil
+}
+
+// ListEmployeeAttributes は会社の社員属性の定義をキーの昇順で返します。
+func (r *CompanyRepository) ListEmployeeAttributes(ctx context.Context, companyID string) ([]*company.EmployeeAttribute, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT company_id, key, type, required, allowed_values, created_at, updated_at
+          FROM company_employee_attributes
+         WHERE company_id = ?
+         ORDER BY key
+    `, companyID)
+	if err != nil {
+		return nil, translateEmployeeAttributeError(err)
+	}
+	defer rows.Close()
+
+	var attributes []*company.EmployeeAttribute
+	for rows.Next() {
+		a, err := scanEmployeeAttribute(rows)
+		if err != nil {
+			return nil, translateEmployeeAttributeError(err)
+		}
+		attributes = append(attributes, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateEmployeeAttributeError(err)
+	}
+	return attributes, nil
+}
+
+// AddAddress は会社に住所を追加します。
+func (r *CompanyRepository) AddAddress(ctx context.Context, a *company.Address) (*company.Address, error) {
+	lines, err := json.Marshal(a.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO company_addresses (id, company_id, type, country, postal_code, lines, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING id, company_id, type, country, postal_code, lines, created_at
+    `, uuid.NewString(), a.CompanyID, string(a.Type), a.Country, a.PostalCode, string(lines), formatTimestamp(a.CreatedAt))
+
+	created, err := scanAddress(row)
+	if err != nil {
+		return nil, translateAddressError(err)
+	}
+	return created, nil
+}
+
+// DeleteAddress は会社の住所を削除します。
+func (r *CompanyRepository) DeleteAddress(ctx context.Context, companyID, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM company_addresses WHERE company_id = ? AND id = ?`, companyID, id)
+	if err != nil {
+		return translateAddressError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return company.ErrAddressNotFound
+	}
+	return nil
+}
+
+// ListAddresses は指定した会社の住所を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListAddresses(ctx context.Context, companyIDs []string) ([]*company.Address, error) {
+	if len(companyIDs) == 0 {
+		return nil, nil
+	}
+	inClause, args := inPlaceholders(companyIDs)
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT id, company_id, type, country, postal_code, lines, created_at
+          FROM company_addresses
+         WHERE company_id IN (`+inClause+`)
+         ORDER BY company_id, created_at, id
+    `, args...)
+	if err != nil {
+		return nil, translateAddressError(err)
+	}
+	defer rows.Close()
+
+	var addresses []*company.Address
+	for rows.Next() {
+		a, err := scanAddress(rows)
+		if err != nil {
+			return nil, translateAddressError(err)
+		}
+		addresses = append(addresses, a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translateAddressError(err)
+	}
+	return addresses, nil
+}
+
+// AddPhone は会社に電話番号を追加します。
+func (r *CompanyRepository) AddPhone(ctx context.Context, p *company.Phone) (*company.Phone, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO company_phones (id, company_id, number, created_at)
+        VALUES (?, ?, ?, ?)
+        RETURNING id, company_id, number, created_at
+    `, uuid.NewString(), p.CompanyID, p.Number, formatTimestamp(p.CreatedAt))
+
+	created, err := scanPhone(row)
+	if err != nil {
+		return nil, translatePhoneError(err)
+	}
+	return created, nil
+}
+
+// DeletePhone は会社の電話番号を削除します。
+func (r *CompanyRepository) DeletePhone(ctx context.Context, companyID, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM company_phones WHERE company_id = ? AND id = ?`, companyID, id)
+	if err != nil {
+		return translatePhoneError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return company.ErrPhoneNotFound
+	}
+	return nil
+}
+
+// ListPhones は指定した会社の電話番号を会社ごとに登録順で返します。
+func (r *CompanyRepository) ListPhones(ctx context.Context, companyIDs []string) ([]*company.Phone, error) {
+	if len(companyIDs) == 0 {
+		return nil, nil
+	}
+	inClause, args := inPlaceholders(companyIDs)
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT id, company_id, number, created_at
+          FROM company_phones
+         WHERE company_id IN (`+inClause+`)
+         ORDER BY company_id, created_at, id
+    `, args...)
+	if err != nil {
+		return nil, translatePhoneError(err)
+	}
+	defer rows.Close()
+
+	var phones []*company.Phone
+	for rows.Next() {
+		p, err := scanPhone(rows)
+		if err != nil {
+			return nil, translatePhoneError(err)
+		}
+		phones = append(phones, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, translatePhoneError(err)
+	}
+	return phones, nil
+}
+
+func scanCompany(row rowScanner) (*company.Company, error) {
+	var (
+		c           company.Company
+		status      string
+		description sql.NullString
+		parentID    sql.NullString
+		labels      string
+		website     sql.NullString
+		createdAt   string
+		updatedAt   string
+	)
+	if err := row.Scan(&c.ID, &c.Name, &c.Code, &status, &description, &parentID,
+		&c.EmployeeCodePolicy.Prefix, &c.EmployeeCodePolicy.Width, &c.EmployeeCodePolicy.NextSequence,
+		&labels, &website, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if c.Labels, err = unmarshalStringMap(labels); err != nil {
+		return nil, err
+	}
+	if c.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if c.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	c.Status = company.Status(status)
+	if description.Valid {
+		d := description.String
+		c.Description = &d
+	}
+	if parentID.Valid {
+		p := parentID.String
+		c.ParentCompanyID = &p
+	}
+	if website.Valid {
+		w := website.String
+		c.Website = &w
+	}
+	return &c, nil
+}
+
+func translateCompanyError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return company.ErrCompanyNotFound
+	}
+	if isUniqueViolation(err) {
+		return company.ErrCodeAlreadyExists
+	}
+	switch errorCode(err) {
+	case constraintForeignKeyCode:
+		return company.ErrParentCompanyNotFound
+	case constraintCheckCode:
+		if strings.Contains(err.Error(), "companies_employee_code_") {
+			return company.ErrInvalidEmployeeCodePolicy
+		}
+		return company.ErrHierarchyCycle
+	}
+	return err
+}
+
+func scanEmployeeAttribute(row rowScanner) (*company.EmployeeAttribute, error) {
+	var (
+		a         company.EmployeeAttribute
+		typ       string
+		allowed   string
+		createdAt string
+		updatedAt string
+	)
+	if err := row.Scan(&a.CompanyID, &a.Key, &typ, &a.Required, &allowed, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if a.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if a.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	a.Type = company.AttributeType(typ)
+	if err := json.Unmarshal([]byte(allowed), &a.AllowedValues); err != nil {
+		return nil, err
+	}
+	if len(a.AllowedValues) == 0 {
+		a.AllowedValues = nil
+	}
+	return &a, nil
+}
+
+func marshalAllowedValues(values []string) (string, error) {
+	if values == nil {
+		values = []string{}
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func translateEmployeeAttributeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return company.ErrAttributeNotFound
+	}
+	if isUniqueViolation(err) {
+		return company.ErrAttributeAlreadyExists
+	}
+	switch errorCode(err) {
+	case constraintForeignKeyCode:
+		return company.ErrCompanyNotFound
+	case constraintCheckCode:
+		return company.ErrInvalidAttributeType
+	}
+	return err
+}
+
+func scanAddress(row rowScanner) (*company.Address, error) {
+	var (
+		a         company.Address
+		typ       string
+		lines     string
+		createdAt string
+	)
+	if err := row.Scan(&a.ID, &a.CompanyID, &typ, &a.Country, &a.PostalCode, &lines, &createdAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if a.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(lines), &a.Lines); err != nil {
+		return nil, err
+	}
+	a.Type = company.AddressType(typ)
+	return &a, nil
+}
+
+func translateAddressError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return company.ErrAddressNotFound
+	}
+	switch errorCode(err) {
+	case constraintForeignKeyCode:
+		return company.ErrCompanyNotFound
+	case constraintCheckCode:
+		return company.ErrInvalidAddressType
+	}
+	return err
+}
+
+func scanPhone(row rowScanner) (*company.Phone, error) {
+	var (
+		p         company.Phone
+		createdAt string
+	)
+	if err := row.Scan(&p.ID, &p.CompanyID, &p.Number, &createdAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if p.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func translatePhoneError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return company.ErrPhoneNotFound
+	}
+	if isUniqueViolation(err) {
+		return company.ErrPhoneAlreadyExists
+	}
+	if errorCode(err) == constraintForeignKeyCode {
+		return company.ErrCompanyNotFound
+	}
+	return err
+}
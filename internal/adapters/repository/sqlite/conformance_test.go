@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/assets"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/repositorytest"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/migration"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+func newTestRepositories(t *testing.T) repositorytest.Repositories {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "app.db")
+	if err := migration.Up(assets.SQLiteMigrations, assets.SQLiteMigrationsDir, sqlitedb.MigrationURL(path)); err != nil {
+		t.Fatalf("failed to migrate sqlite database: %v", err)
+	}
+
+	db, err := sqlitedb.Open(context.Background(), path)
+	if err != nil {
+		t.Fatalf("failed to open sqlite database: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	return repositorytest.Repositories{
+		Users:               NewUserRepository(db),
+		Companies:           NewCompanyRepository(db),
+		Employees:           NewEmployeeRepository(db),
+		Departments:         NewDepartmentRepository(db),
+		JobRuns:             NewJobRunRepository(db),
+		EmailChangeTokens:   NewEmailChangeTokenRepository(db),
+		Credentials:         NewCredentialRepository(db),
+		PasswordSetupTokens: NewPasswordSetupTokenRepository(db),
+	}
+}
+
+func TestUserRepositoryConformance(t *testing.T) {
+	repositorytest.RunUserRepositorySuite(t, newTestRepositories)
+}
+
+func TestCompanyRepositoryConformance(t *testing.T) {
+	repositorytest.RunCompanyRepositorySuite(t, newTestRepositories)
+}
+
+func TestEmployeeRepositoryConformance(t *testing.T) {
+	repositorytest.RunEmployeeRepositorySuite(t, newTestRepositories)
+}
+
+func TestDepartmentRepositoryConformance(t *testing.T) {
+	repositorytest.RunDepartmentRepositorySuite(t, newTestRepositories)
+}
+
+func TestJobRunRepositoryConformance(t *testing.T) {
+	repositorytest.RunJobRunRepositorySuite(t, newTestRepositories)
+}
+
+func TestEmailChangeTokenRepositoryConformance(t *testing.T) {
+	repositorytest.RunEmailChangeTokenRepositorySuite(t, newTestRepositories)
+}
+
+func TestCredentialRepositoryConformance(t *testing.T) {
+	repositorytest.RunCredentialRepositorySuite(t, newTestRepositories)
+}
+
+func TestPasswordSetupTokenRepositoryConformance(t *testing.T) {
+	repositorytest.RunPasswordSetupTokenRepositorySuite(t, newTestRepositories)
+}
@@ -0,0 +1,170 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+const credentialColumns = `user_id, password_hash, failed_attempts, locked_until, last_login_at, refresh_token_id, password_updated_at, created_at, updated_at`
+
+// CredentialRepository は SQLite を利用したパスワード認証情報の実装です。
+type CredentialRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewCredentialRepository は CredentialRepository を生成します。
+func NewCredentialRepository(db sqlitedb.Queryer) *CredentialRepository {
+	return &CredentialRepository{db: db}
+}
+
+// FindByUserID はユーザーの認証情報を取得します。
+func (r *CredentialRepository) FindByUserID(ctx context.Context, userID string) (*auth.Credential, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT `+credentialColumns+`
+          FROM user_credentials
+         WHERE user_id = ?
+    `, userID)
+
+	credential, err := scanCredential(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, auth.ErrCredentialNotFound
+	}
+	return credential, err
+}
+
+// Save は認証情報を作成または更新します。失敗回数とロックは解除してリフレッシュトークンを失効させ、最終ログイン日時は維持します。
+func (r *CredentialRepository) Save(ctx context.Context, credential *auth.Credential) (*auth.Credential, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO user_credentials (user_id, password_hash, failed_attempts, locked_until, password_updated_at, created_at, updated_at)
+        VALUES (?, ?, 0, NULL, ?, ?, ?)
+        ON CONFLICT (user_id) DO UPDATE
+           SET password_hash = excluded.password_hash,
+               failed_attempts = 0,
+               locked_until = NULL,
+               refresh_token_id = NULL,
+               password_updated_at = excluded.password_updated_at,
+               updated_at = excluded.updated_at
+        RETURNING `+credentialColumns,
+		credential.UserID, credential.PasswordHash, formatTimestamp(credential.PasswordUpdatedAt),
+		formatTimestamp(credential.CreatedAt), formatTimestamp(credential.UpdatedAt))
+
+	saved, err := scanCredential(row)
+	if err != nil {
+		// user_credentials の外部キーは user_id のみです。
+		if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+			return nil, auth.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return saved, nil
+}
+
+// IncrementFailedAttempts は失敗回数を 1 増やし、増やした後の回数を返します。
+func (r *CredentialRepository) IncrementFailedAttempts(ctx context.Context, userID string, at time.Time) (int, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	var attempts int
+	err := exec.QueryRowContext(ctx, `
+        UPDATE user_credentials
+           SET failed_attempts = failed_attempts + 1,
+               updated_at = ?
+         WHERE user_id = ?
+        RETURNING failed_attempts
+    `, formatTimestamp(at), userID).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, auth.ErrCredentialNotFound
+		}
+		return 0, err
+	}
+	return attempts, nil
+}
+
+// Lock は指定日時までロックし、失敗回数を 0 に戻します。
+func (r *CredentialRepository) Lock(ctx context.Context, userID string, until time.Time, at time.Time) error {
+	return r.update(ctx, auth.ErrCredentialNotFound, `
+        UPDATE user_credentials
+           SET failed_attempts = 0,
+               locked_until = ?,
+               updated_at = ?
+         WHERE user_id = ?
+    `, formatTimestamp(until), formatTimestamp(at), userID)
+}
+
+// RecordLogin はログインの成功を記録し、失敗回数とロックを解除してリフレッシュトークンの ID を置き換えます。
+func (r *CredentialRepository) RecordLogin(ctx context.Context, userID, refreshTokenID string, at time.Time) error {
+	return r.update(ctx, auth.ErrCredentialNotFound, `
+        UPDATE user_credentials
+           SET failed_attempts = 0,
+               locked_until = NULL,
+               last_login_at = ?,
+               refresh_token_id = ?,
+               updated_at = ?
+         WHERE user_id = ?
+    `, formatTimestamp(at), refreshTokenID, formatTimestamp(at), userID)
+}
+
+// RotateRefreshToken はリフレッシュトークンの ID が currentID と一致する場合のみ nextID に置き換えます。
+func (r *CredentialRepository) RotateRefreshToken(ctx context.Context, userID, currentID, nextID string, at time.Time) error {
+	return r.update(ctx, auth.ErrInvalidToken, `
+        UPDATE user_credentials
+           SET refresh_token_id = ?,
+               updated_at = ?
+         WHERE user_id = ?
+           AND refresh_token_id = ?
+    `, nextID, formatTimestamp(at), userID, currentID)
+}
+
+// update は query を実行し、更新した行がない場合は notFound を返します。
+func (r *CredentialRepository) update(ctx context.Context, notFound error, query string, args ...any) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return notFound
+	}
+	return nil
+}
+
+func scanCredential(row rowScanner) (*auth.Credential, error) {
+	var (
+		c                                       auth.Credential
+		passwordUpdatedAt, createdAt, updatedAt string
+		lockedUntil, lastLoginAt                sql.NullString
+		refreshTokenID                          sql.NullString
+	)
+	if err := row.Scan(&c.UserID, &c.PasswordHash, &c.FailedAttempts, &lockedUntil, &lastLoginAt, &refreshTokenID, &passwordUpdatedAt, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+	c.RefreshTokenID = refreshTokenID.String
+
+	var err error
+	if c.PasswordUpdatedAt, err = parseTimestamp(passwordUpdatedAt); err != nil {
+		return nil, err
+	}
+	if c.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if c.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	if c.LockedUntil, err = parseNullableTimestamp(lockedUntil); err != nil {
+		return nil, err
+	}
+	if c.LastLoginAt, err = parseNullableTimestamp(lastLoginAt); err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
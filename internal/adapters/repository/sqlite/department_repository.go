@@ -0,0 +1,265 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+// DepartmentRepository は SQLite を利用した部署永続化の実装です。
+type DepartmentRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewDepartmentRepository は DepartmentRepository を生成します。
+func NewDepartmentRepository(db sqlitedb.Queryer) *DepartmentRepository {
+	return &DepartmentRepository{db: db}
+}
+
+// Create は部署を新規作成します。
+func (r *DepartmentRepository) Create(ctx context.Context, d *department.Department) (*department.Department, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO departments (id, company_id, parent_department_id, name, code, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING id, company_id, parent_department_id, name, code, created_at, updated_at
+    `, uuid.NewString(), d.CompanyID, nullableString(d.ParentDepartmentID), d.Name, d.Code, formatTimestamp(d.CreatedAt), formatTimestamp(d.UpdatedAt))
+
+	created, err := scanDepartment(row)
+	if err != nil {
+		return nil, r.translateError(ctx, exec, err, d.CompanyID)
+	}
+	return created, nil
+}
+
+// Update は部署情報を更新します。所属会社は変更できません。
+func (r *DepartmentRepository) Update(ctx context.Context, d *department.Department) (*department.Department, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        UPDATE departments
+           SET parent_department_id = ?,
+               name = ?,
+               code = ?,
+               updated_at = ?
+         WHERE id = ?
+        RETURNING id, company_id, parent_department_id, name, code, created_at, updated_at
+    `, nullableString(d.ParentDepartmentID), d.Name, d.Code, formatTimestamp(d.UpdatedAt), d.ID)
+
+	updated, err := scanDepartment(row)
+	if err != nil {
+		return nil, r.translateError(ctx, exec, err, d.CompanyID)
+	}
+	return updated, nil
+}
+
+// Delete は部署を削除します。子部署や所属社員が存在する場合は削除しません。
+// SQLite の外部キー違反はどの制約かを報告しないため、子部署の有無を確認して判別します。
+func (r *DepartmentRepository) Delete(ctx context.Context, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM departments WHERE id = ?`, id)
+	if err != nil {
+		if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+			var exists int
+			lookupErr := exec.QueryRowContext(ctx, `SELECT 1 FROM departments WHERE parent_department_id = ? LIMIT 1`, id).Scan(&exists)
+			if lookupErr == nil {
+				return department.ErrDepartmentHasChildren
+			}
+			if !errors.Is(lookupErr, sql.ErrNoRows) {
+				return errors.Join(err, lookupErr)
+			}
+			return department.ErrDepartmentHasEmployees
+		}
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return department.ErrDepartmentNotFound
+	}
+	return nil
+}
+
+// FindByID は ID で部署を取得します。
+func (r *DepartmentRepository) FindByID(ctx context.Context, id string) (*department.Department, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE id = ?
+    `, id)
+
+	found, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentNotFound(err)
+	}
+	return found, nil
+}
+
+// FindByCompanyAndCode は会社 ID と部署コードで検索します。
+func (r *DepartmentRepository) FindByCompanyAndCode(ctx context.Context, companyID, code string) (*department.Department, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE company_id = ? AND code = ?
+    `, companyID, code)
+
+	found, err := scanDepartment(row)
+	if err != nil {
+		return nil, translateDepartmentNotFound(err)
+	}
+	return found, nil
+}
+
+// List は部署の一覧を取得します。
+func (r *DepartmentRepository) List(ctx context.Context, filter department.ListDepartmentsFilter) ([]*department.Department, string, error) {
+	if strings.TrimSpace(filter.CompanyID) == "" {
+		return nil, "", department.ErrInvalidCompanyID
+	}
+	if filter.Limit <= 0 {
+		return nil, "", department.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", department.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := []any{filter.CompanyID}
+	conditions := []string{"company_id = ?"}
+	if filter.ParentID != nil {
+		conditions = append(conditions, "parent_department_id = ?")
+		args = append(args, *filter.ParentID)
+	}
+	args = append(args, limitWithBuffer, filter.Offset)
+
+	departments, err := r.query(ctx, `
+        SELECT id, company_id, parent_department_id, name, code, created_at, updated_at
+          FROM departments
+         WHERE `+strings.Join(conditions, " AND ")+`
+         ORDER BY created_at DESC, id DESC
+         LIMIT ? OFFSET ?
+    `, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(departments) == limitWithBuffer {
+		departments = departments[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return departments, nextToken, nil
+}
+
+// ListAncestors は直近の親部署から最上位の部署までを順に返します。
+// 階層が循環している場合は、たどった部署（id 自身を含む）に戻った時点で打ち切ります。
+func (r *DepartmentRepository) ListAncestors(ctx context.Context, id string) ([]*department.Department, error) {
+	return r.query(ctx, `
+        WITH RECURSIVE ancestors AS (
+            SELECT parent_department_id AS id, 1 AS depth, '/' || id || '/' AS path
+              FROM departments
+             WHERE id = ?
+            UNION ALL
+            SELECT d.parent_department_id, a.depth + 1, a.path || d.id || '/'
+              FROM departments d
+              JOIN ancestors a ON d.id = a.id
+             WHERE instr(a.path, '/' || d.id || '/') = 0
+        )
+        SELECT d.id, d.company_id, d.parent_department_id, d.name, d.code, d.created_at, d.updated_at
+          FROM ancestors a
+          JOIN departments d ON d.id = a.id
+         WHERE instr(a.path, '/' || a.id || '/') = 0
+         ORDER BY a.depth
+    `, id)
+}
+
+// LockHierarchy は何もしません。書き込みトランザクションは BEGIN IMMEDIATE で開始するため、付け替えは既に直列化されています。
+func (r *DepartmentRepository) LockHierarchy(context.Context) error {
+	return nil
+}
+
+func (r *DepartmentRepository) query(ctx context.Context, query string, args ...any) ([]*department.Department, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var departments []*department.Department
+	for rows.Next() {
+		d, err := scanDepartment(rows)
+		if err != nil {
+			return nil, err
+		}
+		departments = append(departments, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return departments, nil
+}
+
+func scanDepartment(row rowScanner) (*department.Department, error) {
+	var (
+		d         department.Department
+		parentID  sql.NullString
+		createdAt string
+		updatedAt string
+	)
+	if err := row.Scan(&d.ID, &d.CompanyID, &parentID, &d.Name, &d.Code, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if d.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if d.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	if parentID.Valid {
+		p := parentID.String
+		d.ParentDepartmentID = &p
+	}
+	return &d, nil
+}
+
+func translateDepartmentNotFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return department.ErrDepartmentNotFound
+	}
+	return err
+}
+
+// translateError は制約違反をドメインエラーへ変換します。
+// SQLite の外部キー違反はどの制約かを報告しないため、会社の存在を確認して判別します。
+func (r *DepartmentRepository) translateError(ctx context.Context, exec sqlitedb.Queryer, err error, companyID string) error {
+	switch errorCode(err) {
+	case constraintUniqueCode, constraintPrimaryKeyCode:
+		return department.ErrCodeAlreadyExists
+	case constraintCheckCode:
+		return department.ErrHierarchyCycle
+	case constraintForeignKeyCode:
+		var exists int
+		lookupErr := exec.QueryRowContext(ctx, `SELECT 1 FROM companies WHERE id = ?`, companyID).Scan(&exists)
+		if errors.Is(lookupErr, sql.ErrNoRows) {
+			return department.ErrCompanyNotFound
+		}
+		if lookupErr != nil {
+			return errors.Join(err, lookupErr)
+		}
+		return department.ErrParentDepartmentNotFound
+	}
+	return translateDepartmentNotFound(err)
+}
@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+const emailChangeTokenColumns = `id, user_id, new_email, token_hash, expires_at, used_at, created_at`
+
+// EmailChangeTokenRepository は SQLite を利用したメールアドレス変更トークンの実装です。
+type EmailChangeTokenRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewEmailChangeTokenRepository は EmailChangeTokenRepository を生成します。
+func NewEmailChangeTokenRepository(db sqlitedb.Queryer) *EmailChangeTokenRepository {
+	return &EmailChangeTokenRepository{db: db}
+}
+
+// Create はトークンを保存します。
+func (r *EmailChangeTokenRepository) Create(ctx context.Context, token *user.EmailChangeToken) (*user.EmailChangeToken, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO email_change_tokens (id, user_id, new_email, token_hash, expires_at, used_at, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING `+emailChangeTokenColumns,
+		uuid.NewString(), token.UserID, token.NewEmail, token.TokenHash, formatTimestamp(token.ExpiresAt),
+		nullableTimestamp(token.UsedAt), formatTimestamp(token.CreatedAt))
+
+	created, err := scanEmailChangeToken(row)
+	if err != nil {
+		// email_change_tokens の外部キーは user_id のみです。
+		if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+			return nil, user.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *EmailChangeTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*user.EmailChangeToken, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT `+emailChangeTokenColumns+`
+          FROM email_change_tokens
+         WHERE token_hash = ?
+    `, tokenHash)
+
+	token, err := scanEmailChangeToken(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, user.ErrInvalidEmailChangeToken
+	}
+	return token, err
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *EmailChangeTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `
+        UPDATE email_change_tokens
+           SET used_at = ?
+         WHERE id = ?
+           AND used_at IS NULL
+    `, formatTimestamp(usedAt), id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return user.ErrInvalidEmailChangeToken
+	}
+	return nil
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *EmailChangeTokenRepository) DeleteUnusedByUser(ctx context.Context, userID string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	_, err := exec.ExecContext(ctx, `DELETE FROM email_change_tokens WHERE user_id = ? AND used_at IS NULL`, userID)
+	return err
+}
+
+func scanEmailChangeToken(row rowScanner) (*user.EmailChangeToken, error) {
+	var (
+		token                user.EmailChangeToken
+		expiresAt, createdAt string
+		usedAt               sql.NullString
+	)
+	if err := row.Scan(&token.ID, &token.UserID, &token.NewEmail, &token.TokenHash, &expiresAt, &usedAt, &createdAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if token.ExpiresAt, err = parseTimestamp(expiresAt); err != nil {
+		return nil, err
+	}
+	if token.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if usedAt.Valid {
+		t, err := parseTimestamp(usedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		token.UsedAt = &t
+	}
+	return &token, nil
+}
@@ -0,0 +1,865 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+const employeeColumns = `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at
+          FROM employees e
+          JOIN users u ON u.id = e.user_id`
+
+// EmployeeRepository は SQLite を利用した社員永続化の実装です。
+type EmployeeRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewEmployeeRepository は EmployeeRepository を生成します。
+func NewEmployeeRepository(db sqlitedb.Queryer) *EmployeeRepository {
+	return &EmployeeRepository{db: db}
+}
+
+// Create は社員を新規作成します。
+func (r *EmployeeRepository) Create(ctx context.Context, e *employee.Employee) (*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	attributes, err := marshalStringMap(e.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	id := uuid.NewString()
+	_, err = exec.ExecContext(ctx, `
+        INSERT INTO employees (id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, transferred_from_employee_id, attributes, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `,
+		id,
+		e.CompanyID,
+		e.EmployeeCode,
+		e.UserID,
+		nullableString(e.DepartmentID),
+		nullableString(e.ManagerEmployeeID),
+		string(e.Status),
+		nullableDate(e.HiredAt),
+		nullableDate(e.TerminatedAt),
+		nullableString(e.TransferredFromEmployeeID),
+		attributes,
+		formatTimestamp(e.CreatedAt),
+		formatTimestamp(e.UpdatedAt),
+	)
+	if err != nil {
+		return nil, r.translateError(ctx, exec, err, e)
+	}
+	return r.findByID(ctx, exec, id)
+}
+
+// Update は社員情報を更新します。
+func (r *EmployeeRepository) Update(ctx context.Context, e *employee.Employee) (*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	attributes, err := marshalStringMap(e.Attributes)
+	if err != nil {
+		return nil, err
+	}
+	result, err := exec.ExecContext(ctx, `
+        UPDATE employees
+           SET employee_code = ?,
+               user_id = ?,
+               department_id = ?,
+               manager_employee_id = ?,
+               status = ?,
+               hired_at = ?,
+               terminated_at = ?,
+               attributes = ?,
+               updated_at = ?
+         WHERE id = ?
+    `,
+		e.EmployeeCode,
+		e.UserID,
+		nullableString(e.DepartmentID),
+		nullableString(e.ManagerEmployeeID),
+		string(e.Status),
+		nullableDate(e.HiredAt),
+		nullableDate(e.TerminatedAt),
+		attributes,
+		formatTimestamp(e.UpdatedAt),
+		e.ID,
+	)
+	if err != nil {
+		return nil, r.translateError(ctx, exec, err, e)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, employee.ErrEmployeeNotFound
+	}
+	return r.findByID(ctx, exec, e.ID)
+}
+
+// Delete は社員を削除します。
+func (r *EmployeeRepository) Delete(ctx context.Context, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM employees WHERE id = ?`, id)
+	if err != nil {
+		switch errorCode(err) {
+		case constraintForeignKeyCode, constraintTriggerCode:
+			return employee.ErrManagerHasReports
+		}
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return employee.ErrEmployeeNotFound
+	}
+	return nil
+}
+
+// FindByID は ID で社員を取得します。
+func (r *EmployeeRepository) FindByID(ctx context.Context, id string) (*employee.Employee, error) {
+	return r.findByID(ctx, sqlitedb.QueryerFromContext(ctx, r.db), id)
+}
+
+func (r *EmployeeRepository) findByID(ctx context.Context, exec sqlitedb.Queryer, id string) (*employee.Employee, error) {
+	row := exec.QueryRowContext(ctx, employeeColumns+`
+         WHERE e.id = ?
+    `, id)
+
+	found, err := scanEmployee(row)
+	if err != nil {
+		return nil, translateEmployeeNotFound(err)
+	}
+	return found, nil
+}
+
+// FindByCompanyAndCode は会社 ID と社員コードで検索します。
+func (r *EmployeeRepository) FindByCompanyAndCode(ctx context.Context, companyID, employeeCode string) (*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, employeeColumns+`
+         WHERE e.company_id = ? AND e.employee_code = ?
+    `, companyID, employeeCode)
+
+	found, err := scanEmployee(row)
+	if err != nil {
+		return nil, translateEmployeeNotFound(err)
+	}
+	return found, nil
+}
+
+// List は社員の一覧を取得します。
+func (r *EmployeeRepository) List(ctx context.Context, filter employee.ListEmployeesFilter) ([]*employee.Employee, string, error) {
+	if strings.TrimSpace(filter.CompanyID) == "" {
+		return nil, "", employee.ErrInvalidCompanyID
+	}
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := make([]any, 0, 4)
+	conditions := []string{"e.company_id = ?"}
+	if filter.IncludeSubsidiaries {
+		conditions = []string{`e.company_id IN (
+            WITH RECURSIVE tree AS (
+                SELECT id FROM companies WHERE id = ?
+                UNION
+                SELECT c.id FROM companies c JOIN tree t ON c.parent_company_id = t.id
+            )
+            SELECT id FROM tree
+        )`}
+	}
+	args = append(args, filter.CompanyID)
+
+	if filter.DepartmentID != "" {
+		if filter.IncludeSubDepartments {
+			conditions = append(conditions, `e.department_id IN (
+            WITH RECURSIVE subtree AS (
+                SELECT id FROM departments WHERE id = ?
+                UNION
+                SELECT d.id FROM departments d JOIN subtree s ON d.parent_department_id = s.id
+            )
+            SELECT id FROM subtree
+        )`)
+		} else {
+			conditions = append(conditions, "e.department_id = ?")
+		}
+		args = append(args, filter.DepartmentID)
+	}
+
+	if filter.Status != nil {
+		conditions = append(conditions, "e.status = ?")
+		args = append(args, string(*filter.Status))
+	}
+
+	keys := make([]string, 0, len(filter.Attributes))
+	for key := range filter.Attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		conditions = append(conditions, "json_extract(e.attributes, ?) = ?")
+		args = append(args, attributePath(key), filter.Attributes[key])
+	}
+	args = append(args, limitWithBuffer, filter.Offset)
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, employeeColumns+`
+         WHERE `+strings.Join(conditions, " AND ")+`
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT ? OFFSET ?
+    `, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	employees, err := collectEmployees(rows, filter.Limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(employees) == limitWithBuffer {
+		employees = employees[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return employees, nextToken, nil
+}
+
+// ListDirectReports は上長に直属する社員の一覧を取得します。
+func (r *EmployeeRepository) ListDirectReports(ctx context.Context, filter employee.ListDirectReportsFilter) ([]*employee.Employee, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, employeeColumns+`
+         WHERE e.manager_employee_id = ?
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT ? OFFSET ?
+    `, filter.ManagerID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", err
+	}
+
+	employees, err := collectEmployees(rows, filter.Limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(employees) == limitWithBuffer {
+		employees = employees[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return employees, nextToken, nil
+}
+
+// ListEmploymentsByUser はユーザーの社員レコードを所属会社の概要とあわせて取得します。
+func (r *EmployeeRepository) ListEmploymentsByUser(ctx context.Context, filter employee.ListEmploymentsFilter) ([]*employee.Employment, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT e.id,
+               e.company_id,
+               e.employee_code,
+               e.user_id,
+               e.department_id,
+               e.manager_employee_id,
+               e.status,
+               e.hired_at,
+               e.terminated_at,
+               e.transferred_from_employee_id,
+               e.attributes,
+               e.created_at,
+               e.updated_at,
+               u.id,
+               u.email,
+               u.name,
+               u.display_name,
+               COALESCE(u.locale, ''),
+               u.status,
+               u.created_at,
+               u.updated_at,
+               c.id,
+               c.name,
+               c.code,
+               c.status,
+               c.parent_company_id
+          FROM employees e
+          JOIN users u ON u.id = e.user_id
+          JOIN companies c ON c.id = e.company_id
+         WHERE e.user_id = ?
+         ORDER BY e.created_at DESC, e.id DESC
+         LIMIT ? OFFSET ?
+    `, filter.UserID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	employments := make([]*employee.Employment, 0, limitWithBuffer)
+	for rows.Next() {
+		var (
+			c        employee.CompanySnapshot
+			parentID sql.NullString
+		)
+		emp, err := scanEmployeeWith(rows, &c.ID, &c.Name, &c.Code, &c.Status, &parentID)
+		if err != nil {
+			return nil, "", err
+		}
+		if parentID.Valid {
+			parent := parentID.String
+			c.ParentCompanyID = &parent
+		}
+		employments = append(employments, &employee.Employment{Employee: emp, Company: &c})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(employments) == limitWithBuffer {
+		employments = employments[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return employments, nextToken, nil
+}
+
+// ListReportingChain は社員の上長を直近から最上位まで順に取得します。
+// 報告ラインが循環している場合は、たどった社員（id 自身を含む）に戻った時点で打ち切ります。
+func (r *EmployeeRepository) ListReportingChain(ctx context.Context, id string) ([]*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        WITH RECURSIVE chain (id, depth, path) AS (
+            SELECT manager_employee_id, 1, '/' || id || '/'
+              FROM employees
+             WHERE id = ? AND manager_employee_id IS NOT NULL
+            UNION ALL
+            SELECT m.manager_employee_id, c.depth + 1, c.path || m.id || '/'
+              FROM employees m
+              JOIN chain c ON m.id = c.id
+             WHERE m.manager_employee_id IS NOT NULL
+               AND instr(c.path, '/' || m.id || '/') = 0
+        )`+employeeColumns+`
+          JOIN chain c ON c.id = e.id
+         WHERE instr(c.path, '/' || c.id || '/') = 0
+         ORDER BY c.depth
+    `, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// ListSubordinates は社員の配下を maxDepth 階層まで浅い順に取得します。
+func (r *EmployeeRepository) ListSubordinates(ctx context.Context, id string, maxDepth int) ([]*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        WITH RECURSIVE subordinates (id, depth) AS (
+            SELECT id, 1
+              FROM employees
+             WHERE manager_employee_id = ?
+            UNION ALL
+            SELECT e.id, s.depth + 1
+              FROM employees e
+              JOIN subordinates s ON e.manager_employee_id = s.id
+             WHERE s.depth < ?
+        )`+employeeColumns+`
+          JOIN subordinates s ON s.id = e.id
+         ORDER BY s.depth, e.created_at DESC, e.id DESC
+    `, id, maxDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// LockReportingLines は何もしません。書き込みトランザクションは BEGIN IMMEDIATE で開始するため、付け替えは既に直列化されています。
+func (r *EmployeeRepository) LockReportingLines(context.Context) error {
+	return nil
+}
+
+// ReassignDirectReports は直属の部下の上長をまとめて付け替えます。
+func (r *EmployeeRepository) ReassignDirectReports(ctx context.Context, managerID string, newManagerID *string, updatedAt time.Time) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	_, err := exec.ExecContext(ctx, `
+        UPDATE employees
+           SET manager_employee_id = ?,
+               updated_at = ?
+         WHERE manager_employee_id = ?
+    `, nullableString(newManagerID), formatTimestamp(updatedAt), managerID)
+	switch errorCode(err) {
+	case constraintForeignKeyCode:
+		return employee.ErrManagerNotFound
+	case constraintCheckCode:
+		return employee.ErrReportingCycle
+	}
+	return err
+}
+
+// ListDueStatusTransitions は入社日・退職日を迎えたのに状態が追随していない社員を取得します。
+func (r *EmployeeRepository) ListDueStatusTransitions(ctx context.Context, asOf time.Time) ([]*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	date := nullableDate(&asOf)
+	rows, err := exec.QueryContext(ctx, employeeColumns+`
+         WHERE (e.status = ? AND (e.hired_at IS NULL OR e.hired_at <= ?))
+            OR (e.status <> ? AND e.terminated_at <= ?)
+         ORDER BY e.company_id, e.employee_code
+    `, string(employee.StatusPending), date, string(employee.StatusTerminated), date)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectEmployees(rows, 0)
+}
+
+// FindTerminatedByCompanyAndUser は会社とユーザーに紐づく退職済みの社員のうち最後に更新されたものを取得します。
+func (r *EmployeeRepository) FindTerminatedByCompanyAndUser(ctx context.Context, companyID, userID string) (*employee.Employee, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, employeeColumns+`
+         WHERE e.company_id = ?
+           AND e.user_id = ?
+           AND e.status = ?
+         ORDER BY e.updated_at DESC, e.id DESC
+         LIMIT 1
+    `, companyID, userID, string(employee.StatusTerminated))
+
+	found, err := scanEmployee(row)
+	if err != nil {
+		return nil, translateEmployeeNotFound(err)
+	}
+	return found, nil
+}
+
+// NextCodeSequence は会社の次の連番を払い出します。SQLite は書き込みを直列化するため、同時実行時も重複しません。
+func (r *EmployeeRepository) NextCodeSequence(ctx context.Context, companyID string) (*employee.CodeSequence, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	var seq employee.CodeSequence
+	err := exec.QueryRowContext(ctx, `
+        UPDATE companies
+           SET employee_code_next_sequence = employee_code_next_sequence + 1
+         WHERE id = ?
+        RETURNING employee_code_prefix, employee_code_width, employee_code_next_sequence - 1
+    `, companyID).Scan(&seq.Prefix, &seq.Width, &seq.Sequence)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, employee.ErrCompanyNotFound
+		}
+		return nil, err
+	}
+	return &seq, nil
+}
+
+// RecordHistory は有効中の履歴を閉じ、新しい履歴を保存します。
+func (r *EmployeeRepository) RecordHistory(ctx context.Context, entry *employee.HistoryEntry) (*employee.HistoryEntry, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	effectiveFrom := nullableDate(&entry.EffectiveFrom)
+
+	if _, err := exec.ExecContext(ctx, `
+        DELETE FROM employee_history
+         WHERE employee_id = ? AND effective_to IS NULL AND effective_from >= ?
+    `, entry.EmployeeID, effectiveFrom); err != nil {
+		return nil, err
+	}
+
+	if _, err := exec.ExecContext(ctx, `
+        UPDATE employee_history
+           SET effective_to = ?
+         WHERE employee_id = ? AND effective_to IS NULL
+    `, effectiveFrom, entry.EmployeeID); err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	if _, err := exec.ExecContext(ctx, `
+        INSERT INTO employee_history (id, employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status, hired_at, terminated_at, effective_from, created_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    `,
+		id,
+		entry.EmployeeID,
+		entry.CompanyID,
+		entry.EmployeeCode,
+		entry.UserID,
+		nullableString(entry.DepartmentID),
+		nullableString(entry.ManagerEmployeeID),
+		string(entry.Status),
+		nullableDate(entry.HiredAt),
+		nullableDate(entry.TerminatedAt),
+		effectiveFrom,
+		formatTimestamp(entry.CreatedAt),
+	); err != nil {
+		if errorCode(err) == constraintForeignKeyCode {
+			return nil, employee.ErrEmployeeNotFound
+		}
+		return nil, err
+	}
+
+	row := exec.QueryRowContext(ctx, historyColumns+`
+         WHERE id = ?
+    `, id)
+	return scanHistoryEntry(row)
+}
+
+// ListHistory は社員の履歴を有効開始日の降順で取得します。
+func (r *EmployeeRepository) ListHistory(ctx context.Context, filter employee.ListHistoryFilter) ([]*employee.HistoryEntry, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", employee.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", employee.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, historyColumns+`
+         WHERE employee_id = ?
+         ORDER BY effective_from DESC, id DESC
+         LIMIT ? OFFSET ?
+    `, filter.EmployeeID, limitWithBuffer, filter.Offset)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	entries := make([]*employee.HistoryEntry, 0, filter.Limit)
+	for rows.Next() {
+		entry, err := scanHistoryEntry(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		entries = append(entries, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	var nextToken string
+	if len(entries) == limitWithBuffer {
+		entries = entries[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return entries, nextToken, nil
+}
+
+// FindHistoryAsOf は指定日に有効だった履歴を取得します。
+func (r *EmployeeRepository) FindHistoryAsOf(ctx context.Context, employeeID string, date time.Time) (*employee.HistoryEntry, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	day := nullableDate(&date)
+	row := exec.QueryRowContext(ctx, historyColumns+`
+         WHERE employee_id = ?
+           AND effective_from <= ?
+           AND (effective_to IS NULL OR effective_to > ?)
+         LIMIT 1
+    `, employeeID, day, day)
+
+	found, err := scanHistoryEntry(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, employee.ErrHistoryNotFound
+	}
+	return found, err
+}
+
+const historyColumns = `
+        SELECT id, employee_id, company_id, employee_code, user_id, department_id, manager_employee_id, status,
+               hired_at, terminated_at, effective_from, effective_to, created_at
+          FROM employee_history`
+
+func scanHistoryEntry(row rowScanner) (*employee.HistoryEntry, error) {
+	var (
+		entry         employee.HistoryEntry
+		departmentID  sql.NullString
+		managerID     sql.NullString
+		status        string
+		hiredAt       sql.NullString
+		terminatedAt  sql.NullString
+		effectiveFrom string
+		effectiveTo   sql.NullString
+		createdAt     string
+	)
+
+	if err := row.Scan(
+		&entry.ID,
+		&entry.EmployeeID,
+		&entry.CompanyID,
+		&entry.EmployeeCode,
+		&entry.UserID,
+		&departmentID,
+		&managerID,
+		&status,
+		&hiredAt,
+		&terminatedAt,
+		&effectiveFrom,
+		&effectiveTo,
+		&createdAt,
+	); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if entry.HiredAt, err = parseNullableDate(hiredAt); err != nil {
+		return nil, err
+	}
+	if entry.TerminatedAt, err = parseNullableDate(terminatedAt); err != nil {
+		return nil, err
+	}
+	if entry.EffectiveFrom, err = time.Parse(dateLayout, effectiveFrom); err != nil {
+		return nil, err
+	}
+	if entry.EffectiveTo, err = parseNullableDate(effectiveTo); err != nil {
+		return nil, err
+	}
+	if entry.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if departmentID.Valid {
+		d := departmentID.String
+		entry.DepartmentID = &d
+	}
+	if managerID.Valid {
+		m := managerID.String
+		entry.ManagerEmployeeID = &m
+	}
+	entry.Status = employee.Status(status)
+	return &entry, nil
+}
+
+func collectEmployees(rows *sql.Rows, capacity int) ([]*employee.Employee, error) {
+	defer rows.Close()
+
+	employees := make([]*employee.Employee, 0, capacity)
+	for rows.Next() {
+		emp, err := scanEmployee(rows)
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, emp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return employees, nil
+}
+
+func scanEmployee(row rowScanner) (*employee.Employee, error) {
+	return scanEmployeeWith(row)
+}
+
+// scanEmployeeWith は社員とユーザーの列に続く列を extra へ読み取ります。
+func scanEmployeeWith(row rowScanner, extra ...any) (*employee.Employee, error) {
+	var (
+		e            employee.Employee
+		u            employee.UserSnapshot
+		departmentID sql.NullString
+		managerID    sql.NullString
+		status       string
+		hiredAt      sql.NullString
+		terminatedAt sql.NullString
+		transferred  sql.NullString
+		attributes   string
+		createdAt    string
+		updatedAt    string
+		userCreated  string
+		userUpdated  string
+	)
+
+	dest := []any{
+		&e.ID,
+		&e.CompanyID,
+		&e.EmployeeCode,
+		&e.UserID,
+		&departmentID,
+		&managerID,
+		&status,
+		&hiredAt,
+		&terminatedAt,
+		&transferred,
+		&attributes,
+		&createdAt,
+		&updatedAt,
+		&u.ID,
+		&u.Email,
+		&u.Name,
+		&u.DisplayName,
+		&u.Locale,
+		&u.Status,
+		&userCreated,
+		&userUpdated,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if e.HiredAt, err = parseNullableDate(hiredAt); err != nil {
+		return nil, err
+	}
+	if e.TerminatedAt, err = parseNullableDate(terminatedAt); err != nil {
+		return nil, err
+	}
+	if e.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if e.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	if u.CreatedAt, err = parseTimestamp(userCreated); err != nil {
+		return nil, err
+	}
+	if u.UpdatedAt, err = parseTimestamp(userUpdated); err != nil {
+		return nil, err
+	}
+	if departmentID.Valid {
+		d := departmentID.String
+		e.DepartmentID = &d
+	}
+	if managerID.Valid {
+		m := managerID.String
+		e.ManagerEmployeeID = &m
+	}
+	if transferred.Valid {
+		from := transferred.String
+		e.TransferredFromEmployeeID = &from
+	}
+	if e.Attributes, err = unmarshalStringMap(attributes); err != nil {
+		return nil, err
+	}
+	e.Status = employee.Status(status)
+	e.User = &u
+	return &e, nil
+}
+
+// marshalStringMap は社員属性・ラベルを JSON 文字列へ変換します。
+func marshalStringMap(values map[string]string) (string, error) {
+	if len(values) == 0 {
+		return "{}", nil
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalStringMap(raw string) (map[string]string, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var values map[string]string
+	if err := json.Unmarshal([]byte(raw), &values); err != nil {
+		return nil, err
+	}
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return values, nil
+}
+
+func translateEmployeeNotFound(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return employee.ErrEmployeeNotFound
+	}
+	return err
+}
+
+// translateError は制約違反をドメインエラーへ変換します。
+// SQLite の外部キー違反はどの制約かを報告しないため、会社・部署・上長の存在を確認して判別します。
+func (r *EmployeeRepository) translateError(ctx context.Context, exec sqlitedb.Queryer, err error, e *employee.Employee) error {
+	companyID := e.CompanyID
+	switch errorCode(err) {
+	case constraintUniqueCode, constraintPrimaryKeyCode:
+		return employee.ErrEmployeeCodeAlreadyExists
+	case constraintCheckCode:
+		if strings.Contains(err.Error(), "employees_manager_not_self") {
+			return employee.ErrReportingCycle
+		}
+		return employee.ErrInvalidDateRange
+	case constraintForeignKeyCode:
+		if companyID == "" {
+			return employee.ErrUserNotFound
+		}
+		var exists int
+		lookupErr := exec.QueryRowContext(ctx, `SELECT 1 FROM companies WHERE id = ?`, companyID).Scan(&exists)
+		if errors.Is(lookupErr, sql.ErrNoRows) {
+			return employee.ErrCompanyNotFound
+		}
+		if lookupErr != nil {
+			return errors.Join(err, lookupErr)
+		}
+		if e.DepartmentID != nil {
+			lookupErr = exec.QueryRowContext(ctx, `SELECT 1 FROM departments WHERE id = ? AND company_id = ?`, *e.DepartmentID, companyID).Scan(&exists)
+			if errors.Is(lookupErr, sql.ErrNoRows) {
+				return employee.ErrDepartmentNotFound
+			}
+			if lookupErr != nil {
+				return errors.Join(err, lookupErr)
+			}
+		}
+		if e.ManagerEmployeeID != nil {
+			lookupErr = exec.QueryRowContext(ctx, `SELECT 1 FROM employees WHERE id = ? AND company_id = ?`, *e.ManagerEmployeeID, companyID).Scan(&exists)
+			if errors.Is(lookupErr, sql.ErrNoRows) {
+				return employee.ErrManagerNotFound
+			}
+			if lookupErr != nil {
+				return errors.Join(err, lookupErr)
+			}
+		}
+		return employee.ErrUserNotFound
+	}
+	return err
+}
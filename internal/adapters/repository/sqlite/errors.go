@@ -0,0 +1,118 @@
+// Package sqlite は SQLite を利用したリポジトリ実装を提供します。
+package sqlite
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+)
+
+// SQLite の拡張エラーコードです。
+const (
+	constraintCheckCode      = 275
+	constraintForeignKeyCode = 787
+	constraintPrimaryKeyCode = 1555
+	constraintTriggerCode    = 1811
+	constraintUniqueCode     = 2067
+)
+
+const (
+	// timestampLayout は TEXT 列へ保存する日時の書式です。桁数を固定し、文字列比較で時系列順に並ぶようにします。
+	timestampLayout = "2006-01-02T15:04:05.000000000Z"
+	// dateLayout は DATE 相当の列へ保存する日付の書式です。
+	dateLayout = "2006-01-02"
+)
+
+// sqliteError は modernc.org/sqlite のエラーが実装するインターフェースです。
+type sqliteError interface {
+	error
+	Code() int
+}
+
+func errorCode(err error) int {
+	var sqlErr sqliteError
+	if errors.As(err, &sqlErr) {
+		return sqlErr.Code()
+	}
+	return 0
+}
+
+func isUniqueViolation(err error) bool {
+	code := errorCode(err)
+	return code == constraintUniqueCode || code == constraintPrimaryKeyCode
+}
+
+func formatTimestamp(t time.Time) string {
+	return t.UTC().Format(timestampLayout)
+}
+
+func parseTimestamp(s string) (time.Time, error) {
+	return time.Parse(timestampLayout, s)
+}
+
+func nullableTimestamp(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return formatTimestamp(*t)
+}
+
+func parseNullableTimestamp(s sql.NullString) (*time.Time, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	t, err := parseTimestamp(s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func nullableDate(t *time.Time) any {
+	if t == nil {
+		return nil
+	}
+	return t.UTC().Format(dateLayout)
+}
+
+func parseNullableDate(s sql.NullString) (*time.Time, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	t, err := time.Parse(dateLayout, s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// attributePath は社員属性のキーを JSON パスへ変換します。
+func attributePath(key string) string {
+	return `$."` + key + `"`
+}
+
+// inPlaceholders は values の件数分の IN 句のプレースホルダと引数を返します。
+func inPlaceholders(values []string) (string, []any) {
+	args := make([]any, 0, len(values))
+	for _, v := range values {
+		args = append(args, v)
+	}
+	return strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", "), args
+}
+
+func nullableString(value *string) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
+
+// stringPtr は NULL を nil として value を *string に変換します。
+func stringPtr(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+	v := value.String
+	return &v
+}
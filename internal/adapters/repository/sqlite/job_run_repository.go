@@ -0,0 +1,130 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/scheduler"
+)
+
+const jobRunColumns = `id, job_name, status, scheduled_at, started_at, finished_at, error`
+
+// JobRunRepository は SQLite を利用したジョブ実行記録の実装です。
+type JobRunRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewJobRunRepository は JobRunRepository を生成します。
+func NewJobRunRepository(db sqlitedb.Queryer) *JobRunRepository {
+	return &JobRunRepository{db: db}
+}
+
+// CreateRun はジョブの実行開始を記録します。
+func (r *JobRunRepository) CreateRun(ctx context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO job_runs (id, job_name, status, scheduled_at, started_at, finished_at, error)
+        VALUES (?, ?, ?, ?, ?, ?, ?)
+        RETURNING `+jobRunColumns,
+		uuid.NewString(), run.JobName, string(run.Status), formatTimestamp(run.ScheduledAt), formatTimestamp(run.StartedAt),
+		nullableTimestamp(run.FinishedAt), nullableString(run.Error))
+
+	created, err := scanJobRun(row)
+	if err != nil {
+		return nil, translateJobRunError(err)
+	}
+	return created, nil
+}
+
+// FinishRun はジョブの実行結果を記録します。
+func (r *JobRunRepository) FinishRun(ctx context.Context, run *scheduler.Run) (*scheduler.Run, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        UPDATE job_runs
+           SET status = ?,
+               finished_at = ?,
+               error = ?
+         WHERE id = ?
+        RETURNING `+jobRunColumns,
+		string(run.Status), nullableTimestamp(run.FinishedAt), nullableString(run.Error), run.ID)
+
+	updated, err := scanJobRun(row)
+	if err != nil {
+		return nil, translateJobRunError(err)
+	}
+	return updated, nil
+}
+
+// ListRuns は jobName の実行記録を予定時刻の降順で返します。
+func (r *JobRunRepository) ListRuns(ctx context.Context, jobName string, limit int) ([]*scheduler.Run, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT `+jobRunColumns+`
+          FROM job_runs
+         WHERE job_name = ?
+         ORDER BY scheduled_at DESC
+         LIMIT ?
+    `, jobName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	runs := make([]*scheduler.Run, 0, limit)
+	for rows.Next() {
+		run, err := scanJobRun(rows)
+		if err != nil {
+			return nil, err
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+func scanJobRun(row rowScanner) (*scheduler.Run, error) {
+	var (
+		run                    scheduler.Run
+		status                 string
+		scheduledAt, startedAt string
+		finishedAt, message    sql.NullString
+	)
+	if err := row.Scan(&run.ID, &run.JobName, &status, &scheduledAt, &startedAt, &finishedAt, &message); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if run.ScheduledAt, err = parseTimestamp(scheduledAt); err != nil {
+		return nil, err
+	}
+	if run.StartedAt, err = parseTimestamp(startedAt); err != nil {
+		return nil, err
+	}
+	if finishedAt.Valid {
+		t, err := parseTimestamp(finishedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		run.FinishedAt = &t
+	}
+	if message.Valid {
+		run.Error = &message.String
+	}
+	run.Status = scheduler.RunStatus(status)
+	return &run, nil
+}
+
+func translateJobRunError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return scheduler.ErrRunNotFound
+	}
+	if isUniqueViolation(err) {
+		return scheduler.ErrRunAlreadyExists
+	}
+	return err
+}
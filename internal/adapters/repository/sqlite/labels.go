@@ -0,0 +1,27 @@
+package sqlite
+
+import "github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+
+// labelSelectorConditions はラベルセレクタを column の JSON に対する条件式へ変換し、args にプレースホルダの値を追加します。
+func labelSelectorConditions(column string, selector label.Selector, args []any) ([]string, []any) {
+	conditions := make([]string, 0, len(selector))
+	for _, req := range selector {
+		path := attributePath(req.Key)
+		switch req.Operator {
+		case label.OperatorEquals:
+			conditions = append(conditions, "json_extract("+column+", ?) = ?")
+			args = append(args, path, req.Value)
+		case label.OperatorNotEquals:
+			// キーが存在しない行も一致させるため IS NOT で比較します。
+			conditions = append(conditions, "json_extract("+column+", ?) IS NOT ?")
+			args = append(args, path, req.Value)
+		case label.OperatorExists:
+			conditions = append(conditions, "json_type("+column+", ?) IS NOT NULL")
+			args = append(args, path)
+		case label.OperatorDoesNotExist:
+			conditions = append(conditions, "json_type("+column+", ?) IS NULL")
+			args = append(args, path)
+		}
+	}
+	return conditions, args
+}
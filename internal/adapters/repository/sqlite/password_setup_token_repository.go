@@ -0,0 +1,117 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+const passwordSetupTokenColumns = `id, user_id, token_hash, expires_at, used_at, created_at`
+
+// PasswordSetupTokenRepository は SQLite を利用したパスワード設定トークンの実装です。
+type PasswordSetupTokenRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewPasswordSetupTokenRepository は PasswordSetupTokenRepository を生成します。
+func NewPasswordSetupTokenRepository(db sqlitedb.Queryer) *PasswordSetupTokenRepository {
+	return &PasswordSetupTokenRepository{db: db}
+}
+
+// Create はトークンを保存します。
+func (r *PasswordSetupTokenRepository) Create(ctx context.Context, token *auth.PasswordSetupToken) (*auth.PasswordSetupToken, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO password_setup_tokens (id, user_id, token_hash, expires_at, used_at, created_at)
+        VALUES (?, ?, ?, ?, ?, ?)
+        RETURNING `+passwordSetupTokenColumns,
+		uuid.NewString(), token.UserID, token.TokenHash, formatTimestamp(token.ExpiresAt),
+		nullableTimestamp(token.UsedAt), formatTimestamp(token.CreatedAt))
+
+	created, err := scanPasswordSetupToken(row)
+	if err != nil {
+		// password_setup_tokens の外部キーは user_id のみです。
+		if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+			return nil, auth.ErrUserNotFound
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// FindByHash はハッシュ値でトークンを取得します。
+func (r *PasswordSetupTokenRepository) FindByHash(ctx context.Context, tokenHash string) (*auth.PasswordSetupToken, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT `+passwordSetupTokenColumns+`
+          FROM password_setup_tokens
+         WHERE token_hash = ?
+    `, tokenHash)
+
+	token, err := scanPasswordSetupToken(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, auth.ErrInvalidSetupToken
+	}
+	return token, err
+}
+
+// MarkUsed は未使用のトークンを使用済みにします。
+func (r *PasswordSetupTokenRepository) MarkUsed(ctx context.Context, id string, usedAt time.Time) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `
+        UPDATE password_setup_tokens
+           SET used_at = ?
+         WHERE id = ?
+           AND used_at IS NULL
+    `, formatTimestamp(usedAt), id)
+	if err != nil {
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return auth.ErrInvalidSetupToken
+	}
+	return nil
+}
+
+// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+func (r *PasswordSetupTokenRepository) DeleteUnusedByUser(ctx context.Context, userID string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	_, err := exec.ExecContext(ctx, `DELETE FROM password_setup_tokens WHERE user_id = ? AND used_at IS NULL`, userID)
+	return err
+}
+
+func scanPasswordSetupToken(row rowScanner) (*auth.PasswordSetupToken, error) {
+	var (
+		token                auth.PasswordSetupToken
+		expiresAt, createdAt string
+		usedAt               sql.NullString
+	)
+	if err := row.Scan(&token.ID, &token.UserID, &token.TokenHash, &expiresAt, &usedAt, &createdAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if token.ExpiresAt, err = parseTimestamp(expiresAt); err != nil {
+		return nil, err
+	}
+	if token.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if usedAt.Valid {
+		t, err := parseTimestamp(usedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		token.UsedAt = &t
+	}
+	return &token, nil
+}
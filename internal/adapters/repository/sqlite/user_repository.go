@@ -0,0 +1,254 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
+	sqlitedb "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/sqlite"
+)
+
+// UserRepository は SQLite を利用したユーザーリポジトリ実装です。
+type UserRepository struct {
+	db sqlitedb.Queryer
+}
+
+// NewUserRepository は UserRepository を生成します。
+func NewUserRepository(db sqlitedb.Queryer) *UserRepository {
+	return &UserRepository{db: db}
+}
+
+// Create はユーザーを新規作成します。
+func (r *UserRepository) Create(ctx context.Context, u *user.User) (*user.User, error) {
+	labels, err := marshalStringMap(u.Labels)
+	if err != nil {
+		return nil, err
+	}
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        INSERT INTO users (id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at)
+        VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+        RETURNING id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+    `, uuid.NewString(), u.Email, nullableTimestamp(u.EmailVerifiedAt), u.Name, nullableString(u.FamilyName), nullableString(u.GivenName), u.DisplayName, nullableString(u.Locale), nullableString(u.TimeZone), nullableString(u.Phone), nullableString(u.AvatarURL), string(u.Status), labels, formatTimestamp(u.CreatedAt), formatTimestamp(u.UpdatedAt))
+
+	created, err := scanUser(row)
+	if err != nil {
+		return nil, translateUserError(err)
+	}
+	return created, nil
+}
+
+// Update はユーザー情報を更新します。
+func (r *UserRepository) Update(ctx context.Context, u *user.User) (*user.User, error) {
+	labels, err := marshalStringMap(u.Labels)
+	if err != nil {
+		return nil, err
+	}
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        UPDATE users
+           SET email = ?,
+               email_verified_at = ?,
+               name = ?,
+               family_name = ?,
+               given_name = ?,
+               display_name = ?,
+               locale = ?,
+               time_zone = ?,
+               phone = ?,
+               avatar_url = ?,
+               status = ?,
+               labels = ?,
+               updated_at = ?
+         WHERE id = ?
+        RETURNING id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+    `, u.Email, nullableTimestamp(u.EmailVerifiedAt), u.Name, nullableString(u.FamilyName), nullableString(u.GivenName), u.DisplayName, nullableString(u.Locale), nullableString(u.TimeZone), nullableString(u.Phone), nullableString(u.AvatarURL), string(u.Status), labels, formatTimestamp(u.UpdatedAt), u.ID)
+
+	updated, err := scanUser(row)
+	if err != nil {
+		return nil, translateUserError(err)
+	}
+	return updated, nil
+}
+
+// Delete はユーザーを削除します。社員から参照されている場合は削除しません。
+func (r *UserRepository) Delete(ctx context.Context, id string) error {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	result, err := exec.ExecContext(ctx, `DELETE FROM users WHERE id = ?`, id)
+	if err != nil {
+		return translateUserError(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if affected == 0 {
+		return user.ErrUserNotFound
+	}
+	return nil
+}
+
+// FindByID はIDでユーザーを取得します。
+func (r *UserRepository) FindByID(ctx context.Context, id string) (*user.User, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+          FROM users
+         WHERE id = ?
+    `, id)
+
+	found, err := scanUser(row)
+	if err != nil {
+		return nil, translateUserError(err)
+	}
+	return found, nil
+}
+
+// FindByEmail はメールアドレスでユーザーを取得します。
+func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*user.User, error) {
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	row := exec.QueryRowContext(ctx, `
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+          FROM users
+         WHERE email = ?
+    `, email)
+
+	found, err := scanUser(row)
+	if err != nil {
+		return nil, translateUserError(err)
+	}
+	return found, nil
+}
+
+// List はユーザーの一覧を取得します。
+func (r *UserRepository) List(ctx context.Context, filter user.ListUsersFilter) ([]*user.User, string, error) {
+	if filter.Limit <= 0 {
+		return nil, "", user.ErrInvalidPageSize
+	}
+	if filter.Offset < 0 {
+		return nil, "", user.ErrInvalidPageToken
+	}
+
+	limitWithBuffer := filter.Limit + 1
+
+	args := make([]any, 0, 3)
+	conditions := make([]string, 0, 1)
+
+	if filter.Status != nil {
+		conditions = append(conditions, "status = ?")
+		args = append(args, string(*filter.Status))
+	}
+
+	labelConditions, args := labelSelectorConditions("labels", filter.LabelSelector, args)
+	conditions = append(conditions, labelConditions...)
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+	args = append(args, limitWithBuffer, filter.Offset)
+
+	exec := sqlitedb.QueryerFromContext(ctx, r.db)
+	rows, err := exec.QueryContext(ctx, `
+        SELECT id, email, email_verified_at, name, family_name, given_name, display_name, locale, time_zone, phone, avatar_url, status, labels, created_at, updated_at
+          FROM users`+whereClause+`
+         ORDER BY created_at DESC, id DESC
+         LIMIT ? OFFSET ?
+    `, args...)
+	if err != nil {
+		return nil, "", translateUserError(err)
+	}
+	defer rows.Close()
+
+	users := make([]*user.User, 0, filter.Limit)
+	for rows.Next() {
+		u, err := scanUser(rows)
+		if err != nil {
+			return nil, "", translateUserError(err)
+		}
+		users = append(users, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", translateUserError(err)
+	}
+
+	var nextToken string
+	if len(users) == limitWithBuffer {
+		users = users[:filter.Limit]
+		nextToken = strconv.Itoa(filter.Offset + filter.Limit)
+	}
+
+	return users, nextToken, nil
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanUser(row rowScanner) (*user.User, error) {
+	var (
+		u               user.User
+		emailVerifiedAt sql.NullString
+		familyName      sql.NullString
+		givenName       sql.NullString
+		locale          sql.NullString
+		timeZone        sql.NullString
+		phone           sql.NullString
+		avatarURL       sql.NullString
+		status          string
+		labels          string
+		createdAt       string
+		updatedAt       string
+	)
+	if err := row.Scan(&u.ID, &u.Email, &emailVerifiedAt, &u.Name, &familyName, &givenName, &u.DisplayName, &locale, &timeZone, &phone, &avatarURL,
+		&status, &labels, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if u.Labels, err = unmarshalStringMap(labels); err != nil {
+		return nil, err
+	}
+	if u.CreatedAt, err = parseTimestamp(createdAt); err != nil {
+		return nil, err
+	}
+	if u.UpdatedAt, err = parseTimestamp(updatedAt); err != nil {
+		return nil, err
+	}
+	if emailVerifiedAt.Valid {
+		t, err := parseTimestamp(emailVerifiedAt.String)
+		if err != nil {
+			return nil, err
+		}
+		u.EmailVerifiedAt = &t
+	}
+	u.FamilyName = stringPtr(familyName)
+	u.GivenName = stringPtr(givenName)
+	u.Locale = stringPtr(locale)
+	u.TimeZone = stringPtr(timeZone)
+	u.Phone = stringPtr(phone)
+	u.AvatarURL = stringPtr(avatarURL)
+	u.Status = user.Status(status)
+	return &u, nil
+}
+
+func translateUserError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return user.ErrUserNotFound
+	}
+	if isUniqueViolation(err) {
+		return user.ErrEmailAlreadyExists
+	}
+	if code := errorCode(err); code == constraintForeignKeyCode || code == constraintTriggerCode {
+		// users を参照する外部キーは employees.user_id のみです。
+		return user.ErrUserHasEmployments
+	}
+	return err
+}
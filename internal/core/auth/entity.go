@@ -0,0 +1,72 @@
+package auth
+
+import "time"
+
+// Credential はユーザーのパスワード認証情報です。パスワードはハッシュ値のみを保持します。
+type Credential struct {
+	UserID string
+	// PasswordHash は argon2id（PHC 形式）または bcrypt のハッシュ値です。
+	PasswordHash string
+	// FailedAttempts は直近の成功またはロック以降に連続して失敗した回数です。
+	FailedAttempts int
+	// LockedUntil はロックが解除される日時です。ロックされていない場合は nil です。
+	LockedUntil *time.Time
+	// LastLoginAt は最後にログインに成功した日時です。未ログインの場合は nil です。
+	LastLoginAt *time.Time
+	// RefreshTokenID は最後に発行したリフレッシュトークンの ID（jti）です。
+	// この ID のリフレッシュトークンのみ再発行に使えます。未発行または失効させた場合は空です。
+	RefreshTokenID    string
+	PasswordUpdatedAt time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+// IsLocked は指定日時にロックされているかを返します。
+func (c *Credential) IsLocked(now time.Time) bool {
+	return c.LockedUntil != nil && now.Before(*c.LockedUntil)
+}
+
+// Account はログインの判定に使うユーザーの情報です。
+type Account struct {
+	UserID string
+	Email  string
+	// Active はユーザーが有効（user.StatusActive）かを表します。
+	Active bool
+}
+
+// Employment はトークンのクレームに含める社員レコードの概要です。
+type Employment struct {
+	CompanyID    string
+	EmployeeID   string
+	EmployeeCode string
+	Status       string
+}
+
+// TokenType はトークンの用途です。
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// Claims はトークンに含めるクレームです。
+type Claims struct {
+	// ID はトークンの ID（jti）です。空の場合は署名時に乱数を割り当てます。
+	ID string
+	// Subject はユーザー ID です。
+	Subject   string
+	TokenType TokenType
+	// Employments は会社ごとの在籍情報です。アクセストークンにのみ含めます。
+	Employments []Employment
+	IssuedAt    time.Time
+	ExpiresAt   time.Time
+}
+
+// Token は発行したアクセストークンとリフレッシュトークンの組です。
+type Token struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
@@ -0,0 +1,30 @@
+package auth
+
+import "errors"
+
+var (
+	// ErrCredentialNotFound はユーザーのパスワードが設定されていない場合に返却されます。
+	ErrCredentialNotFound = errors.New("credential not found")
+	// ErrUserNotFound はユーザーが存在しない場合に返却されます。
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidID はIDが不正な場合に返却されます。
+	ErrInvalidID = errors.New("invalid id")
+	// ErrInvalidCredentials はメールアドレスまたはパスワードが一致しない場合に返却されます。
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	// ErrAccountLocked はログインの失敗が続き、一時的にロックされている場合に返却されます。
+	ErrAccountLocked = errors.New("account locked")
+	// ErrUserInactive は有効でないユーザーがログインしようとした場合に返却されます。
+	ErrUserInactive = errors.New("user inactive")
+	// ErrInvalidPassword は新しいパスワードがポリシーを満たさない場合に返却されます。
+	ErrInvalidPassword = errors.New("invalid password")
+	// ErrInvalidToken はトークンが不正・期限切れ・失効済みの場合に返却されます。
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrAuthUnavailable はトークンの署名鍵が設定されていない場合に返却されます。
+	ErrAuthUnavailable = errors.New("authentication is not available")
+	// ErrInvalidEmail はメールアドレスの形式が不正な場合に返却されます。
+	ErrInvalidEmail = errors.New("invalid email")
+	// ErrInvalidSetupToken はパスワード設定トークンが未指定・不正・使用済み・期限切れの場合に返却されます。
+	ErrInvalidSetupToken = errors.New("invalid password setup token")
+	// ErrPasswordSetupUnavailable はパスワード設定トークンのリポジトリまたはメール送信が設定されていない場合に返却されます。
+	ErrPasswordSetupUnavailable = errors.New("password setup is not available")
+)
@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+// PasswordSetupToken は初回のパスワード設定に使う確認トークンです。トークン自体は保存せず、SHA-256 のハッシュ値のみを保持します。
+type PasswordSetupToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	// UsedAt はパスワードの設定に使われた日時です。未使用の場合は nil です。
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// PasswordSetupTokenRepository はパスワード設定トークンの永続化を行うインターフェースです。
+type PasswordSetupTokenRepository interface {
+	// Create はトークンを保存します。ユーザーが存在しない場合は ErrUserNotFound を返します。
+	Create(ctx context.Context, token *PasswordSetupToken) (*PasswordSetupToken, error)
+	// FindByHash はハッシュ値でトークンを取得します。存在しない場合は ErrInvalidSetupToken を返します。
+	FindByHash(ctx context.Context, tokenHash string) (*PasswordSetupToken, error)
+	// MarkUsed は未使用のトークンを使用済みにします。使用済みまたは存在しない場合は ErrInvalidSetupToken を返します。
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+	DeleteUnusedByUser(ctx context.Context, userID string) error
+}
+
+// WithPasswordSetup は初回のパスワード設定に使うトークンのリポジトリとメール送信を設定します。
+// 未設定の場合、RequestPasswordSetup と初回の SetPassword は ErrPasswordSetupUnavailable を返します。
+func WithPasswordSetup(tokens PasswordSetupTokenRepository, mailer verification.Mailer) Option {
+	return func(s *Service) {
+		s.setupTokens = tokens
+		s.mailer = mailer
+	}
+}
+
+// RequestPasswordSetupInput はパスワード設定トークンの要求時の入力です。
+type RequestPasswordSetupInput struct {
+	Email string
+}
+
+// RequestPasswordSetup は登録済みのメールアドレス宛てにパスワード設定トークンを送信します。
+// 登録の有無がわからないよう、ユーザーが存在しない場合やパスワードを設定済みの場合も送信せずに成功を返します。
+// 同じユーザーの未使用のトークンは無効になります。
+func (s *Service) RequestPasswordSetup(ctx context.Context, in RequestPasswordSetupInput) error {
+	if s.setupTokens == nil || s.mailer == nil {
+		return ErrPasswordSetupUnavailable
+	}
+	email, ok := normalizeEmail(in.Email)
+	if !ok {
+		return ErrInvalidEmail
+	}
+
+	var mail *verification.Mail
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		account, err := s.users.FindByEmail(txCtx, email)
+		if errors.Is(err, ErrUserNotFound) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		_, err = s.credentials.FindByUserID(txCtx, account.UserID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, ErrCredentialNotFound) {
+			return err
+		}
+
+		if err := s.setupTokens.DeleteUnusedByUser(txCtx, account.UserID); err != nil {
+			return err
+		}
+
+		raw, err := s.newSetupToken()
+		if err != nil {
+			return fmt.Errorf("generate password setup token: %w", err)
+		}
+
+		now := s.clock.Now()
+		created, err := s.setupTokens.Create(txCtx, &PasswordSetupToken{
+			UserID:    account.UserID,
+			TokenHash: verification.HashToken(raw),
+			ExpiresAt: now.Add(verification.TokenTTL),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		m := passwordSetupMail(account, created, raw)
+		mail = &m
+		return nil
+	}); err != nil {
+		return err
+	}
+	if mail == nil {
+		return nil
+	}
+
+	if err := s.mailer.Send(ctx, *mail); err != nil {
+		return fmt.Errorf("send password setup mail: %w", err)
+	}
+	return nil
+}
+
+// consumeSetupToken はパスワード設定トークンを検証し、使用済みにします。
+// トークンが指定したユーザーのものでない場合も ErrInvalidSetupToken を返します。
+func (s *Service) consumeSetupToken(ctx context.Context, userID, raw string, now time.Time) error {
+	if s.setupTokens == nil {
+		return ErrPasswordSetupUnavailable
+	}
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return fmt.Errorf("%w: setup_token is required", ErrInvalidSetupToken)
+	}
+
+	token, err := s.setupTokens.FindByHash(ctx, verification.HashToken(raw))
+	if err != nil {
+		return err
+	}
+	if token.UserID != userID || token.UsedAt != nil || !now.Before(token.ExpiresAt) {
+		return ErrInvalidSetupToken
+	}
+	return s.setupTokens.MarkUsed(ctx, token.ID, now)
+}
+
+func passwordSetupMail(account *Account, token *PasswordSetupToken, raw string) verification.Mail {
+	var body strings.Builder
+	body.WriteString("パスワードを設定するため、次の確認コードを SetPassword の setup_token に指定してください。\n\n")
+	fmt.Fprintf(&body, "確認コード: %s\n", raw)
+	fmt.Fprintf(&body, "有効期限: %s\n\n", token.ExpiresAt.UTC().Format(time.RFC3339))
+	body.WriteString("このメールに心当たりがない場合は破棄してください。\n")
+	return verification.Mail{
+		To:      account.Email,
+		Subject: "パスワードの設定",
+		Body:    body.String(),
+	}
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"time"
+)
+
+// CredentialRepository はパスワード認証情報の永続化を行うインターフェースです。
+type CredentialRepository interface {
+	// FindByUserID はユーザーの認証情報を取得します。存在しない場合は ErrCredentialNotFound を返します。
+	FindByUserID(ctx context.Context, userID string) (*Credential, error)
+	// Save は認証情報を作成または更新します。ユーザーが存在しない場合は ErrUserNotFound を返します。
+	// 更新時は失敗回数とロックを解除し、リフレッシュトークンの ID を空にします。
+	Save(ctx context.Context, credential *Credential) (*Credential, error)
+	// IncrementFailedAttempts は失敗回数を 1 増やし、増やした後の回数を返します。
+	IncrementFailedAttempts(ctx context.Context, userID string, at time.Time) (int, error)
+	// Lock は指定日時までロックし、失敗回数を 0 に戻します。
+	Lock(ctx context.Context, userID string, until time.Time, at time.Time) error
+	// RecordLogin はログインの成功を記録し、失敗回数とロックを解除します。
+	// 発行したリフレッシュトークンの ID を refreshTokenID に置き換えます。
+	RecordLogin(ctx context.Context, userID, refreshTokenID string, at time.Time) error
+	// RotateRefreshToken はリフレッシュトークンの ID が currentID と一致する場合のみ nextID に置き換えます。
+	// 一致しない場合や認証情報が存在しない場合は ErrInvalidToken を返します。
+	RotateRefreshToken(ctx context.Context, userID, currentID, nextID string, at time.Time) error
+}
+
+// Users はログインの判定に使うユーザーを参照するポートです。実装はユーザードメインが提供します。
+type Users interface {
+	// FindByEmail はメールアドレスでユーザーを取得します。存在しない場合は ErrUserNotFound を返します。
+	FindByEmail(ctx context.Context, email string) (*Account, error)
+	// FindByID は ID でユーザーを取得します。存在しない場合は ErrUserNotFound を返します。
+	FindByID(ctx context.Context, id string) (*Account, error)
+}
+
+// Employments はトークンのクレームに含める社員レコードを参照するポートです。実装は社員ドメインが提供します。
+type Employments interface {
+	// ListByUser は退職済みを除くユーザーの社員レコードを返します。
+	ListByUser(ctx context.Context, userID string) ([]Employment, error)
+}
+
+// PasswordHasher はパスワードのハッシュ化と照合を行うポートです。
+type PasswordHasher interface {
+	Hash(password string) (string, error)
+	// Verify はパスワードがハッシュ値と一致するかを返します。ハッシュ値の形式が不正な場合はエラーを返します。
+	Verify(hash, password string) (bool, error)
+}
+
+// TokenSigner はトークンの署名と検証を行うポートです。
+type TokenSigner interface {
+	Sign(claims Claims) (string, error)
+	// Verify は署名を検証してクレームを返します。有効期限は検証しません。
+	// 署名や形式が不正な場合は ErrInvalidToken を返します。
+	Verify(token string) (*Claims, error)
+}
@@ -0,0 +1,489 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+// Clock は現在時刻を提供します。
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// TransactionManager はトランザクション制御の抽象化です。
+type TransactionManager interface {
+	WithinReadOnly(ctx context.Context, fn func(context.Context) error) error
+	WithinReadWrite(ctx context.Context, fn func(context.Context) error) error
+}
+
+type noopTransactionManager struct{}
+
+func (noopTransactionManager) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx)
+}
+
+func (noopTransactionManager) WithinReadWrite(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx)
+}
+
+const (
+	// minPasswordLength はパスワードの最小文字数です。
+	minPasswordLength = 8
+	// maxPasswordBytes はパスワードの最大バイト数です。bcrypt の上限に合わせます。
+	maxPasswordBytes = 72
+
+	defaultMaxFailedAttempts = 5
+	defaultLockoutDuration   = 15 * time.Minute
+	defaultAccessTokenTTL    = 15 * time.Minute
+	defaultRefreshTokenTTL   = 30 * 24 * time.Hour
+
+	// dummyPassword は存在しないユーザーの照合に使うダミーのハッシュ値の元です。
+	dummyPassword = "dummy password for timing"
+)
+
+// Service は認証に関するユースケースをまとめます。
+type Service struct {
+	credentials CredentialRepository
+	users       Users
+	hasher      PasswordHasher
+	clock       Clock
+	tx          TransactionManager
+	employments Employments
+
+	setupTokens   PasswordSetupTokenRepository
+	mailer        verification.Mailer
+	newSetupToken func() (string, error)
+
+	signer          TokenSigner
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+
+	maxFailedAttempts int
+	lockoutDuration   time.Duration
+
+	dummyHashOnce sync.Once
+	dummyHash     string
+}
+
+// Option は Service の任意設定です。
+type Option func(*Service)
+
+// WithTokens はトークンの署名と有効期間を設定します。0 以下の有効期間は既定値（15 分・30 日）を使います。
+// 未設定の場合、Login と RefreshToken は ErrAuthUnavailable を返します。
+func WithTokens(signer TokenSigner, accessTokenTTL, refreshTokenTTL time.Duration) Option {
+	return func(s *Service) {
+		s.signer = signer
+		if accessTokenTTL > 0 {
+			s.accessTokenTTL = accessTokenTTL
+		}
+		if refreshTokenTTL > 0 {
+			s.refreshTokenTTL = refreshTokenTTL
+		}
+	}
+}
+
+// WithEmployments はアクセストークンのクレームに含める社員レコードのポートを設定します。
+// 未設定の場合、クレームに社員レコードを含めません。
+func WithEmployments(employments Employments) Option {
+	return func(s *Service) {
+		s.employments = employments
+	}
+}
+
+// WithLockout はロックまでの連続失敗回数とロック期間を設定します。0 以下の値は既定値（5 回・15 分）を使います。
+func WithLockout(maxFailedAttempts int, duration time.Duration) Option {
+	return func(s *Service) {
+		if maxFailedAttempts > 0 {
+			s.maxFailedAttempts = maxFailedAttempts
+		}
+		if duration > 0 {
+			s.lockoutDuration = duration
+		}
+	}
+}
+
+// UseCase は認証ユースケースの公開インターフェースです。
+type UseCase interface {
+	RequestPasswordSetup(ctx context.Context, in RequestPasswordSetupInput) error
+	SetPassword(ctx context.Context, in SetPasswordInput) error
+	Login(ctx context.Context, in LoginInput) (*Token, error)
+	RefreshToken(ctx context.Context, in RefreshTokenInput) (*Token, error)
+}
+
+// NewService は Service を生成します。
+func NewService(credentials CredentialRepository, users Users, hasher PasswordHasher, clock Clock, tx TransactionManager, opts ...Option) *Service {
+	if clock == nil {
+		clock = realClock{}
+	}
+	if tx == nil {
+		tx = noopTransactionManager{}
+	}
+	s := &Service{
+		credentials:       credentials,
+		users:             users,
+		hasher:            hasher,
+		clock:             clock,
+		tx:                tx,
+		accessTokenTTL:    defaultAccessTokenTTL,
+		refreshTokenTTL:   defaultRefreshTokenTTL,
+		maxFailedAttempts: defaultMaxFailedAttempts,
+		lockoutDuration:   defaultLockoutDuration,
+		newSetupToken:     verification.NewToken,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// SetPasswordInput はパスワード設定時の入力です。
+type SetPasswordInput struct {
+	UserID string
+	// SetupToken は初回の設定時に必須です。RequestPasswordSetup で送信したトークンを指定します。
+	SetupToken string
+	// CurrentPassword は設定済みのパスワードを変更する場合に必須です。
+	CurrentPassword string
+	NewPassword     string
+}
+
+// LoginInput はログイン時の入力です。
+type LoginInput struct {
+	Email    string
+	Password string
+}
+
+// RefreshTokenInput はトークン再発行時の入力です。
+type RefreshTokenInput struct {
+	RefreshToken string
+}
+
+// SetPassword はユーザーのパスワードを設定します。
+// 初回はメールで送信したパスワード設定トークンを検証し、使用済みにします。
+// 設定済みの場合は現在のパスワードを照合し、不一致はログインの失敗と同様に数えます。
+// 設定後は失敗回数とロックを解除し、それ以前に発行したリフレッシュトークンを無効にします。
+func (s *Service) SetPassword(ctx context.Context, in SetPasswordInput) error {
+	if strings.TrimSpace(in.UserID) == "" {
+		return fmt.Errorf("user_id: %w", ErrInvalidID)
+	}
+	if err := validatePassword(in.NewPassword); err != nil {
+		return err
+	}
+	// ハッシュ化は時間がかかるため、トランザクションを開始する前に行います。
+	hash, err := s.hasher.Hash(in.NewPassword)
+	if err != nil {
+		return fmt.Errorf("hash password: %w", err)
+	}
+
+	// 失敗回数の記録をコミットするため、照合の失敗はトランザクションの外で返します。
+	var denied error
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		account, err := s.users.FindByID(txCtx, in.UserID)
+		if err != nil {
+			return err
+		}
+
+		now := s.clock.Now()
+		createdAt := now
+		existing, err := s.credentials.FindByUserID(txCtx, account.UserID)
+		switch {
+		case errors.Is(err, ErrCredentialNotFound):
+			// 初回はユーザー ID だけでは本人と確認できないため、メールで送信したトークンを必須とします。
+			if err := s.consumeSetupToken(txCtx, account.UserID, in.SetupToken, now); err != nil {
+				return err
+			}
+		case err != nil:
+			return err
+		default:
+			if denied, err = s.checkPassword(txCtx, existing, in.CurrentPassword, now); err != nil || denied != nil {
+				return err
+			}
+			createdAt = existing.CreatedAt
+		}
+
+		_, err = s.credentials.Save(txCtx, &Credential{
+			UserID:            account.UserID,
+			PasswordHash:      hash,
+			PasswordUpdatedAt: now,
+			CreatedAt:         createdAt,
+			UpdatedAt:         now,
+		})
+		return err
+	}); err != nil {
+		return err
+	}
+
+	return denied
+}
+
+// Login はメールアドレスとパスワードを照合し、トークンを発行します。
+// 有効なユーザーのみログインでき、連続して失敗した場合は一定期間ロックします。
+// 発行したリフレッシュトークンのみ再発行に使えるよう、それ以前のリフレッシュトークンは無効にします。
+func (s *Service) Login(ctx context.Context, in LoginInput) (*Token, error) {
+	if s.signer == nil {
+		return nil, ErrAuthUnavailable
+	}
+	email, ok := normalizeEmail(in.Email)
+	if !ok || in.Password == "" {
+		return nil, ErrInvalidCredentials
+	}
+	refreshTokenID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		issued *Token
+		denied error
+	)
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		// 応答時間からユーザーの有無がわからないよう、照合できない場合もダミーのハッシュ値と照合します。
+		account, err := s.users.FindByEmail(txCtx, email)
+		if errors.Is(err, ErrUserNotFound) {
+			s.verifyDummy(in.Password)
+			denied = ErrInvalidCredentials
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		credential, err := s.credentials.FindByUserID(txCtx, account.UserID)
+		if errors.Is(err, ErrCredentialNotFound) {
+			s.verifyDummy(in.Password)
+			denied = ErrInvalidCredentials
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		now := s.clock.Now()
+		if denied, err = s.checkPassword(txCtx, credential, in.Password, now); err != nil || denied != nil {
+			return err
+		}
+		if !account.Active {
+			denied = ErrUserInactive
+			return nil
+		}
+
+		if err := s.credentials.RecordLogin(txCtx, account.UserID, refreshTokenID, now); err != nil {
+			return err
+		}
+		issued, err = s.issueToken(txCtx, account.UserID, refreshTokenID, now)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	if denied != nil {
+		return nil, denied
+	}
+
+	return issued, nil
+}
+
+// RefreshToken はリフレッシュトークンを検証し、新しいトークンを発行します。
+// 使用したリフレッシュトークンは無効にし、最後に発行したリフレッシュトークンのみ受け付けます。
+// 社員レコードのクレームは発行時点の内容で作り直します。
+func (s *Service) RefreshToken(ctx context.Context, in RefreshTokenInput) (*Token, error) {
+	if s.signer == nil {
+		return nil, ErrAuthUnavailable
+	}
+	raw := strings.TrimSpace(in.RefreshToken)
+	if raw == "" {
+		return nil, ErrInvalidToken
+	}
+
+	claims, err := s.signer.Verify(raw)
+	if err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
+	if claims.TokenType != TokenTypeRefresh {
+		return nil, fmt.Errorf("%w: not a refresh token", ErrInvalidToken)
+	}
+	if !now.Before(claims.ExpiresAt) {
+		return nil, fmt.Errorf("%w: expired", ErrInvalidToken)
+	}
+	if claims.ID == "" {
+		return nil, fmt.Errorf("%w: missing token id", ErrInvalidToken)
+	}
+	nextID, err := newTokenID()
+	if err != nil {
+		return nil, err
+	}
+
+	var issued *Token
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		account, err := s.users.FindByID(txCtx, claims.Subject)
+		if errors.Is(err, ErrUserNotFound) {
+			return ErrInvalidToken
+		}
+		if err != nil {
+			return err
+		}
+		if !account.Active {
+			return ErrUserInactive
+		}
+
+		credential, err := s.credentials.FindByUserID(txCtx, account.UserID)
+		if errors.Is(err, ErrCredentialNotFound) {
+			return ErrInvalidToken
+		}
+		if err != nil {
+			return err
+		}
+		// パスワードの設定やログイン、再発行でリフレッシュトークンの ID は置き換わります。
+		if credential.RefreshTokenID != claims.ID {
+			return fmt.Errorf("%w: revoked", ErrInvalidToken)
+		}
+		if credential.IsLocked(now) {
+			return ErrAccountLocked
+		}
+
+		// 同じトークンで同時に再発行した場合も、置き換えられるのは 1 件のみです。
+		if err := s.credentials.RotateRefreshToken(txCtx, account.UserID, claims.ID, nextID, now); err != nil {
+			return err
+		}
+		issued, err = s.issueToken(txCtx, account.UserID, nextID, now)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return issued, nil
+}
+
+// checkPassword はパスワードを照合します。ロック中または不一致の場合は denied でその理由を返します。
+// 不一致の場合は失敗回数を増やし、上限に達するとロックします。
+func (s *Service) checkPassword(ctx context.Context, credential *Credential, password string, now time.Time) (denied, err error) {
+	ok, err := s.hasher.Verify(credential.PasswordHash, password)
+	if err != nil {
+		return nil, fmt.Errorf("verify password: %w", err)
+	}
+	// ロック中であることは正しいパスワードを指定した場合のみ返します。ロック中の試行は失敗回数に数えません。
+	if credential.IsLocked(now) {
+		if ok {
+			return ErrAccountLocked, nil
+		}
+		return ErrInvalidCredentials, nil
+	}
+	if ok {
+		return nil, nil
+	}
+
+	attempts, err := s.credentials.IncrementFailedAttempts(ctx, credential.UserID, now)
+	if err != nil {
+		return nil, err
+	}
+	if attempts < s.maxFailedAttempts {
+		return ErrInvalidCredentials, nil
+	}
+	if err := s.credentials.Lock(ctx, credential.UserID, now.Add(s.lockoutDuration), now); err != nil {
+		return nil, err
+	}
+	return ErrAccountLocked, nil
+}
+
+// verifyDummy はダミーのハッシュ値と照合し、照合にかかる時間を実際のユーザーと揃えます。
+func (s *Service) verifyDummy(password string) {
+	s.dummyHashOnce.Do(func() {
+		s.dummyHash, _ = s.hasher.Hash(dummyPassword)
+	})
+	if s.dummyHash != "" {
+		_, _ = s.hasher.Verify(s.dummyHash, password)
+	}
+}
+
+func (s *Service) issueToken(ctx context.Context, userID, refreshTokenID string, now time.Time) (*Token, error) {
+	var employments []Employment
+	if s.employments != nil {
+		var err error
+		if employments, err = s.employments.ListByUser(ctx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	issuedAt := now.Truncate(time.Second)
+	access := Claims{
+		Subject:     userID,
+		TokenType:   TokenTypeAccess,
+		Employments: employments,
+		IssuedAt:    issuedAt,
+		ExpiresAt:   issuedAt.Add(s.accessTokenTTL),
+	}
+	refresh := Claims{
+		ID:        refreshTokenID,
+		Subject:   userID,
+		TokenType: TokenTypeRefresh,
+		IssuedAt:  issuedAt,
+		ExpiresAt: issuedAt.Add(s.refreshTokenTTL),
+	}
+
+	accessToken, err := s.signer.Sign(access)
+	if err != nil {
+		return nil, fmt.Errorf("sign access token: %w", err)
+	}
+	refreshToken, err := s.signer.Sign(refresh)
+	if err != nil {
+		return nil, fmt.Errorf("sign refresh token: %w", err)
+	}
+
+	return &Token{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  access.ExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
+
+// newTokenID はリフレッシュトークンの ID を生成します。
+func newTokenID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("generate token id: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func validatePassword(password string) error {
+	if utf8.RuneCountInString(password) < minPasswordLength {
+		return fmt.Errorf("%w: must be at least %d characters", ErrInvalidPassword, minPasswordLength)
+	}
+	if len(password) > maxPasswordBytes {
+		return fmt.Errorf("%w: must be at most %d bytes", ErrInvalidPassword, maxPasswordBytes)
+	}
+	if strings.TrimSpace(password) == "" {
+		return fmt.Errorf("%w: must not be blank", ErrInvalidPassword)
+	}
+	return nil
+}
+
+func normalizeEmail(raw string) (string, bool) {
+	addr, err := mail.ParseAddress(strings.TrimSpace(raw))
+	if err != nil {
+		return "", false
+	}
+	return strings.ToLower(addr.Address), true
+}
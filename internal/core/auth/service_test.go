@@ -0,0 +1,690 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+type stubClock struct {
+	now time.Time
+}
+
+func (s *stubClock) Now() time.Time {
+	return s.now
+}
+
+type fakeCredentials struct {
+	credentials map[string]*Credential
+}
+
+func newFakeCredentials() *fakeCredentials {
+	return &fakeCredentials{credentials: make(map[string]*Credential)}
+}
+
+func (r *fakeCredentials) FindByUserID(_ context.Context, userID string) (*Credential, error) {
+	c, ok := r.credentials[userID]
+	if !ok {
+		return nil, ErrCredentialNotFound
+	}
+	clone := *c
+	return &clone, nil
+}
+
+func (r *fakeCredentials) Save(_ context.Context, credential *Credential) (*Credential, error) {
+	clone := *credential
+	clone.FailedAttempts = 0
+	clone.LockedUntil = nil
+	clone.RefreshTokenID = ""
+	if existing, ok := r.credentials[credential.UserID]; ok {
+		clone.LastLoginAt = existing.LastLoginAt
+	}
+	r.credentials[credential.UserID] = &clone
+	saved := clone
+	return &saved, nil
+}
+
+func (r *fakeCredentials) IncrementFailedAttempts(_ context.Context, userID string, at time.Time) (int, error) {
+	c, ok := r.credentials[userID]
+	if !ok {
+		return 0, ErrCredentialNotFound
+	}
+	c.FailedAttempts++
+	c.UpdatedAt = at
+	return c.FailedAttempts, nil
+}
+
+func (r *fakeCredentials) Lock(_ context.Context, userID string, until time.Time, at time.Time) error {
+	c, ok := r.credentials[userID]
+	if !ok {
+		return ErrCredentialNotFound
+	}
+	c.FailedAttempts = 0
+	c.LockedUntil = &until
+	c.UpdatedAt = at
+	return nil
+}
+
+func (r *fakeCredentials) RecordLogin(_ context.Context, userID, refreshTokenID string, at time.Time) error {
+	c, ok := r.credentials[userID]
+	if !ok {
+		return ErrCredentialNotFound
+	}
+	c.FailedAttempts = 0
+	c.LockedUntil = nil
+	c.LastLoginAt = &at
+	c.RefreshTokenID = refreshTokenID
+	c.UpdatedAt = at
+	return nil
+}
+
+func (r *fakeCredentials) RotateRefreshToken(_ context.Context, userID, currentID, nextID string, at time.Time) error {
+	c, ok := r.credentials[userID]
+	if !ok || c.RefreshTokenID == "" || c.RefreshTokenID != currentID {
+		return ErrInvalidToken
+	}
+	c.RefreshTokenID = nextID
+	c.UpdatedAt = at
+	return nil
+}
+
+type fakeUsers struct {
+	accounts map[string]*Account
+}
+
+func (u *fakeUsers) FindByEmail(_ context.Context, email string) (*Account, error) {
+	for _, a := range u.accounts {
+		if a.Email == email {
+			clone := *a
+			return &clone, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+func (u *fakeUsers) FindByID(_ context.Context, id string) (*Account, error) {
+	a, ok := u.accounts[id]
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	clone := *a
+	return &clone, nil
+}
+
+type fakeEmployments struct {
+	employments map[string][]Employment
+}
+
+func (e *fakeEmployments) ListByUser(_ context.Context, userID string) ([]Employment, error) {
+	return e.employments[userID], nil
+}
+
+type fakeHasher struct{}
+
+func (fakeHasher) Hash(password string) (string, error) {
+	return "hashed:" + password, nil
+}
+
+func (fakeHasher) Verify(hash, password string) (bool, error) {
+	if !strings.HasPrefix(hash, "hashed:") {
+		return false, errors.New("unknown hash format")
+	}
+	return hash == "hashed:"+password, nil
+}
+
+// recordingHasher は照合の回数と、トランザクション内でハッシュ化したかを記録します。
+type recordingHasher struct {
+	fakeHasher
+	tx       *recordingTx
+	verified int
+	hashedIn bool
+}
+
+func (h *recordingHasher) Hash(password string) (string, error) {
+	if h.tx != nil && h.tx.active {
+		h.hashedIn = true
+	}
+	return h.fakeHasher.Hash(password)
+}
+
+func (h *recordingHasher) Verify(hash, password string) (bool, error) {
+	h.verified++
+	return h.fakeHasher.Verify(hash, password)
+}
+
+// recordingTx はトランザクションの実行中かを記録します。
+type recordingTx struct {
+	active bool
+}
+
+func (t *recordingTx) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
+	return t.WithinReadWrite(ctx, fn)
+}
+
+func (t *recordingTx) WithinReadWrite(ctx context.Context, fn func(context.Context) error) error {
+	t.active = true
+	defer func() { t.active = false }()
+	return fn(ctx)
+}
+
+// fakeSigner は発行したクレームを保持し、連番のトークンで参照します。
+type fakeSigner struct {
+	issued map[string]Claims
+}
+
+func newFakeSigner() *fakeSigner {
+	return &fakeSigner{issued: make(map[string]Claims)}
+}
+
+func (s *fakeSigner) Sign(claims Claims) (string, error) {
+	token := "token-" + strconv.Itoa(len(s.issued)+1)
+	s.issued[token] = claims
+	return token, nil
+}
+
+func (s *fakeSigner) Verify(token string) (*Claims, error) {
+	claims, ok := s.issued[token]
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+	return &claims, nil
+}
+
+type fakeSetupTokens struct {
+	tokens map[string]*PasswordSetupToken
+}
+
+func newFakeSetupTokens() *fakeSetupTokens {
+	return &fakeSetupTokens{tokens: make(map[string]*PasswordSetupToken)}
+}
+
+func (r *fakeSetupTokens) Create(_ context.Context, token *PasswordSetupToken) (*PasswordSetupToken, error) {
+	clone := *token
+	clone.ID = "setup-token-" + strconv.Itoa(len(r.tokens)+1)
+	r.tokens[clone.ID] = &clone
+	created := clone
+	return &created, nil
+}
+
+func (r *fakeSetupTokens) FindByHash(_ context.Context, tokenHash string) (*PasswordSetupToken, error) {
+	for _, token := range r.tokens {
+		if token.TokenHash == tokenHash {
+			clone := *token
+			return &clone, nil
+		}
+	}
+	return nil, ErrInvalidSetupToken
+}
+
+func (r *fakeSetupTokens) MarkUsed(_ context.Context, id string, usedAt time.Time) error {
+	token, ok := r.tokens[id]
+	if !ok || token.UsedAt != nil {
+		return ErrInvalidSetupToken
+	}
+	token.UsedAt = &usedAt
+	return nil
+}
+
+func (r *fakeSetupTokens) DeleteUnusedByUser(_ context.Context, userID string) error {
+	for id, token := range r.tokens {
+		if token.UserID == userID && token.UsedAt == nil {
+			delete(r.tokens, id)
+		}
+	}
+	return nil
+}
+
+type fakeMailer struct {
+	sent []verification.Mail
+	err  error
+}
+
+func (m *fakeMailer) Send(_ context.Context, mail verification.Mail) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, mail)
+	return nil
+}
+
+type authFixture struct {
+	svc         *Service
+	clock       *stubClock
+	credentials *fakeCredentials
+	users       *fakeUsers
+	signer      *fakeSigner
+	setupTokens *fakeSetupTokens
+	mailer      *fakeMailer
+}
+
+func newAuthFixture(opts ...Option) *authFixture {
+	f := &authFixture{
+		clock:       &stubClock{now: time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+		credentials: newFakeCredentials(),
+		users: &fakeUsers{accounts: map[string]*Account{
+			"user-1": {UserID: "user-1", Email: "taro@example.com", Active: true},
+			"user-2": {UserID: "user-2", Email: "hanako@example.com", Active: false},
+		}},
+		signer:      newFakeSigner(),
+		setupTokens: newFakeSetupTokens(),
+		mailer:      &fakeMailer{},
+	}
+	opts = append([]Option{WithTokens(f.signer, 0, 0), WithPasswordSetup(f.setupTokens, f.mailer)}, opts...)
+	f.svc = NewService(f.credentials, f.users, fakeHasher{}, f.clock, nil, opts...)
+	return f
+}
+
+// grantSetupToken はユーザーのパスワード設定トークンを発行し、平文のトークンを返します。
+func (f *authFixture) grantSetupToken(userID string) string {
+	raw := "setup-" + userID + "-" + strconv.Itoa(len(f.setupTokens.tokens)+1)
+	_, _ = f.setupTokens.Create(context.Background(), &PasswordSetupToken{
+		UserID:    userID,
+		TokenHash: verification.HashToken(raw),
+		ExpiresAt: f.clock.now.Add(verification.TokenTTL),
+		CreatedAt: f.clock.now,
+	})
+	return raw
+}
+
+func TestService_SetPassword(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored := f.credentials.credentials["user-1"]
+	if stored.PasswordHash != "hashed:correct horse" || !stored.PasswordUpdatedAt.Equal(f.clock.now) {
+		t.Fatalf("unexpected credential: %+v", stored)
+	}
+	createdAt := stored.CreatedAt
+
+	// 設定済みの場合は現在のパスワードが必要です。
+	err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", NewPassword: "battery staple"})
+	if !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+	}
+	if got := f.credentials.credentials["user-1"].FailedAttempts; got != 1 {
+		t.Fatalf("expected failed attempt to be recorded, got %d", got)
+	}
+
+	f.clock.now = f.clock.now.Add(time.Hour)
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", CurrentPassword: "correct horse", NewPassword: "battery staple"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	stored = f.credentials.credentials["user-1"]
+	if stored.PasswordHash != "hashed:battery staple" || stored.FailedAttempts != 0 {
+		t.Fatalf("unexpected credential: %+v", stored)
+	}
+	if !stored.CreatedAt.Equal(createdAt) || !stored.PasswordUpdatedAt.Equal(f.clock.now) {
+		t.Fatalf("unexpected timestamps: %+v", stored)
+	}
+}
+
+func TestService_SetPassword_RequiresSetupToken(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+
+	f.users.accounts["user-3"] = &Account{UserID: "user-3", Email: "saburo@example.com", Active: true}
+	others := f.grantSetupToken("user-3")
+	expired := f.grantSetupToken("user-1")
+	f.clock.now = f.clock.now.Add(verification.TokenTTL)
+
+	// 初回の設定はユーザー ID だけでは受け付けません。
+	cases := []struct {
+		name  string
+		token string
+	}{
+		{name: "missing token", token: ""},
+		{name: "unknown token", token: "guessed"},
+		{name: "token of another user", token: others},
+		{name: "expired token", token: expired},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: tc.token, NewPassword: "correct horse"})
+			if !errors.Is(err, ErrInvalidSetupToken) {
+				t.Fatalf("expected ErrInvalidSetupToken, got %v", err)
+			}
+			if _, ok := f.credentials.credentials["user-1"]; ok {
+				t.Fatal("expected no credential to be stored")
+			}
+		})
+	}
+
+	token := f.grantSetupToken("user-1")
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: token, NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 使用済みのトークンは再利用できません。
+	delete(f.credentials.credentials, "user-1")
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: token, NewPassword: "battery staple"}); !errors.Is(err, ErrInvalidSetupToken) {
+		t.Fatalf("expected ErrInvalidSetupToken for a used token, got %v", err)
+	}
+
+	unavailable := NewService(f.credentials, f.users, fakeHasher{}, f.clock, nil)
+	if err := unavailable.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: token, NewPassword: "correct horse"}); !errors.Is(err, ErrPasswordSetupUnavailable) {
+		t.Fatalf("expected ErrPasswordSetupUnavailable, got %v", err)
+	}
+}
+
+func TestService_RequestPasswordSetup(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+	seq := 0
+	f.svc.newSetupToken = func() (string, error) {
+		seq++
+		return "raw-" + strconv.Itoa(seq), nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := f.svc.RequestPasswordSetup(ctx, RequestPasswordSetupInput{Email: " Taro@Example.com "}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(f.mailer.sent) != 2 {
+		t.Fatalf("expected 2 mails, got %d", len(f.mailer.sent))
+	}
+	mail := f.mailer.sent[1]
+	if mail.To != "taro@example.com" || !strings.Contains(mail.Body, "raw-2") {
+		t.Fatalf("unexpected mail: %+v", mail)
+	}
+	if len(f.setupTokens.tokens) != 1 {
+		t.Fatalf("expected the previous token to be invalidated, got %d tokens", len(f.setupTokens.tokens))
+	}
+	for _, token := range f.setupTokens.tokens {
+		if token.TokenHash == "raw-2" || token.UserID != "user-1" || !token.ExpiresAt.Equal(f.clock.now.Add(verification.TokenTTL)) {
+			t.Fatalf("unexpected stored token: %+v", token)
+		}
+	}
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: "raw-1", NewPassword: "correct horse"}); !errors.Is(err, ErrInvalidSetupToken) {
+		t.Fatalf("expected ErrInvalidSetupToken for an invalidated token, got %v", err)
+	}
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: "raw-2", NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 未登録のメールアドレスやパスワードを設定済みのユーザーには送信しませんが、区別せず成功を返します。
+	for _, email := range []string{"jiro@example.com", "taro@example.com"} {
+		if err := f.svc.RequestPasswordSetup(ctx, RequestPasswordSetupInput{Email: email}); err != nil {
+			t.Fatalf("%s: unexpected error: %v", email, err)
+		}
+	}
+	if len(f.mailer.sent) != 2 {
+		t.Fatalf("expected no additional mails, got %d", len(f.mailer.sent))
+	}
+
+	if err := f.svc.RequestPasswordSetup(ctx, RequestPasswordSetupInput{Email: "taro"}); !errors.Is(err, ErrInvalidEmail) {
+		t.Fatalf("expected ErrInvalidEmail, got %v", err)
+	}
+	unavailable := NewService(f.credentials, f.users, fakeHasher{}, f.clock, nil)
+	if err := unavailable.RequestPasswordSetup(ctx, RequestPasswordSetupInput{Email: "hanako@example.com"}); !errors.Is(err, ErrPasswordSetupUnavailable) {
+		t.Fatalf("expected ErrPasswordSetupUnavailable, got %v", err)
+	}
+}
+
+func TestService_SetPassword_HashesOutsideTransaction(t *testing.T) {
+	tx := &recordingTx{}
+	hasher := &recordingHasher{tx: tx}
+	f := newAuthFixture()
+	svc := NewService(f.credentials, f.users, hasher, f.clock, tx, WithPasswordSetup(f.setupTokens, f.mailer))
+
+	if err := svc.SetPassword(context.Background(), SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if hasher.hashedIn {
+		t.Fatal("expected the password to be hashed before the transaction")
+	}
+}
+
+func TestService_SetPassword_Validation(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+
+	cases := []struct {
+		name string
+		in   SetPasswordInput
+		want error
+	}{
+		{name: "missing user", in: SetPasswordInput{NewPassword: "correct horse"}, want: ErrInvalidID},
+		{name: "too short", in: SetPasswordInput{UserID: "user-1", NewPassword: "short"}, want: ErrInvalidPassword},
+		{name: "too long", in: SetPasswordInput{UserID: "user-1", NewPassword: strings.Repeat("a", 73)}, want: ErrInvalidPassword},
+		{name: "blank", in: SetPasswordInput{UserID: "user-1", NewPassword: "          "}, want: ErrInvalidPassword},
+		{name: "unknown user", in: SetPasswordInput{UserID: "missing", NewPassword: "correct horse"}, want: ErrUserNotFound},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := f.svc.SetPassword(ctx, tc.in); !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+
+	// マルチバイト文字は文字数で数えます。
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "パスワードです。"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestService_Login(t *testing.T) {
+	employments := &fakeEmployments{employments: map[string][]Employment{
+		"user-1": {{CompanyID: "company-1", EmployeeID: "employee-1", EmployeeCode: "E001", Status: "active"}},
+	}}
+	f := newAuthFixture(WithEmployments(employments))
+	ctx := context.Background()
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f.clock.now = f.clock.now.Add(1500 * time.Millisecond)
+	token, err := f.svc.Login(ctx, LoginInput{Email: " Taro@Example.com ", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	issuedAt := f.clock.now.Truncate(time.Second)
+	if !token.AccessTokenExpiresAt.Equal(issuedAt.Add(defaultAccessTokenTTL)) || !token.RefreshTokenExpiresAt.Equal(issuedAt.Add(defaultRefreshTokenTTL)) {
+		t.Fatalf("unexpected expirations: %+v", token)
+	}
+	access := f.signer.issued[token.AccessToken]
+	if access.Subject != "user-1" || access.TokenType != TokenTypeAccess || !access.IssuedAt.Equal(issuedAt) {
+		t.Fatalf("unexpected access claims: %+v", access)
+	}
+	if len(access.Employments) != 1 || access.Employments[0].CompanyID != "company-1" || access.Employments[0].EmployeeCode != "E001" {
+		t.Fatalf("unexpected employment claims: %+v", access.Employments)
+	}
+	refresh := f.signer.issued[token.RefreshToken]
+	if refresh.TokenType != TokenTypeRefresh || len(refresh.Employments) != 0 {
+		t.Fatalf("unexpected refresh claims: %+v", refresh)
+	}
+
+	stored := f.credentials.credentials["user-1"]
+	if stored.LastLoginAt == nil || !stored.LastLoginAt.Equal(f.clock.now) {
+		t.Fatalf("expected last login to be recorded, got %+v", stored.LastLoginAt)
+	}
+}
+
+func TestService_Login_Errors(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+
+	for _, id := range []string{"user-1", "user-2"} {
+		if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: id, SetupToken: f.grantSetupToken(id), NewPassword: "correct horse"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	cases := []struct {
+		name string
+		in   LoginInput
+		want error
+	}{
+		{name: "invalid email", in: LoginInput{Email: "taro", Password: "correct horse"}, want: ErrInvalidCredentials},
+		{name: "unknown email", in: LoginInput{Email: "jiro@example.com", Password: "correct horse"}, want: ErrInvalidCredentials},
+		{name: "empty password", in: LoginInput{Email: "taro@example.com"}, want: ErrInvalidCredentials},
+		{name: "inactive user", in: LoginInput{Email: "hanako@example.com", Password: "correct horse"}, want: ErrUserInactive},
+		// 有効でないユーザーでもパスワードが一致しない場合は区別しません。
+		{name: "inactive user with wrong password", in: LoginInput{Email: "hanako@example.com", Password: "wrong horse"}, want: ErrInvalidCredentials},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := f.svc.Login(ctx, tc.in); !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+
+	// 未登録のメールアドレスやパスワード未設定のユーザーでも、照合と同じ処理を行います。
+	hasher := &recordingHasher{}
+	timing := NewService(f.credentials, f.users, hasher, f.clock, nil, WithTokens(f.signer, 0, 0))
+	f.users.accounts["user-3"] = &Account{UserID: "user-3", Email: "saburo@example.com", Active: true}
+	for _, email := range []string{"jiro@example.com", "saburo@example.com"} {
+		hasher.verified = 0
+		if _, err := timing.Login(ctx, LoginInput{Email: email, Password: "correct horse"}); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("%s: expected ErrInvalidCredentials, got %v", email, err)
+		}
+		if hasher.verified != 1 {
+			t.Fatalf("%s: expected a dummy verification, got %d", email, hasher.verified)
+		}
+	}
+
+	unavailable := NewService(f.credentials, f.users, fakeHasher{}, f.clock, nil)
+	if _, err := unavailable.Login(ctx, LoginInput{Email: "taro@example.com", Password: "correct horse"}); !errors.Is(err, ErrAuthUnavailable) {
+		t.Fatalf("expected ErrAuthUnavailable, got %v", err)
+	}
+}
+
+func TestService_Login_Lockout(t *testing.T) {
+	f := newAuthFixture(WithLockout(3, 10*time.Minute))
+	ctx := context.Background()
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	wrong := LoginInput{Email: "taro@example.com", Password: "wrong horse"}
+	right := LoginInput{Email: "taro@example.com", Password: "correct horse"}
+
+	for i := 0; i < 2; i++ {
+		if _, err := f.svc.Login(ctx, wrong); !errors.Is(err, ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected ErrInvalidCredentials, got %v", i+1, err)
+		}
+	}
+	if _, err := f.svc.Login(ctx, wrong); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected ErrAccountLocked, got %v", err)
+	}
+
+	// ロック中は正しいパスワードでもログインできません。ロック中であることは正しいパスワードの場合のみ返します。
+	f.clock.now = f.clock.now.Add(9 * time.Minute)
+	if _, err := f.svc.Login(ctx, wrong); !errors.Is(err, ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials while locked, got %v", err)
+	}
+	if _, err := f.svc.Login(ctx, right); !errors.Is(err, ErrAccountLocked) {
+		t.Fatalf("expected ErrAccountLocked, got %v", err)
+	}
+	if got := f.credentials.credentials["user-1"].FailedAttempts; got != 0 {
+		t.Fatalf("expected attempts not to be counted while locked, got %d", got)
+	}
+
+	f.clock.now = f.clock.now.Add(time.Minute)
+	if _, err := f.svc.Login(ctx, right); err != nil {
+		t.Fatalf("unexpected error after lockout: %v", err)
+	}
+	stored := f.credentials.credentials["user-1"]
+	if stored.LockedUntil != nil || stored.FailedAttempts != 0 {
+		t.Fatalf("expected lockout to be cleared, got %+v", stored)
+	}
+}
+
+func TestService_RefreshToken(t *testing.T) {
+	employments := &fakeEmployments{employments: map[string][]Employment{}}
+	f := newAuthFixture(WithEmployments(employments))
+	ctx := context.Background()
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := f.svc.Login(ctx, LoginInput{Email: "taro@example.com", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// 再発行時は社員レコードのクレームを作り直します。
+	employments.employments["user-1"] = []Employment{{CompanyID: "company-2", EmployeeID: "employee-2", EmployeeCode: "E002", Status: "active"}}
+	f.clock.now = f.clock.now.Add(time.Hour)
+	refreshed, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: token.RefreshToken})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	access := f.signer.issued[refreshed.AccessToken]
+	if access.Subject != "user-1" || len(access.Employments) != 1 || access.Employments[0].CompanyID != "company-2" {
+		t.Fatalf("unexpected access claims: %+v", access)
+	}
+	refresh := f.signer.issued[refreshed.RefreshToken]
+	if refresh.ID == "" || refresh.ID != f.credentials.credentials["user-1"].RefreshTokenID {
+		t.Fatalf("expected the refresh token id to be stored, got %+v", refresh)
+	}
+
+	// 使用したリフレッシュトークンは再利用できません。
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: token.RefreshToken}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for a used refresh token, got %v", err)
+	}
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: refreshed.RefreshToken}); err != nil {
+		t.Fatalf("expected the rotated refresh token to be usable, got %v", err)
+	}
+	refreshed, err = f.svc.Login(ctx, LoginInput{Email: "taro@example.com", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: refreshed.AccessToken}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for access token, got %v", err)
+	}
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: "unknown"}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for unknown token, got %v", err)
+	}
+
+	// パスワードを変更すると、それ以前のリフレッシュトークンは使えません。
+	f.clock.now = f.clock.now.Add(time.Second)
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", CurrentPassword: "correct horse", NewPassword: "battery staple"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: refreshed.RefreshToken}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken after password change, got %v", err)
+	}
+}
+
+func TestService_RefreshToken_Errors(t *testing.T) {
+	f := newAuthFixture()
+	ctx := context.Background()
+
+	if err := f.svc.SetPassword(ctx, SetPasswordInput{UserID: "user-1", SetupToken: f.grantSetupToken("user-1"), NewPassword: "correct horse"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	token, err := f.svc.Login(ctx, LoginInput{Email: "taro@example.com", Password: "correct horse"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: " "}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken, got %v", err)
+	}
+
+	f.users.accounts["user-1"].Active = false
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: token.RefreshToken}); !errors.Is(err, ErrUserInactive) {
+		t.Fatalf("expected ErrUserInactive, got %v", err)
+	}
+	f.users.accounts["user-1"].Active = true
+
+	f.clock.now = token.RefreshTokenExpiresAt
+	if _, err := f.svc.RefreshToken(ctx, RefreshTokenInput{RefreshToken: token.RefreshToken}); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for expired token, got %v", err)
+	}
+}
@@ -0,0 +1,189 @@
+package company
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// maxAddressLines は住所行の最大数です。
+	maxAddressLines = 4
+	// maxAddressLineLength は住所行 1 行あたりの最大文字数です。
+	maxAddressLineLength = 200
+	// maxWebsiteLength は Web サイトの URL の最大長です。
+	maxWebsiteLength = 2048
+)
+
+// phonePattern は E.164 形式の電話番号です。
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// phoneSeparators は電話番号の入力で許容する区切り文字です。
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+
+// countryCodes は ISO 3166-1 alpha-2 の国コードです。
+var countryCodes = newCountrySet(
+	"AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ",
+	"BA BB BD BE BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ",
+	"CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ",
+	"DE DJ DK DM DO DZ",
+	"EC EE EG EH ER ES ET",
+	"FI FJ FK FM FO FR",
+	"GA GB GD GE GF GG GH GI GL GM GN GP GQ GR GS GT GU GW GY",
+	"HK HM HN HR HT HU",
+	"ID IE IL IM IN IO IQ IR IS IT",
+	"JE JM JO JP",
+	"KE KG KH KI KM KN KP KR KW KY KZ",
+	"LA LB LC LI LK LR LS LT LU LV LY",
+	"MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ",
+	"NA NC NE NF NG NI NL NO NP NR NU NZ",
+	"OM",
+	"PA PE PF PG PH PK PL PM PN PR PS PT PW PY",
+	"QA",
+	"RE RO RS RU RW",
+	"SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ",
+	"TC TD TF TG TH TJ TK TL TM TN TO TR TT TV TW TZ",
+	"UA UG UM US UY UZ",
+	"VA VC VE VG VI VN VU",
+	"WF WS",
+	"YE YT",
+	"ZA ZM ZW",
+)
+
+// postalCodePatterns は国ごとの郵便番号の形式です。ここにある国では郵便番号を必須とします。
+var postalCodePatterns = map[string]*regexp.Regexp{
+	"AT": regexp.MustCompile(`^[0-9]{4}$`),
+	"AU": regexp.MustCompile(`^[0-9]{4}$`),
+	"BE": regexp.MustCompile(`^[0-9]{4}$`),
+	"BR": regexp.MustCompile(`^[0-9]{5}-?[0-9]{3}$`),
+	"CA": regexp.MustCompile(`^[A-Z][0-9][A-Z] ?[0-9][A-Z][0-9]$`),
+	"CH": regexp.MustCompile(`^[0-9]{4}$`),
+	"CN": regexp.MustCompile(`^[0-9]{6}$`),
+	"DE": regexp.MustCompile(`^[0-9]{5}$`),
+	"DK": regexp.MustCompile(`^[0-9]{4}$`),
+	"ES": regexp.MustCompile(`^[0-9]{5}$`),
+	"FI": regexp.MustCompile(`^[0-9]{5}$`),
+	"FR": regexp.MustCompile(`^[0-9]{5}$`),
+	"GB": regexp.MustCompile(`^[A-Z]{1,2}[0-9][A-Z0-9]? ?[0-9][A-Z]{2}$`),
+	"IE": regexp.MustCompile(`^[A-Z][0-9][0-9W] ?[A-Z0-9]{4}$`),
+	"IN": regexp.MustCompile(`^[1-9][0-9]{5}$`),
+	"IT": regexp.MustCompile(`^[0-9]{5}$`),
+	"JP": regexp.MustCompile(`^[0-9]{3}-?[0-9]{4}$`),
+	"KR": regexp.MustCompile(`^[0-9]{5}$`),
+	"MX": regexp.MustCompile(`^[0-9]{5}$`),
+	"MY": regexp.MustCompile(`^[0-9]{5}$`),
+	"NL": regexp.MustCompile(`^[0-9]{4} ?[A-Z]{2}$`),
+	"NO": regexp.MustCompile(`^[0-9]{4}$`),
+	"NZ": regexp.MustCompile(`^[0-9]{4}$`),
+	"PH": regexp.MustCompile(`^[0-9]{4}$`),
+	"PL": regexp.MustCompile(`^[0-9]{2}-[0-9]{3}$`),
+	"PT": regexp.MustCompile(`^[0-9]{4}-[0-9]{3}$`),
+	"RU": regexp.MustCompile(`^[0-9]{6}$`),
+	"SE": regexp.MustCompile(`^[0-9]{3} ?[0-9]{2}$`),
+	"SG": regexp.MustCompile(`^[0-9]{6}$`),
+	"TH": regexp.MustCompile(`^[0-9]{5}$`),
+	"TW": regexp.MustCompile(`^[0-9]{3}([0-9]{2,3})?$`),
+	"US": regexp.MustCompile(`^[0-9]{5}(-[0-9]{4})?$`),
+	"VN": regexp.MustCompile(`^[0-9]{6}$`),
+	"ZA": regexp.MustCompile(`^[0-9]{4}$`),
+}
+
+// countriesWithoutPostalCode は郵便番号制度のない国です。郵便番号は指定できません。
+var countriesWithoutPostalCode = newCountrySet("AE HK MO QA")
+
+// genericPostalCodePattern は形式を定義していない国の郵便番号に適用する緩い形式です。
+var genericPostalCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{0,9}$`)
+
+func newCountrySet(groups ...string) map[string]struct{} {
+	set := make(map[string]struct{})
+	for _, group := range groups {
+		for _, code := range strings.Fields(group) {
+			set[code] = struct{}{}
+		}
+	}
+	return set
+}
+
+func isValidAddressType(t AddressType) bool {
+	switch t {
+	case AddressTypeBilling, AddressTypeRegistered, AddressTypeOffice:
+		return true
+	default:
+		return false
+	}
+}
+
+func normalizeCountry(raw string) (string, error) {
+	country := strings.ToUpper(strings.TrimSpace(raw))
+	if _, ok := countryCodes[country]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCountry, raw)
+	}
+	return country, nil
+}
+
+// normalizePostalCode は country の形式で郵便番号を検証します。country は正規化済みの国コードです。
+func normalizePostalCode(country, raw string) (string, error) {
+	code := strings.ToUpper(strings.TrimSpace(raw))
+	if _, ok := countriesWithoutPostalCode[country]; ok {
+		if code != "" {
+			return "", fmt.Errorf("%w: %s has no postal codes", ErrInvalidPostalCode, country)
+		}
+		return "", nil
+	}
+	pattern, ok := postalCodePatterns[country]
+	if !ok {
+		if code == "" {
+			return "", nil
+		}
+		pattern = genericPostalCodePattern
+	}
+	if !pattern.MatchString(code) {
+		return "", fmt.Errorf("%w: %q for %s", ErrInvalidPostalCode, raw, country)
+	}
+	return code, nil
+}
+
+func normalizeAddressLines(lines []string) ([]string, error) {
+	if len(lines) == 0 || len(lines) > maxAddressLines {
+		return nil, fmt.Errorf("%w: 1 to %d lines are required", ErrInvalidAddressLines, maxAddressLines)
+	}
+	normalized := make([]string, 0, len(lines))
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		if line == "" || utf8.RuneCountInString(line) > maxAddressLineLength {
+			return nil, fmt.Errorf("%w: lines[%d]", ErrInvalidAddressLines, i)
+		}
+		normalized = append(normalized, line)
+	}
+	return normalized, nil
+}
+
+// normalizePhoneNumber は区切り文字を取り除き、E.164 形式であることを検証します。
+func normalizePhoneNumber(raw string) (string, error) {
+	number := phoneSeparators.Replace(strings.TrimSpace(raw))
+	if !phonePattern.MatchString(number) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidPhoneNumber, raw)
+	}
+	return number, nil
+}
+
+// normalizeWebsite は http(s) の絶対 URL であることを検証します。空文字の場合は nil を返します。
+func normalizeWebsite(raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	website := strings.TrimSpace(*raw)
+	if website == "" {
+		return nil, nil
+	}
+	if len(website) > maxWebsiteLength {
+		return nil, fmt.Errorf("%w: too long", ErrInvalidWebsite)
+	}
+	u, err := url.Parse(website)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidWebsite, website)
+	}
+	return &website, nil
+}
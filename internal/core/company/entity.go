@@ -12,11 +12,87 @@ const (
 
 // Company は会社エンティティです。
 type Company struct {
-	ID          string
-	Name        string
-	Code        string
-	Status      Status
-	Description *string
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID                 string
+	Name               string
+	Code               string
+	Status             Status
+	Description        *string
+	ParentCompanyID    *string
+	EmployeeCodePolicy EmployeeCodePolicy
+	// Labels はグループ分けに利用する key=value のラベルです。
+	Labels map[string]string
+	// Website は会社の Web サイトの URL です。
+	Website *string
+	// Addresses と Phones は別テーブルに保存する連絡先で、取得系のユースケースが設定します。
+	Addresses []*Address
+	Phones    []*Phone
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// AddressType は会社の住所の種別です。
+type AddressType string
+
+const (
+	AddressTypeBilling    AddressType = "billing"
+	AddressTypeRegistered AddressType = "registered"
+	AddressTypeOffice     AddressType = "office"
+)
+
+// Address は会社の住所です。
+type Address struct {
+	ID        string
+	CompanyID string
+	Type      AddressType
+	// Country は ISO 3166-1 alpha-2 の国コードです。
+	Country    string
+	PostalCode string
+	// Lines は都道府県・市区町村・番地・建物名などの住所行です。
+	Lines     []string
+	CreatedAt time.Time
+}
+
+// Phone は会社の電話番号です。
+type Phone struct {
+	ID        string
+	CompanyID string
+	// Number は E.164 形式（+81312345678 など）の電話番号です。
+	Number    string
+	CreatedAt time.Time
+}
+
+// EmployeeCodePolicy は社員コードの自動採番規則です。
+// 社員コードは Prefix に連番を Width 桁でゼロ埋めした文字列を連結したものになります。
+type EmployeeCodePolicy struct {
+	Prefix string
+	// Width は連番をゼロ埋めする桁数です。0 の場合はゼロ埋めしません。
+	Width int
+	// NextSequence は次に払い出す連番です。
+	NextSequence int64
+}
+
+// DefaultEmployeeCodePolicy は会社作成時に採番規則を指定しなかった場合の規則です。
+var DefaultEmployeeCodePolicy = EmployeeCodePolicy{NextSequence: 1}
+
+// AttributeType は社員のカスタム属性の値の型です。
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeNumber AttributeType = "number"
+	AttributeTypeDate   AttributeType = "date"
+	AttributeTypeEnum   AttributeType = "enum"
+)
+
+// EmployeeAttribute は会社ごとに定義する社員のカスタム属性です。
+type EmployeeAttribute struct {
+	CompanyID string
+	Key       string
+	Type      AttributeType
+	// Required の場合、社員の作成時や属性の更新時に値の指定が必要です。
+	Required bool
+	// AllowedValues は enum 型で指定できる値の一覧です。
+	AllowedValues []string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
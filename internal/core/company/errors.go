@@ -17,6 +17,44 @@ var (
 	ErrInvalidID = errors.New("invalid id")
 	// ErrInvalidPageSize は一覧取得時のページサイズが不正な場合に返却されます。
 	ErrInvalidPageSize = errors.New("invalid page size")
+	// ErrParentCompanyNotFound は親会社として指定した会社が存在しない場合に返却されます。
+	ErrParentCompanyNotFound = errors.New("parent company not found")
+	// ErrHierarchyCycle は親会社の指定で会社階層が循環する場合に返却されます。
+	ErrHierarchyCycle = errors.New("company hierarchy cycle")
+	// ErrCompanyHasSubsidiaries は子会社を持つ会社を削除しようとした場合に返却されます。
+	ErrCompanyHasSubsidiaries = errors.New("company has subsidiaries")
 	// ErrInvalidPageToken は一覧取得時のページトークンが不正な場合に返却されます。
 	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrCompanyHasActiveEmployees は在籍中の社員がいる会社を削除しようとした場合に返却されます。
+	ErrCompanyHasActiveEmployees = errors.New("company has active employees")
+	// ErrInvalidEmployeeCodePolicy は社員コードの採番規則が不正な場合に返却されます。
+	ErrInvalidEmployeeCodePolicy = errors.New("invalid employee code policy")
+	// ErrInvalidAttributeKey は社員属性のキーが不正な場合に返却されます。
+	ErrInvalidAttributeKey = errors.New("invalid employee attribute key")
+	// ErrInvalidAttributeType は社員属性の型が不正な場合に返却されます。
+	ErrInvalidAttributeType = errors.New("invalid employee attribute type")
+	// ErrInvalidAllowedValues は社員属性の選択肢が不正な場合に返却されます。
+	ErrInvalidAllowedValues = errors.New("invalid employee attribute allowed values")
+	// ErrAttributeNotFound は社員属性が定義されていない場合に返却されます。
+	ErrAttributeNotFound = errors.New("employee attribute not found")
+	// ErrAttributeAlreadyExists は同じキーの社員属性が定義済みの場合に返却されます。
+	ErrAttributeAlreadyExists = errors.New("employee attribute already exists")
+	// ErrInvalidWebsite は Web サイトの URL が不正な場合に返却されます。
+	ErrInvalidWebsite = errors.New("invalid website")
+	// ErrInvalidAddressType は住所の種別が不正な場合に返却されます。
+	ErrInvalidAddressType = errors.New("invalid address type")
+	// ErrInvalidCountry は国コードが ISO 3166-1 alpha-2 でない場合に返却されます。
+	ErrInvalidCountry = errors.New("invalid country")
+	// ErrInvalidPostalCode は郵便番号が国の形式に合わない場合に返却されます。
+	ErrInvalidPostalCode = errors.New("invalid postal code")
+	// ErrInvalidAddressLines は住所行が不正な場合に返却されます。
+	ErrInvalidAddressLines = errors.New("invalid address lines")
+	// ErrInvalidPhoneNumber は電話番号が E.164 形式でない場合に返却されます。
+	ErrInvalidPhoneNumber = errors.New("invalid phone number")
+	// ErrAddressNotFound は住所が存在しない場合に返却されます。
+	ErrAddressNotFound = errors.New("address not found")
+	// ErrPhoneNotFound は電話番号が存在しない場合に返却されます。
+	ErrPhoneNotFound = errors.New("phone not found")
+	// ErrPhoneAlreadyExists は同じ電話番号が登録済みの場合に返却されます。
+	ErrPhoneAlreadyExists = errors.New("phone already exists")
 )
@@ -1,15 +1,51 @@
 package company
 
-import "context"
+import (
+	"context"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+)
 
 // Repository は会社エンティティの永続化を行うインターフェースです。
 type Repository interface {
 	Create(ctx context.Context, company *Company) (*Company, error)
+	// Update は会社情報を更新します。社員コードの連番は社員の採番と競合しないよう更新しません。
 	Update(ctx context.Context, company *Company) (*Company, error)
+	// SetEmployeeCodeNextSequence は社員コードの次の連番を設定します。
+	SetEmployeeCodeNextSequence(ctx context.Context, id string, next int64) error
 	Delete(ctx context.Context, id string) error
 	FindByID(ctx context.Context, id string) (*Company, error)
 	FindByCode(ctx context.Context, code string) (*Company, error)
 	List(ctx context.Context, filter ListCompaniesFilter) ([]*Company, string, error)
+	// ListSubsidiaries は子会社の一覧を取得します。Recursive の場合は孫会社以下も含めます。
+	ListSubsidiaries(ctx context.Context, filter ListSubsidiariesFilter) ([]*Company, string, error)
+	// ListAncestors は直近の親会社から最上位の会社までを順に返します。
+	ListAncestors(ctx context.Context, id string) ([]*Company, error)
+	// LockHierarchy は親会社の付け替えをトランザクションの終了まで直列化します。
+	LockHierarchy(ctx context.Context) error
+	// CreateEmployeeAttribute は社員属性の定義を追加します。
+	CreateEmployeeAttribute(ctx context.Context, attribute *EmployeeAttribute) (*EmployeeAttribute, error)
+	// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+	UpdateEmployeeAttribute(ctx context.Context, attribute *EmployeeAttribute) (*EmployeeAttribute, error)
+	// DeleteEmployeeAttribute は社員属性の定義を削除し、会社の社員からその属性の値を取り除きます。
+	DeleteEmployeeAttribute(ctx context.Context, companyID, key string) error
+	// FindEmployeeAttribute は会社の社員属性の定義をキーで取得します。
+	FindEmployeeAttribute(ctx context.Context, companyID, key string) (*EmployeeAttribute, error)
+	// ListEmployeeAttributes は会社の社員属性の定義をキーの昇順で返します。
+	ListEmployeeAttributes(ctx context.Context, companyID string) ([]*EmployeeAttribute, error)
+	// AddAddress は会社に住所を追加します。
+	AddAddress(ctx context.Context, address *Address) (*Address, error)
+	// DeleteAddress は会社の住所を削除します。
+	DeleteAddress(ctx context.Context, companyID, id string) error
+	// ListAddresses は指定した会社の住所を会社ごとに登録順で返します。
+	ListAddresses(ctx context.Context, companyIDs []string) ([]*Address, error)
+	// AddPhone は会社に電話番号を追加します。
+	AddPhone(ctx context.Context, phone *Phone) (*Phone, error)
+	// DeletePhone は会社の電話番号を削除します。
+	DeletePhone(ctx context.Context, companyID, id string) error
+	// ListPhones は指定した会社の電話番号を会社ごとに登録順で返します。
+	ListPhones(ctx context.Context, companyIDs []string) ([]*Phone, error)
 }
 
 // ListCompaniesFilter は一覧取得時の検索条件を表します。
@@ -17,4 +53,31 @@ type ListCompaniesFilter struct {
 	Limit  int
 	Offset int
 	Status *Status
+	// LabelSelector を指定した場合はラベルが一致する会社に絞り込みます。
+	LabelSelector label.Selector
+}
+
+// ListSubsidiariesFilter は子会社一覧取得時の検索条件を表します。
+type ListSubsidiariesFilter struct {
+	ParentID  string
+	Recursive bool
+	Limit     int
+	Offset    int
+	Status    *Status
+}
+
+// EmployeeCounts は会社に所属する社員数です。
+type EmployeeCounts struct {
+	// Total は退職済みを含む社員数です。
+	Total int
+	// Active は退職していない社員数です。
+	Active int
+}
+
+// Employees は会社に所属する社員を扱うポートです。実装は社員ドメインが提供します。
+type Employees interface {
+	// CountByCompany は会社に所属する社員数を返します。
+	CountByCompany(ctx context.Context, companyID string) (EmployeeCounts, error)
+	// TerminateByCompany は退職していない社員に退職日 terminatedAt を設定します。
+	TerminateByCompany(ctx context.Context, companyID string, terminatedAt time.Time) error
 }
@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
 )
 
 // Clock は現在時刻を提供します。
@@ -50,11 +52,32 @@ const (
 
 var codePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
 
+// attributeKeyPattern は社員属性のキーの形式です。
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// maxAttributeKeyLength は社員属性のキーの最大長です。
+const maxAttributeKeyLength = 64
+
+// maxEmployeeCodeWidth は社員コードの連番のゼロ埋め桁数の上限です（int64 の桁数に合わせます）。
+const maxEmployeeCodeWidth = 18
+
 // Service は会社に関するユースケースをまとめます。
 type Service struct {
-	repo  Repository
-	clock Clock
-	tx    TransactionManager
+	repo      Repository
+	clock     Clock
+	tx        TransactionManager
+	employees Employees
+}
+
+// Option は Service の任意設定です。
+type Option func(*Service)
+
+// WithEmployees は会社の無効化・削除時に参照する社員のポートを設定します。
+// 未設定の場合、社員の確認や連動は行いません。
+func WithEmployees(employees Employees) Option {
+	return func(s *Service) {
+		s.employees = employees
+	}
 }
 
 // UseCase は会社ユースケースの公開インターフェースです。
@@ -63,39 +86,82 @@ type UseCase interface {
 	GetCompany(ctx context.Context, in GetCompanyInput) (*Company, error)
 	ListCompanies(ctx context.Context, in ListCompaniesInput) (*ListCompaniesResult, error)
 	UpdateCompany(ctx context.Context, in UpdateCompanyInput) (*Company, error)
-	DeleteCompany(ctx context.Context, in DeleteCompanyInput) error
+	DeleteCompany(ctx context.Context, in DeleteCompanyInput) (*DeleteCompanyResult, error)
+	ListSubsidiaries(ctx context.Context, in ListSubsidiariesInput) (*ListCompaniesResult, error)
+	GetCompanyAncestry(ctx context.Context, in GetCompanyAncestryInput) ([]*Company, error)
+	CreateEmployeeAttribute(ctx context.Context, in CreateEmployeeAttributeInput) (*EmployeeAttribute, error)
+	UpdateEmployeeAttribute(ctx context.Context, in UpdateEmployeeAttributeInput) (*EmployeeAttribute, error)
+	DeleteEmployeeAttribute(ctx context.Context, in DeleteEmployeeAttributeInput) error
+	ListEmployeeAttributes(ctx context.Context, in ListEmployeeAttributesInput) ([]*EmployeeAttribute, error)
+	AddCompanyAddress(ctx context.Context, in AddCompanyAddressInput) (*Company, error)
+	RemoveCompanyAddress(ctx context.Context, in RemoveCompanyAddressInput) (*Company, error)
+	AddCompanyPhone(ctx context.Context, in AddCompanyPhoneInput) (*Company, error)
+	RemoveCompanyPhone(ctx context.Context, in RemoveCompanyPhoneInput) (*Company, error)
 }
 
 // NewService は Service を生成します。
-func NewService(repo Repository, clock Clock, tx TransactionManager) *Service {
+func NewService(repo Repository, clock Clock, tx TransactionManager, opts ...Option) *Service {
 	if clock == nil {
 		clock = realClock{}
 	}
 	if tx == nil {
 		tx = noopTransactionManager{}
 	}
-	return &Service{repo: repo, clock: clock, tx: tx}
+	s := &Service{repo: repo, clock: clock, tx: tx}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateCompanyInput は会社作成時の入力です。
 type CreateCompanyInput struct {
-	Name        string
-	Code        string
-	Description *string
+	Name            string
+	Code            string
+	Description     *string
+	ParentCompanyID *string
+	// EmployeeCodePolicy は社員コードの採番規則です。未指定の場合は DefaultEmployeeCodePolicy を利用します。
+	EmployeeCodePolicy *EmployeeCodePolicy
+	// Labels は会社に付与するラベルです。
+	Labels map[string]string
+	// Website は http(s) の絶対 URL です。
+	Website *string
 }
 
 // UpdateCompanyInput は会社更新時の入力です。
+// ParentCompanyID に空文字を指定すると親会社との関連を解除します。
 type UpdateCompanyInput struct {
-	ID          string
-	Name        *string
-	Code        *string
-	Status      *Status
-	Description *string
+	ID              string
+	Name            *string
+	Code            *string
+	Status          *Status
+	Description     *string
+	ParentCompanyID *string
+	// EmployeeCodePolicy は社員コードの採番規則です。NextSequence が 0 の場合は現在の連番を維持します。
+	EmployeeCodePolicy *EmployeeCodePolicy
+	// CascadeEmployees を指定して inactive へ変更すると、在籍中の社員に退職日を設定します。既に inactive の会社では何もしません。
+	CascadeEmployees bool
+	// EmployeesTerminatedAt は CascadeEmployees 指定時の退職日です。未指定の場合は本日とします。
+	EmployeesTerminatedAt *time.Time
+	// Labels は指定したキーのラベルを追加・上書きします。
+	Labels map[string]string
+	// RemoveLabels は指定したキーのラベルを削除します。
+	RemoveLabels []string
+	// Website に空文字を指定すると Web サイトを削除します。
+	Website *string
 }
 
 // DeleteCompanyInput は会社削除時の入力です。
 type DeleteCompanyInput struct {
 	ID string
+	// Force を指定すると、在籍中の社員がいても社員ごと削除します。
+	Force bool
+}
+
+// DeleteCompanyResult は会社削除の結果です。
+type DeleteCompanyResult struct {
+	// DeletedEmployees は会社と合わせて削除した社員数です。
+	DeletedEmployees int
 }
 
 // GetCompanyInput は会社取得時の入力です。
@@ -108,6 +174,79 @@ type ListCompaniesInput struct {
 	PageSize  int
 	PageToken string
 	Status    *Status
+	// LabelSelector は "region=apac,tier!=free" 形式のラベルセレクタです。
+	LabelSelector string
+}
+
+// ListSubsidiariesInput は子会社一覧取得時の入力です。
+type ListSubsidiariesInput struct {
+	CompanyID string
+	Recursive bool
+	PageSize  int
+	PageToken string
+	Status    *Status
+}
+
+// GetCompanyAncestryInput は親会社の系譜取得時の入力です。
+type GetCompanyAncestryInput struct {
+	ID string
+}
+
+// CreateEmployeeAttributeInput は社員属性の定義追加時の入力です。
+// AllowedValues は Type が enum の場合のみ指定します。
+type CreateEmployeeAttributeInput struct {
+	CompanyID     string
+	Key           string
+	Type          AttributeType
+	Required      bool
+	AllowedValues []string
+}
+
+// UpdateEmployeeAttributeInput は社員属性の定義更新時の入力です。
+// 型は変更できません。Required と AllowedValues は指定した値で置き換えます。
+type UpdateEmployeeAttributeInput struct {
+	CompanyID     string
+	Key           string
+	Required      bool
+	AllowedValues []string
+}
+
+// DeleteEmployeeAttributeInput は社員属性の定義削除時の入力です。
+type DeleteEmployeeAttributeInput struct {
+	CompanyID string
+	Key       string
+}
+
+// ListEmployeeAttributesInput は社員属性の定義一覧取得時の入力です。
+type ListEmployeeAttributesInput struct {
+	CompanyID string
+}
+
+// AddCompanyAddressInput は住所追加時の入力です。
+type AddCompanyAddressInput struct {
+	CompanyID  string
+	Type       AddressType
+	Country    string
+	PostalCode string
+	Lines      []string
+}
+
+// RemoveCompanyAddressInput は住所削除時の入力です。
+type RemoveCompanyAddressInput struct {
+	CompanyID string
+	AddressID string
+}
+
+// AddCompanyPhoneInput は電話番号追加時の入力です。
+type AddCompanyPhoneInput struct {
+	CompanyID string
+	Number    string
+}
+
+// RemoveCompanyPhoneInput は電話番号削除時の入力です。
+type RemoveCompanyPhoneInput struct {
+	CompanyID string
+	PhoneID   string
 }
 
 // ListCompaniesResult は一覧取得結果を表します。
@@ -129,6 +268,24 @@ func (s *Service) CreateCompany(ctx context.Context, in CreateCompanyInput) (*Co
 	}
 
 	description := normalizeDescription(in.Description)
+	parentID := normalizeParentID(in.ParentCompanyID)
+
+	policy := DefaultEmployeeCodePolicy
+	if in.EmployeeCodePolicy != nil {
+		policy, err = normalizeEmployeeCodePolicy(*in.EmployeeCodePolicy, DefaultEmployeeCodePolicy.NextSequence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := label.Validate(in.Labels); err != nil {
+		return nil, err
+	}
+
+	website, err := normalizeWebsite(in.Website)
+	if err != nil {
+		return nil, err
+	}
 
 	var created *Company
 	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
@@ -136,14 +293,24 @@ func (s *Service) CreateCompany(ctx context.Context, in CreateCompanyInput) (*Co
 			return err
 		}
 
+		if parentID != nil {
+			if err := s.ensureParentExists(txCtx, *parentID); err != nil {
+				return err
+			}
+		}
+
 		now := s.clock.Now()
 		company := &Company{
-			Name:        name,
-			Code:        code,
-			Status:      StatusActive,
-			Description: description,
-			CreatedAt:   now,
-			UpdatedAt:   now,
+			Name:               name,
+			Code:               code,
+			Status:             StatusActive,
+			Description:        description,
+			ParentCompanyID:    parentID,
+			EmployeeCodePolicy: policy,
+			Labels:             label.Clone(in.Labels),
+			Website:            website,
+			CreatedAt:          now,
+			UpdatedAt:          now,
 		}
 
 		result, err := s.repo.Create(txCtx, company)
@@ -198,6 +365,15 @@ func (s *Service) UpdateCompany(ctx context.Context, in UpdateCompanyInput) (*Co
 			if !isValidStatus(*in.Status) {
 				return ErrInvalidStatus
 			}
+			if *in.Status == StatusInactive && existing.Status != StatusInactive && in.CascadeEmployees && s.employees != nil {
+				terminatedAt := s.clock.Now()
+				if in.EmployeesTerminatedAt != nil {
+					terminatedAt = *in.EmployeesTerminatedAt
+				}
+				if err := s.employees.TerminateByCompany(txCtx, existing.ID, terminatedAt); err != nil {
+					return err
+				}
+			}
 			existing.Status = *in.Status
 		}
 
@@ -205,12 +381,55 @@ func (s *Service) UpdateCompany(ctx context.Context, in UpdateCompanyInput) (*Co
 			existing.Description = normalizeDescription(in.Description)
 		}
 
+		if in.ParentCompanyID != nil {
+			parentID := normalizeParentID(in.ParentCompanyID)
+			if parentID != nil {
+				if err := s.ensureNoCycle(txCtx, existing.ID, *parentID); err != nil {
+					return err
+				}
+			}
+			existing.ParentCompanyID = parentID
+		}
+
+		if in.EmployeeCodePolicy != nil {
+			policy, err := normalizeEmployeeCodePolicy(*in.EmployeeCodePolicy, existing.EmployeeCodePolicy.NextSequence)
+			if err != nil {
+				return err
+			}
+			existing.EmployeeCodePolicy = policy
+			// 連番は社員の採番で進むため、明示的に指定された場合のみ専用の更新で設定します。
+			if in.EmployeeCodePolicy.NextSequence != 0 {
+				if err := s.repo.SetEmployeeCodeNextSequence(txCtx, existing.ID, policy.NextSequence); err != nil {
+					return err
+				}
+			}
+		}
+
+		if len(in.Labels) > 0 || len(in.RemoveLabels) > 0 {
+			labels, err := label.Apply(existing.Labels, in.Labels, in.RemoveLabels)
+			if err != nil {
+				return err
+			}
+			existing.Labels = labels
+		}
+
+		if in.Website != nil {
+			website, err := normalizeWebsite(in.Website)
+			if err != nil {
+				return err
+			}
+			existing.Website = website
+		}
+
 		existing.UpdatedAt = s.clock.Now()
 
 		result, err := s.repo.Update(txCtx, existing)
 		if err != nil {
 			return err
 		}
+		if err := s.loadContacts(txCtx, result); err != nil {
+			return err
+		}
 
 		updated = result
 		return nil
@@ -221,15 +440,34 @@ func (s *Service) UpdateCompany(ctx context.Context, in UpdateCompanyInput) (*Co
 	return updated, nil
 }
 
-// DeleteCompany は会社を削除します。
-func (s *Service) DeleteCompany(ctx context.Context, in DeleteCompanyInput) error {
+// DeleteCompany は会社を削除します。所属する社員は会社と合わせて削除されます。
+// 在籍中の社員がいる場合は ErrCompanyHasActiveEmployees を返し、Force 指定時のみ削除します。
+func (s *Service) DeleteCompany(ctx context.Context, in DeleteCompanyInput) (*DeleteCompanyResult, error) {
 	if strings.TrimSpace(in.ID) == "" {
-		return fmt.Errorf("id: %w", ErrInvalidID)
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
 	}
 
-	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+	result := &DeleteCompanyResult{}
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if s.employees != nil {
+			if _, err := s.repo.FindByID(txCtx, in.ID); err != nil {
+				return err
+			}
+			counts, err := s.employees.CountByCompany(txCtx, in.ID)
+			if err != nil {
+				return err
+			}
+			if counts.Active > 0 && !in.Force {
+				return fmt.Errorf("%w: %d active employees", ErrCompanyHasActiveEmployees, counts.Active)
+			}
+			result.DeletedEmployees = counts.Total
+		}
 		return s.repo.Delete(txCtx, in.ID)
-	})
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
 }
 
 // GetCompany は ID で会社を取得します。
@@ -244,6 +482,9 @@ func (s *Service) GetCompany(ctx context.Context, in GetCompanyInput) (*Company,
 		if err != nil {
 			return err
 		}
+		if err := s.loadContacts(txCtx, result); err != nil {
+			return err
+		}
 		company = result
 		return nil
 	}); err != nil {
@@ -274,6 +515,11 @@ func (s *Service) ListCompanies(ctx context.Context, in ListCompaniesInput) (*Li
 		statusPtr = &status
 	}
 
+	selector, err := label.ParseSelector(in.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		companies []*Company
 		nextToken string
@@ -281,13 +527,17 @@ func (s *Service) ListCompanies(ctx context.Context, in ListCompaniesInput) (*Li
 
 	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
 		resultCompanies, token, err := s.repo.List(txCtx, ListCompaniesFilter{
-			Limit:  limit,
-			Offset: offset,
-			Status: statusPtr,
+			Limit:         limit,
+			Offset:        offset,
+			Status:        statusPtr,
+			LabelSelector: selector,
 		})
 		if err != nil {
 			return err
 		}
+		if err := s.loadContacts(txCtx, resultCompanies...); err != nil {
+			return err
+		}
 		companies = resultCompanies
 		nextToken = token
 		return nil
@@ -301,6 +551,391 @@ func (s *Service) ListCompanies(ctx context.Context, in ListCompaniesInput) (*Li
 	}, nil
 }
 
+// ListSubsidiaries は子会社の一覧を取得します。Recursive の場合は階層全体を対象にします。
+func (s *Service) ListSubsidiaries(ctx context.Context, in ListSubsidiariesInput) (*ListCompaniesResult, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusPtr *Status
+	if in.Status != nil {
+		if !isValidStatus(*in.Status) {
+			return nil, ErrInvalidStatus
+		}
+		status := *in.Status
+		statusPtr = &status
+	}
+
+	result := &ListCompaniesResult{}
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.CompanyID); err != nil {
+			return err
+		}
+
+		companies, token, err := s.repo.ListSubsidiaries(txCtx, ListSubsidiariesFilter{
+			ParentID:  in.CompanyID,
+			Recursive: in.Recursive,
+			Limit:     limit,
+			Offset:    offset,
+			Status:    statusPtr,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.loadContacts(txCtx, companies...); err != nil {
+			return err
+		}
+		result.Companies = companies
+		result.NextPageToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// GetCompanyAncestry は直近の親会社から最上位の会社までを順に返します。
+func (s *Service) GetCompanyAncestry(ctx context.Context, in GetCompanyAncestryInput) ([]*Company, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	var ancestors []*Company
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.ID); err != nil {
+			return err
+		}
+
+		result, err := s.repo.ListAncestors(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.loadContacts(txCtx, result...); err != nil {
+			return err
+		}
+		ancestors = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return ancestors, nil
+}
+
+// CreateEmployeeAttribute は会社に社員属性の定義を追加します。
+// 既存の社員には値を設定しないため、必須の属性は社員の属性を次に更新する際に指定が必要になります。
+func (s *Service) CreateEmployeeAttribute(ctx context.Context, in CreateEmployeeAttributeInput) (*EmployeeAttribute, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	key, err := normalizeAttributeKey(in.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidAttributeType(in.Type) {
+		return nil, ErrInvalidAttributeType
+	}
+	allowed, err := normalizeAllowedValues(in.Type, in.AllowedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *EmployeeAttribute
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.CompanyID); err != nil {
+			return err
+		}
+		if _, err := s.repo.FindEmployeeAttribute(txCtx, in.CompanyID, key); err == nil {
+			return ErrAttributeAlreadyExists
+		} else if !errors.Is(err, ErrAttributeNotFound) {
+			return err
+		}
+
+		now := s.clock.Now()
+		result, err := s.repo.CreateEmployeeAttribute(txCtx, &EmployeeAttribute{
+			CompanyID:     in.CompanyID,
+			Key:           key,
+			Type:          in.Type,
+			Required:      in.Required,
+			AllowedValues: allowed,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+		if err != nil {
+			return err
+		}
+		created = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateEmployeeAttribute は社員属性の必須指定と選択肢を更新します。
+// 選択肢から外した値を持つ社員の値はそのまま残ります。
+func (s *Service) UpdateEmployeeAttribute(ctx context.Context, in UpdateEmployeeAttributeInput) (*EmployeeAttribute, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	key, err := normalizeAttributeKey(in.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *EmployeeAttribute
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		existing, err := s.repo.FindEmployeeAttribute(txCtx, in.CompanyID, key)
+		if err != nil {
+			return err
+		}
+		allowed, err := normalizeAllowedValues(existing.Type, in.AllowedValues)
+		if err != nil {
+			return err
+		}
+
+		existing.Required = in.Required
+		existing.AllowedValues = allowed
+		existing.UpdatedAt = s.clock.Now()
+
+		result, err := s.repo.UpdateEmployeeAttribute(txCtx, existing)
+		if err != nil {
+			return err
+		}
+		updated = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteEmployeeAttribute は社員属性の定義を削除します。会社の社員が持つその属性の値も削除します。
+func (s *Service) DeleteEmployeeAttribute(ctx context.Context, in DeleteEmployeeAttributeInput) error {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	key, err := normalizeAttributeKey(in.Key)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		return s.repo.DeleteEmployeeAttribute(txCtx, in.CompanyID, key)
+	})
+}
+
+// ListEmployeeAttributes は会社の社員属性の定義をキーの昇順で返します。
+func (s *Service) ListEmployeeAttributes(ctx context.Context, in ListEmployeeAttributesInput) ([]*EmployeeAttribute, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+
+	var attributes []*EmployeeAttribute
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.CompanyID); err != nil {
+			return err
+		}
+		result, err := s.repo.ListEmployeeAttributes(txCtx, in.CompanyID)
+		if err != nil {
+			return err
+		}
+		attributes = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return attributes, nil
+}
+
+// AddCompanyAddress は会社に住所を追加し、更新後の会社を返します。
+func (s *Service) AddCompanyAddress(ctx context.Context, in AddCompanyAddressInput) (*Company, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	if !isValidAddressType(in.Type) {
+		return nil, ErrInvalidAddressType
+	}
+	country, err := normalizeCountry(in.Country)
+	if err != nil {
+		return nil, err
+	}
+	postalCode, err := normalizePostalCode(country, in.PostalCode)
+	if err != nil {
+		return nil, err
+	}
+	lines, err := normalizeAddressLines(in.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.modifyContacts(ctx, in.CompanyID, func(txCtx context.Context) error {
+		_, err := s.repo.AddAddress(txCtx, &Address{
+			CompanyID:  in.CompanyID,
+			Type:       in.Type,
+			Country:    country,
+			PostalCode: postalCode,
+			Lines:      lines,
+			CreatedAt:  s.clock.Now(),
+		})
+		return err
+	})
+}
+
+// RemoveCompanyAddress は会社の住所を削除し、更新後の会社を返します。
+func (s *Service) RemoveCompanyAddress(ctx context.Context, in RemoveCompanyAddressInput) (*Company, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	if strings.TrimSpace(in.AddressID) == "" {
+		return nil, fmt.Errorf("address_id: %w", ErrInvalidID)
+	}
+
+	return s.modifyContacts(ctx, in.CompanyID, func(txCtx context.Context) error {
+		return s.repo.DeleteAddress(txCtx, in.CompanyID, in.AddressID)
+	})
+}
+
+// AddCompanyPhone は会社に電話番号を追加し、更新後の会社を返します。
+func (s *Service) AddCompanyPhone(ctx context.Context, in AddCompanyPhoneInput) (*Company, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	number, err := normalizePhoneNumber(in.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.modifyContacts(ctx, in.CompanyID, func(txCtx context.Context) error {
+		_, err := s.repo.AddPhone(txCtx, &Phone{
+			CompanyID: in.CompanyID,
+			Number:    number,
+			CreatedAt: s.clock.Now(),
+		})
+		return err
+	})
+}
+
+// RemoveCompanyPhone は会社の電話番号を削除し、更新後の会社を返します。
+func (s *Service) RemoveCompanyPhone(ctx context.Context, in RemoveCompanyPhoneInput) (*Company, error) {
+	if strings.TrimSpace(in.CompanyID) == "" {
+		return nil, fmt.Errorf("company_id: %w", ErrInvalidID)
+	}
+	if strings.TrimSpace(in.PhoneID) == "" {
+		return nil, fmt.Errorf("phone_id: %w", ErrInvalidID)
+	}
+
+	return s.modifyContacts(ctx, in.CompanyID, func(txCtx context.Context) error {
+		return s.repo.DeletePhone(txCtx, in.CompanyID, in.PhoneID)
+	})
+}
+
+// modifyContacts は会社の存在を確認してから連絡先を変更し、連絡先を含む会社を返します。
+func (s *Service) modifyContacts(ctx context.Context, companyID string, fn func(context.Context) error) (*Company, error) {
+	var company *Company
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		found, err := s.repo.FindByID(txCtx, companyID)
+		if err != nil {
+			return err
+		}
+		if err := fn(txCtx); err != nil {
+			return err
+		}
+		if err := s.loadContacts(txCtx, found); err != nil {
+			return err
+		}
+		company = found
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return company, nil
+}
+
+// loadContacts は companies に住所と電話番号を設定します。
+func (s *Service) loadContacts(ctx context.Context, companies ...*Company) error {
+	if len(companies) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(companies))
+	byID := make(map[string]*Company, len(companies))
+	for _, c := range companies {
+		ids = append(ids, c.ID)
+		byID[c.ID] = c
+	}
+
+	addresses, err := s.repo.ListAddresses(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, a := range addresses {
+		if c, ok := byID[a.CompanyID]; ok {
+			c.Addresses = append(c.Addresses, a)
+		}
+	}
+
+	phones, err := s.repo.ListPhones(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, p := range phones {
+		if c, ok := byID[p.CompanyID]; ok {
+			c.Phones = append(c.Phones, p)
+		}
+	}
+	return nil
+}
+
+func (s *Service) ensureParentExists(ctx context.Context, parentID string) error {
+	if _, err := s.repo.FindByID(ctx, parentID); err != nil {
+		if errors.Is(err, ErrCompanyNotFound) {
+			return ErrParentCompanyNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ensureNoCycle は id の親会社を parentID にした場合に階層が循環しないことを確認します。
+// 同時に行われた付け替えで循環しないよう、確認の前に階層のロックを取得します。
+func (s *Service) ensureNoCycle(ctx context.Context, id, parentID string) error {
+	if id == parentID {
+		return ErrHierarchyCycle
+	}
+	if err := s.repo.LockHierarchy(ctx); err != nil {
+		return err
+	}
+	if err := s.ensureParentExists(ctx, parentID); err != nil {
+		return err
+	}
+
+	ancestors, err := s.repo.ListAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == id {
+			return ErrHierarchyCycle
+		}
+	}
+	return nil
+}
+
 func (s *Service) ensureCodeNotExists(ctx context.Context, code string) error {
 	company, err := s.repo.FindByCode(ctx, code)
 	if err != nil && !errors.Is(err, ErrCompanyNotFound) {
@@ -320,6 +955,67 @@ func normalizeName(raw string) (string, error) {
 	return trimmed, nil
 }
 
+// normalizeEmployeeCodePolicy は採番規則を検証して正規化します。NextSequence が 0 の場合は defaultNext を利用します。
+func normalizeEmployeeCodePolicy(policy EmployeeCodePolicy, defaultNext int64) (EmployeeCodePolicy, error) {
+	prefix := strings.ToLower(strings.TrimSpace(policy.Prefix))
+	if prefix != "" && !codePattern.MatchString(prefix) {
+		return EmployeeCodePolicy{}, fmt.Errorf("prefix: %w", ErrInvalidEmployeeCodePolicy)
+	}
+	if policy.Width < 0 || policy.Width > maxEmployeeCodeWidth {
+		return EmployeeCodePolicy{}, fmt.Errorf("width: %w", ErrInvalidEmployeeCodePolicy)
+	}
+	next := policy.NextSequence
+	if next == 0 {
+		next = defaultNext
+	}
+	if next < 1 {
+		return EmployeeCodePolicy{}, fmt.Errorf("next sequence: %w", ErrInvalidEmployeeCodePolicy)
+	}
+	return EmployeeCodePolicy{Prefix: prefix, Width: policy.Width, NextSequence: next}, nil
+}
+
+func normalizeAttributeKey(raw string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(raw))
+	if key == "" || len(key) > maxAttributeKeyLength || !attributeKeyPattern.MatchString(key) {
+		return "", ErrInvalidAttributeKey
+	}
+	return key, nil
+}
+
+func isValidAttributeType(t AttributeType) bool {
+	switch t {
+	case AttributeTypeString, AttributeTypeNumber, AttributeTypeDate, AttributeTypeEnum:
+		return true
+	default:
+		return false
+	}
+}
+
+// normalizeAllowedValues は選択肢の前後の空白を除去し、enum 型では 1 件以上の重複しない値を要求します。
+func normalizeAllowedValues(t AttributeType, values []string) ([]string, error) {
+	if t != AttributeTypeEnum {
+		if len(values) > 0 {
+			return nil, ErrInvalidAllowedValues
+		}
+		return nil, nil
+	}
+	if len(values) == 0 {
+		return nil, ErrInvalidAllowedValues
+	}
+
+	normalized := make([]string, 0, len(values))
+	seen := make(map[string]bool, len(values))
+	for _, v := range values {
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" || seen[trimmed] {
+			return nil, ErrInvalidAllowedValues
+		}
+		seen[trimmed] = true
+		normalized = append(normalized, trimmed)
+	}
+	return normalized, nil
+}
+
 func normalizeCode(raw string) (string, error) {
 	trimmed := strings.TrimSpace(raw)
 	if trimmed == "" {
@@ -348,6 +1044,19 @@ func normalizeDescription(raw *string) *string {
 	return &desc
 }
 
+func normalizeParentID(raw *string) *string {
+	if raw == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*raw)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
+
 func isValidStatus(status Status) bool {
 	switch status {
 	case StatusActive, StatusInactive:
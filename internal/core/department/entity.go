@@ -0,0 +1,14 @@
+package department
+
+import "time"
+
+// Department は会社内の部署エンティティです。ParentDepartmentID が nil の部署は会社直下の部署です。
+type Department struct {
+	ID                 string
+	CompanyID          string
+	ParentDepartmentID *string
+	Name               string
+	Code               string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
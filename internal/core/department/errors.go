@@ -0,0 +1,19 @@
+package department
+
+import "errors"
+
+var (
+	ErrInvalidID                = errors.New("department: invalid id")
+	ErrInvalidCompanyID         = errors.New("department: invalid company id")
+	ErrInvalidName              = errors.New("department: invalid name")
+	ErrInvalidCode              = errors.New("department: invalid code")
+	ErrInvalidPageSize          = errors.New("department: invalid page size")
+	ErrInvalidPageToken         = errors.New("department: invalid page token")
+	ErrDepartmentNotFound       = errors.New("department: not found")
+	ErrCompanyNotFound          = errors.New("department: company not found")
+	ErrParentDepartmentNotFound = errors.New("department: parent department not found in company")
+	ErrCodeAlreadyExists        = errors.New("department: code already exists")
+	ErrHierarchyCycle           = errors.New("department: hierarchy cycle")
+	ErrDepartmentHasChildren    = errors.New("department: department has child departments")
+	ErrDepartmentHasEmployees   = errors.New("department: department has employees")
+)
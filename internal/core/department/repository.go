@@ -0,0 +1,26 @@
+package department
+
+import "context"
+
+// Repository は部署永続化の抽象です。
+type Repository interface {
+	Create(ctx context.Context, department *Department) (*Department, error)
+	Update(ctx context.Context, department *Department) (*Department, error)
+	Delete(ctx context.Context, id string) error
+	FindByID(ctx context.Context, id string) (*Department, error)
+	FindByCompanyAndCode(ctx context.Context, companyID, code string) (*Department, error)
+	List(ctx context.Context, filter ListDepartmentsFilter) ([]*Department, string, error)
+	// ListAncestors は直近の親部署から最上位の部署までを順に返します。
+	ListAncestors(ctx context.Context, id string) ([]*Department, error)
+	// LockHierarchy は親部署の付け替えをトランザクションの終了まで直列化します。
+	LockHierarchy(ctx context.Context) error
+}
+
+// ListDepartmentsFilter は一覧取得用フィルタです。
+// ParentID を指定するとその部署の直下の部署のみを対象にします。
+type ListDepartmentsFilter struct {
+	CompanyID string
+	ParentID  *string
+	Limit     int
+	Offset    int
+}
@@ -0,0 +1,458 @@
+package department
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Clock は現在時刻を提供します。
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// TransactionManager はトランザクション制御の抽象化です。
+type TransactionManager interface {
+	WithinReadOnly(ctx context.Context, fn func(context.Context) error) error
+	WithinReadWrite(ctx context.Context, fn func(context.Context) error) error
+}
+
+type noopTransactionManager struct{}
+
+func (noopTransactionManager) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx)
+}
+
+func (noopTransactionManager) WithinReadWrite(ctx context.Context, fn func(context.Context) error) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx)
+}
+
+const (
+	defaultListPageSize = 50
+	maxListPageSize     = 200
+)
+
+var codePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// Service は部署に関するユースケースをまとめます。
+type Service struct {
+	repo  Repository
+	clock Clock
+	tx    TransactionManager
+}
+
+// UseCase は部署ユースケースの公開インターフェースです。
+type UseCase interface {
+	CreateDepartment(ctx context.Context, in CreateDepartmentInput) (*Department, error)
+	GetDepartment(ctx context.Context, in GetDepartmentInput) (*Department, error)
+	ListDepartments(ctx context.Context, in ListDepartmentsInput) (*ListDepartmentsResult, error)
+	UpdateDepartment(ctx context.Context, in UpdateDepartmentInput) (*Department, error)
+	MoveDepartment(ctx context.Context, in MoveDepartmentInput) (*Department, error)
+	DeleteDepartment(ctx context.Context, in DeleteDepartmentInput) error
+}
+
+// NewService は Service を生成します。
+func NewService(repo Repository, clock Clock, tx TransactionManager) *Service {
+	if clock == nil {
+		clock = realClock{}
+	}
+	if tx == nil {
+		tx = noopTransactionManager{}
+	}
+	return &Service{repo: repo, clock: clock, tx: tx}
+}
+
+// CreateDepartmentInput は部署作成時の入力です。
+type CreateDepartmentInput struct {
+	CompanyID          string
+	Name               string
+	Code               string
+	ParentDepartmentID *string
+}
+
+// UpdateDepartmentInput は部署更新時の入力です。親部署の変更は MoveDepartment を利用します。
+type UpdateDepartmentInput struct {
+	ID   string
+	Name *string
+	Code *string
+}
+
+// MoveDepartmentInput は部署移動時の入力です。ParentDepartmentID が nil または空文字の場合は会社直下へ移動します。
+type MoveDepartmentInput struct {
+	ID                 string
+	ParentDepartmentID *string
+}
+
+// DeleteDepartmentInput は部署削除時の入力です。
+type DeleteDepartmentInput struct {
+	ID string
+}
+
+// GetDepartmentInput は部署取得時の入力です。
+type GetDepartmentInput struct {
+	ID string
+}
+
+// ListDepartmentsInput は一覧取得時の入力です。
+type ListDepartmentsInput struct {
+	CompanyID          string
+	ParentDepartmentID *string
+	PageSize           int
+	PageToken          string
+}
+
+// ListDepartmentsResult は一覧取得結果を表します。
+type ListDepartmentsResult struct {
+	Departments   []*Department
+	NextPageToken string
+}
+
+// CreateDepartment は新しい部署を作成します。
+func (s *Service) CreateDepartment(ctx context.Context, in CreateDepartmentInput) (*Department, error) {
+	companyID, err := normalizeCompanyID(in.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := normalizeName(in.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := normalizeCode(in.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	parentID := normalizeParentID(in.ParentDepartmentID)
+
+	var created *Department
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if err := s.ensureCodeNotExists(txCtx, companyID, code); err != nil {
+			return err
+		}
+
+		if parentID != nil {
+			if err := s.ensureParentInCompany(txCtx, companyID, *parentID); err != nil {
+				return err
+			}
+		}
+
+		now := s.clock.Now()
+		department := &Department{
+			CompanyID:          companyID,
+			ParentDepartmentID: parentID,
+			Name:               name,
+			Code:               code,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		}
+
+		result, err := s.repo.Create(txCtx, department)
+		if err != nil {
+			return err
+		}
+
+		created = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateDepartment は部署の名称・コードを更新します。
+func (s *Service) UpdateDepartment(ctx context.Context, in UpdateDepartmentInput) (*Department, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	var updated *Department
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		existing, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		if in.Name != nil {
+			name, err := normalizeName(*in.Name)
+			if err != nil {
+				return err
+			}
+			existing.Name = name
+		}
+
+		if in.Code != nil {
+			code, err := normalizeCode(*in.Code)
+			if err != nil {
+				return err
+			}
+			if code != existing.Code {
+				if err := s.ensureCodeNotExists(txCtx, existing.CompanyID, code); err != nil {
+					return err
+				}
+				existing.Code = code
+			}
+		}
+
+		existing.UpdatedAt = s.clock.Now()
+
+		result, err := s.repo.Update(txCtx, existing)
+		if err != nil {
+			return err
+		}
+
+		updated = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// MoveDepartment は部署を別の親部署の配下（または会社直下）へ移動します。配下の部署も合わせて移動します。
+func (s *Service) MoveDepartment(ctx context.Context, in MoveDepartmentInput) (*Department, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	parentID := normalizeParentID(in.ParentDepartmentID)
+
+	var moved *Department
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		existing, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		if parentID != nil {
+			if err := s.ensureNoCycle(txCtx, existing, *parentID); err != nil {
+				return err
+			}
+		}
+
+		existing.ParentDepartmentID = parentID
+		existing.UpdatedAt = s.clock.Now()
+
+		result, err := s.repo.Update(txCtx, existing)
+		if err != nil {
+			return err
+		}
+
+		moved = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return moved, nil
+}
+
+// DeleteDepartment は部署を削除します。子部署や所属社員が存在する場合は削除できません。
+func (s *Service) DeleteDepartment(ctx context.Context, in DeleteDepartmentInput) error {
+	if strings.TrimSpace(in.ID) == "" {
+		return fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		return s.repo.Delete(txCtx, in.ID)
+	})
+}
+
+// GetDepartment は部署を取得します。
+func (s *Service) GetDepartment(ctx context.Context, in GetDepartmentInput) (*Department, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	var result *Department
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		found, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+		result = found
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListDepartments は会社の部署一覧を取得します。ParentDepartmentID を指定すると直下の部署のみを返します。
+func (s *Service) ListDepartments(ctx context.Context, in ListDepartmentsInput) (*ListDepartmentsResult, error) {
+	companyID, err := normalizeCompanyID(in.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		departments []*Department
+		nextToken   string
+	)
+
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		resultDepartments, token, err := s.repo.List(txCtx, ListDepartmentsFilter{
+			CompanyID: companyID,
+			ParentID:  normalizeParentID(in.ParentDepartmentID),
+			Limit:     limit,
+			Offset:    offset,
+		})
+		if err != nil {
+			return err
+		}
+		departments = resultDepartments
+		nextToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ListDepartmentsResult{Departments: departments, NextPageToken: nextToken}, nil
+}
+
+func (s *Service) ensureCodeNotExists(ctx context.Context, companyID, code string) error {
+	department, err := s.repo.FindByCompanyAndCode(ctx, companyID, code)
+	if err != nil && !errors.Is(err, ErrDepartmentNotFound) {
+		return err
+	}
+	if department != nil {
+		return ErrCodeAlreadyExists
+	}
+	return nil
+}
+
+// ensureParentInCompany は親部署が存在し、同じ会社に属することを確認します。
+func (s *Service) ensureParentInCompany(ctx context.Context, companyID, parentID string) error {
+	parent, err := s.repo.FindByID(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, ErrDepartmentNotFound) {
+			return ErrParentDepartmentNotFound
+		}
+		return err
+	}
+	if parent.CompanyID != companyID {
+		return ErrParentDepartmentNotFound
+	}
+	return nil
+}
+
+// ensureNoCycle は department の親部署を parentID にした場合に階層が循環しないことを確認します。
+// 同時に行われた付け替えで循環しないよう、確認の前に階層のロックを取得します。
+func (s *Service) ensureNoCycle(ctx context.Context, department *Department, parentID string) error {
+	if department.ID == parentID {
+		return ErrHierarchyCycle
+	}
+	if err := s.repo.LockHierarchy(ctx); err != nil {
+		return err
+	}
+	if err := s.ensureParentInCompany(ctx, department.CompanyID, parentID); err != nil {
+		return err
+	}
+
+	ancestors, err := s.repo.ListAncestors(ctx, parentID)
+	if err != nil {
+		return err
+	}
+	for _, ancestor := range ancestors {
+		if ancestor.ID == department.ID {
+			return ErrHierarchyCycle
+		}
+	}
+	return nil
+}
+
+func normalizeCompanyID(raw string) (string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", ErrInvalidCompanyID
+	}
+	return trimmed, nil
+}
+
+func normalizeName(raw string) (string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", ErrInvalidName
+	}
+	return trimmed, nil
+}
+
+func normalizeCode(raw string) (string, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return "", ErrInvalidCode
+	}
+
+	lower := strings.ToLower(trimmed)
+	if !codePattern.MatchString(lower) {
+		return "", ErrInvalidCode
+	}
+
+	return lower, nil
+}
+
+func normalizeParentID(raw *string) *string {
+	if raw == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(*raw)
+	if trimmed == "" {
+		return nil
+	}
+
+	return &trimmed
+}
+
+func normalizePageSize(pageSize int) (int, error) {
+	if pageSize <= 0 {
+		return defaultListPageSize, nil
+	}
+	if pageSize > maxListPageSize {
+		return 0, ErrInvalidPageSize
+	}
+	return pageSize, nil
+}
+
+func parsePageToken(token string) (int, error) {
+	if strings.TrimSpace(token) == "" {
+		return 0, nil
+	}
+
+	offset, err := strconv.Atoi(token)
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidPageToken
+	}
+
+	return offset, nil
+}
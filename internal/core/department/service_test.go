@@ -0,0 +1,304 @@
+package department
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+)
+
+type stubClock struct {
+	now time.Time
+}
+
+func (s *stubClock) Now() time.Time {
+	return s.now
+}
+
+type fakeDepartmentRepo struct {
+	departments map[string]*Department
+	sequence    int
+	order       []string
+
+	hierarchyLocks int
+}
+
+func newFakeDepartmentRepo() *fakeDepartmentRepo {
+	return &fakeDepartmentRepo{departments: make(map[string]*Department)}
+}
+
+func (r *fakeDepartmentRepo) Create(_ context.Context, d *Department) (*Department, error) {
+	for _, existing := range r.departments {
+		if existing.CompanyID == d.CompanyID && existing.Code == d.Code {
+			return nil, ErrCodeAlreadyExists
+		}
+	}
+
+	clone := cloneDepartment(d)
+	r.sequence++
+	id := fmt.Sprintf("dept-%d", r.sequence)
+	clone.ID = id
+	r.departments[id] = clone
+	r.order = append(r.order, id)
+	return cloneDepartment(clone), nil
+}
+
+func (r *fakeDepartmentRepo) Update(_ context.Context, d *Department) (*Department, error) {
+	if _, ok := r.departments[d.ID]; !ok {
+		return nil, ErrDepartmentNotFound
+	}
+	r.departments[d.ID] = cloneDepartment(d)
+	return cloneDepartment(d), nil
+}
+
+func (r *fakeDepartmentRepo) Delete(_ context.Context, id string) error {
+	if _, ok := r.departments[id]; !ok {
+		return ErrDepartmentNotFound
+	}
+	for _, d := range r.departments {
+		if d.ParentDepartmentID != nil && *d.ParentDepartmentID == id {
+			return ErrDepartmentHasChildren
+		}
+	}
+	delete(r.departments, id)
+	return nil
+}
+
+func (r *fakeDepartmentRepo) FindByID(_ context.Context, id string) (*Department, error) {
+	d, ok := r.departments[id]
+	if !ok {
+		return nil, ErrDepartmentNotFound
+	}
+	return cloneDepartment(d), nil
+}
+
+func (r *fakeDepartmentRepo) FindByCompanyAndCode(_ context.Context, companyID, code string) (*Department, error) {
+	for _, d := range r.departments {
+		if d.CompanyID == companyID && d.Code == code {
+			return cloneDepartment(d), nil
+		}
+	}
+	return nil, ErrDepartmentNotFound
+}
+
+func (r *fakeDepartmentRepo) List(_ context.Context, filter ListDepartmentsFilter) ([]*Department, string, error) {
+	var filtered []*Department
+	for _, id := range r.order {
+		d, ok := r.departments[id]
+		if !ok || d.CompanyID != filter.CompanyID {
+			continue
+		}
+		if filter.ParentID != nil && (d.ParentDepartmentID == nil || *d.ParentDepartmentID != *filter.ParentID) {
+			continue
+		}
+		filtered = append(filtered, cloneDepartment(d))
+	}
+
+	if filter.Offset > len(filtered) {
+		return []*Department{}, "", nil
+	}
+
+	end := filter.Offset + filter.Limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	nextToken := ""
+	if end < len(filtered) {
+		nextToken = strconv.Itoa(end)
+	}
+
+	return filtered[filter.Offset:end], nextToken, nil
+}
+
+func (r *fakeDepartmentRepo) LockHierarchy(context.Context) error {
+	r.hierarchyLocks++
+	return nil
+}
+
+func (r *fakeDepartmentRepo) ListAncestors(_ context.Context, id string) ([]*Department, error) {
+	var ancestors []*Department
+	current, ok := r.departments[id]
+	for ok && current.ParentDepartmentID != nil {
+		current, ok = r.departments[*current.ParentDepartmentID]
+		if ok {
+			ancestors = append(ancestors, cloneDepartment(current))
+		}
+	}
+	return ancestors, nil
+}
+
+func cloneDepartment(d *Department) *Department {
+	if d == nil {
+		return nil
+	}
+	copy := *d
+	if d.ParentDepartmentID != nil {
+		parentID := *d.ParentDepartmentID
+		copy.ParentDepartmentID = &parentID
+	}
+	return &copy
+}
+
+func TestService_CreateDepartment(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeDepartmentRepo()
+	now := time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC)
+	svc := NewService(repo, &stubClock{now: now}, nil)
+	ctx := context.Background()
+
+	root, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: " company-1 ", Name: " Engineering ", Code: " ENG "})
+	if err != nil {
+		t.Fatalf("CreateDepartment returned error: %v", err)
+	}
+	if root.CompanyID != "company-1" || root.Name != "Engineering" || root.Code != "eng" || root.ParentDepartmentID != nil {
+		t.Fatalf("unexpected department: %+v", root)
+	}
+	if !root.CreatedAt.Equal(now) {
+		t.Fatalf("expected created_at %v, got %v", now, root.CreatedAt)
+	}
+
+	child, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Platform", Code: "platform", ParentDepartmentID: &root.ID})
+	if err != nil {
+		t.Fatalf("CreateDepartment returned error: %v", err)
+	}
+	if child.ParentDepartmentID == nil || *child.ParentDepartmentID != root.ID {
+		t.Fatalf("expected parent %s, got %v", root.ID, child.ParentDepartmentID)
+	}
+
+	if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Dup", Code: "eng"}); !errors.Is(err, ErrCodeAlreadyExists) {
+		t.Fatalf("expected ErrCodeAlreadyExists, got %v", err)
+	}
+	if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-2", Name: "Other", Code: "other", ParentDepartmentID: &root.ID}); !errors.Is(err, ErrParentDepartmentNotFound) {
+		t.Fatalf("expected ErrParentDepartmentNotFound for parent in another company, got %v", err)
+	}
+	if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Bad", Code: "bad code"}); !errors.Is(err, ErrInvalidCode) {
+		t.Fatalf("expected ErrInvalidCode, got %v", err)
+	}
+	if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{Name: "No company", Code: "none"}); !errors.Is(err, ErrInvalidCompanyID) {
+		t.Fatalf("expected ErrInvalidCompanyID, got %v", err)
+	}
+}
+
+func TestService_MoveDepartment(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeDepartmentRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	root, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Root", Code: "root"})
+	child, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Child", Code: "child", ParentDepartmentID: &root.ID})
+	grandchild, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Grandchild", Code: "grandchild", ParentDepartmentID: &child.ID})
+	other, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-2", Name: "Other", Code: "other"})
+
+	if _, err := svc.MoveDepartment(ctx, MoveDepartmentInput{ID: root.ID, ParentDepartmentID: &root.ID}); !errors.Is(err, ErrHierarchyCycle) {
+		t.Fatalf("expected ErrHierarchyCycle for self parent, got %v", err)
+	}
+	if _, err := svc.MoveDepartment(ctx, MoveDepartmentInput{ID: root.ID, ParentDepartmentID: &grandchild.ID}); !errors.Is(err, ErrHierarchyCycle) {
+		t.Fatalf("expected ErrHierarchyCycle for descendant parent, got %v", err)
+	}
+	if repo.hierarchyLocks == 0 {
+		t.Fatalf("expected the hierarchy lock before checking ancestors")
+	}
+	if _, err := svc.MoveDepartment(ctx, MoveDepartmentInput{ID: child.ID, ParentDepartmentID: &other.ID}); !errors.Is(err, ErrParentDepartmentNotFound) {
+		t.Fatalf("expected ErrParentDepartmentNotFound for parent in another company, got %v", err)
+	}
+
+	moved, err := svc.MoveDepartment(ctx, MoveDepartmentInput{ID: grandchild.ID, ParentDepartmentID: &root.ID})
+	if err != nil {
+		t.Fatalf("MoveDepartment returned error: %v", err)
+	}
+	if moved.ParentDepartmentID == nil || *moved.ParentDepartmentID != root.ID {
+		t.Fatalf("expected parent %s, got %v", root.ID, moved.ParentDepartmentID)
+	}
+
+	moved, err = svc.MoveDepartment(ctx, MoveDepartmentInput{ID: grandchild.ID})
+	if err != nil {
+		t.Fatalf("MoveDepartment returned error: %v", err)
+	}
+	if moved.ParentDepartmentID != nil {
+		t.Fatalf("expected department to move to the top level, got %v", *moved.ParentDepartmentID)
+	}
+}
+
+func TestService_UpdateAndDeleteDepartment(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeDepartmentRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	root, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Root", Code: "root"})
+	child, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Child", Code: "child", ParentDepartmentID: &root.ID})
+
+	name := "Renamed"
+	code := "Renamed"
+	updated, err := svc.UpdateDepartment(ctx, UpdateDepartmentInput{ID: child.ID, Name: &name, Code: &code})
+	if err != nil {
+		t.Fatalf("UpdateDepartment returned error: %v", err)
+	}
+	if updated.Name != "Renamed" || updated.Code != "renamed" || updated.ParentDepartmentID == nil {
+		t.Fatalf("unexpected department: %+v", updated)
+	}
+
+	taken := "root"
+	if _, err := svc.UpdateDepartment(ctx, UpdateDepartmentInput{ID: child.ID, Code: &taken}); !errors.Is(err, ErrCodeAlreadyExists) {
+		t.Fatalf("expected ErrCodeAlreadyExists, got %v", err)
+	}
+
+	if err := svc.DeleteDepartment(ctx, DeleteDepartmentInput{ID: root.ID}); !errors.Is(err, ErrDepartmentHasChildren) {
+		t.Fatalf("expected ErrDepartmentHasChildren, got %v", err)
+	}
+	if err := svc.DeleteDepartment(ctx, DeleteDepartmentInput{ID: child.ID}); err != nil {
+		t.Fatalf("DeleteDepartment returned error: %v", err)
+	}
+	if _, err := svc.GetDepartment(ctx, GetDepartmentInput{ID: child.ID}); !errors.Is(err, ErrDepartmentNotFound) {
+		t.Fatalf("expected ErrDepartmentNotFound, got %v", err)
+	}
+}
+
+func TestService_ListDepartments(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeDepartmentRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	root, _ := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: "Root", Code: "root"})
+	for i := 0; i < 3; i++ {
+		code := fmt.Sprintf("team-%d", i)
+		if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-1", Name: code, Code: code, ParentDepartmentID: &root.ID}); err != nil {
+			t.Fatalf("CreateDepartment returned error: %v", err)
+		}
+	}
+	if _, err := svc.CreateDepartment(ctx, CreateDepartmentInput{CompanyID: "company-2", Name: "Other", Code: "other"}); err != nil {
+		t.Fatalf("CreateDepartment returned error: %v", err)
+	}
+
+	all, err := svc.ListDepartments(ctx, ListDepartmentsInput{CompanyID: "company-1", PageSize: 3})
+	if err != nil {
+		t.Fatalf("ListDepartments returned error: %v", err)
+	}
+	if len(all.Departments) != 3 || all.NextPageToken != "3" {
+		t.Fatalf("unexpected page: %d departments, token %q", len(all.Departments), all.NextPageToken)
+	}
+
+	children, err := svc.ListDepartments(ctx, ListDepartmentsInput{CompanyID: "company-1", ParentDepartmentID: &root.ID})
+	if err != nil {
+		t.Fatalf("ListDepartments returned error: %v", err)
+	}
+	if len(children.Departments) != 3 {
+		t.Fatalf("expected 3 child departments, got %d", len(children.Departments))
+	}
+
+	if _, err := svc.ListDepartments(ctx, ListDepartmentsInput{}); !errors.Is(err, ErrInvalidCompanyID) {
+		t.Fatalf("expected ErrInvalidCompanyID, got %v", err)
+	}
+	if _, err := svc.ListDepartments(ctx, ListDepartmentsInput{CompanyID: "company-1", PageToken: "abc"}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
@@ -1,35 +1,143 @@
 package employee
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 // Status は社員の状態を表します。
+// pending（入社日が未来）→ active ⇄ on_leave → terminated の順に遷移します。
 type Status string
 
 const (
-	StatusActive   Status = "active"
-	StatusInactive Status = "inactive"
+	StatusPending    Status = "pending"
+	StatusActive     Status = "active"
+	StatusOnLeave    Status = "on_leave"
+	StatusTerminated Status = "terminated"
 )
 
+// statusTransitions は状態ごとに遷移可能な状態を定義します。
+// terminated からの復帰は CreateEmployee による再雇用でのみ行います。
+var statusTransitions = map[Status][]Status{
+	StatusPending:    {StatusActive, StatusTerminated},
+	StatusActive:     {StatusOnLeave, StatusTerminated},
+	StatusOnLeave:    {StatusActive, StatusTerminated},
+	StatusTerminated: {},
+}
+
+// CanTransitionTo は s から next へ遷移できるかを返します。同じ状態への遷移は常に許可します。
+func (s Status) CanTransitionTo(next Status) bool {
+	if s == next {
+		return true
+	}
+	for _, allowed := range statusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 // Employee は社員エンティティです。
+// DepartmentID は所属部署で、CompanyID と同じ会社の部署である必要があります。
+// ManagerEmployeeID は上長の社員 ID で、同じ会社の社員である必要があります。
+// TransferredFromEmployeeID は転籍で作成された場合の転籍元の社員 ID です。
+// Attributes は会社が定義したカスタム属性の値で、型に応じて正規化した文字列を保持します。
 type Employee struct {
-	ID           string
-	CompanyID    string
-	EmployeeCode string
-	UserID       string
-	Status       Status
-	HiredAt      *time.Time
-	TerminatedAt *time.Time
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	User         *UserSnapshot
+	ID                        string
+	CompanyID                 string
+	EmployeeCode              string
+	UserID                    string
+	DepartmentID              *string
+	ManagerEmployeeID         *string
+	Status                    Status
+	HiredAt                   *time.Time
+	TerminatedAt              *time.Time
+	TransferredFromEmployeeID *string
+	Attributes                map[string]string
+	CreatedAt                 time.Time
+	UpdatedAt                 time.Time
+	User                      *UserSnapshot
+}
+
+// OrgChartNode は組織図の 1 ノードです。Reports には直属の部下が入ります。
+type OrgChartNode struct {
+	Employee *Employee
+	Reports  []*OrgChartNode
+}
+
+// HistoryEntry は社員レコードのある期間の内容です。
+// 有効期間は EffectiveFrom 以上 EffectiveTo 未満の日付で、EffectiveTo が nil の場合は現在も有効です。
+type HistoryEntry struct {
+	ID                string
+	EmployeeID        string
+	CompanyID         string
+	EmployeeCode      string
+	UserID            string
+	DepartmentID      *string
+	ManagerEmployeeID *string
+	Status            Status
+	HiredAt           *time.Time
+	TerminatedAt      *time.Time
+	EffectiveFrom     time.Time
+	EffectiveTo       *time.Time
+	CreatedAt         time.Time
 }
 
 // UserSnapshot は社員に紐づくユーザー情報のスナップショットです。
 type UserSnapshot struct {
-	ID        string
-	Email     string
-	Name      string
-	Status    string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID    string
+	Email string
+	Name  string
+	// DisplayName はユーザーの表示名、Locale は BCP 47 の言語タグ（未設定の場合は空文字）です。
+	DisplayName string
+	Locale      string
+	Status      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// CompanySnapshot は社員の所属会社の概要です。
+type CompanySnapshot struct {
+	ID              string
+	Name            string
+	Code            string
+	Status          string
+	ParentCompanyID *string
+}
+
+// Employment はユーザーの社員レコードと所属会社の概要の組です。
+type Employment struct {
+	Employee *Employee
+	Company  *CompanySnapshot
+}
+
+// CodeSequence は会社の採番規則から払い出した社員コードの連番です。
+type CodeSequence struct {
+	Prefix   string
+	Width    int
+	Sequence int64
+}
+
+// Code は接頭辞にゼロ埋めした連番を連結した社員コードを返します。
+func (c CodeSequence) Code() string {
+	return c.Prefix + fmt.Sprintf("%0*d", c.Width, c.Sequence)
+}
+
+// AttributeType は社員のカスタム属性の値の型です。
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeNumber AttributeType = "number"
+	AttributeTypeDate   AttributeType = "date"
+	AttributeTypeEnum   AttributeType = "enum"
+)
+
+// AttributeDefinition は会社が定義した社員のカスタム属性です。
+type AttributeDefinition struct {
+	Key           string
+	Type          AttributeType
+	Required      bool
+	AllowedValues []string
 }
@@ -11,8 +11,18 @@ var (
 	ErrInvalidPageSize           = errors.New("employee: invalid page size")
 	ErrInvalidPageToken          = errors.New("employee: invalid page token")
 	ErrInvalidDateRange          = errors.New("employee: invalid employment period")
+	ErrInvalidOrgChartDepth      = errors.New("employee: invalid org chart depth")
+	ErrInvalidEffectiveDate      = errors.New("employee: invalid effective date")
+	ErrTransferToSameCompany     = errors.New("employee: transfer target must be a different company")
 	ErrEmployeeNotFound          = errors.New("employee: not found")
 	ErrCompanyNotFound           = errors.New("employee: company not found")
 	ErrUserNotFound              = errors.New("employee: user not found")
+	ErrDepartmentNotFound        = errors.New("employee: department not found in company")
+	ErrManagerNotFound           = errors.New("employee: manager not found in company")
+	ErrHistoryNotFound           = errors.New("employee: no record effective on the requested date")
 	ErrEmployeeCodeAlreadyExists = errors.New("employee: employee code already exists")
+	ErrReportingCycle            = errors.New("employee: reporting line would form a cycle")
+	ErrManagerHasReports         = errors.New("employee: employee still has direct reports")
+	ErrInvalidStatusTransition   = errors.New("employee: invalid status transition")
+	ErrInvalidAttribute          = errors.New("employee: invalid attribute")
 )
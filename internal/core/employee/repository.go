@@ -1,6 +1,9 @@
 package employee
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository は社員永続化の抽象です。
 type Repository interface {
@@ -10,12 +13,72 @@ type Repository interface {
 	FindByID(ctx context.Context, id string) (*Employee, error)
 	FindByCompanyAndCode(ctx context.Context, companyID, employeeCode string) (*Employee, error)
 	List(ctx context.Context, filter ListEmployeesFilter) ([]*Employee, string, error)
+	ListDirectReports(ctx context.Context, filter ListDirectReportsFilter) ([]*Employee, string, error)
+	// ListEmploymentsByUser はユーザーの社員レコードを在籍中・退職済みを問わず所属会社の概要とあわせて返します。
+	ListEmploymentsByUser(ctx context.Context, filter ListEmploymentsFilter) ([]*Employment, string, error)
+	// ListReportingChain は直近の上長から最上位の上長までを順に返します。
+	ListReportingChain(ctx context.Context, id string) ([]*Employee, error)
+	// LockReportingLines は上長の付け替えをトランザクションの終了まで直列化します。
+	LockReportingLines(ctx context.Context) error
+	// ListSubordinates は id の配下の社員を maxDepth 階層まで、階層の浅い順に返します。
+	ListSubordinates(ctx context.Context, id string, maxDepth int) ([]*Employee, error)
+	// ReassignDirectReports は managerID の直属の部下の上長を newManagerID に付け替えます。nil の場合は上長を解除します。
+	ReassignDirectReports(ctx context.Context, managerID string, newManagerID *string, updatedAt time.Time) error
+	// FindTerminatedByCompanyAndUser は会社とユーザーに紐づく退職済みの社員のうち最後に更新されたものを返します。
+	FindTerminatedByCompanyAndUser(ctx context.Context, companyID, userID string) (*Employee, error)
+	// NextCodeSequence は会社の採番規則から次の連番を払い出し、会社の次の連番を 1 進めます。
+	// 会社の行をロックして更新するため、同時に払い出しても同じ連番は返しません。
+	NextCodeSequence(ctx context.Context, companyID string) (*CodeSequence, error)
+	// ListDueStatusTransitions は asOf 時点で入社日・退職日を迎えたのに状態が追随していない社員を返します。
+	// 入社日が到来した pending の社員と、退職日が到来した terminated 以外の社員が対象です。
+	ListDueStatusTransitions(ctx context.Context, asOf time.Time) ([]*Employee, error)
+	// RecordHistory は有効中の履歴を entry.EffectiveFrom で閉じ、entry を新たな有効中の履歴として保存します。
+	// EffectiveFrom 以降に開始した有効中の履歴は entry で置き換えます。
+	RecordHistory(ctx context.Context, entry *HistoryEntry) (*HistoryEntry, error)
+	// ListHistory は社員の履歴を有効開始日の降順で返します。
+	ListHistory(ctx context.Context, filter ListHistoryFilter) ([]*HistoryEntry, string, error)
+	// FindHistoryAsOf は date 時点で有効だった履歴を返します。該当しない場合は ErrHistoryNotFound を返します。
+	FindHistoryAsOf(ctx context.Context, employeeID string, date time.Time) (*HistoryEntry, error)
 }
 
-// ListEmployeesFilter は一覧取得用フィルタです。
-type ListEmployeesFilter struct {
-	CompanyID string
-	Status    *Status
+// ListHistoryFilter は履歴の一覧取得用フィルタです。
+type ListHistoryFilter struct {
+	EmployeeID string
+	Limit      int
+	Offset     int
+}
+
+// ListEmploymentsFilter はユーザーの雇用一覧の取得用フィルタです。
+type ListEmploymentsFilter struct {
+	UserID string
+	Limit  int
+	Offset int
+}
+
+// ListDirectReportsFilter は直属の部下の一覧取得用フィルタです。
+type ListDirectReportsFilter struct {
+	ManagerID string
 	Limit     int
 	Offset    int
 }
+
+// ListEmployeesFilter は一覧取得用フィルタです。
+// IncludeSubsidiaries を指定すると CompanyID 配下の子会社（孫会社以下を含む）の社員も対象にします。
+// DepartmentID を指定するとその部署の社員に絞り込み、IncludeSubDepartments の場合は配下の部署の社員も含めます。
+// Attributes を指定すると、すべての属性の値が一致する社員に絞り込みます。
+type ListEmployeesFilter struct {
+	CompanyID             string
+	IncludeSubsidiaries   bool
+	DepartmentID          string
+	IncludeSubDepartments bool
+	Status                *Status
+	Attributes            map[string]string
+	Limit                 int
+	Offset                int
+}
+
+// AttributeSchemas は会社ごとの社員属性の定義を参照するポートです。実装は会社ドメインが提供します。
+type AttributeSchemas interface {
+	// ListByCompany は会社の社員属性の定義を返します。
+	ListByCompany(ctx context.Context, companyID string) ([]AttributeDefinition, error)
+}
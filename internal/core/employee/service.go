@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -48,15 +49,33 @@ func (noopTransactionManager) WithinReadWrite(ctx context.Context, fn func(conte
 const (
 	defaultListPageSize = 50
 	maxListPageSize     = 200
+
+	defaultOrgChartDepth = 3
+	maxOrgChartDepth     = 10
 )
 
 var employeeCodePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
 
+// attributeDateLayout は date 型の社員属性の書式です。
+const attributeDateLayout = "2006-01-02"
+
 // Service は社員に関するユースケースをまとめます。
 type Service struct {
-	repo  Repository
-	clock Clock
-	tx    TransactionManager
+	repo       Repository
+	clock      Clock
+	tx         TransactionManager
+	attributes AttributeSchemas
+}
+
+// Option は Service の任意設定です。
+type Option func(*Service)
+
+// WithAttributeSchemas は社員属性の検証に利用する会社ごとの属性定義のポートを設定します。
+// 未設定の場合、属性の値は検証せずに保存します。
+func WithAttributeSchemas(schemas AttributeSchemas) Option {
+	return func(s *Service) {
+		s.attributes = schemas
+	}
 }
 
 // UseCase は社員ユースケースの公開インターフェースです。
@@ -65,40 +84,86 @@ type UseCase interface {
 	GetEmployee(ctx context.Context, in GetEmployeeInput) (*Employee, error)
 	ListEmployees(ctx context.Context, in ListEmployeesInput) (*ListEmployeesResult, error)
 	UpdateEmployee(ctx context.Context, in UpdateEmployeeInput) (*Employee, error)
+	TransferEmployee(ctx context.Context, in TransferEmployeeInput) (*TransferEmployeeResult, error)
 	DeleteEmployee(ctx context.Context, in DeleteEmployeeInput) error
+	ListEmployeeHistory(ctx context.Context, in ListEmployeeHistoryInput) (*ListEmployeeHistoryResult, error)
+	ListDirectReports(ctx context.Context, in ListDirectReportsInput) (*ListEmployeesResult, error)
+	ListUserEmployments(ctx context.Context, in ListUserEmploymentsInput) (*ListUserEmploymentsResult, error)
+	GetReportingChain(ctx context.Context, in GetReportingChainInput) ([]*Employee, error)
+	GetOrgChart(ctx context.Context, in GetOrgChartInput) (*OrgChartNode, error)
 }
 
 // NewService は Service を生成します。
-func NewService(repo Repository, clock Clock, tx TransactionManager) *Service {
+func NewService(repo Repository, clock Clock, tx TransactionManager, opts ...Option) *Service {
 	if clock == nil {
 		clock = realClock{}
 	}
 	if tx == nil {
 		tx = noopTransactionManager{}
 	}
-	return &Service{repo: repo, clock: clock, tx: tx}
+	s := &Service{repo: repo, clock: clock, tx: tx}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateEmployeeInput は社員作成時の入力です。
+// 同じ会社に退職済みの社員レコードを持つユーザーを指定した場合は、新しい行を作らずにそのレコードを再雇用として再開します。
+// EmployeeCode を省略すると会社の採番規則から払い出します（再雇用の場合は以前の社員コードを引き継ぎます）。
+// Attributes は会社が定義した社員属性の値です。再雇用で省略した場合は以前の値を引き継ぎます。
 type CreateEmployeeInput struct {
-	CompanyID    string
-	EmployeeCode string
-	UserID       string
-	Status       *Status
-	HiredAt      *time.Time
-	TerminatedAt *time.Time
+	CompanyID         string
+	EmployeeCode      string
+	UserID            string
+	DepartmentID      *string
+	ManagerEmployeeID *string
+	Status            *Status
+	HiredAt           *time.Time
+	TerminatedAt      *time.Time
+	Attributes        map[string]string
 }
 
 // UpdateEmployeeInput は社員更新時の入力です。
+// DepartmentID・ManagerEmployeeID に空文字を指定すると部署への所属・上長を解除します。
+// ReassignReportsTo を指定すると直属の部下の上長をその社員へ付け替えます（空文字の場合は解除）。
+// 直属の部下を持つ社員を退職させる場合は ReassignReportsTo の指定が必要です。
+// Attributes は指定したキーの値だけを更新し、空文字を指定した属性は削除します。
 type UpdateEmployeeInput struct {
-	ID              string
-	EmployeeCode    *string
-	UserID          *string
-	Status          *Status
-	HiredAt         *time.Time
-	HiredAtSet      bool
-	TerminatedAt    *time.Time
-	TerminatedAtSet bool
+	ID                string
+	EmployeeCode      *string
+	UserID            *string
+	DepartmentID      *string
+	ManagerEmployeeID *string
+	ReassignReportsTo *string
+	Status            *Status
+	HiredAt           *time.Time
+	HiredAtSet        bool
+	TerminatedAt      *time.Time
+	TerminatedAtSet   bool
+	Attributes        map[string]string
+}
+
+// TransferEmployeeInput は転籍時の入力です。
+// 転籍元の社員を EffectiveDate の前日付で退職させ、TargetCompanyID の会社に EffectiveDate を入社日とする社員を作成します。
+// DepartmentID・ManagerEmployeeID は転籍先での所属部署・上長です。EmployeeCode を省略すると転籍先の採番規則から払い出します。
+// 転籍元の社員が直属の部下を持つ場合は ReassignReportsTo の指定が必要です（空文字の場合は上長を解除）。
+type TransferEmployeeInput struct {
+	ID                string
+	TargetCompanyID   string
+	EmployeeCode      string
+	EffectiveDate     time.Time
+	DepartmentID      *string
+	ManagerEmployeeID *string
+	ReassignReportsTo *string
+	// Attributes は転籍先の会社が定義した社員属性の値です。
+	Attributes map[string]string
+}
+
+// TransferEmployeeResult は転籍の結果です。Previous は退職させた転籍元、Employee は転籍先で作成した社員です。
+type TransferEmployeeResult struct {
+	Previous *Employee
+	Employee *Employee
 }
 
 // DeleteEmployeeInput は社員削除時の入力です。
@@ -106,17 +171,85 @@ type DeleteEmployeeInput struct {
 	ID string
 }
 
-// GetEmployeeInput は社員取得時の入力です。
+// GetEmployeeInput は社員取得時の入力です。AsOf を指定するとその日付時点の社員レコードを返します。
 type GetEmployeeInput struct {
-	ID string
+	ID   string
+	AsOf *time.Time
+}
+
+// ListEmployeeHistoryInput は社員履歴の一覧取得時の入力です。
+type ListEmployeeHistoryInput struct {
+	EmployeeID string
+	PageSize   int
+	PageToken  string
+}
+
+// ListEmployeeHistoryResult は社員履歴の一覧取得結果を表します。
+type ListEmployeeHistoryResult struct {
+	Entries       []*HistoryEntry
+	NextPageToken string
+}
+
+// StatusTransitionFailure は日付による状態更新に失敗した社員とその理由です。
+type StatusTransitionFailure struct {
+	EmployeeID string
+	Err        error
+}
+
+// ApplyStatusTransitionsResult は日付による状態更新の結果を表します。
+type ApplyStatusTransitionsResult struct {
+	Transitioned []*Employee
+	Failures     []StatusTransitionFailure
+}
+
+// CompanyEmployeeCounts は会社に所属する社員数です。Active は退職していない社員数です。
+type CompanyEmployeeCounts struct {
+	Total  int
+	Active int
 }
 
 // ListEmployeesInput は一覧取得時の入力です。
+// Attributes は CompanyID の会社の属性定義に従って値を正規化し、すべてが一致する社員に絞り込みます。
 type ListEmployeesInput struct {
-	CompanyID string
+	CompanyID             string
+	IncludeSubsidiaries   bool
+	DepartmentID          string
+	IncludeSubDepartments bool
+	PageSize              int
+	PageToken             string
+	Status                *Status
+	Attributes            map[string]string
+}
+
+// ListDirectReportsInput は直属の部下の一覧取得時の入力です。
+type ListDirectReportsInput struct {
+	EmployeeID string
+	PageSize   int
+	PageToken  string
+}
+
+// ListUserEmploymentsInput はユーザーの雇用一覧の取得時の入力です。
+type ListUserEmploymentsInput struct {
+	UserID    string
 	PageSize  int
 	PageToken string
-	Status    *Status
+}
+
+// ListUserEmploymentsResult はユーザーの雇用一覧の取得結果を表します。
+type ListUserEmploymentsResult struct {
+	Employments   []*Employment
+	NextPageToken string
+}
+
+// GetReportingChainInput は報告ライン取得時の入力です。
+type GetReportingChainInput struct {
+	ID string
+}
+
+// GetOrgChartInput は組織図取得時の入力です。Depth が 0 の場合は既定の階層数まで取得します。
+type GetOrgChartInput struct {
+	ID    string
+	Depth int
 }
 
 // ListEmployeesResult は一覧取得結果を表します。
@@ -132,7 +265,7 @@ func (s *Service) CreateEmployee(ctx context.Context, in CreateEmployeeInput) (*
 		return nil, err
 	}
 
-	code, err := normalizeEmployeeCode(in.EmployeeCode)
+	code, err := normalizeOptionalEmployeeCode(in.EmployeeCode)
 	if err != nil {
 		return nil, err
 	}
@@ -145,40 +278,107 @@ func (s *Service) CreateEmployee(ctx context.Context, in CreateEmployeeInput) (*
 	hiredAt := normalizeDate(in.HiredAt)
 	terminatedAt := normalizeDate(in.TerminatedAt)
 
-	if err := validateEmploymentPeriod(hiredAt, terminatedAt); err != nil {
+	now := s.clock.Now()
+	status, terminatedAt, err := resolveStatus("", in.Status, hiredAt, terminatedAt, now)
+	if err != nil {
 		return nil, err
 	}
 
-	status := StatusActive
-	if in.Status != nil {
-		if !isValidStatus(*in.Status) {
-			return nil, ErrInvalidStatus
-		}
-		status = *in.Status
+	if err := validateEmploymentPeriod(hiredAt, terminatedAt); err != nil {
+		return nil, err
 	}
 
 	var created *Employee
 	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
-		if err := s.ensureEmployeeCodeNotExists(txCtx, companyID, code); err != nil {
+		managerID := normalizeOptionalID(in.ManagerEmployeeID)
+
+		previous, err := s.repo.FindTerminatedByCompanyAndUser(txCtx, companyID, userID)
+		switch {
+		case err == nil:
+			if code == "" {
+				code = previous.EmployeeCode
+			}
+			if code != previous.EmployeeCode {
+				if err := s.ensureEmployeeCodeNotExists(txCtx, companyID, code); err != nil {
+					return err
+				}
+			}
+			if managerID != nil {
+				if err := s.ensureValidManager(txCtx, previous, *managerID); err != nil {
+					return err
+				}
+			}
+
+			attributes := previous.Attributes
+			if in.Attributes != nil {
+				attributes = in.Attributes
+			}
+			if previous.Attributes, err = s.validateAttributes(txCtx, companyID, attributes); err != nil {
+				return err
+			}
+
+			previous.EmployeeCode = code
+			previous.DepartmentID = normalizeOptionalID(in.DepartmentID)
+			previous.ManagerEmployeeID = managerID
+			previous.Status = status
+			previous.HiredAt = cloneTime(hiredAt)
+			previous.TerminatedAt = cloneTime(terminatedAt)
+			previous.UpdatedAt = now
+
+			result, err := s.repo.Update(txCtx, previous)
+			if err != nil {
+				return err
+			}
+			if err := s.recordHistory(txCtx, result); err != nil {
+				return err
+			}
+
+			created = result
+			return nil
+		case !errors.Is(err, ErrEmployeeNotFound):
+			return err
+		}
+
+		if code == "" {
+			if code, err = s.allocateEmployeeCode(txCtx, companyID); err != nil {
+				return err
+			}
+		} else if err := s.ensureEmployeeCodeNotExists(txCtx, companyID, code); err != nil {
+			return err
+		}
+
+		if managerID != nil {
+			if err := s.ensureManagerInCompany(txCtx, companyID, *managerID); err != nil {
+				return err
+			}
+		}
+
+		attributes, err := s.validateAttributes(txCtx, companyID, in.Attributes)
+		if err != nil {
 			return err
 		}
 
-		now := s.clock.Now()
 		emp := &Employee{
-			CompanyID:    companyID,
-			EmployeeCode: code,
-			UserID:       userID,
-			Status:       status,
-			HiredAt:      cloneTime(hiredAt),
-			TerminatedAt: cloneTime(terminatedAt),
-			CreatedAt:    now,
-			UpdatedAt:    now,
+			CompanyID:         companyID,
+			EmployeeCode:      code,
+			UserID:            userID,
+			DepartmentID:      normalizeOptionalID(in.DepartmentID),
+			ManagerEmployeeID: managerID,
+			Status:            status,
+			HiredAt:           cloneTime(hiredAt),
+			TerminatedAt:      cloneTime(terminatedAt),
+			Attributes:        attributes,
+			CreatedAt:         now,
+			UpdatedAt:         now,
 		}
 
 		result, err := s.repo.Create(txCtx, emp)
 		if err != nil {
 			return err
 		}
+		if err := s.recordHistory(txCtx, result); err != nil {
+			return err
+		}
 
 		created = result
 		return nil
@@ -201,6 +401,7 @@ func (s *Service) UpdateEmployee(ctx context.Context, in UpdateEmployeeInput) (*
 		if err != nil {
 			return err
 		}
+		wasTerminated := isTerminated(existing)
 
 		if in.EmployeeCode != nil {
 			code, err := normalizeEmployeeCode(*in.EmployeeCode)
@@ -223,11 +424,37 @@ func (s *Service) UpdateEmployee(ctx context.Context, in UpdateEmployeeInput) (*
 			existing.UserID = userID
 		}
 
-		if in.Status != nil {
-			if !isValidStatus(*in.Status) {
-				return ErrInvalidStatus
+		if in.DepartmentID != nil {
+			existing.DepartmentID = normalizeOptionalID(in.DepartmentID)
+		}
+
+		if in.ManagerEmployeeID != nil {
+			managerID := normalizeOptionalID(in.ManagerEmployeeID)
+			if managerID != nil {
+				if err := s.ensureValidManager(txCtx, existing, *managerID); err != nil {
+					return err
+				}
+			}
+			existing.ManagerEmployeeID = managerID
+		}
+
+		if in.Attributes != nil {
+			merged := make(map[string]string, len(existing.Attributes)+len(in.Attributes))
+			for key, value := range existing.Attributes {
+				merged[key] = value
+			}
+			for key, value := range in.Attributes {
+				if strings.TrimSpace(value) == "" {
+					delete(merged, key)
+					continue
+				}
+				merged[key] = value
 			}
-			existing.Status = *in.Status
+			attributes, err := s.validateAttributes(txCtx, existing.CompanyID, merged)
+			if err != nil {
+				return err
+			}
+			existing.Attributes = attributes
 		}
 
 		if in.HiredAtSet {
@@ -238,16 +465,37 @@ func (s *Service) UpdateEmployee(ctx context.Context, in UpdateEmployeeInput) (*
 			existing.TerminatedAt = cloneTime(normalizeDate(in.TerminatedAt))
 		}
 
+		now := s.clock.Now()
+		status, terminatedAt, err := resolveStatus(existing.Status, in.Status, existing.HiredAt, existing.TerminatedAt, now)
+		if err != nil {
+			return err
+		}
+		existing.Status = status
+		existing.TerminatedAt = terminatedAt
+
 		if err := validateEmploymentPeriod(existing.HiredAt, existing.TerminatedAt); err != nil {
 			return err
 		}
 
-		existing.UpdatedAt = s.clock.Now()
+		existing.UpdatedAt = now
+
+		if in.ReassignReportsTo != nil {
+			if err := s.reassignDirectReports(txCtx, existing, normalizeOptionalID(in.ReassignReportsTo), existing.UpdatedAt); err != nil {
+				return err
+			}
+		} else if !wasTerminated && isTerminated(existing) {
+			if err := s.ensureNoDirectReports(txCtx, existing.ID, existing.TerminatedAt); err != nil {
+				return err
+			}
+		}
 
 		result, err := s.repo.Update(txCtx, existing)
 		if err != nil {
 			return err
 		}
+		if err := s.recordHistory(txCtx, result); err != nil {
+			return err
+		}
 
 		updated = result
 		return nil
@@ -258,92 +506,921 @@ func (s *Service) UpdateEmployee(ctx context.Context, in UpdateEmployeeInput) (*
 	return updated, nil
 }
 
-// DeleteEmployee は社員を削除します。
-func (s *Service) DeleteEmployee(ctx context.Context, in DeleteEmployeeInput) error {
+// TransferEmployee は社員を別の会社へ転籍させます。
+// 転籍元の退職と転籍先の社員作成を 1 つのトランザクションで行い、転籍先の社員に転籍元の社員 ID を記録します。
+func (s *Service) TransferEmployee(ctx context.Context, in TransferEmployeeInput) (*TransferEmployeeResult, error) {
 	if strings.TrimSpace(in.ID) == "" {
-		return fmt.Errorf("id: %w", ErrInvalidID)
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
 	}
 
-	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
-		return s.repo.Delete(txCtx, in.ID)
-	})
-}
+	targetCompanyID, err := normalizeCompanyID(in.TargetCompanyID)
+	if err != nil {
+		return nil, err
+	}
 
-// GetEmployee は社員を取得します。
-func (s *Service) GetEmployee(ctx context.Context, in GetEmployeeInput) (*Employee, error) {
-	if strings.TrimSpace(in.ID) == "" {
-		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	code, err := normalizeOptionalEmployeeCode(in.EmployeeCode)
+	if err != nil {
+		return nil, err
 	}
 
-	var result *Employee
+	if in.EffectiveDate.IsZero() {
+		return nil, ErrInvalidEffectiveDate
+	}
+	effectiveDate := normalizeDate(&in.EffectiveDate)
+	lastDay := effectiveDate.AddDate(0, 0, -1)
+
+	var result *TransferEmployeeResult
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		source, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+		if source.CompanyID == targetCompanyID {
+			return ErrTransferToSameCompany
+		}
+		if isTerminated(source) {
+			return ErrInvalidStatusTransition
+		}
+
+		if code == "" {
+			if code, err = s.allocateEmployeeCode(txCtx, targetCompanyID); err != nil {
+				return err
+			}
+		} else if err := s.ensureEmployeeCodeNotExists(txCtx, targetCompanyID, code); err != nil {
+			return err
+		}
+
+		managerID := normalizeOptionalID(in.ManagerEmployeeID)
+		if managerID != nil {
+			if err := s.ensureManagerInCompany(txCtx, targetCompanyID, *managerID); err != nil {
+				return err
+			}
+		}
+
+		attributes, err := s.validateAttributes(txCtx, targetCompanyID, in.Attributes)
+		if err != nil {
+			return err
+		}
+
+		// 転籍日が未来でも転籍元は部下を持ったまま退職予定になるため、付け替え先の指定を常に求めます。
+		if in.ReassignReportsTo == nil {
+			if err := s.ensureNoDirectReports(txCtx, source.ID, &lastDay); err != nil {
+				return err
+			}
+		}
+
+		previous, err := s.UpdateEmployee(txCtx, UpdateEmployeeInput{
+			ID:                source.ID,
+			ReassignReportsTo: in.ReassignReportsTo,
+			TerminatedAt:      &lastDay,
+			TerminatedAtSet:   true,
+		})
+		if err != nil {
+			return err
+		}
+
+		now := s.clock.Now()
+		status, _, err := resolveStatus("", nil, effectiveDate, nil, now)
+		if err != nil {
+			return err
+		}
+
+		created, err := s.repo.Create(txCtx, &Employee{
+			CompanyID:                 targetCompanyID,
+			EmployeeCode:              code,
+			UserID:                    source.UserID,
+			DepartmentID:              normalizeOptionalID(in.DepartmentID),
+			ManagerEmployeeID:         managerID,
+			Status:                    status,
+			HiredAt:                   cloneTime(effectiveDate),
+			TransferredFromEmployeeID: &source.ID,
+			Attributes:                attributes,
+			CreatedAt:                 now,
+			UpdatedAt:                 now,
+		})
+		if err != nil {
+			return err
+		}
+		if err := s.recordHistory(txCtx, created); err != nil {
+			return err
+		}
+
+		result = &TransferEmployeeResult{Previous: previous, Employee: created}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ApplyStatusTransitions は入社日・退職日を迎えた社員の状態を本日付に合わせて更新します。
+// 社員ごとに UpdateEmployee と同じ検証と履歴の記録を行い、更新できなかった社員は Failures に記録して処理を続けます。
+func (s *Service) ApplyStatusTransitions(ctx context.Context) (*ApplyStatusTransitionsResult, error) {
+	now := s.clock.Now()
+	today := *normalizeDate(&now)
+
+	var due []*Employee
 	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
-		found, err := s.repo.FindByID(txCtx, in.ID)
+		found, err := s.repo.ListDueStatusTransitions(txCtx, today)
 		if err != nil {
 			return err
 		}
-		result = found
+		due = found
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
+	result := &ApplyStatusTransitionsResult{}
+	for _, e := range due {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		updated, err := s.UpdateEmployee(ctx, UpdateEmployeeInput{ID: e.ID})
+		if err != nil {
+			result.Failures = append(result.Failures, StatusTransitionFailure{EmployeeID: e.ID, Err: err})
+			continue
+		}
+		result.Transitioned = append(result.Transitioned, updated)
+	}
+
 	return result, nil
 }
 
-// ListEmployees は社員の一覧を取得します。
-func (s *Service) ListEmployees(ctx context.Context, in ListEmployeesInput) (*ListEmployeesResult, error) {
-	companyID, err := normalizeCompanyID(in.CompanyID)
+// DeleteEmployee は社員を削除します。
+func (s *Service) DeleteEmployee(ctx context.Context, in DeleteEmployeeInput) error {
+	if strings.TrimSpace(in.ID) == "" {
+		return fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		return s.repo.Delete(txCtx, in.ID)
+	})
+}
+
+// TerminateUserEmployments はユーザーの退職していない社員レコードを本日付で退職させ、退職させた社員を返します。
+// 直属の部下は退職する社員の上長へ付け替え（上長がいない場合は解除）、入社前の社員は入社日を取り消して退職させます。
+func (s *Service) TerminateUserEmployments(ctx context.Context, userID string) ([]*Employee, error) {
+	userID, err := normalizeUserID(userID)
 	if err != nil {
 		return nil, err
 	}
 
-	limit, err := normalizePageSize(in.PageSize)
-	if err != nil {
+	var terminated []*Employee
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		employees, err := s.listAllUserEmployees(txCtx, userID)
+		if err != nil {
+			return err
+		}
+		for _, e := range employees {
+			if isTerminated(e) {
+				continue
+			}
+			status := StatusTerminated
+			reassignTo := ""
+			if e.ManagerEmployeeID != nil {
+				reassignTo = *e.ManagerEmployeeID
+			}
+			updated, err := s.UpdateEmployee(txCtx, UpdateEmployeeInput{
+				ID:                e.ID,
+				Status:            &status,
+				ReassignReportsTo: &reassignTo,
+				HiredAtSet:        e.Status == StatusPending,
+				TerminatedAtSet:   true,
+			})
+			if err != nil {
+				return err
+			}
+			terminated = append(terminated, updated)
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
-	offset, err := parsePageToken(in.PageToken)
+	return terminated, nil
+}
+
+// DeleteUserEmployments はユーザーの社員レコードを削除します。
+// 直属の部下が残っている場合は削除する社員の上長へ付け替えてから削除します。
+func (s *Service) DeleteUserEmployments(ctx context.Context, userID string) error {
+	userID, err := normalizeUserID(userID)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	var statusPtr *Status
-	if in.Status != nil {
-		if !isValidStatus(*in.Status) {
-			return nil, ErrInvalidStatus
+	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		employees, err := s.listAllUserEmployees(txCtx, userID)
+		if err != nil {
+			return err
 		}
-		status := *in.Status
-		statusPtr = &status
-	}
+		now := s.clock.Now()
+		for _, e := range employees {
+			// 先に削除した社員の部下を付け替えている場合があるため、最新の上長を参照します。
+			current, err := s.repo.FindByID(txCtx, e.ID)
+			if err != nil {
+				return err
+			}
+			if err := s.reassignDirectReports(txCtx, current, current.ManagerEmployeeID, now); err != nil {
+				return err
+			}
+			if err := s.repo.Delete(txCtx, current.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
 
-	var (
-		employees []*Employee
-		nextToken string
-	)
+// CountCompanyEmployees は会社に所属する退職済みを含む社員数と、退職していない社員数を返します。
+func (s *Service) CountCompanyEmployees(ctx context.Context, companyID string) (*CompanyEmployeeCounts, error) {
+	companyID, err := normalizeCompanyID(companyID)
+	if err != nil {
+		return nil, err
+	}
 
+	counts := &CompanyEmployeeCounts{}
 	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
-		resultEmployees, token, err := s.repo.List(txCtx, ListEmployeesFilter{
-			CompanyID: companyID,
-			Status:    statusPtr,
-			Limit:     limit,
-			Offset:    offset,
-		})
+		employees, err := s.listAllCompanyEmployees(txCtx, companyID)
 		if err != nil {
 			return err
 		}
-		employees = resultEmployees
-		nextToken = token
+		counts.Total = len(employees)
+		for _, e := range employees {
+			if !isTerminated(e) {
+				counts.Active++
+			}
+		}
 		return nil
 	}); err != nil {
 		return nil, err
 	}
 
-	return &ListEmployeesResult{Employees: employees, NextPageToken: nextToken}, nil
+	return counts, nil
 }
 
-func (s *Service) ensureEmployeeCodeNotExists(ctx context.Context, companyID, code string) error {
-	emp, err := s.repo.FindByCompanyAndCode(ctx, companyID, code)
-	if err != nil && !errors.Is(err, ErrEmployeeNotFound) {
+// TerminateCompanyEmployees は会社の退職していない社員に退職日 terminatedAt を設定し、更新した社員を返します。
+// 社員全員が退職するため報告ラインは付け替えません。terminatedAt 以前の退職日が設定済みの社員はそのままにし、
+// terminatedAt より後に入社予定の社員は入社日を取り消して本日付で退職させます。
+func (s *Service) TerminateCompanyEmployees(ctx context.Context, companyID string, terminatedAt time.Time) ([]*Employee, error) {
+	companyID, err := normalizeCompanyID(companyID)
+	if err != nil {
+		return nil, err
+	}
+	if terminatedAt.IsZero() {
+		return nil, ErrInvalidEffectiveDate
+	}
+	lastDay := normalizeDate(&terminatedAt)
+
+	var terminated []*Employee
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		employees, err := s.listAllCompanyEmployees(txCtx, companyID)
+		if err != nil {
+			return err
+		}
+		now := s.clock.Now()
+		for _, e := range employees {
+			if isTerminated(e) || (e.TerminatedAt != nil && !e.TerminatedAt.After(*lastDay)) {
+				continue
+			}
+
+			end := cloneTime(lastDay)
+			if e.HiredAt != nil && e.HiredAt.After(*lastDay) {
+				e.HiredAt = nil
+				end = normalizeDate(&now)
+			}
+
+			status, end, err := resolveStatus(e.Status, nil, e.HiredAt, end, now)
+			if err != nil {
+				return fmt.Errorf("employee %s: %w", e.ID, err)
+			}
+			e.Status = status
+			e.TerminatedAt = end
+			if err := validateEmploymentPeriod(e.HiredAt, e.TerminatedAt); err != nil {
+				return fmt.Errorf("employee %s: %w", e.ID, err)
+			}
+			e.UpdatedAt = now
+
+			updated, err := s.repo.Update(txCtx, e)
+			if err != nil {
+				return err
+			}
+			if err := s.recordHistory(txCtx, updated); err != nil {
+				return err
+			}
+			terminated = append(terminated, updated)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return terminated, nil
+}
+
+// GetEmployee は社員を取得します。
+func (s *Service) GetEmployee(ctx context.Context, in GetEmployeeInput) (*Employee, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	var result *Employee
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		found, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+		if in.AsOf == nil {
+			result = found
+			return nil
+		}
+
+		entry, err := s.repo.FindHistoryAsOf(txCtx, found.ID, *normalizeDate(in.AsOf))
+		if err != nil {
+			return err
+		}
+		result = employeeFromHistory(found, entry)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ListEmployeeHistory は社員レコードの変更履歴を有効開始日の降順で取得します。
+func (s *Service) ListEmployeeHistory(ctx context.Context, in ListEmployeeHistoryInput) (*ListEmployeeHistoryResult, error) {
+	if strings.TrimSpace(in.EmployeeID) == "" {
+		return nil, fmt.Errorf("employee_id: %w", ErrInvalidID)
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		entries   []*HistoryEntry
+		nextToken string
+	)
+
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.EmployeeID); err != nil {
+			return err
+		}
+
+		resultEntries, token, err := s.repo.ListHistory(txCtx, ListHistoryFilter{
+			EmployeeID: in.EmployeeID,
+			Limit:      limit,
+			Offset:     offset,
+		})
+		if err != nil {
+			return err
+		}
+		entries = resultEntries
+		nextToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ListEmployeeHistoryResult{Entries: entries, NextPageToken: nextToken}, nil
+}
+
+// recordHistory は社員の現在の内容を本日付で有効な履歴として保存します。
+func (s *Service) recordHistory(ctx context.Context, e *Employee) error {
+	_, err := s.repo.RecordHistory(ctx, &HistoryEntry{
+		EmployeeID:        e.ID,
+		CompanyID:         e.CompanyID,
+		EmployeeCode:      e.EmployeeCode,
+		UserID:            e.UserID,
+		DepartmentID:      cloneString(e.DepartmentID),
+		ManagerEmployeeID: cloneString(e.ManagerEmployeeID),
+		Status:            e.Status,
+		HiredAt:           cloneTime(e.HiredAt),
+		TerminatedAt:      cloneTime(e.TerminatedAt),
+		EffectiveFrom:     *normalizeDate(&e.UpdatedAt),
+		CreatedAt:         e.UpdatedAt,
+	})
+	return err
+}
+
+// listAllCompanyEmployees は会社に所属する社員をページングせずにすべて取得します。
+func (s *Service) listAllCompanyEmployees(ctx context.Context, companyID string) ([]*Employee, error) {
+	var employees []*Employee
+	for offset := 0; ; offset += maxListPageSize {
+		page, nextToken, err := s.repo.List(ctx, ListEmployeesFilter{
+			CompanyID: companyID,
+			Limit:     maxListPageSize,
+			Offset:    offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		employees = append(employees, page...)
+		if nextToken == "" {
+			return employees, nil
+		}
+	}
+}
+
+// listAllUserEmployees はユーザーの社員レコードをページングせずにすべて取得します。
+func (s *Service) listAllUserEmployees(ctx context.Context, userID string) ([]*Employee, error) {
+	var employees []*Employee
+	for offset := 0; ; offset += maxListPageSize {
+		page, nextToken, err := s.repo.ListEmploymentsByUser(ctx, ListEmploymentsFilter{
+			UserID: userID,
+			Limit:  maxListPageSize,
+			Offset: offset,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, employment := range page {
+			employees = append(employees, employment.Employee)
+		}
+		if nextToken == "" {
+			return employees, nil
+		}
+	}
+}
+
+// reassignDirectReports は manager の直属の部下の上長を newManagerID へ付け替え、付け替えた部下の履歴を記録します。
+// newManagerID が直属の部下自身の場合はその部下を昇格させ、manager の上長の配下へ移します。
+func (s *Service) reassignDirectReports(ctx context.Context, manager *Employee, newManagerID *string, updatedAt time.Time) error {
+	reports, err := s.repo.ListSubordinates(ctx, manager.ID, 1)
+	if err != nil {
+		return err
+	}
+	if newManagerID != nil {
+		if err := s.ensureValidReassignment(ctx, manager, reports, *newManagerID); err != nil {
+			return err
+		}
+		for _, report := range reports {
+			if report.ID != *newManagerID {
+				continue
+			}
+			report.ManagerEmployeeID = cloneString(manager.ManagerEmployeeID)
+			report.UpdatedAt = updatedAt
+			if _, err := s.repo.Update(ctx, report); err != nil {
+				return err
+			}
+		}
+	}
+	if err := s.repo.ReassignDirectReports(ctx, manager.ID, newManagerID, updatedAt); err != nil {
+		return err
+	}
+	for _, report := range reports {
+		reassigned, err := s.repo.FindByID(ctx, report.ID)
+		if err != nil {
+			return err
+		}
+		if err := s.recordHistory(ctx, reassigned); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// employeeFromHistory は履歴の内容で社員レコードを組み立てます。ユーザーが変わっていない場合のみ現在のユーザー情報を付与します。
+// 属性は履歴に記録していないため、現在の値を返します。
+func employeeFromHistory(current *Employee, entry *HistoryEntry) *Employee {
+	emp := &Employee{
+		ID:                        current.ID,
+		CompanyID:                 entry.CompanyID,
+		EmployeeCode:              entry.EmployeeCode,
+		UserID:                    entry.UserID,
+		DepartmentID:              cloneString(entry.DepartmentID),
+		ManagerEmployeeID:         cloneString(entry.ManagerEmployeeID),
+		Status:                    entry.Status,
+		HiredAt:                   cloneTime(entry.HiredAt),
+		TerminatedAt:              cloneTime(entry.TerminatedAt),
+		TransferredFromEmployeeID: cloneString(current.TransferredFromEmployeeID),
+		Attributes:                cloneAttributes(current.Attributes),
+		CreatedAt:                 current.CreatedAt,
+		UpdatedAt:                 entry.CreatedAt,
+	}
+	if entry.UserID == current.UserID {
+		emp.User = current.User
+	}
+	return emp
+}
+
+// ListEmployees は社員の一覧を取得します。
+func (s *Service) ListEmployees(ctx context.Context, in ListEmployeesInput) (*ListEmployeesResult, error) {
+	companyID, err := normalizeCompanyID(in.CompanyID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var statusPtr *Status
+	if in.Status != nil {
+		if !isValidStatus(*in.Status) {
+			return nil, ErrInvalidStatus
+		}
+		status := *in.Status
+		statusPtr = &status
+	}
+
+	var (
+		employees []*Employee
+		nextToken string
+	)
+
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		attributes, err := s.normalizeAttributeFilter(txCtx, companyID, in.Attributes)
+		if err != nil {
+			return err
+		}
+
+		resultEmployees, token, err := s.repo.List(txCtx, ListEmployeesFilter{
+			CompanyID:             companyID,
+			IncludeSubsidiaries:   in.IncludeSubsidiaries,
+			DepartmentID:          strings.TrimSpace(in.DepartmentID),
+			IncludeSubDepartments: in.IncludeSubDepartments,
+			Status:                statusPtr,
+			Attributes:            attributes,
+			Limit:                 limit,
+			Offset:                offset,
+		})
+		if err != nil {
+			return err
+		}
+		employees = resultEmployees
+		nextToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ListEmployeesResult{Employees: employees, NextPageToken: nextToken}, nil
+}
+
+// ListDirectReports は社員の直属の部下の一覧を取得します。
+func (s *Service) ListDirectReports(ctx context.Context, in ListDirectReportsInput) (*ListEmployeesResult, error) {
+	if strings.TrimSpace(in.EmployeeID) == "" {
+		return nil, fmt.Errorf("employee_id: %w", ErrInvalidID)
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		employees []*Employee
+		nextToken string
+	)
+
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.EmployeeID); err != nil {
+			return err
+		}
+
+		resultEmployees, token, err := s.repo.ListDirectReports(txCtx, ListDirectReportsFilter{
+			ManagerID: in.EmployeeID,
+			Limit:     limit,
+			Offset:    offset,
+		})
+		if err != nil {
+			return err
+		}
+		employees = resultEmployees
+		nextToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ListEmployeesResult{Employees: employees, NextPageToken: nextToken}, nil
+}
+
+// ListUserEmployments はユーザーが在籍している・在籍していた会社ごとの社員レコードを取得します。
+func (s *Service) ListUserEmployments(ctx context.Context, in ListUserEmploymentsInput) (*ListUserEmploymentsResult, error) {
+	userID, err := normalizeUserID(in.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit, err := normalizePageSize(in.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := parsePageToken(in.PageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		employments []*Employment
+		nextToken   string
+	)
+
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		result, token, err := s.repo.ListEmploymentsByUser(txCtx, ListEmploymentsFilter{
+			UserID: userID,
+			Limit:  limit,
+			Offset: offset,
+		})
+		if err != nil {
+			return err
+		}
+		employments = result
+		nextToken = token
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ListUserEmploymentsResult{Employments: employments, NextPageToken: nextToken}, nil
+}
+
+// GetReportingChain は社員の直近の上長から最上位の上長までを取得します。
+func (s *Service) GetReportingChain(ctx context.Context, in GetReportingChainInput) ([]*Employee, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	var chain []*Employee
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		if _, err := s.repo.FindByID(txCtx, in.ID); err != nil {
+			return err
+		}
+
+		result, err := s.repo.ListReportingChain(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+		chain = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+// GetOrgChart は社員を頂点とする組織図を指定された階層数まで取得します。
+func (s *Service) GetOrgChart(ctx context.Context, in GetOrgChartInput) (*OrgChartNode, error) {
+	if strings.TrimSpace(in.ID) == "" {
+		return nil, fmt.Errorf("id: %w", ErrInvalidID)
+	}
+
+	depth := in.Depth
+	if depth == 0 {
+		depth = defaultOrgChartDepth
+	}
+	if depth < 0 || depth > maxOrgChartDepth {
+		return nil, ErrInvalidOrgChartDepth
+	}
+
+	var chart *OrgChartNode
+	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		root, err := s.repo.FindByID(txCtx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		subordinates, err := s.repo.ListSubordinates(txCtx, root.ID, depth)
+		if err != nil {
+			return err
+		}
+		chart = buildOrgChart(root, subordinates)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return chart, nil
+}
+
+// buildOrgChart は階層の浅い順に並んだ部下を上長のノードへ順に割り当てて組織図を組み立てます。
+func buildOrgChart(root *Employee, subordinates []*Employee) *OrgChartNode {
+	chart := &OrgChartNode{Employee: root}
+	nodes := map[string]*OrgChartNode{root.ID: chart}
+	for _, emp := range subordinates {
+		if emp.ManagerEmployeeID == nil {
+			continue
+		}
+		parent, ok := nodes[*emp.ManagerEmployeeID]
+		if !ok {
+			continue
+		}
+		node := &OrgChartNode{Employee: emp}
+		parent.Reports = append(parent.Reports, node)
+		nodes[emp.ID] = node
+	}
+	return chart
+}
+
+// ensureManagerInCompany は上長が存在し、同じ会社に属することを確認します。
+func (s *Service) ensureManagerInCompany(ctx context.Context, companyID, managerID string) error {
+	manager, err := s.repo.FindByID(ctx, managerID)
+	if err != nil {
+		if errors.Is(err, ErrEmployeeNotFound) {
+			return ErrManagerNotFound
+		}
+		return err
+	}
+	if manager.CompanyID != companyID {
+		return ErrManagerNotFound
+	}
+	return nil
+}
+
+// ensureValidManager は emp の上長を managerID にした場合に報告ラインが循環しないことを確認します。
+// 同時に行われた付け替えで循環しないよう、確認の前に報告ラインのロックを取得します。
+func (s *Service) ensureValidManager(ctx context.Context, emp *Employee, managerID string) error {
+	if emp.ID == managerID {
+		return ErrReportingCycle
+	}
+	if err := s.repo.LockReportingLines(ctx); err != nil {
+		return err
+	}
+	if err := s.ensureManagerInCompany(ctx, emp.CompanyID, managerID); err != nil {
+		return err
+	}
+
+	chain, err := s.repo.ListReportingChain(ctx, managerID)
+	if err != nil {
+		return err
+	}
+	for _, manager := range chain {
+		if manager.ID == emp.ID {
+			return ErrReportingCycle
+		}
+	}
+	return nil
+}
+
+// ensureValidReassignment は manager の直属の部下 reports を managerID の配下へ付け替えた場合に報告ラインが循環しないことを確認します。
+// 付け替え先は付け替える部下の配下であってはなりません。付け替え先が部下自身の場合、その部下は付け替えの対象外です。
+func (s *Service) ensureValidReassignment(ctx context.Context, manager *Employee, reports []*Employee, managerID string) error {
+	if manager.ID == managerID {
+		return ErrReportingCycle
+	}
+	if err := s.repo.LockReportingLines(ctx); err != nil {
+		return err
+	}
+	if err := s.ensureManagerInCompany(ctx, manager.CompanyID, managerID); err != nil {
+		return err
+	}
+
+	chain, err := s.repo.ListReportingChain(ctx, managerID)
+	if err != nil {
+		return err
+	}
+	above := make(map[string]bool, len(chain))
+	for _, m := range chain {
+		above[m.ID] = true
+	}
+	for _, report := range reports {
+		if report.ID != managerID && above[report.ID] {
+			return ErrReportingCycle
+		}
+	}
+	return nil
+}
+
+// ensureNoDirectReports は上長が lastDay で退職した後も在籍する直属の部下がいないことを確認します。
+// lastDay までに退職する部下は、会社の無効化などで上長と同時に退職するため対象外とします。
+func (s *Service) ensureNoDirectReports(ctx context.Context, managerID string, lastDay *time.Time) error {
+	for offset := 0; ; offset += maxListPageSize {
+		reports, nextToken, err := s.repo.ListDirectReports(ctx, ListDirectReportsFilter{ManagerID: managerID, Limit: maxListPageSize, Offset: offset})
+		if err != nil {
+			return err
+		}
+		for _, report := range reports {
+			leaves := report.TerminatedAt != nil && lastDay != nil && !report.TerminatedAt.After(*lastDay)
+			if !isTerminated(report) && !leaves {
+				return ErrManagerHasReports
+			}
+		}
+		if nextToken == "" {
+			return nil
+		}
+	}
+}
+
+// validateAttributes は属性の値を会社の属性定義に従って検証・正規化します。
+// 定義のないキー、型に合わない値、必須属性の欠落は ErrInvalidAttribute を返します。
+func (s *Service) validateAttributes(ctx context.Context, companyID string, attributes map[string]string) (map[string]string, error) {
+	if s.attributes == nil {
+		return cloneAttributes(attributes), nil
+	}
+	definitions, err := s.attributes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[string]AttributeDefinition, len(definitions))
+	for _, def := range definitions {
+		byKey[def.Key] = def
+	}
+
+	normalized := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		def, ok := byKey[key]
+		if !ok {
+			return nil, fmt.Errorf("attributes.%s: undefined: %w", key, ErrInvalidAttribute)
+		}
+		v, err := normalizeAttributeValue(def, value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[key] = v
+	}
+	for _, def := range definitions {
+		if _, ok := normalized[def.Key]; def.Required && !ok {
+			return nil, fmt.Errorf("attributes.%s: required: %w", def.Key, ErrInvalidAttribute)
+		}
+	}
+	if len(normalized) == 0 {
+		return nil, nil
+	}
+	return normalized, nil
+}
+
+// normalizeAttributeFilter は一覧の絞り込みに指定した属性の値を会社の属性定義に従って正規化します。
+func (s *Service) normalizeAttributeFilter(ctx context.Context, companyID string, attributes map[string]string) (map[string]string, error) {
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+	if s.attributes == nil {
+		return cloneAttributes(attributes), nil
+	}
+	definitions, err := s.attributes.ListByCompany(ctx, companyID)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		var found bool
+		for _, def := range definitions {
+			if def.Key != key {
+				continue
+			}
+			v, err := normalizeAttributeValue(def, value)
+			if err != nil {
+				return nil, err
+			}
+			normalized[key] = v
+			found = true
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("attributes.%s: undefined: %w", key, ErrInvalidAttribute)
+		}
+	}
+	return normalized, nil
+}
+
+// allocateEmployeeCode は会社の採番規則から社員コードを払い出します。
+// 手動で登録済みのコードと重なった連番は読み飛ばします。
+func (s *Service) allocateEmployeeCode(ctx context.Context, companyID string) (string, error) {
+	for {
+		seq, err := s.repo.NextCodeSequence(ctx, companyID)
+		if err != nil {
+			return "", err
+		}
+		code := seq.Code()
+		err = s.ensureEmployeeCodeNotExists(ctx, companyID, code)
+		if err == nil {
+			return code, nil
+		}
+		if !errors.Is(err, ErrEmployeeCodeAlreadyExists) {
+			return "", err
+		}
+	}
+}
+
+func (s *Service) ensureEmployeeCodeNotExists(ctx context.Context, companyID, code string) error {
+	emp, err := s.repo.FindByCompanyAndCode(ctx, companyID, code)
+	if err != nil && !errors.Is(err, ErrEmployeeNotFound) {
 		return err
 	}
 	if emp != nil {
@@ -373,6 +1450,14 @@ func normalizeEmployeeCode(raw string) (string, error) {
 	return lower, nil
 }
 
+// normalizeOptionalEmployeeCode は省略可能な社員コードを正規化します。空の場合は空文字を返します。
+func normalizeOptionalEmployeeCode(raw string) (string, error) {
+	if strings.TrimSpace(raw) == "" {
+		return "", nil
+	}
+	return normalizeEmployeeCode(raw)
+}
+
 func normalizeUserID(raw string) (string, error) {
 	trimmed := strings.TrimSpace(raw)
 	if trimmed == "" {
@@ -384,6 +1469,17 @@ func normalizeUserID(raw string) (string, error) {
 	return trimmed, nil
 }
 
+func normalizeOptionalID(raw *string) *string {
+	if raw == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*raw)
+	if trimmed == "" {
+		return nil
+	}
+	return &trimmed
+}
+
 func normalizeDate(t *time.Time) *time.Time {
 	if t == nil {
 		return nil
@@ -403,6 +1499,58 @@ func validateEmploymentPeriod(hiredAt, terminatedAt *time.Time) error {
 	return nil
 }
 
+// normalizeAttributeValue は属性の型に応じて値を検証し、比較可能な文字列へ正規化します。
+// number は 10 進表記に、date は YYYY-MM-DD に揃えます。
+func normalizeAttributeValue(def AttributeDefinition, raw string) (string, error) {
+	value := strings.TrimSpace(raw)
+	if value == "" {
+		return "", fmt.Errorf("attributes.%s: empty: %w", def.Key, ErrInvalidAttribute)
+	}
+
+	switch def.Type {
+	case AttributeTypeNumber:
+		n, err := strconv.ParseFloat(value, 64)
+		if err != nil || math.IsNaN(n) || math.IsInf(n, 0) {
+			return "", fmt.Errorf("attributes.%s: not a number: %w", def.Key, ErrInvalidAttribute)
+		}
+		return strconv.FormatFloat(n, 'f', -1, 64), nil
+	case AttributeTypeDate:
+		t, err := time.Parse(attributeDateLayout, value)
+		if err != nil {
+			return "", fmt.Errorf("attributes.%s: not a date: %w", def.Key, ErrInvalidAttribute)
+		}
+		return t.Format(attributeDateLayout), nil
+	case AttributeTypeEnum:
+		for _, allowed := range def.AllowedValues {
+			if value == allowed {
+				return value, nil
+			}
+		}
+		return "", fmt.Errorf("attributes.%s: not an allowed value: %w", def.Key, ErrInvalidAttribute)
+	default:
+		return value, nil
+	}
+}
+
+func cloneAttributes(attributes map[string]string) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+	clone := make(map[string]string, len(attributes))
+	for key, value := range attributes {
+		clone[key] = value
+	}
+	return clone
+}
+
+func cloneString(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	clone := *s
+	return &clone
+}
+
 func cloneTime(t *time.Time) *time.Time {
 	if t == nil {
 		return nil
@@ -411,15 +1559,71 @@ func cloneTime(t *time.Time) *time.Time {
 	return &clone
 }
 
+func isTerminated(e *Employee) bool {
+	return e.Status == StatusTerminated
+}
+
 func isValidStatus(status Status) bool {
 	switch status {
-	case StatusActive, StatusInactive:
+	case StatusPending, StatusActive, StatusOnLeave, StatusTerminated:
 		return true
 	default:
 		return false
 	}
 }
 
+// resolveStatus は入退社日と指定された状態から保存する状態を決定します。
+// 入社日が未来なら pending、退職日が本日以前なら terminated とし、日付と矛盾する状態の指定は拒否します。
+// terminated を指定して退職日が未設定の場合は本日を退職日とします。
+// current が空の場合は新規作成（再雇用を含む）として遷移の検証を行いません。
+func resolveStatus(current Status, requested *Status, hiredAt, terminatedAt *time.Time, now time.Time) (Status, *time.Time, error) {
+	if requested != nil && !isValidStatus(*requested) {
+		return "", nil, ErrInvalidStatus
+	}
+
+	today := *normalizeDate(&now)
+	implied := statusFromDates(hiredAt, terminatedAt, today)
+
+	target := current
+	switch {
+	case requested != nil:
+		target = *requested
+	case implied != "":
+		target = implied
+	case current == "" || current == StatusPending:
+		target = StatusActive
+	}
+
+	if requested != nil && target == StatusTerminated && terminatedAt == nil {
+		terminatedAt = &today
+		implied = statusFromDates(hiredAt, terminatedAt, today)
+	}
+
+	if implied != "" && target != implied {
+		return "", nil, ErrInvalidStatusTransition
+	}
+	if implied == "" && (target == StatusPending || target == StatusTerminated) {
+		return "", nil, ErrInvalidStatusTransition
+	}
+	if current != "" && !current.CanTransitionTo(target) {
+		return "", nil, ErrInvalidStatusTransition
+	}
+
+	return target, terminatedAt, nil
+}
+
+// statusFromDates は日付から決まる状態を返します。日付から決まらない場合は空文字を返します。
+func statusFromDates(hiredAt, terminatedAt *time.Time, today time.Time) Status {
+	switch {
+	case terminatedAt != nil && !terminatedAt.After(today):
+		return StatusTerminated
+	case hiredAt != nil && hiredAt.After(today):
+		return StatusPending
+	default:
+		return ""
+	}
+}
+
 func normalizePageSize(pageSize int) (int, error) {
 	if pageSize <= 0 {
 		return defaultListPageSize, nil
@@ -18,13 +18,17 @@ func (s *stubClock) Now() time.Time {
 }
 
 type fakeEmployeeRepo struct {
-	employees map[string]*Employee
-	sequence  int
-	order     []string
+	employees     map[string]*Employee
+	sequence      int
+	order         []string
+	history       []*HistoryEntry
+	codeSequences map[string]*CodeSequence
+
+	reportingLineLocks int
 }
 
 func newFakeEmployeeRepo() *fakeEmployeeRepo {
-	return &fakeEmployeeRepo{employees: make(map[string]*Employee)}
+	return &fakeEmployeeRepo{employees: make(map[string]*Employee), codeSequences: make(map[string]*CodeSequence)}
 }
 
 const (
@@ -106,6 +110,9 @@ func (r *fakeEmployeeRepo) List(_ context.Context, filter ListEmployeesFilter) (
 		if filter.Status != nil && emp.Status != *filter.Status {
 			continue
 		}
+		if !matchesAttributes(emp.Attributes, filter.Attributes) {
+			continue
+		}
 		filtered = append(filtered, cloneEmployee(emp))
 	}
 
@@ -128,6 +135,196 @@ func (r *fakeEmployeeRepo) List(_ context.Context, filter ListEmployeesFilter) (
 	return page, nextToken, nil
 }
 
+func (r *fakeEmployeeRepo) ListDirectReports(_ context.Context, filter ListDirectReportsFilter) ([]*Employee, string, error) {
+	var filtered []*Employee
+	for _, id := range r.order {
+		emp := r.employees[id]
+		if emp.ManagerEmployeeID != nil && *emp.ManagerEmployeeID == filter.ManagerID {
+			filtered = append(filtered, cloneEmployee(emp))
+		}
+	}
+
+	if filter.Offset > len(filtered) {
+		return []*Employee{}, "", nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	nextToken := ""
+	if end < len(filtered) {
+		nextToken = strconv.Itoa(end)
+	}
+	return filtered[filter.Offset:end], nextToken, nil
+}
+
+func (r *fakeEmployeeRepo) ListEmploymentsByUser(_ context.Context, filter ListEmploymentsFilter) ([]*Employment, string, error) {
+	var filtered []*Employment
+	for i := len(r.order) - 1; i >= 0; i-- {
+		emp := r.employees[r.order[i]]
+		if emp.UserID == filter.UserID {
+			filtered = append(filtered, &Employment{Employee: cloneEmployee(emp), Company: &CompanySnapshot{ID: emp.CompanyID}})
+		}
+	}
+
+	if filter.Offset > len(filtered) {
+		return []*Employment{}, "", nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	nextToken := ""
+	if end < len(filtered) {
+		nextToken = strconv.Itoa(end)
+	}
+	return filtered[filter.Offset:end], nextToken, nil
+}
+
+func (r *fakeEmployeeRepo) ListReportingChain(_ context.Context, id string) ([]*Employee, error) {
+	emp, ok := r.employees[id]
+	if !ok {
+		return nil, ErrEmployeeNotFound
+	}
+	var chain []*Employee
+	for emp.ManagerEmployeeID != nil {
+		emp = r.employees[*emp.ManagerEmployeeID]
+		chain = append(chain, cloneEmployee(emp))
+	}
+	return chain, nil
+}
+
+func (r *fakeEmployeeRepo) LockReportingLines(context.Context) error {
+	r.reportingLineLocks++
+	return nil
+}
+
+func (r *fakeEmployeeRepo) ListSubordinates(_ context.Context, id string, maxDepth int) ([]*Employee, error) {
+	var result []*Employee
+	current := []string{id}
+	for depth := 0; depth < maxDepth && len(current) > 0; depth++ {
+		var next []string
+		for _, managerID := range current {
+			for _, empID := range r.order {
+				emp := r.employees[empID]
+				if emp.ManagerEmployeeID != nil && *emp.ManagerEmployeeID == managerID {
+					result = append(result, cloneEmployee(emp))
+					next = append(next, emp.ID)
+				}
+			}
+		}
+		current = next
+	}
+	return result, nil
+}
+
+func (r *fakeEmployeeRepo) ReassignDirectReports(_ context.Context, managerID string, newManagerID *string, updatedAt time.Time) error {
+	for _, emp := range r.employees {
+		if emp.ManagerEmployeeID != nil && *emp.ManagerEmployeeID == managerID {
+			if newManagerID != nil {
+				value := *newManagerID
+				emp.ManagerEmployeeID = &value
+			} else {
+				emp.ManagerEmployeeID = nil
+			}
+			emp.UpdatedAt = updatedAt
+		}
+	}
+	return nil
+}
+
+func (r *fakeEmployeeRepo) ListDueStatusTransitions(_ context.Context, asOf time.Time) ([]*Employee, error) {
+	var due []*Employee
+	for _, id := range r.order {
+		emp, ok := r.employees[id]
+		if !ok {
+			continue
+		}
+		hired := emp.Status == StatusPending && (emp.HiredAt == nil || !emp.HiredAt.After(asOf))
+		terminated := emp.Status != StatusTerminated && emp.TerminatedAt != nil && !emp.TerminatedAt.After(asOf)
+		if hired || terminated {
+			due = append(due, cloneEmployee(emp))
+		}
+	}
+	return due, nil
+}
+
+func (r *fakeEmployeeRepo) FindTerminatedByCompanyAndUser(_ context.Context, companyID, userID string) (*Employee, error) {
+	for _, id := range r.order {
+		emp := r.employees[id]
+		if emp.CompanyID == companyID && emp.UserID == userID && isTerminated(emp) {
+			return cloneEmployee(emp), nil
+		}
+	}
+	return nil, ErrEmployeeNotFound
+}
+
+func (r *fakeEmployeeRepo) NextCodeSequence(_ context.Context, companyID string) (*CodeSequence, error) {
+	next, ok := r.codeSequences[companyID]
+	if !ok {
+		return nil, ErrCompanyNotFound
+	}
+	seq := *next
+	next.Sequence++
+	return &seq, nil
+}
+
+func (r *fakeEmployeeRepo) RecordHistory(_ context.Context, entry *HistoryEntry) (*HistoryEntry, error) {
+	kept := r.history[:0]
+	for _, existing := range r.history {
+		if existing.EmployeeID == entry.EmployeeID && existing.EffectiveTo == nil {
+			if !existing.EffectiveFrom.Before(entry.EffectiveFrom) {
+				continue
+			}
+			to := entry.EffectiveFrom
+			existing.EffectiveTo = &to
+		}
+		kept = append(kept, existing)
+	}
+	clone := *entry
+	r.sequence++
+	clone.ID = fmt.Sprintf("history-%d", r.sequence)
+	r.history = append(kept, &clone)
+	result := clone
+	return &result, nil
+}
+
+func (r *fakeEmployeeRepo) ListHistory(_ context.Context, filter ListHistoryFilter) ([]*HistoryEntry, string, error) {
+	var filtered []*HistoryEntry
+	for i := len(r.history) - 1; i >= 0; i-- {
+		if r.history[i].EmployeeID == filter.EmployeeID {
+			clone := *r.history[i]
+			filtered = append(filtered, &clone)
+		}
+	}
+
+	if filter.Offset > len(filtered) {
+		return []*HistoryEntry{}, "", nil
+	}
+	end := filter.Offset + filter.Limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+	nextToken := ""
+	if end < len(filtered) {
+		nextToken = strconv.Itoa(end)
+	}
+	return filtered[filter.Offset:end], nextToken, nil
+}
+
+func (r *fakeEmployeeRepo) FindHistoryAsOf(_ context.Context, employeeID string, date time.Time) (*HistoryEntry, error) {
+	for _, entry := range r.history {
+		if entry.EmployeeID != employeeID || entry.EffectiveFrom.After(date) {
+			continue
+		}
+		if entry.EffectiveTo == nil || entry.EffectiveTo.After(date) {
+			clone := *entry
+			return &clone, nil
+		}
+	}
+	return nil, ErrHistoryNotFound
+}
+
 func cloneEmployee(emp *Employee) *Employee {
 	if emp == nil {
 		return nil
@@ -145,9 +342,35 @@ func cloneEmployee(emp *Employee) *Employee {
 		userCopy := *emp.User
 		copy.User = &userCopy
 	}
+	if emp.DepartmentID != nil {
+		departmentID := *emp.DepartmentID
+		copy.DepartmentID = &departmentID
+	}
+	if emp.ManagerEmployeeID != nil {
+		managerID := *emp.ManagerEmployeeID
+		copy.ManagerEmployeeID = &managerID
+	}
+	copy.Attributes = cloneAttributes(emp.Attributes)
 	return &copy
 }
 
+func matchesAttributes(attributes, filter map[string]string) bool {
+	for key, value := range filter {
+		if attributes[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+type stubAttributeSchemas struct {
+	definitions map[string][]AttributeDefinition
+}
+
+func (s *stubAttributeSchemas) ListByCompany(_ context.Context, companyID string) ([]AttributeDefinition, error) {
+	return s.definitions[companyID], nil
+}
+
 func TestService_CreateEmployee_Success(t *testing.T) {
 	t.Parallel()
 
@@ -284,7 +507,7 @@ func TestService_UpdateEmployee_Success(t *testing.T) {
 
 	newCode := "EMP-999"
 	newUser := "  " + userID5 + "  "
-	newStatus := StatusInactive
+	newStatus := StatusTerminated
 	hired := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
 	terminated := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
 
@@ -308,8 +531,8 @@ func TestService_UpdateEmployee_Success(t *testing.T) {
 	if updated.UserID != userID5 {
 		t.Fatalf("expected normalized user id, got %s", updated.UserID)
 	}
-	if updated.Status != StatusInactive {
-		t.Fatalf("expected status inactive, got %s", updated.Status)
+	if updated.Status != StatusTerminated {
+		t.Fatalf("expected status terminated, got %s", updated.Status)
 	}
 	if updated.HiredAt == nil || !updated.HiredAt.Equal(hired) {
 		t.Fatalf("expected hired date to update, got %+v", updated.HiredAt)
@@ -373,7 +596,7 @@ func TestService_ListEmployees_FilterAndPagination(t *testing.T) {
 	svc := NewService(repo, &stubClock{now: time.Now().UTC()}, nil)
 
 	// seed
-	statuses := []Status{StatusActive, StatusInactive, StatusActive}
+	statuses := []Status{StatusActive, StatusTerminated, StatusActive}
 	seedUserIDs := []string{userID1, userID2, userID3}
 	for i := 0; i < 3; i++ {
 		status := statuses[i]
@@ -387,7 +610,7 @@ func TestService_ListEmployees_FilterAndPagination(t *testing.T) {
 		}
 	}
 
-	inactive := StatusInactive
+	inactive := StatusTerminated
 	result, err := svc.ListEmployees(context.Background(), ListEmployeesInput{
 		CompanyID: "company-1",
 		PageSize:  2,
@@ -397,7 +620,7 @@ func TestService_ListEmployees_FilterAndPagination(t *testing.T) {
 		t.Fatalf("ListEmployees returned error: %v", err)
 	}
 	if len(result.Employees) != 1 {
-		t.Fatalf("expected 1 inactive employee, got %d", len(result.Employees))
+		t.Fatalf("expected 1 terminated employee, got %d", len(result.Employees))
 	}
 
 	active := StatusActive
@@ -456,3 +679,905 @@ func TestService_ListEmployees_InvalidCompanyID(t *testing.T) {
 		t.Fatalf("expected ErrInvalidCompanyID, got %v", err)
 	}
 }
+
+func TestService_EmployeeDepartmentAssignment(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	departmentID := " dept-1 "
+	created, err := svc.CreateEmployee(ctx, CreateEmployeeInput{
+		CompanyID:    "company-1",
+		EmployeeCode: "emp-001",
+		UserID:       userID1,
+		DepartmentID: &departmentID,
+	})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if created.DepartmentID == nil || *created.DepartmentID != "dept-1" {
+		t.Fatalf("expected normalized department id, got %v", created.DepartmentID)
+	}
+
+	other := "dept-2"
+	updated, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, DepartmentID: &other})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if updated.DepartmentID == nil || *updated.DepartmentID != other {
+		t.Fatalf("expected department %s, got %v", other, updated.DepartmentID)
+	}
+
+	clear := ""
+	updated, err = svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, DepartmentID: &clear})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if updated.DepartmentID != nil {
+		t.Fatalf("expected department to be cleared, got %v", *updated.DepartmentID)
+	}
+}
+
+func TestService_ReportingLines(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	create := func(code, userID string, managerID *string) *Employee {
+		t.Helper()
+		emp, err := svc.CreateEmployee(ctx, CreateEmployeeInput{
+			CompanyID:         "company-1",
+			EmployeeCode:      code,
+			UserID:            userID,
+			ManagerEmployeeID: managerID,
+		})
+		if err != nil {
+			t.Fatalf("CreateEmployee(%s) returned error: %v", code, err)
+		}
+		return emp
+	}
+
+	ceo := create("ceo", userID1, nil)
+	vp := create("vp", userID2, &ceo.ID)
+	lead := create("lead", userID3, &vp.ID)
+	member := create("member", userID4, &lead.ID)
+
+	reports, err := svc.ListDirectReports(ctx, ListDirectReportsInput{EmployeeID: vp.ID})
+	if err != nil {
+		t.Fatalf("ListDirectReports returned error: %v", err)
+	}
+	if len(reports.Employees) != 1 || reports.Employees[0].ID != lead.ID {
+		t.Fatalf("unexpected direct reports: %+v", reports.Employees)
+	}
+
+	chain, err := svc.GetReportingChain(ctx, GetReportingChainInput{ID: member.ID})
+	if err != nil {
+		t.Fatalf("GetReportingChain returned error: %v", err)
+	}
+	if len(chain) != 3 || chain[0].ID != lead.ID || chain[2].ID != ceo.ID {
+		t.Fatalf("unexpected reporting chain: %+v", chain)
+	}
+
+	chart, err := svc.GetOrgChart(ctx, GetOrgChartInput{ID: ceo.ID, Depth: 2})
+	if err != nil {
+		t.Fatalf("GetOrgChart returned error: %v", err)
+	}
+	if len(chart.Reports) != 1 || chart.Reports[0].Employee.ID != vp.ID {
+		t.Fatalf("unexpected org chart root reports: %+v", chart.Reports)
+	}
+	if len(chart.Reports[0].Reports) != 1 || len(chart.Reports[0].Reports[0].Reports) != 0 {
+		t.Fatalf("expected org chart to stop at depth 2")
+	}
+
+	if _, err := svc.GetOrgChart(ctx, GetOrgChartInput{ID: ceo.ID, Depth: maxOrgChartDepth + 1}); !errors.Is(err, ErrInvalidOrgChartDepth) {
+		t.Fatalf("expected ErrInvalidOrgChartDepth, got %v", err)
+	}
+
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: ceo.ID, ManagerEmployeeID: &member.ID}); !errors.Is(err, ErrReportingCycle) {
+		t.Fatalf("expected ErrReportingCycle, got %v", err)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: ceo.ID, ManagerEmployeeID: &ceo.ID}); !errors.Is(err, ErrReportingCycle) {
+		t.Fatalf("expected ErrReportingCycle for self, got %v", err)
+	}
+
+	missing := "emp-missing"
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: member.ID, ManagerEmployeeID: &missing}); !errors.Is(err, ErrManagerNotFound) {
+		t.Fatalf("expected ErrManagerNotFound, got %v", err)
+	}
+
+	outsider, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-2", EmployeeCode: "outsider", UserID: userID5})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "new", UserID: userID6, ManagerEmployeeID: &outsider.ID}); !errors.Is(err, ErrManagerNotFound) {
+		t.Fatalf("expected ErrManagerNotFound for other company, got %v", err)
+	}
+}
+
+func TestService_TerminateManager(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	ceo, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "ceo", UserID: userID1})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	manager, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "manager", UserID: userID2, ManagerEmployeeID: &ceo.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	report, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "report", UserID: userID3, ManagerEmployeeID: &manager.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	inactive := StatusTerminated
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: manager.ID, Status: &inactive}); !errors.Is(err, ErrManagerHasReports) {
+		t.Fatalf("expected ErrManagerHasReports, got %v", err)
+	}
+
+	member, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "member", UserID: userID4, ManagerEmployeeID: &report.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: manager.ID, Status: &inactive, ReassignReportsTo: &member.ID}); !errors.Is(err, ErrReportingCycle) {
+		t.Fatalf("expected ErrReportingCycle when reassigning under a moved report, got %v", err)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: manager.ID, Status: &inactive, ReassignReportsTo: &manager.ID}); !errors.Is(err, ErrReportingCycle) {
+		t.Fatalf("expected ErrReportingCycle when reassigning to the leaving employee, got %v", err)
+	}
+
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: manager.ID, Status: &inactive, ReassignReportsTo: &ceo.ID}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+
+	reassigned, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: report.ID})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if reassigned.ManagerEmployeeID == nil || *reassigned.ManagerEmployeeID != ceo.ID {
+		t.Fatalf("expected report to be reassigned to %s, got %v", ceo.ID, reassigned.ManagerEmployeeID)
+	}
+}
+
+func TestService_TerminateManager_PromoteDirectReport(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	svc := NewService(repo, &stubClock{now: time.Now()}, nil)
+	ctx := context.Background()
+
+	ceo, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "ceo", UserID: userID1})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	manager, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "manager", UserID: userID2, ManagerEmployeeID: &ceo.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	promoted, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "promoted", UserID: userID3, ManagerEmployeeID: &manager.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	peer, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "peer", UserID: userID4, ManagerEmployeeID: &manager.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	locks := repo.reportingLineLocks
+	inactive := StatusTerminated
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: manager.ID, Status: &inactive, ReassignReportsTo: &promoted.ID}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if repo.reportingLineLocks == locks {
+		t.Fatalf("expected the reporting lines to be locked before reassigning")
+	}
+
+	gotPromoted, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: promoted.ID})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if gotPromoted.ManagerEmployeeID == nil || *gotPromoted.ManagerEmployeeID != ceo.ID {
+		t.Fatalf("expected promoted report to move under %s, got %v", ceo.ID, gotPromoted.ManagerEmployeeID)
+	}
+	gotPeer, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: peer.ID})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if gotPeer.ManagerEmployeeID == nil || *gotPeer.ManagerEmployeeID != promoted.ID {
+		t.Fatalf("expected peer to report to %s, got %v", promoted.ID, gotPeer.ManagerEmployeeID)
+	}
+
+	history, err := svc.ListEmployeeHistory(ctx, ListEmployeeHistoryInput{EmployeeID: promoted.ID})
+	if err != nil {
+		t.Fatalf("ListEmployeeHistory returned error: %v", err)
+	}
+	latest := history.Entries[0]
+	if latest.ManagerEmployeeID == nil || *latest.ManagerEmployeeID != ceo.ID {
+		t.Fatalf("expected history to record the new manager, got %+v", latest)
+	}
+}
+
+func TestService_EmployeeHistory(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clock := &stubClock{now: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clock, nil)
+	ctx := context.Background()
+
+	created, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-001", UserID: userID1})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	clock.now = time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	code := "emp-100"
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, EmployeeCode: &code}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+
+	clock.now = time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC)
+	inactive := StatusTerminated
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &inactive}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+
+	history, err := svc.ListEmployeeHistory(ctx, ListEmployeeHistoryInput{EmployeeID: created.ID})
+	if err != nil {
+		t.Fatalf("ListEmployeeHistory returned error: %v", err)
+	}
+	if len(history.Entries) != 2 {
+		t.Fatalf("expected same-day updates to collapse into 2 entries, got %d", len(history.Entries))
+	}
+	latest, first := history.Entries[0], history.Entries[1]
+	if latest.Status != StatusTerminated || latest.EmployeeCode != "emp-100" || latest.EffectiveTo != nil {
+		t.Fatalf("unexpected latest entry: %+v", latest)
+	}
+	if first.EffectiveTo == nil || !first.EffectiveTo.Equal(latest.EffectiveFrom) {
+		t.Fatalf("expected first entry to be closed at %v, got %v", latest.EffectiveFrom, first.EffectiveTo)
+	}
+
+	asOf := time.Date(2024, 2, 15, 0, 0, 0, 0, time.UTC)
+	past, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: created.ID, AsOf: &asOf})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if past.EmployeeCode != "emp-001" || past.Status != StatusActive {
+		t.Fatalf("unexpected record as of %v: %+v", asOf, past)
+	}
+
+	beforeHire := time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: created.ID, AsOf: &beforeHire}); !errors.Is(err, ErrHistoryNotFound) {
+		t.Fatalf("expected ErrHistoryNotFound, got %v", err)
+	}
+}
+
+func TestService_CreateEmployee_RehireReopensEmployment(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clock := &stubClock{now: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clock, nil)
+	ctx := context.Background()
+
+	hired := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	original, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-001", UserID: userID1, HiredAt: &hired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	clock.now = time.Date(2024, 6, 30, 9, 0, 0, 0, time.UTC)
+	terminated := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	inactive := StatusTerminated
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: original.ID, Status: &inactive, TerminatedAt: &terminated, TerminatedAtSet: true}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+
+	clock.now = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	rehired := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	reopened, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-002", UserID: userID1, HiredAt: &rehired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if reopened.ID != original.ID {
+		t.Fatalf("expected re-hire to reopen %s, got %s", original.ID, reopened.ID)
+	}
+	if reopened.Status != StatusActive || reopened.TerminatedAt != nil || !reopened.HiredAt.Equal(rehired) || reopened.EmployeeCode != "emp-002" {
+		t.Fatalf("unexpected reopened employee: %+v", reopened)
+	}
+	if len(repo.employees) != 1 {
+		t.Fatalf("expected no new employee rows, got %d", len(repo.employees))
+	}
+
+	history, err := svc.ListEmployeeHistory(ctx, ListEmployeeHistoryInput{EmployeeID: original.ID})
+	if err != nil {
+		t.Fatalf("ListEmployeeHistory returned error: %v", err)
+	}
+	if len(history.Entries) != 3 {
+		t.Fatalf("expected 3 history entries, got %d", len(history.Entries))
+	}
+	if history.Entries[1].Status != StatusTerminated {
+		t.Fatalf("expected terminated period to be kept in history, got %+v", history.Entries[1])
+	}
+}
+
+func TestService_CreateEmployee_AllocatesCode(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	repo.codeSequences["company-1"] = &CodeSequence{Prefix: "emp-", Width: 4, Sequence: 1}
+	svc := NewService(repo, &stubClock{now: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)}, nil)
+	ctx := context.Background()
+
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-0002", UserID: userID1}); err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	first, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", UserID: userID2})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if first.EmployeeCode != "emp-0001" {
+		t.Fatalf("expected emp-0001, got %s", first.EmployeeCode)
+	}
+
+	second, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "  ", UserID: userID3})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if second.EmployeeCode != "emp-0003" {
+		t.Fatalf("expected manually registered emp-0002 to be skipped, got %s", second.EmployeeCode)
+	}
+	if next := repo.codeSequences["company-1"].Sequence; next != 4 {
+		t.Fatalf("expected next sequence 4, got %d", next)
+	}
+
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-2", UserID: userID4}); !errors.Is(err, ErrCompanyNotFound) {
+		t.Fatalf("expected ErrCompanyNotFound, got %v", err)
+	}
+
+	inactive := StatusTerminated
+	terminated := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: first.ID, Status: &inactive, TerminatedAt: &terminated, TerminatedAtSet: true}); err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	rehired, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", UserID: userID2})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if rehired.ID != first.ID || rehired.EmployeeCode != "emp-0001" {
+		t.Fatalf("expected re-hire to keep emp-0001, got %+v", rehired)
+	}
+	if next := repo.codeSequences["company-1"].Sequence; next != 4 {
+		t.Fatalf("expected re-hire not to consume a sequence, got next %d", next)
+	}
+}
+
+func TestCodeSequence_Code(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		seq  CodeSequence
+		want string
+	}{
+		{CodeSequence{Sequence: 7}, "7"},
+		{CodeSequence{Prefix: "hq-", Width: 5, Sequence: 42}, "hq-00042"},
+		{CodeSequence{Prefix: "hq-", Width: 2, Sequence: 123}, "hq-123"},
+	}
+	for _, tc := range cases {
+		if got := tc.seq.Code(); got != tc.want {
+			t.Fatalf("Code() = %s, want %s", got, tc.want)
+		}
+	}
+}
+
+func TestService_EmployeeAttributes(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	schemas := &stubAttributeSchemas{definitions: map[string][]AttributeDefinition{
+		"company-1": {
+			{Key: "grade", Type: AttributeTypeEnum, Required: true, AllowedValues: []string{"G1", "G2"}},
+			{Key: "joined_on", Type: AttributeTypeDate},
+			{Key: "salary_band", Type: AttributeTypeNumber},
+		},
+	}}
+	svc := NewService(repo, &stubClock{now: time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)}, nil, WithAttributeSchemas(schemas))
+	ctx := context.Background()
+
+	invalid := []map[string]string{
+		nil,
+		{"grade": "G3"},
+		{"grade": "G1", "unknown": "x"},
+		{"grade": "G1", "joined_on": "2024/01/01"},
+		{"grade": "G1", "salary_band": "high"},
+	}
+	for _, attrs := range invalid {
+		if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-x", UserID: userID1, Attributes: attrs}); !errors.Is(err, ErrInvalidAttribute) {
+			t.Fatalf("expected ErrInvalidAttribute for %v, got %v", attrs, err)
+		}
+	}
+
+	created, err := svc.CreateEmployee(ctx, CreateEmployeeInput{
+		CompanyID:    "company-1",
+		EmployeeCode: "emp-1",
+		UserID:       userID1,
+		Attributes:   map[string]string{"grade": "G1", "salary_band": "03.50"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if created.Attributes["salary_band"] != "3.5" {
+		t.Fatalf("expected normalized number 3.5, got %+v", created.Attributes)
+	}
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID2, Attributes: map[string]string{"grade": "G2"}}); err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	updated, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Attributes: map[string]string{"salary_band": "", "joined_on": "2024-01-01"}})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if len(updated.Attributes) != 2 || updated.Attributes["grade"] != "G1" || updated.Attributes["joined_on"] != "2024-01-01" {
+		t.Fatalf("expected merged attributes, got %+v", updated.Attributes)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Attributes: map[string]string{"grade": ""}}); !errors.Is(err, ErrInvalidAttribute) {
+		t.Fatalf("expected ErrInvalidAttribute when removing a required attribute, got %v", err)
+	}
+
+	result, err := svc.ListEmployees(ctx, ListEmployeesInput{CompanyID: "company-1", PageSize: 10, Attributes: map[string]string{"grade": "G1"}})
+	if err != nil {
+		t.Fatalf("ListEmployees returned error: %v", err)
+	}
+	if len(result.Employees) != 1 || result.Employees[0].ID != created.ID {
+		t.Fatalf("expected only emp-1, got %+v", result.Employees)
+	}
+	if _, err := svc.ListEmployees(ctx, ListEmployeesInput{CompanyID: "company-1", PageSize: 10, Attributes: map[string]string{"unknown": "x"}}); !errors.Is(err, ErrInvalidAttribute) {
+		t.Fatalf("expected ErrInvalidAttribute for undefined filter key, got %v", err)
+	}
+
+	asOf := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+	past, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: created.ID, AsOf: &asOf})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if len(past.Attributes) != 2 || past.Attributes["grade"] != "G1" || past.Attributes["joined_on"] != "2024-01-01" {
+		t.Fatalf("expected current attributes for as_of lookup, got %+v", past.Attributes)
+	}
+}
+
+func TestService_StatusLifecycle(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clk := &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clk, nil)
+	ctx := context.Background()
+
+	hired := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	created, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-1", UserID: userID1, HiredAt: &hired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if created.Status != StatusPending {
+		t.Fatalf("expected pending for future hire, got %s", created.Status)
+	}
+
+	onLeave := StatusOnLeave
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &onLeave}); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition for pending -> on_leave, got %v", err)
+	}
+	active := StatusActive
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &active}); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition for active before hire date, got %v", err)
+	}
+
+	clk.now = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	started, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID})
+	if err != nil {
+		t.Fatalf("UpdateEmployee returned error: %v", err)
+	}
+	if started.Status != StatusActive {
+		t.Fatalf("expected active once hire date arrives, got %s", started.Status)
+	}
+
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &onLeave}); err != nil {
+		t.Fatalf("UpdateEmployee on_leave returned error: %v", err)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &active}); err != nil {
+		t.Fatalf("UpdateEmployee back to active returned error: %v", err)
+	}
+
+	terminated := StatusTerminated
+	left, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &terminated})
+	if err != nil {
+		t.Fatalf("UpdateEmployee terminated returned error: %v", err)
+	}
+	today := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	if left.Status != StatusTerminated || left.TerminatedAt == nil || !left.TerminatedAt.Equal(today) {
+		t.Fatalf("expected termination dated today, got %+v", left)
+	}
+
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, Status: &active}); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition for terminated -> active, got %v", err)
+	}
+	if _, err := svc.UpdateEmployee(ctx, UpdateEmployeeInput{ID: created.ID, TerminatedAtSet: true}); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition when clearing termination date, got %v", err)
+	}
+
+	past := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID2, Status: &active, TerminatedAt: &past}); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition for active with past termination, got %v", err)
+	}
+	scheduled := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+	leaving, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID2, TerminatedAt: &scheduled})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if leaving.Status != StatusActive {
+		t.Fatalf("expected active until the termination date, got %s", leaving.Status)
+	}
+}
+
+func TestService_ApplyStatusTransitions(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clk := &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clk, nil)
+	ctx := context.Background()
+
+	hired := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	leaving := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	joiner, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-1", UserID: userID1, HiredAt: &hired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	leaver, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID2, TerminatedAt: &leaving})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	manager, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-3", UserID: userID3, TerminatedAt: &leaving})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-4", UserID: userID4, ManagerEmployeeID: &manager.ID}); err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	result, err := svc.ApplyStatusTransitions(ctx)
+	if err != nil {
+		t.Fatalf("ApplyStatusTransitions returned error: %v", err)
+	}
+	if len(result.Transitioned) != 0 || len(result.Failures) != 0 {
+		t.Fatalf("expected nothing due yet, got %+v", result)
+	}
+
+	clk.now = time.Date(2025, 4, 1, 0, 5, 0, 0, time.UTC)
+	result, err = svc.ApplyStatusTransitions(ctx)
+	if err != nil {
+		t.Fatalf("ApplyStatusTransitions returned error: %v", err)
+	}
+	if len(result.Transitioned) != 2 || result.Transitioned[0].ID != joiner.ID || result.Transitioned[1].ID != leaver.ID {
+		t.Fatalf("unexpected transitioned employees: %+v", result.Transitioned)
+	}
+	if result.Transitioned[0].Status != StatusActive || result.Transitioned[1].Status != StatusTerminated {
+		t.Fatalf("unexpected statuses: %s, %s", result.Transitioned[0].Status, result.Transitioned[1].Status)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].EmployeeID != manager.ID || !errors.Is(result.Failures[0].Err, ErrManagerHasReports) {
+		t.Fatalf("expected manager with reports to fail, got %+v", result.Failures)
+	}
+}
+
+func TestService_TransferEmployee(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clk := &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clk, nil)
+	ctx := context.Background()
+
+	source, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-1", UserID: userID1})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	report, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID2, ManagerEmployeeID: &source.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	targetManager, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-2", EmployeeCode: "boss", UserID: userID3})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	effective := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	in := TransferEmployeeInput{ID: source.ID, TargetCompanyID: "company-2", EmployeeCode: "new-1", EffectiveDate: effective, ManagerEmployeeID: &targetManager.ID}
+
+	sameCompany := in
+	sameCompany.TargetCompanyID = "company-1"
+	if _, err := svc.TransferEmployee(ctx, sameCompany); !errors.Is(err, ErrTransferToSameCompany) {
+		t.Fatalf("expected ErrTransferToSameCompany, got %v", err)
+	}
+	noDate := in
+	noDate.EffectiveDate = time.Time{}
+	if _, err := svc.TransferEmployee(ctx, noDate); !errors.Is(err, ErrInvalidEffectiveDate) {
+		t.Fatalf("expected ErrInvalidEffectiveDate, got %v", err)
+	}
+	duplicate := in
+	duplicate.EmployeeCode = "BOSS"
+	if _, err := svc.TransferEmployee(ctx, duplicate); !errors.Is(err, ErrEmployeeCodeAlreadyExists) {
+		t.Fatalf("expected ErrEmployeeCodeAlreadyExists, got %v", err)
+	}
+	wrongManager := in
+	wrongManager.ManagerEmployeeID = &report.ID
+	if _, err := svc.TransferEmployee(ctx, wrongManager); !errors.Is(err, ErrManagerNotFound) {
+		t.Fatalf("expected ErrManagerNotFound for manager in source company, got %v", err)
+	}
+	if _, err := svc.TransferEmployee(ctx, in); !errors.Is(err, ErrManagerHasReports) {
+		t.Fatalf("expected ErrManagerHasReports, got %v", err)
+	}
+
+	empty := ""
+	in.ReassignReportsTo = &empty
+	result, err := svc.TransferEmployee(ctx, in)
+	if err != nil {
+		t.Fatalf("TransferEmployee returned error: %v", err)
+	}
+
+	lastDay := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	if result.Previous.ID != source.ID || result.Previous.TerminatedAt == nil || !result.Previous.TerminatedAt.Equal(lastDay) {
+		t.Fatalf("expected source to be terminated on the day before transfer, got %+v", result.Previous)
+	}
+	if result.Previous.Status != StatusActive {
+		t.Fatalf("expected source to stay active until the termination date, got %s", result.Previous.Status)
+	}
+
+	created := result.Employee
+	if created.CompanyID != "company-2" || created.EmployeeCode != "new-1" || created.UserID != userID1 {
+		t.Fatalf("unexpected transferred employee: %+v", created)
+	}
+	if created.HiredAt == nil || !created.HiredAt.Equal(effective) || created.Status != StatusPending {
+		t.Fatalf("expected pending employee hired on the effective date, got %+v", created)
+	}
+	if created.TransferredFromEmployeeID == nil || *created.TransferredFromEmployeeID != source.ID {
+		t.Fatalf("expected link to source employee, got %v", created.TransferredFromEmployeeID)
+	}
+	if created.ManagerEmployeeID == nil || *created.ManagerEmployeeID != targetManager.ID {
+		t.Fatalf("expected manager in target company, got %v", created.ManagerEmployeeID)
+	}
+
+	detached, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: report.ID})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if detached.ManagerEmployeeID != nil {
+		t.Fatalf("expected report to be detached from transferred manager, got %v", *detached.ManagerEmployeeID)
+	}
+
+	clk.now = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	if _, err := svc.ApplyStatusTransitions(ctx); err != nil {
+		t.Fatalf("ApplyStatusTransitions returned error: %v", err)
+	}
+	past, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: created.ID, AsOf: &effective})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if past.TransferredFromEmployeeID == nil || *past.TransferredFromEmployeeID != source.ID {
+		t.Fatalf("expected as_of lookup to keep the link to source employee, got %v", past.TransferredFromEmployeeID)
+	}
+	in.EmployeeCode = "new-2"
+	in.TargetCompanyID = "company-3"
+	in.ManagerEmployeeID = nil
+	if _, err := svc.TransferEmployee(ctx, in); !errors.Is(err, ErrInvalidStatusTransition) {
+		t.Fatalf("expected ErrInvalidStatusTransition for terminated source, got %v", err)
+	}
+}
+
+func TestService_ListUserEmployments(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	svc := NewService(repo, &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}, nil)
+	ctx := context.Background()
+
+	for i, companyID := range []string{"company-1", "company-2", "company-3"} {
+		if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: companyID, EmployeeCode: fmt.Sprintf("emp-%d", i), UserID: userID1}); err != nil {
+			t.Fatalf("CreateEmployee returned error: %v", err)
+		}
+	}
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "other", UserID: userID2}); err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	first, err := svc.ListUserEmployments(ctx, ListUserEmploymentsInput{UserID: " " + userID1 + " ", PageSize: 2})
+	if err != nil {
+		t.Fatalf("ListUserEmployments returned error: %v", err)
+	}
+	if len(first.Employments) != 2 || first.NextPageToken != "2" {
+		t.Fatalf("unexpected first page: %d employments, token %q", len(first.Employments), first.NextPageToken)
+	}
+	if first.Employments[0].Company.ID != "company-3" || first.Employments[0].Employee.UserID != userID1 {
+		t.Fatalf("expected newest employment first, got %+v", first.Employments[0].Employee)
+	}
+
+	second, err := svc.ListUserEmployments(ctx, ListUserEmploymentsInput{UserID: userID1, PageSize: 2, PageToken: first.NextPageToken})
+	if err != nil {
+		t.Fatalf("ListUserEmployments returned error: %v", err)
+	}
+	if len(second.Employments) != 1 || second.NextPageToken != "" {
+		t.Fatalf("unexpected second page: %d employments, token %q", len(second.Employments), second.NextPageToken)
+	}
+
+	if _, err := svc.ListUserEmployments(ctx, ListUserEmploymentsInput{UserID: "not-a-uuid"}); !errors.Is(err, ErrInvalidUserID) {
+		t.Fatalf("expected ErrInvalidUserID, got %v", err)
+	}
+	if _, err := svc.ListUserEmployments(ctx, ListUserEmploymentsInput{UserID: userID1, PageToken: "x"}); !errors.Is(err, ErrInvalidPageToken) {
+		t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+	}
+}
+
+func TestService_TerminateAndDeleteUserEmployments(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clk := &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clk, nil)
+	ctx := context.Background()
+
+	boss, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "boss", UserID: userID2})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	active, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-1", UserID: userID1, ManagerEmployeeID: &boss.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	report, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID3, ManagerEmployeeID: &active.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	hired := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	pending, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-2", EmployeeCode: "emp-1", UserID: userID1, HiredAt: &hired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	if _, err := svc.TerminateUserEmployments(ctx, " "); !errors.Is(err, ErrInvalidUserID) {
+		t.Fatalf("expected ErrInvalidUserID, got %v", err)
+	}
+
+	terminated, err := svc.TerminateUserEmployments(ctx, userID1)
+	if err != nil {
+		t.Fatalf("TerminateUserEmployments returned error: %v", err)
+	}
+	if len(terminated) != 2 {
+		t.Fatalf("expected 2 terminated employees, got %d", len(terminated))
+	}
+	today := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	for _, e := range terminated {
+		if e.Status != StatusTerminated || e.TerminatedAt == nil || !e.TerminatedAt.Equal(today) {
+			t.Fatalf("expected employee terminated today, got %+v", e)
+		}
+		if e.ID == pending.ID && e.HiredAt != nil {
+			t.Fatalf("expected hire date of pending employee to be cleared, got %v", e.HiredAt)
+		}
+	}
+
+	reassigned, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: report.ID})
+	if err != nil {
+		t.Fatalf("GetEmployee returned error: %v", err)
+	}
+	if reassigned.ManagerEmployeeID == nil || *reassigned.ManagerEmployeeID != boss.ID {
+		t.Fatalf("expected report to be reassigned to %s, got %v", boss.ID, reassigned.ManagerEmployeeID)
+	}
+
+	if err := svc.DeleteUserEmployments(ctx, userID1); err != nil {
+		t.Fatalf("DeleteUserEmployments returned error: %v", err)
+	}
+	for _, id := range []string{active.ID, pending.ID} {
+		if _, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: id}); !errors.Is(err, ErrEmployeeNotFound) {
+			t.Fatalf("expected employee %s to be deleted, got %v", id, err)
+		}
+	}
+	if _, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: report.ID}); err != nil {
+		t.Fatalf("expected other employees to remain, got %v", err)
+	}
+}
+
+func TestService_TerminateCompanyEmployees(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeEmployeeRepo()
+	clk := &stubClock{now: time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)}
+	svc := NewService(repo, clk, nil)
+	ctx := context.Background()
+
+	boss, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "boss", UserID: userID1})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	report, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-1", UserID: userID2, ManagerEmployeeID: &boss.ID})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	hired := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	pending, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-2", UserID: userID3, HiredAt: &hired})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	earlier := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+	leaving, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-1", EmployeeCode: "emp-3", UserID: userID3, TerminatedAt: &earlier})
+	if err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+	if _, err := svc.CreateEmployee(ctx, CreateEmployeeInput{CompanyID: "company-2", EmployeeCode: "other", UserID: userID1}); err != nil {
+		t.Fatalf("CreateEmployee returned error: %v", err)
+	}
+
+	counts, err := svc.CountCompanyEmployees(ctx, "company-1")
+	if err != nil {
+		t.Fatalf("CountCompanyEmployees returned error: %v", err)
+	}
+	if counts.Total != 4 || counts.Active != 4 {
+		t.Fatalf("expected 4 active employees, got %+v", counts)
+	}
+
+	if _, err := svc.TerminateCompanyEmployees(ctx, "company-1", time.Time{}); !errors.Is(err, ErrInvalidEffectiveDate) {
+		t.Fatalf("expected ErrInvalidEffectiveDate, got %v", err)
+	}
+
+	lastDay := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	terminated, err := svc.TerminateCompanyEmployees(ctx, "company-1", lastDay)
+	if err != nil {
+		t.Fatalf("TerminateCompanyEmployees returned error: %v", err)
+	}
+	if len(terminated) != 3 {
+		t.Fatalf("expected 3 updated employees, got %d", len(terminated))
+	}
+
+	for _, id := range []string{boss.ID, report.ID} {
+		got, err := svc.GetEmployee(ctx, GetEmployeeInput{ID: id})
+		if err != nil {
+			t.Fatalf("GetEmployee returned error: %v", err)
+		}
+		if got.Status != StatusActive || got.TerminatedAt == nil || !got.TerminatedAt.Equal(lastDay) {
+			t.Fatalf("expected %s to stay active until %v, got %+v", id, lastDay, got)
+		}
+	}
+	gotReport, _ := svc.GetEmployee(ctx, GetEmployeeInput{ID: report.ID})
+	if gotReport.ManagerEmployeeID == nil || *gotReport.ManagerEmployeeID != boss.ID {
+		t.Fatalf("expected reporting line to be kept, got %v", gotReport.ManagerEmployeeID)
+	}
+
+	gotPending, _ := svc.GetEmployee(ctx, GetEmployeeInput{ID: pending.ID})
+	today := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	if gotPending.Status != StatusTerminated || gotPending.HiredAt != nil || !gotPending.TerminatedAt.Equal(today) {
+		t.Fatalf("expected pending employee to be cancelled today, got %+v", gotPending)
+	}
+
+	gotLeaving, _ := svc.GetEmployee(ctx, GetEmployeeInput{ID: leaving.ID})
+	if !gotLeaving.TerminatedAt.Equal(earlier) {
+		t.Fatalf("expected earlier termination date to be kept, got %v", gotLeaving.TerminatedAt)
+	}
+
+	clk.now = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+	if _, err := svc.ApplyStatusTransitions(ctx); err != nil {
+		t.Fatalf("ApplyStatusTransitions returned error: %v", err)
+	}
+	counts, err = svc.CountCompanyEmployees(ctx, "company-1")
+	if err != nil {
+		t.Fatalf("CountCompanyEmployees returned error: %v", err)
+	}
+	if counts.Total != 4 || counts.Active != 0 {
+		t.Fatalf("expected all employees to be terminated, got %+v", counts)
+	}
+}
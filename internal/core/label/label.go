@@ -0,0 +1,112 @@
+// Package label は会社・ユーザーに付与するラベル（key=value）の検証とラベルセレクタを提供します。
+// 書式は Kubernetes のラベルに合わせています。会社・ユーザーの各ドメインで同じ規則を共有するため、
+// ドメインに依存しない値オブジェクトとして切り出しています。
+package label
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxLabels は 1 件の会社・ユーザーに付与できるラベルの最大数です。
+const MaxLabels = 64
+
+const (
+	maxNameLength   = 63
+	maxPrefixLength = 253
+	maxValueLength  = 63
+)
+
+var (
+	namePattern   = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	prefixPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+var (
+	// ErrInvalidLabel はラベルのキーまたは値の形式が不正な場合に返却されます。
+	ErrInvalidLabel = errors.New("invalid label")
+	// ErrTooManyLabels はラベル数が MaxLabels を超える場合に返却されます。
+	ErrTooManyLabels = errors.New("too many labels")
+	// ErrInvalidSelector はラベルセレクタの構文が不正な場合に返却されます。
+	ErrInvalidSelector = errors.New("invalid label selector")
+)
+
+// Validate はラベルのキー・値の形式と件数を検証します。
+func Validate(labels map[string]string) error {
+	if len(labels) > MaxLabels {
+		return fmt.Errorf("%w: %d > %d", ErrTooManyLabels, len(labels), MaxLabels)
+	}
+	for key, value := range labels {
+		if !isValidKey(key) {
+			return fmt.Errorf("labels.%s: key: %w", key, ErrInvalidLabel)
+		}
+		if !isValidValue(value) {
+			return fmt.Errorf("labels.%s: value: %w", key, ErrInvalidLabel)
+		}
+	}
+	return nil
+}
+
+// Apply は current に set のラベルを追加・上書きし、remove のキーを取り除いた新しいラベルを返します。
+// 結果は Validate で検証します。
+func Apply(current, set map[string]string, remove []string) (map[string]string, error) {
+	for _, key := range remove {
+		if !isValidKey(key) {
+			return nil, fmt.Errorf("labels.%s: key: %w", key, ErrInvalidLabel)
+		}
+	}
+
+	merged := make(map[string]string, len(current)+len(set))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for _, key := range remove {
+		delete(merged, key)
+	}
+	for key, value := range set {
+		merged[key] = value
+	}
+	if err := Validate(merged); err != nil {
+		return nil, err
+	}
+	if len(merged) == 0 {
+		return nil, nil
+	}
+	return merged, nil
+}
+
+// Clone はラベルを複製します。空の場合は nil を返します。
+func Clone(labels map[string]string) map[string]string {
+	if len(labels) == 0 {
+		return nil
+	}
+	clone := make(map[string]string, len(labels))
+	for key, value := range labels {
+		clone[key] = value
+	}
+	return clone
+}
+
+// isValidKey は [prefix/]name 形式のキーかを判定します。
+// prefix は DNS サブドメイン形式（253 文字以内）、name は英数字で始まり英数字で終わる 63 文字以内の文字列です。
+func isValidKey(key string) bool {
+	name := key
+	if i := strings.LastIndex(key, "/"); i >= 0 {
+		prefix := key[:i]
+		if prefix == "" || len(prefix) > maxPrefixLength || !prefixPattern.MatchString(prefix) {
+			return false
+		}
+		name = key[i+1:]
+	}
+	return name != "" && len(name) <= maxNameLength && namePattern.MatchString(name)
+}
+
+// isValidValue は空文字または name と同じ形式の値かを判定します。
+func isValidValue(value string) bool {
+	if value == "" {
+		return true
+	}
+	return len(value) <= maxValueLength && namePattern.MatchString(value)
+}
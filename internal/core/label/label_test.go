@@ -0,0 +1,134 @@
+package label
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	valid := []map[string]string{
+		nil,
+		{"region": "apac"},
+		{"example.com/tier": "enterprise", "team": ""},
+		{"a.b_c-d": "X.y_z-1"},
+	}
+	for _, labels := range valid {
+		if err := Validate(labels); err != nil {
+			t.Fatalf("expected %v to be valid, got %v", labels, err)
+		}
+	}
+
+	invalid := []map[string]string{
+		{"": "x"},
+		{"-region": "apac"},
+		{"region-": "apac"},
+		{"Example.com/tier": "x"},
+		{"/tier": "x"},
+		{"a/b/c": "x"},
+		{"region": "ap ac"},
+		{"region": "-apac"},
+		{"region": string(make([]byte, 64))},
+	}
+	for _, labels := range invalid {
+		if err := Validate(labels); !errors.Is(err, ErrInvalidLabel) {
+			t.Fatalf("expected ErrInvalidLabel for %v, got %v", labels, err)
+		}
+	}
+
+	many := make(map[string]string, MaxLabels+1)
+	for i := 0; i <= MaxLabels; i++ {
+		many[fmt.Sprintf("key%d", i)] = "v"
+	}
+	if err := Validate(many); !errors.Is(err, ErrTooManyLabels) {
+		t.Fatalf("expected ErrTooManyLabels, got %v", err)
+	}
+}
+
+func TestApply(t *testing.T) {
+	t.Parallel()
+
+	current := map[string]string{"region": "apac", "tier": "free"}
+	got, err := Apply(current, map[string]string{"tier": "enterprise", "team": "core"}, []string{"region"})
+	if err != nil {
+		t.Fatalf("Apply returned error: %v", err)
+	}
+	if len(got) != 2 || got["tier"] != "enterprise" || got["team"] != "core" {
+		t.Fatalf("unexpected labels: %v", got)
+	}
+	if current["tier"] != "free" || current["region"] != "apac" {
+		t.Fatalf("expected current labels to be left untouched, got %v", current)
+	}
+
+	if got, err := Apply(current, nil, []string{"region", "tier"}); err != nil || got != nil {
+		t.Fatalf("expected nil labels after removing all, got %v (%v)", got, err)
+	}
+	if _, err := Apply(current, nil, []string{"bad key"}); !errors.Is(err, ErrInvalidLabel) {
+		t.Fatalf("expected ErrInvalidLabel for invalid remove key, got %v", err)
+	}
+}
+
+func TestParseSelector(t *testing.T) {
+	t.Parallel()
+
+	selector, err := ParseSelector(" region=apac, tier != free ,example.com/team==core,owner,!deprecated ")
+	if err != nil {
+		t.Fatalf("ParseSelector returned error: %v", err)
+	}
+	want := Selector{
+		{Key: "region", Operator: OperatorEquals, Value: "apac"},
+		{Key: "tier", Operator: OperatorNotEquals, Value: "free"},
+		{Key: "example.com/team", Operator: OperatorEquals, Value: "core"},
+		{Key: "owner", Operator: OperatorExists},
+		{Key: "deprecated", Operator: OperatorDoesNotExist},
+	}
+	if len(selector) != len(want) {
+		t.Fatalf("expected %d requirements, got %+v", len(want), selector)
+	}
+	for i := range want {
+		if selector[i] != want[i] {
+			t.Fatalf("requirement[%d]: expected %+v, got %+v", i, want[i], selector[i])
+		}
+	}
+
+	if selector, err := ParseSelector("  "); err != nil || selector != nil {
+		t.Fatalf("expected empty selector, got %+v (%v)", selector, err)
+	}
+
+	for _, raw := range []string{"region=apac,", "=apac", "region=ap ac", "!", "region in (apac)", "!region=apac"} {
+		if _, err := ParseSelector(raw); !errors.Is(err, ErrInvalidSelector) {
+			t.Fatalf("expected ErrInvalidSelector for %q, got %v", raw, err)
+		}
+	}
+}
+
+func TestSelector_Matches(t *testing.T) {
+	t.Parallel()
+
+	selector, err := ParseSelector("region=apac,tier!=free,!deprecated")
+	if err != nil {
+		t.Fatalf("ParseSelector returned error: %v", err)
+	}
+
+	cases := []struct {
+		labels map[string]string
+		want   bool
+	}{
+		{map[string]string{"region": "apac", "tier": "enterprise"}, true},
+		{map[string]string{"region": "apac"}, true},
+		{map[string]string{"region": "apac", "tier": "free"}, false},
+		{map[string]string{"region": "emea"}, false},
+		{map[string]string{"region": "apac", "deprecated": ""}, false},
+		{nil, false},
+	}
+	for _, tc := range cases {
+		if got := selector.Matches(tc.labels); got != tc.want {
+			t.Fatalf("Matches(%v) = %v, want %v", tc.labels, got, tc.want)
+		}
+	}
+	if !Selector(nil).Matches(nil) {
+		t.Fatalf("expected empty selector to match everything")
+	}
+}
@@ -0,0 +1,108 @@
+package label
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operator はラベルセレクタの条件の種類です。
+type Operator string
+
+const (
+	// OperatorEquals はキーが存在し値が一致する条件です（key=value / key==value）。
+	OperatorEquals Operator = "="
+	// OperatorNotEquals は値が一致しない条件です（key!=value）。キーを持たない場合も一致します。
+	OperatorNotEquals Operator = "!="
+	// OperatorExists はキーが存在する条件です（key）。
+	OperatorExists Operator = "exists"
+	// OperatorDoesNotExist はキーが存在しない条件です（!key）。
+	OperatorDoesNotExist Operator = "!"
+)
+
+// Requirement はラベルセレクタの 1 つの条件です。
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Value は OperatorEquals / OperatorNotEquals の場合のみ利用します。
+	Value string
+}
+
+// Selector はすべての条件を満たすラベルに一致するラベルセレクタです。空の場合はすべてに一致します。
+type Selector []Requirement
+
+// ParseSelector は "region=apac,tier!=free" のようなカンマ区切りのラベルセレクタを解析します。
+// 条件には key=value、key==value、key!=value、key（存在）、!key（非存在）を指定できます。
+func ParseSelector(raw string) (Selector, error) {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" {
+		return nil, nil
+	}
+
+	terms := strings.Split(trimmed, ",")
+	selector := make(Selector, 0, len(terms))
+	for _, term := range terms {
+		req, err := parseRequirement(strings.TrimSpace(term))
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, req)
+	}
+	return selector, nil
+}
+
+func parseRequirement(term string) (Requirement, error) {
+	if term == "" {
+		return Requirement{}, fmt.Errorf("%w: empty requirement", ErrInvalidSelector)
+	}
+
+	var req Requirement
+	switch {
+	case strings.HasPrefix(term, "!") && !strings.Contains(term, "="):
+		req = Requirement{Key: strings.TrimSpace(term[1:]), Operator: OperatorDoesNotExist}
+	case strings.Contains(term, "!="):
+		key, value, _ := strings.Cut(term, "!=")
+		req = Requirement{Key: strings.TrimSpace(key), Operator: OperatorNotEquals, Value: strings.TrimSpace(value)}
+	case strings.Contains(term, "=="):
+		key, value, _ := strings.Cut(term, "==")
+		req = Requirement{Key: strings.TrimSpace(key), Operator: OperatorEquals, Value: strings.TrimSpace(value)}
+	case strings.Contains(term, "="):
+		key, value, _ := strings.Cut(term, "=")
+		req = Requirement{Key: strings.TrimSpace(key), Operator: OperatorEquals, Value: strings.TrimSpace(value)}
+	default:
+		req = Requirement{Key: term, Operator: OperatorExists}
+	}
+
+	if !isValidKey(req.Key) {
+		return Requirement{}, fmt.Errorf("%w: %q: key", ErrInvalidSelector, term)
+	}
+	if !isValidValue(req.Value) {
+		return Requirement{}, fmt.Errorf("%w: %q: value", ErrInvalidSelector, term)
+	}
+	return req, nil
+}
+
+// Matches はラベルがセレクタのすべての条件を満たすかを判定します。
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, req := range s {
+		value, ok := labels[req.Key]
+		switch req.Operator {
+		case OperatorEquals:
+			if !ok || value != req.Value {
+				return false
+			}
+		case OperatorNotEquals:
+			if ok && value == req.Value {
+				return false
+			}
+		case OperatorExists:
+			if !ok {
+				return false
+			}
+		case OperatorDoesNotExist:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,187 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
+)
+
+// EmailChangeToken はメールアドレス変更の確認トークンです。トークン自体は保存せず、SHA-256 のハッシュ値のみを保持します。
+type EmailChangeToken struct {
+	ID        string
+	UserID    string
+	NewEmail  string
+	TokenHash string
+	ExpiresAt time.Time
+	// UsedAt は確認に使われた日時です。未使用の場合は nil です。
+	UsedAt    *time.Time
+	CreatedAt time.Time
+}
+
+// EmailChangeTokenRepository はメールアドレス変更トークンの永続化を行うインターフェースです。
+type EmailChangeTokenRepository interface {
+	Create(ctx context.Context, token *EmailChangeToken) (*EmailChangeToken, error)
+	// FindByHash はハッシュ値でトークンを取得します。存在しない場合は ErrInvalidEmailChangeToken を返します。
+	FindByHash(ctx context.Context, tokenHash string) (*EmailChangeToken, error)
+	// MarkUsed は未使用のトークンを使用済みにします。使用済みまたは存在しない場合は ErrInvalidEmailChangeToken を返します。
+	MarkUsed(ctx context.Context, id string, usedAt time.Time) error
+	// DeleteUnusedByUser はユーザーの未使用のトークンを削除します。
+	DeleteUnusedByUser(ctx context.Context, userID string) error
+}
+
+// WithEmailChange はメールアドレス変更に使うトークンのリポジトリとメール送信を設定します。
+// 未設定の場合、メールアドレス変更は ErrEmailChangeUnavailable を返します。
+func WithEmailChange(tokens EmailChangeTokenRepository, mailer verification.Mailer) Option {
+	return func(s *Service) {
+		s.emailChangeTokens = tokens
+		s.mailer = mailer
+	}
+}
+
+// RequestEmailChangeInput はメールアドレス変更の要求時の入力です。
+type RequestEmailChangeInput struct {
+	UserID   string
+	NewEmail string
+}
+
+// ConfirmEmailChangeInput はメールアドレス変更の確認時の入力です。
+type ConfirmEmailChangeInput struct {
+	Token string
+}
+
+// RequestEmailChange は新しいメールアドレス宛てに確認トークンを送信します。
+// 現在のメールアドレスを指定した場合は、未確認のメールアドレスの確認として扱います。
+// 同じユーザーの未使用のトークンは無効になります。メールアドレスの重複は確認時に検証します。
+func (s *Service) RequestEmailChange(ctx context.Context, in RequestEmailChangeInput) (*EmailChangeToken, error) {
+	if s.emailChangeTokens == nil || s.mailer == nil {
+		return nil, ErrEmailChangeUnavailable
+	}
+	if strings.TrimSpace(in.UserID) == "" {
+		return nil, fmt.Errorf("user_id: %w", ErrInvalidID)
+	}
+	email, err := normalizeEmail(in.NewEmail)
+	if err != nil {
+		return nil, ErrInvalidEmail
+	}
+
+	var (
+		issued *EmailChangeToken
+		mail   verification.Mail
+	)
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		u, err := s.repo.FindByID(txCtx, in.UserID)
+		if err != nil {
+			return err
+		}
+		if u.Email == email && u.EmailVerifiedAt != nil {
+			return ErrEmailUnchanged
+		}
+
+		if err := s.emailChangeTokens.DeleteUnusedByUser(txCtx, u.ID); err != nil {
+			return err
+		}
+
+		raw, err := s.newToken()
+		if err != nil {
+			return fmt.Errorf("generate email change token: %w", err)
+		}
+
+		now := s.clock.Now()
+		created, err := s.emailChangeTokens.Create(txCtx, &EmailChangeToken{
+			UserID:    u.ID,
+			NewEmail:  email,
+			TokenHash: verification.HashToken(raw),
+			ExpiresAt: now.Add(verification.TokenTTL),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+
+		issued = created
+		mail = emailChangeMail(u, created, raw)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// 送信に失敗したトークンは平文がどこにも残らないため使用できず、期限切れか次の要求で無効になります。
+	if err := s.mailer.Send(ctx, mail); err != nil {
+		return nil, fmt.Errorf("send email change mail: %w", err)
+	}
+
+	return issued, nil
+}
+
+// ConfirmEmailChange はトークンを検証し、ユーザーのメールアドレスを変更して確認済みにします。
+// トークンは一度だけ使用できます。
+func (s *Service) ConfirmEmailChange(ctx context.Context, in ConfirmEmailChangeInput) (*User, error) {
+	if s.emailChangeTokens == nil {
+		return nil, ErrEmailChangeUnavailable
+	}
+	raw := strings.TrimSpace(in.Token)
+	if raw == "" {
+		return nil, ErrInvalidEmailChangeToken
+	}
+
+	var updated *User
+	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		token, err := s.emailChangeTokens.FindByHash(txCtx, verification.HashToken(raw))
+		if err != nil {
+			return err
+		}
+		if token.UsedAt != nil {
+			return ErrInvalidEmailChangeToken
+		}
+		now := s.clock.Now()
+		if !now.Before(token.ExpiresAt) {
+			return ErrEmailChangeTokenExpired
+		}
+
+		u, err := s.repo.FindByID(txCtx, token.UserID)
+		if err != nil {
+			return err
+		}
+		if u.Email != token.NewEmail {
+			if err := s.ensureEmailNotExists(txCtx, token.NewEmail); err != nil {
+				return err
+			}
+		}
+
+		if err := s.emailChangeTokens.MarkUsed(txCtx, token.ID, now); err != nil {
+			return err
+		}
+
+		u.Email = token.NewEmail
+		u.EmailVerifiedAt = &now
+		u.UpdatedAt = now
+
+		result, err := s.repo.Update(txCtx, u)
+		if err != nil {
+			return err
+		}
+		updated = result
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func emailChangeMail(u *User, token *EmailChangeToken, raw string) verification.Mail {
+	var body strings.Builder
+	fmt.Fprintf(&body, "%s 様\n\n", u.DisplayName)
+	body.WriteString("メールアドレスの確認のため、次の確認コードを ConfirmEmailChange に指定してください。\n\n")
+	fmt.Fprintf(&body, "確認コード: %s\n", raw)
+	fmt.Fprintf(&body, "有効期限: %s\n\n", token.ExpiresAt.UTC().Format(time.RFC3339))
+	body.WriteString("このメールに心当たりがない場合は破棄してください。\n")
+	return verification.Mail{
+		To:      token.NewEmail,
+		Subject: "メールアドレスの確認",
+		Body:    body.String(),
+	}
+}
@@ -12,10 +12,27 @@ const (
 
 // User はユーザーエンティティです。
 type User struct {
-	ID        string
-	Email     string
-	Name      string
+	ID    string
+	Email string
+	// EmailVerifiedAt はメールアドレスの所有を確認した日時です。未確認の場合は nil です。
+	EmailVerifiedAt *time.Time
+	Name            string
+	// FamilyName と GivenName は姓と名です。
+	FamilyName *string
+	GivenName  *string
+	// DisplayName は姓名とロケールから生成する表示名です。姓名が未設定の場合は Name と同じです。
+	DisplayName string
+	// Locale は BCP 47 の言語タグ（ja-JP など）です。
+	Locale *string
+	// TimeZone は IANA タイムゾーン名（Asia/Tokyo など）です。
+	TimeZone *string
+	// Phone は E.164 形式の電話番号です。
+	Phone *string
+	// AvatarURL はアバター画像の URL です。
+	AvatarURL *string
 	Status    Status
+	// Labels はグループ分けに利用する key=value のラベルです。
+	Labels    map[string]string
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -11,6 +11,14 @@ var (
 	ErrInvalidEmail = errors.New("invalid email")
 	// ErrInvalidName は名前が不正な場合に返却されます。
 	ErrInvalidName = errors.New("invalid name")
+	// ErrInvalidLocale はロケールが BCP 47 の言語タグでない場合に返却されます。
+	ErrInvalidLocale = errors.New("invalid locale")
+	// ErrInvalidTimeZone はタイムゾーンが IANA タイムゾーン名でない場合に返却されます。
+	ErrInvalidTimeZone = errors.New("invalid time zone")
+	// ErrInvalidPhone は電話番号が E.164 形式でない場合に返却されます。
+	ErrInvalidPhone = errors.New("invalid phone")
+	// ErrInvalidAvatarURL はアバター画像の URL が不正な場合に返却されます。
+	ErrInvalidAvatarURL = errors.New("invalid avatar url")
 	// ErrInvalidStatus はステータスが不正な場合に返却されます。
 	ErrInvalidStatus = errors.New("invalid status")
 	// ErrInvalidID はIDが不正な場合に返却されます。
@@ -19,4 +27,14 @@ var (
 	ErrInvalidPageSize = errors.New("invalid page size")
 	// ErrInvalidPageToken は一覧取得時のページトークンが不正な場合に返却されます。
 	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrUserHasEmployments は社員レコードが紐づくユーザーを削除しようとした場合に返却されます。
+	ErrUserHasEmployments = errors.New("user has employments")
+	// ErrEmailUnchanged は確認済みの現在のメールアドレスへの変更を要求した場合に返却されます。
+	ErrEmailUnchanged = errors.New("email unchanged")
+	// ErrInvalidEmailChangeToken はメールアドレス変更トークンが存在しないか使用済みの場合に返却されます。
+	ErrInvalidEmailChangeToken = errors.New("invalid email change token")
+	// ErrEmailChangeTokenExpired はメールアドレス変更トークンの有効期限が切れている場合に返却されます。
+	ErrEmailChangeTokenExpired = errors.New("email change token expired")
+	// ErrEmailChangeUnavailable はメールアドレス変更の設定がない場合に返却されます。
+	ErrEmailChangeUnavailable = errors.New("email change is not available")
 )
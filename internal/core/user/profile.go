@@ -0,0 +1,159 @@
+package user
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+const (
+	// maxPersonNameLength は姓・名それぞれの最大文字数です。
+	maxPersonNameLength = 100
+	// maxAvatarURLLength はアバター画像の URL の最大長です。
+	maxAvatarURLLength = 2048
+)
+
+// phonePattern は E.164 形式の電話番号です。
+var phonePattern = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// phoneSeparators は電話番号の入力で許容する区切り文字です。
+var phoneSeparators = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "", ".", "")
+
+// familyNameFirstLanguages は表示名で姓を先に表記する言語です。
+var familyNameFirstLanguages = map[string]bool{
+	"ja": true,
+	"ko": true,
+	"zh": true,
+	"hu": true,
+	"vi": true,
+}
+
+// displayNameFor は姓名とロケールから表示名を生成します。
+// 姓名がどちらも未設定の場合は name を返します。姓を先に表記する言語（ja など）では「姓 名」、それ以外は「名 姓」の順です。
+func displayNameFor(name string, familyName, givenName, locale *string) string {
+	family, given := valueOf(familyName), valueOf(givenName)
+	switch {
+	case family == "" && given == "":
+		return name
+	case family == "":
+		return given
+	case given == "":
+		return family
+	case isFamilyNameFirst(valueOf(locale)):
+		return family + " " + given
+	default:
+		return given + " " + family
+	}
+}
+
+func isFamilyNameFirst(locale string) bool {
+	if locale == "" {
+		return false
+	}
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return false
+	}
+	base, _ := tag.Base()
+	return familyNameFirstLanguages[base.String()]
+}
+
+// normalizePersonName は姓・名を検証します。空文字の場合は nil を返します。
+func normalizePersonName(field string, raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	name := strings.TrimSpace(*raw)
+	if name == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(name) > maxPersonNameLength {
+		return nil, fmt.Errorf("%s: %w", field, ErrInvalidName)
+	}
+	return &name, nil
+}
+
+// normalizeLocale は BCP 47 の言語タグとして検証し、正規化した表記を返します。空文字の場合は nil を返します。
+func normalizeLocale(raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*raw)
+	if value == "" {
+		return nil, nil
+	}
+	tag, err := language.Parse(value)
+	if err != nil || tag == language.Und {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidLocale, value)
+	}
+	locale := tag.String()
+	return &locale, nil
+}
+
+// normalizeTimeZone は IANA タイムゾーン名として検証します。空文字の場合は nil を返します。
+func normalizeTimeZone(raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*raw)
+	if value == "" {
+		return nil, nil
+	}
+	// "Local" はサーバーの設定に依存するため受け付けません。
+	if value == "Local" {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, value)
+	}
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidTimeZone, value)
+	}
+	zone := loc.String()
+	return &zone, nil
+}
+
+// normalizePhone は区切り文字を取り除き、E.164 形式であることを検証します。空文字の場合は nil を返します。
+func normalizePhone(raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*raw)
+	if value == "" {
+		return nil, nil
+	}
+	number := phoneSeparators.Replace(value)
+	if !phonePattern.MatchString(number) {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidPhone, value)
+	}
+	return &number, nil
+}
+
+// normalizeAvatarURL は http(s) の絶対 URL であることを検証します。空文字の場合は nil を返します。
+func normalizeAvatarURL(raw *string) (*string, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*raw)
+	if value == "" {
+		return nil, nil
+	}
+	if len(value) > maxAvatarURLLength {
+		return nil, fmt.Errorf("%w: too long", ErrInvalidAvatarURL)
+	}
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" || u.User != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAvatarURL, value)
+	}
+	return &value, nil
+}
+
+func valueOf(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
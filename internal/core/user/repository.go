@@ -1,6 +1,10 @@
 package user
 
-import "context"
+import (
+	"context"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+)
 
 // Repository はユーザーエンティティの永続化を行うインターフェースです。
 type Repository interface {
@@ -17,4 +21,24 @@ type ListUsersFilter struct {
 	Limit  int
 	Offset int
 	Status *Status
+	// LabelSelector を指定した場合はラベルが一致するユーザーに絞り込みます。
+	LabelSelector label.Selector
+}
+
+// Employment はユーザーに紐づく社員レコードの概要です。
+type Employment struct {
+	EmployeeID   string
+	CompanyID    string
+	EmployeeCode string
+	Status       string
+}
+
+// Employments はユーザーに紐づく社員レコードを扱うポートです。実装は社員ドメインが提供します。
+type Employments interface {
+	// ListByUser は退職済みを含むユーザーの社員レコードを返します。
+	ListByUser(ctx context.Context, userID string) ([]Employment, error)
+	// TerminateByUser は退職していない社員レコードを本日付で退職させます。
+	TerminateByUser(ctx context.Context, userID string) error
+	// DeleteByUser はユーザーの社員レコードを削除します。
+	DeleteByUser(ctx context.Context, userID string) error
 }
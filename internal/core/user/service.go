@@ -8,6 +8,9 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
 )
 
 // Clock は現在時刻を提供します。
@@ -50,9 +53,25 @@ const (
 
 // Service はユーザーに関するユースケースをまとめます。
 type Service struct {
-	repo  Repository
-	clock Clock
-	tx    TransactionManager
+	repo        Repository
+	clock       Clock
+	tx          TransactionManager
+	employments Employments
+
+	emailChangeTokens EmailChangeTokenRepository
+	mailer            verification.Mailer
+	newToken          func() (string, error)
+}
+
+// Option は Service の任意設定です。
+type Option func(*Service)
+
+// WithEmployments はユーザーの削除・無効化時に参照する社員レコードのポートを設定します。
+// 未設定の場合、社員レコードの確認や連動は行いません。
+func WithEmployments(employments Employments) Option {
+	return func(s *Service) {
+		s.employments = employments
+	}
 }
 
 // UseCase はユーザーユースケースの公開インターフェースです。
@@ -62,23 +81,30 @@ type UseCase interface {
 	DeleteUser(ctx context.Context, in DeleteUserInput) error
 	GetUser(ctx context.Context, in GetUserInput) (*User, error)
 	ListUsers(ctx context.Context, in ListUsersInput) (*ListUsersResult, error)
+	RequestEmailChange(ctx context.Context, in RequestEmailChangeInput) (*EmailChangeToken, error)
+	ConfirmEmailChange(ctx context.Context, in ConfirmEmailChangeInput) (*User, error)
 }
 
 // NewService は Service を生成します。
-func NewService(repo Repository, clock Clock, tx TransactionManager) *Service {
+func NewService(repo Repository, clock Clock, tx TransactionManager, opts ...Option) *Service {
 	if clock == nil {
 		clock = realClock{}
 	}
 	if tx == nil {
 		tx = noopTransactionManager{}
 	}
-	return &Service{repo: repo, clock: clock, tx: tx}
+	s := &Service{repo: repo, clock: clock, tx: tx, newToken: verification.NewToken}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 // CreateUserInput はユーザー作成時の入力です。
 type CreateUserInput struct {
-	Email string
-	Name  string
+	Email  string
+	Name   string
+	Labels map[string]string
 }
 
 // UpdateUserInput はユーザー更新時の入力です。
@@ -86,11 +112,30 @@ type UpdateUserInput struct {
 	ID     string
 	Name   *string
 	Status *Status
+	// CascadeEmployments を指定して inactive へ変更すると、在籍中の社員レコードも退職させます。
+	CascadeEmployments bool
+	// Labels は指定したキーのラベルを追加・上書きします。
+	Labels map[string]string
+	// RemoveLabels は指定したキーのラベルを削除します。
+	RemoveLabels []string
+	// 以下のプロフィール項目は nil の場合は変更せず、空文字を指定すると削除します。
+	FamilyName *string
+	GivenName  *string
+	// Locale は BCP 47 の言語タグです。
+	Locale *string
+	// TimeZone は IANA タイムゾーン名です。
+	TimeZone *string
+	// Phone は E.164 形式の電話番号です。区切り文字（空白・ハイフン・括弧・ピリオド）は取り除きます。
+	Phone *string
+	// AvatarURL は http(s) の絶対 URL です。
+	AvatarURL *string
 }
 
 // DeleteUserInput はユーザー削除時の入力です。
 type DeleteUserInput struct {
 	ID string
+	// Force を指定すると、紐づく社員レコードを退職させたうえで削除してからユーザーを削除します。
+	Force bool
 }
 
 // GetUserInput はユーザー取得時の入力です。
@@ -103,6 +148,8 @@ type ListUsersInput struct {
 	PageSize  int
 	PageToken string
 	Status    *Status
+	// LabelSelector は "region=apac,tier!=free" 形式のラベルセレクタです。
+	LabelSelector string
 }
 
 // ListUsersResult は一覧取得結果を表します。
@@ -123,6 +170,10 @@ func (s *Service) CreateUser(ctx context.Context, in CreateUserInput) (*User, er
 		return nil, ErrInvalidName
 	}
 
+	if err := label.Validate(in.Labels); err != nil {
+		return nil, err
+	}
+
 	var created *User
 	if err := s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
 		if err := s.ensureEmailNotExists(txCtx, email); err != nil {
@@ -131,11 +182,13 @@ func (s *Service) CreateUser(ctx context.Context, in CreateUserInput) (*User, er
 
 		now := s.clock.Now()
 		u := &User{
-			Email:     email,
-			Name:      name,
-			Status:    StatusActive,
-			CreatedAt: now,
-			UpdatedAt: now,
+			Email:       email,
+			Name:        name,
+			DisplayName: name,
+			Status:      StatusActive,
+			Labels:      label.Clone(in.Labels),
+			CreatedAt:   now,
+			UpdatedAt:   now,
 		}
 
 		result, err := s.repo.Create(txCtx, u)
@@ -177,9 +230,26 @@ func (s *Service) UpdateUser(ctx context.Context, in UpdateUserInput) (*User, er
 			if !isValidStatus(*in.Status) {
 				return ErrInvalidStatus
 			}
+			if *in.Status == StatusInactive && in.CascadeEmployments && s.employments != nil {
+				if err := s.employments.TerminateByUser(txCtx, existing.ID); err != nil {
+					return err
+				}
+			}
 			existing.Status = *in.Status
 		}
 
+		if len(in.Labels) > 0 || len(in.RemoveLabels) > 0 {
+			labels, err := label.Apply(existing.Labels, in.Labels, in.RemoveLabels)
+			if err != nil {
+				return err
+			}
+			existing.Labels = labels
+		}
+
+		if err := applyProfile(existing, in); err != nil {
+			return err
+		}
+
 		existing.UpdatedAt = s.clock.Now()
 
 		result, err := s.repo.Update(txCtx, existing)
@@ -197,11 +267,17 @@ func (s *Service) UpdateUser(ctx context.Context, in UpdateUserInput) (*User, er
 }
 
 // DeleteUser はユーザーを削除します。
+// 社員レコードが紐づく場合は ErrUserHasEmployments を返し、Force 指定時は社員レコードを退職・削除してから削除します。
 func (s *Service) DeleteUser(ctx context.Context, in DeleteUserInput) error {
 	if strings.TrimSpace(in.ID) == "" {
 		return fmt.Errorf("id: %w", ErrInvalidID)
 	}
 	return s.tx.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if s.employments != nil {
+			if err := s.releaseEmployments(txCtx, in.ID, in.Force); err != nil {
+				return err
+			}
+		}
 		return s.repo.Delete(txCtx, in.ID)
 	})
 }
@@ -246,6 +322,11 @@ func (s *Service) ListUsers(ctx context.Context, in ListUsersInput) (*ListUsersR
 		statusPtr = &status
 	}
 
+	selector, err := label.ParseSelector(in.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+
 	var (
 		users     []*User
 		nextToken string
@@ -253,9 +334,10 @@ func (s *Service) ListUsers(ctx context.Context, in ListUsersInput) (*ListUsersR
 
 	if err := s.tx.WithinReadOnly(ctx, func(txCtx context.Context) error {
 		resultUsers, token, err := s.repo.List(txCtx, ListUsersFilter{
-			Limit:  limit,
-			Offset: offset,
-			Status: statusPtr,
+			Limit:         limit,
+			Offset:        offset,
+			Status:        statusPtr,
+			LabelSelector: selector,
 		})
 		if err != nil {
 			return err
@@ -273,6 +355,60 @@ func (s *Service) ListUsers(ctx context.Context, in ListUsersInput) (*ListUsersR
 	}, nil
 }
 
+// applyProfile は in で指定されたプロフィール項目を検証して u に反映し、表示名を生成し直します。
+func applyProfile(u *User, in UpdateUserInput) error {
+	fields := []struct {
+		value     *string
+		target    **string
+		normalize func(*string) (*string, error)
+	}{
+		{in.FamilyName, &u.FamilyName, func(v *string) (*string, error) { return normalizePersonName("family_name", v) }},
+		{in.GivenName, &u.GivenName, func(v *string) (*string, error) { return normalizePersonName("given_name", v) }},
+		{in.Locale, &u.Locale, normalizeLocale},
+		{in.TimeZone, &u.TimeZone, normalizeTimeZone},
+		{in.Phone, &u.Phone, normalizePhone},
+		{in.AvatarURL, &u.AvatarURL, normalizeAvatarURL},
+	}
+	for _, f := range fields {
+		if f.value == nil {
+			continue
+		}
+		normalized, err := f.normalize(f.value)
+		if err != nil {
+			return err
+		}
+		*f.target = normalized
+	}
+
+	u.DisplayName = displayNameFor(u.Name, u.FamilyName, u.GivenName, u.Locale)
+	return nil
+}
+
+func (s *Service) releaseEmployments(ctx context.Context, userID string, force bool) error {
+	employments, err := s.employments.ListByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if len(employments) == 0 {
+		return nil
+	}
+	if !force {
+		return hasEmploymentsError(employments)
+	}
+	if err := s.employments.TerminateByUser(ctx, userID); err != nil {
+		return err
+	}
+	return s.employments.DeleteByUser(ctx, userID)
+}
+
+func hasEmploymentsError(employments []Employment) error {
+	descriptions := make([]string, 0, len(employments))
+	for _, e := range employments {
+		descriptions = append(descriptions, fmt.Sprintf("%s (company=%s, code=%s, status=%s)", e.EmployeeID, e.CompanyID, e.EmployeeCode, e.Status))
+	}
+	return fmt.Errorf("%w: %s", ErrUserHasEmployments, strings.Join(descriptions, ", "))
+}
+
 func (s *Service) ensureEmailNotExists(ctx context.Context, email string) error {
 	user, err := s.repo.FindByEmail(ctx, email)
 	if err != nil && !errors.Is(err, ErrUserNotFound) {
@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/label"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/verification"
 )
 
 type stubClock struct {
@@ -89,6 +93,9 @@ func (r *fakeRepo) List(_ context.Context, filter ListUsersFilter) ([]*User, str
 		if filter.Status != nil && u.Status != *filter.Status {
 			continue
 		}
+		if !filter.LabelSelector.Matches(u.Labels) {
+			continue
+		}
 		filtered = append(filtered, cloneUser(u))
 	}
 
@@ -116,6 +123,7 @@ func cloneUser(u *User) *User {
 		return nil
 	}
 	copy := *u
+	copy.Labels = label.Clone(u.Labels)
 	return &copy
 }
 
@@ -233,6 +241,107 @@ func TestService_DeleteUser_InvalidID(t *testing.T) {
 	}
 }
 
+type fakeEmployments struct {
+	employments map[string][]Employment
+	terminated  []string
+	deleted     []string
+}
+
+func (f *fakeEmployments) ListByUser(_ context.Context, userID string) ([]Employment, error) {
+	return f.employments[userID], nil
+}
+
+func (f *fakeEmployments) TerminateByUser(_ context.Context, userID string) error {
+	f.terminated = append(f.terminated, userID)
+	return nil
+}
+
+func (f *fakeEmployments) DeleteByUser(_ context.Context, userID string) error {
+	f.deleted = append(f.deleted, userID)
+	delete(f.employments, userID)
+	return nil
+}
+
+func TestService_DeleteUser_Employments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeRepo()
+	employments := &fakeEmployments{employments: make(map[string][]Employment)}
+	svc := NewService(repo, stubClock{now: time.Now()}, nil, WithEmployments(employments))
+
+	created, err := svc.CreateUser(ctx, CreateUserInput{Email: "user@example.com", Name: "User"})
+	if err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+	employments.employments[created.ID] = []Employment{
+		{EmployeeID: "emp-1", CompanyID: "company-1", EmployeeCode: "E001", Status: "active"},
+		{EmployeeID: "emp-2", CompanyID: "company-2", EmployeeCode: "E002", Status: "terminated"},
+	}
+
+	err = svc.DeleteUser(ctx, DeleteUserInput{ID: created.ID})
+	if !errors.Is(err, ErrUserHasEmployments) {
+		t.Fatalf("expected ErrUserHasEmployments, got %v", err)
+	}
+	for _, id := range []string{"emp-1", "emp-2"} {
+		if !strings.Contains(err.Error(), id) {
+			t.Errorf("expected error to list %s, got %v", id, err)
+		}
+	}
+	if len(employments.terminated) != 0 || len(employments.deleted) != 0 {
+		t.Fatalf("expected employments to be untouched without force")
+	}
+	if _, err := repo.FindByID(ctx, created.ID); err != nil {
+		t.Fatalf("expected user to remain, got %v", err)
+	}
+
+	if err := svc.DeleteUser(ctx, DeleteUserInput{ID: created.ID, Force: true}); err != nil {
+		t.Fatalf("DeleteUser with force returned error: %v", err)
+	}
+	if len(employments.terminated) != 1 || len(employments.deleted) != 1 {
+		t.Fatalf("expected employments to be terminated and deleted, got %+v", employments)
+	}
+	if _, err := repo.FindByID(ctx, created.ID); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected user to be deleted, got %v", err)
+	}
+}
+
+func TestService_UpdateUser_CascadeEmployments(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	employments := &fakeEmployments{}
+	svc := NewService(newFakeRepo(), stubClock{now: time.Now()}, nil, WithEmployments(employments))
+
+	created, err := svc.CreateUser(ctx, CreateUserInput{Email: "user@example.com", Name: "User"})
+	if err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+
+	inactive := StatusInactive
+	if _, err := svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, Status: &inactive}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if len(employments.terminated) != 0 {
+		t.Fatalf("expected no cascade without the flag")
+	}
+
+	active := StatusActive
+	if _, err := svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, Status: &active, CascadeEmployments: true}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if len(employments.terminated) != 0 {
+		t.Fatalf("expected no cascade when activating")
+	}
+
+	if _, err := svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, Status: &inactive, CascadeEmployments: true}); err != nil {
+		t.Fatalf("UpdateUser returned error: %v", err)
+	}
+	if len(employments.terminated) != 1 || employments.terminated[0] != created.ID {
+		t.Fatalf("expected employments of %s to be terminated, got %v", created.ID, employments.terminated)
+	}
+}
+
 func TestService_GetUser_Success(t *testing.T) {
 	t.Parallel()
 
@@ -356,3 +465,357 @@ func TestService_ListUsers_FilterByStatus(t *testing.T) {
 		t.Fatalf("expected inactive status, got %s", result.Users[0].Status)
 	}
 }
+
+func TestService_Labels(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeRepo()
+	clk := stubClock{now: time.Now()}
+	svc := NewService(repo, &clk, nil)
+
+	created, err := svc.CreateUser(ctx, CreateUserInput{Email: "apac@example.com", Name: "Apac", Labels: map[string]string{"region": "apac", "tier": "free"}})
+	if err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+	if created.Labels["region"] != "apac" || created.Labels["tier"] != "free" {
+		t.Fatalf("unexpected labels: %+v", created.Labels)
+	}
+	if _, err := svc.CreateUser(ctx, CreateUserInput{Email: "emea@example.com", Name: "Emea", Labels: map[string]string{"region": "emea"}}); err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+
+	if _, err := svc.CreateUser(ctx, CreateUserInput{Email: "bad@example.com", Name: "Bad", Labels: map[string]string{"bad key": "v"}}); !errors.Is(err, label.ErrInvalidLabel) {
+		t.Fatalf("expected ErrInvalidLabel, got %v", err)
+	}
+
+	updated, err := svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, Labels: map[string]string{"tier": "gold"}, RemoveLabels: []string{"region"}})
+	if err != nil {
+		t.Fatalf("UpdateUser error: %v", err)
+	}
+	if len(updated.Labels) != 1 || updated.Labels["tier"] != "gold" {
+		t.Fatalf("unexpected labels after update: %+v", updated.Labels)
+	}
+
+	result, err := svc.ListUsers(ctx, ListUsersInput{LabelSelector: "tier=gold"})
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if len(result.Users) != 1 || result.Users[0].ID != created.ID {
+		t.Fatalf("unexpected users: %+v", result.Users)
+	}
+
+	result, err = svc.ListUsers(ctx, ListUsersInput{LabelSelector: "!region"})
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if len(result.Users) != 1 || result.Users[0].ID != created.ID {
+		t.Fatalf("unexpected users: %+v", result.Users)
+	}
+
+	if _, err := svc.ListUsers(ctx, ListUsersInput{LabelSelector: "region in (apac)"}); !errors.Is(err, label.ErrInvalidSelector) {
+		t.Fatalf("expected ErrInvalidSelector, got %v", err)
+	}
+}
+
+func TestService_UpdateUser_Profile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeRepo()
+	svc := NewService(repo, stubClock{now: time.Now()}, nil)
+
+	created, err := svc.CreateUser(ctx, CreateUserInput{Email: "taro@example.com", Name: "Taro"})
+	if err != nil {
+		t.Fatalf("CreateUser error: %v", err)
+	}
+	if created.DisplayName != "Taro" {
+		t.Fatalf("expected display name to default to name, got %q", created.DisplayName)
+	}
+
+	ptr := func(v string) *string { return &v }
+	updated, err := svc.UpdateUser(ctx, UpdateUserInput{
+		ID:         created.ID,
+		FamilyName: ptr(" Yamada "),
+		GivenName:  ptr("Taro"),
+		Locale:     ptr("en-us"),
+		TimeZone:   ptr("Asia/Tokyo"),
+		Phone:      ptr("+81 90-1234-5678"),
+		AvatarURL:  ptr("https://cdn.example.com/avatars/taro.png"),
+	})
+	if err != nil {
+		t.Fatalf("UpdateUser error: %v", err)
+	}
+	if updated.DisplayName != "Taro Yamada" || *updated.FamilyName != "Yamada" {
+		t.Fatalf("unexpected display name %q", updated.DisplayName)
+	}
+	if *updated.Locale != "en-US" || *updated.TimeZone != "Asia/Tokyo" || *updated.Phone != "+819012345678" {
+		t.Fatalf("unexpected profile: locale=%s zone=%s phone=%s", *updated.Locale, *updated.TimeZone, *updated.Phone)
+	}
+
+	updated, err = svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, FamilyName: ptr("山田"), GivenName: ptr("太郎"), Locale: ptr("ja-JP")})
+	if err != nil {
+		t.Fatalf("UpdateUser error: %v", err)
+	}
+	if updated.DisplayName != "山田 太郎" {
+		t.Fatalf("expected family name first for ja, got %q", updated.DisplayName)
+	}
+
+	updated, err = svc.UpdateUser(ctx, UpdateUserInput{ID: created.ID, FamilyName: ptr(""), GivenName: ptr(""), Phone: ptr(""), Name: ptr("Taro Y.")})
+	if err != nil {
+		t.Fatalf("UpdateUser error: %v", err)
+	}
+	if updated.FamilyName != nil || updated.GivenName != nil || updated.Phone != nil || updated.DisplayName != "Taro Y." {
+		t.Fatalf("expected profile fields to be cleared: %+v", updated)
+	}
+	if updated.Locale == nil || updated.AvatarURL == nil {
+		t.Fatalf("expected untouched fields to be kept: %+v", updated)
+	}
+
+	invalid := []struct {
+		in   UpdateUserInput
+		want error
+	}{
+		{UpdateUserInput{ID: created.ID, Locale: ptr("not a locale")}, ErrInvalidLocale},
+		{UpdateUserInput{ID: created.ID, Locale: ptr("und")}, ErrInvalidLocale},
+		{UpdateUserInput{ID: created.ID, TimeZone: ptr("Mars/Olympus")}, ErrInvalidTimeZone},
+		{UpdateUserInput{ID: created.ID, TimeZone: ptr("Local")}, ErrInvalidTimeZone},
+		{UpdateUserInput{ID: created.ID, Phone: ptr("090-1234-5678")}, ErrInvalidPhone},
+		{UpdateUserInput{ID: created.ID, AvatarURL: ptr("ftp://example.com/a.png")}, ErrInvalidAvatarURL},
+		{UpdateUserInput{ID: created.ID, AvatarURL: ptr("/avatars/a.png")}, ErrInvalidAvatarURL},
+		{UpdateUserInput{ID: created.ID, GivenName: ptr(strings.Repeat("a", 101))}, ErrInvalidName},
+	}
+	for _, tc := range invalid {
+		if _, err := svc.UpdateUser(ctx, tc.in); !errors.Is(err, tc.want) {
+			t.Fatalf("%+v: expected %v, got %v", tc.in, tc.want, err)
+		}
+	}
+}
+
+type fakeEmailChangeTokens struct {
+	tokens map[string]*EmailChangeToken
+	seq    int
+}
+
+func newFakeEmailChangeTokens() *fakeEmailChangeTokens {
+	return &fakeEmailChangeTokens{tokens: make(map[string]*EmailChangeToken)}
+}
+
+func (f *fakeEmailChangeTokens) Create(_ context.Context, token *EmailChangeToken) (*EmailChangeToken, error) {
+	f.seq++
+	created := *token
+	created.ID = "token-" + strconv.Itoa(f.seq)
+	f.tokens[created.ID] = &created
+	result := created
+	return &result, nil
+}
+
+func (f *fakeEmailChangeTokens) FindByHash(_ context.Context, tokenHash string) (*EmailChangeToken, error) {
+	for _, token := range f.tokens {
+		if token.TokenHash == tokenHash {
+			result := *token
+			return &result, nil
+		}
+	}
+	return nil, ErrInvalidEmailChangeToken
+}
+
+func (f *fakeEmailChangeTokens) MarkUsed(_ context.Context, id string, usedAt time.Time) error {
+	token, ok := f.tokens[id]
+	if !ok || token.UsedAt != nil {
+		return ErrInvalidEmailChangeToken
+	}
+	token.UsedAt = &usedAt
+	return nil
+}
+
+func (f *fakeEmailChangeTokens) DeleteUnusedByUser(_ context.Context, userID string) error {
+	for id, token := range f.tokens {
+		if token.UserID == userID && token.UsedAt == nil {
+			delete(f.tokens, id)
+		}
+	}
+	return nil
+}
+
+type fakeMailer struct {
+	sent []verification.Mail
+	err  error
+}
+
+func (f *fakeMailer) Send(_ context.Context, mail verification.Mail) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.sent = append(f.sent, mail)
+	return nil
+}
+
+// recordingTx はトランザクション内かどうかを記録するトランザクションマネージャーです。
+type recordingTx struct {
+	inTx bool
+}
+
+func (r *recordingTx) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
+	return r.WithinReadWrite(ctx, fn)
+}
+
+func (r *recordingTx) WithinReadWrite(ctx context.Context, fn func(context.Context) error) error {
+	r.inTx = true
+	defer func() { r.inTx = false }()
+	return fn(ctx)
+}
+
+// txAwareMailer は送信時にトランザクション内だったかを記録します。
+type txAwareMailer struct {
+	tx       *recordingTx
+	sentInTx []bool
+}
+
+func (m *txAwareMailer) Send(_ context.Context, _ verification.Mail) error {
+	m.sentInTx = append(m.sentInTx, m.tx.inTx)
+	return nil
+}
+
+func TestService_RequestEmailChange_SendsAfterCommit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeRepo()
+	created, err := NewService(repo, nil, nil).CreateUser(ctx, CreateUserInput{Email: "user@example.com", Name: "User"})
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+
+	tx := &recordingTx{}
+	mailer := &txAwareMailer{tx: tx}
+	svc := NewService(repo, nil, tx, WithEmailChange(newFakeEmailChangeTokens(), mailer))
+
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "new@example.com"}); err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	if len(mailer.sentInTx) != 1 || mailer.sentInTx[0] {
+		t.Fatalf("expected one mail sent after the transaction, got %v", mailer.sentInTx)
+	}
+}
+
+func TestService_EmailChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	clock := &stubClock{now: time.Date(2025, 3, 3, 9, 0, 0, 0, time.UTC)}
+	repo := newFakeRepo()
+	tokens := newFakeEmailChangeTokens()
+	mailer := &fakeMailer{}
+	svc := NewService(repo, clock, nil, WithEmailChange(tokens, mailer))
+	seq := 0
+	svc.newToken = func() (string, error) {
+		seq++
+		return "raw-" + strconv.Itoa(seq), nil
+	}
+
+	created, err := svc.CreateUser(ctx, CreateUserInput{Email: "before@example.com", Name: "User"})
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	if created.EmailVerifiedAt != nil {
+		t.Fatalf("expected new user to be unverified: %+v", created)
+	}
+
+	issued, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: " After@Example.com "})
+	if err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	if issued.NewEmail != "after@example.com" || !issued.ExpiresAt.Equal(clock.now.Add(24*time.Hour)) {
+		t.Fatalf("unexpected token: %+v", issued)
+	}
+	if issued.TokenHash == "raw-1" || issued.TokenHash != verification.HashToken("raw-1") {
+		t.Fatalf("expected only the token hash to be stored: %+v", issued)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "after@example.com" || !strings.Contains(mailer.sent[0].Body, "raw-1") {
+		t.Fatalf("unexpected mail: %+v", mailer.sent)
+	}
+
+	// 再要求すると以前のトークンは使えなくなります。
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "after@example.com"}); err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	if _, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: "raw-1"}); !errors.Is(err, ErrInvalidEmailChangeToken) {
+		t.Fatalf("expected superseded token to be rejected, got %v", err)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+	confirmed, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: "raw-2"})
+	if err != nil {
+		t.Fatalf("ConfirmEmailChange returned error: %v", err)
+	}
+	if confirmed.Email != "after@example.com" || confirmed.EmailVerifiedAt == nil || !confirmed.EmailVerifiedAt.Equal(clock.now) {
+		t.Fatalf("unexpected confirmed user: %+v", confirmed)
+	}
+	if _, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: "raw-2"}); !errors.Is(err, ErrInvalidEmailChangeToken) {
+		t.Fatalf("expected used token to be rejected, got %v", err)
+	}
+
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "after@example.com"}); !errors.Is(err, ErrEmailUnchanged) {
+		t.Fatalf("expected ErrEmailUnchanged, got %v", err)
+	}
+
+	// 期限切れのトークンは使えません。
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "expired@example.com"}); err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	clock.now = clock.now.Add(24 * time.Hour)
+	if _, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: "raw-3"}); !errors.Is(err, ErrEmailChangeTokenExpired) {
+		t.Fatalf("expected ErrEmailChangeTokenExpired, got %v", err)
+	}
+
+	// メールアドレスの重複は確認時に検証します。
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "taken@example.com"}); err != nil {
+		t.Fatalf("RequestEmailChange returned error: %v", err)
+	}
+	if _, err := svc.CreateUser(ctx, CreateUserInput{Email: "taken@example.com", Name: "Other"}); err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+	if _, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: "raw-4"}); !errors.Is(err, ErrEmailAlreadyExists) {
+		t.Fatalf("expected ErrEmailAlreadyExists, got %v", err)
+	}
+}
+
+func TestService_RequestEmailChange_Errors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	repo := newFakeRepo()
+	created, err := NewService(repo, nil, nil).CreateUser(ctx, CreateUserInput{Email: "user@example.com", Name: "User"})
+	if err != nil {
+		t.Fatalf("CreateUser returned error: %v", err)
+	}
+
+	if _, err := NewService(repo, nil, nil).RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "new@example.com"}); !errors.Is(err, ErrEmailChangeUnavailable) {
+		t.Fatalf("expected ErrEmailChangeUnavailable, got %v", err)
+	}
+
+	tokens := newFakeEmailChangeTokens()
+	mailer := &fakeMailer{}
+	svc := NewService(repo, nil, nil, WithEmailChange(tokens, mailer))
+
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "invalid"}); !errors.Is(err, ErrInvalidEmail) {
+		t.Fatalf("expected ErrInvalidEmail, got %v", err)
+	}
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: "missing", NewEmail: "new@example.com"}); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if _, err := svc.ConfirmEmailChange(ctx, ConfirmEmailChangeInput{Token: " "}); !errors.Is(err, ErrInvalidEmailChangeToken) {
+		t.Fatalf("expected ErrInvalidEmailChangeToken, got %v", err)
+	}
+
+	// 未確認の現在のメールアドレスは確認のために指定できます。
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "user@example.com"}); err != nil {
+		t.Fatalf("expected verification of the current email to be accepted, got %v", err)
+	}
+
+	mailer.err = errors.New("smtp down")
+	if _, err := svc.RequestEmailChange(ctx, RequestEmailChangeInput{UserID: created.ID, NewEmail: "new@example.com"}); err == nil || !strings.Contains(err.Error(), "smtp down") {
+		t.Fatalf("expected mailer error, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Package verification はメールで送る一回限りの確認トークンの生成とハッシュ化、およびメール送信のポートを提供します。
+package verification
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+const (
+	// TokenTTL は確認トークンの有効期間です。
+	TokenTTL = 24 * time.Hour
+	// tokenBytes はトークンに使う乱数のバイト数です。
+	tokenBytes = 32
+)
+
+// Mail は送信するメールです。
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer はメール送信を行うポートです。
+// 送信の待ち時間でトランザクションとロックを保持しないよう、呼び出し側はコミット後に Send を呼び出します。
+type Mailer interface {
+	Send(ctx context.Context, mail Mail) error
+}
+
+// NewToken は URL に含められる形式のランダムなトークンを生成します。
+func NewToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken は保存用にトークンの SHA-256 ハッシュ値を 16 進数で返します。
+func HashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,38 @@
+package verification
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestNewToken(t *testing.T) {
+	t.Parallel()
+
+	first, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken returned error: %v", err)
+	}
+	second, err := NewToken()
+	if err != nil {
+		t.Fatalf("NewToken returned error: %v", err)
+	}
+	if first == second {
+		t.Fatalf("expected distinct tokens, got %q twice", first)
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(first)
+	if err != nil || len(decoded) != tokenBytes {
+		t.Fatalf("expected %d random bytes in URL-safe base64, got %q (%v)", tokenBytes, first, err)
+	}
+}
+
+func TestHashToken(t *testing.T) {
+	t.Parallel()
+
+	hash := HashToken("raw")
+	if hash != HashToken("raw") {
+		t.Fatalf("expected HashToken to be deterministic")
+	}
+	if hash == "raw" || len(hash) != 64 || hash == HashToken("other") {
+		t.Fatalf("unexpected hash %q", hash)
+	}
+}
@@ -12,8 +12,11 @@ import (
 
 // Config はアプリケーション全体の設定を表現します。
 type Config struct {
-	Server   ServerConfig   `yaml:"server"`
-	Database DatabaseConfig `yaml:"database"`
+	Server    ServerConfig    `yaml:"server"`
+	Database  DatabaseConfig  `yaml:"database"`
+	Scheduler SchedulerConfig `yaml:"scheduler"`
+	Mail      MailConfig      `yaml:"mail"`
+	Auth      AuthConfig      `yaml:"auth"`
 }
 
 // ServerConfig は gRPC サーバーに関する設定です。
@@ -21,8 +24,53 @@ type ServerConfig struct {
 	ListenAddr string `yaml:"listen_addr"`
 }
 
+// SchedulerConfig はプロセス内のジョブスケジューラに関する設定です。
+// スケジュールは cron 形式（UTC）で指定します。
+type SchedulerConfig struct {
+	Enabled                   bool   `yaml:"enabled"`
+	EmployeeStatusTransitions string `yaml:"employee_status_transitions"`
+}
+
+// MailConfig はメール送信に関する設定です。
+// driver が log の場合は送信内容を標準のログへ、file の場合は path のファイルへ追記し、実際には送信しません。
+type MailConfig struct {
+	Driver string     `yaml:"driver"`
+	From   string     `yaml:"from"`
+	Path   string     `yaml:"path"`
+	SMTP   SMTPConfig `yaml:"smtp"`
+}
+
+// SMTPConfig は SMTP サーバーへの接続設定です。username を指定した場合は PLAIN 認証を行います。
+type SMTPConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+}
+
+// AuthConfig はパスワード認証とトークン発行に関する設定です。
+// jwt_secret が空の場合、パスワードの設定のみ行えてログインとトークンの再発行は利用できません。
+type AuthConfig struct {
+	// JWTSecret は HS256 の署名鍵です。32 バイト以上を指定します。
+	JWTSecret    string `yaml:"jwt_secret"`
+	Issuer       string `yaml:"issuer"`
+	PasswordHash string `yaml:"password_hash"`
+
+	AccessTokenTTL     time.Duration `yaml:"-"`
+	RefreshTokenTTL    time.Duration `yaml:"-"`
+	AccessTokenTTLRaw  string        `yaml:"access_token_ttl"`
+	RefreshTokenTTLRaw string        `yaml:"refresh_token_ttl"`
+
+	// MaxFailedAttempts はロックするまでの連続失敗回数です。
+	MaxFailedAttempts  int           `yaml:"max_failed_attempts"`
+	LockoutDuration    time.Duration `yaml:"-"`
+	LockoutDurationRaw string        `yaml:"lockout_duration"`
+}
+
 // DatabaseConfig は PostgreSQL 接続に関する設定です。
 type DatabaseConfig struct {
+	Driver             string        `yaml:"driver"`
+	Path               string        `yaml:"path"`
 	Host               string        `yaml:"host"`
 	Port               int           `yaml:"port"`
 	User               string        `yaml:"user"`
@@ -35,8 +83,76 @@ type DatabaseConfig struct {
 	ConnMaxIdleTime    time.Duration `yaml:"-"`
 	ConnMaxLifetimeRaw string        `yaml:"conn_max_lifetime"`
 	ConnMaxIdleTimeRaw string        `yaml:"conn_max_idle_time"`
+
+	ReadOnlyStatementTimeout     time.Duration `yaml:"-"`
+	ReadWriteStatementTimeout    time.Duration `yaml:"-"`
+	ReadOnlyStatementTimeoutRaw  string        `yaml:"read_only_statement_timeout"`
+	ReadWriteStatementTimeoutRaw string        `yaml:"read_write_statement_timeout"`
+
+	AutoMigrate bool `yaml:"auto_migrate"`
+
+	Replicas                   []ReplicaConfig `yaml:"replicas"`
+	ReadYourWrites             bool            `yaml:"read_your_writes"`
+	ReplicaHealthCheckInterval time.Duration   `yaml:"-"`
+	ReplicaHealthCheckRaw      string          `yaml:"replica_health_check_interval"`
 }
 
+// ReplicaConfig は読み取り専用レプリカへの接続設定です。
+// 未指定の項目はプライマリ (DatabaseConfig) の値を引き継ぎます。
+type ReplicaConfig struct {
+	Host     string `yaml:"host"`
+	Port     int    `yaml:"port"`
+	User     string `yaml:"user"`
+	Password string `yaml:"password"`
+	Name     string `yaml:"name"`
+	SSLMode  string `yaml:"ssl_mode"`
+}
+
+const (
+	// DriverPostgres は PostgreSQL を永続化先として利用します（既定値）。
+	DriverPostgres = "postgres"
+	// DriverMemory はプロセス内メモリを永続化先として利用します。データベース設定は不要です。
+	DriverMemory = "memory"
+	// DriverSQLite は database.path の SQLite ファイルを永続化先として利用します。
+	DriverSQLite = "sqlite"
+)
+
+const (
+	// MailDriverLog は送信内容をログへ出力します（既定値）。
+	MailDriverLog = "log"
+	// MailDriverFile は送信内容を mail.path のファイルへ追記します。
+	MailDriverFile = "file"
+	// MailDriverSMTP は mail.smtp の SMTP サーバーからメールを送信します。
+	MailDriverSMTP = "smtp"
+)
+
+const (
+	// PasswordHashArgon2id は argon2id でパスワードをハッシュ化します（既定値）。
+	PasswordHashArgon2id = "argon2id"
+	// PasswordHashBcrypt は bcrypt でパスワードをハッシュ化します。
+	PasswordHashBcrypt = "bcrypt"
+)
+
+const (
+	// minJWTSecretLength は HS256 の署名鍵の最小バイト数です。
+	minJWTSecretLength = 32
+	// defaultAuthIssuer はトークンの iss クレームの既定値です。
+	defaultAuthIssuer = "codex-grpc-clean-arch"
+	// 以下は未指定時の既定値です。
+	defaultAccessTokenTTL    = 15 * time.Minute
+	defaultRefreshTokenTTL   = 30 * 24 * time.Hour
+	defaultMaxFailedAttempts = 5
+	defaultLockoutDuration   = 15 * time.Minute
+)
+
+// defaultMailFrom はログ・ファイル出力時の既定の差出人です。
+const defaultMailFrom = "no-reply@localhost"
+
+const defaultReplicaHealthCheckInterval = 10 * time.Second
+
+// defaultEmployeeStatusTransitionsSchedule は社員の状態更新ジョブの既定スケジュール（毎日 00:05）です。
+const defaultEmployeeStatusTransitionsSchedule = "5 0 * * *"
+
 // Load は指定されたパスから設定ファイルを読み込みます。
 func Load(path string) (*Config, error) {
 	b, err := os.ReadFile(path)
@@ -66,10 +182,117 @@ func (c *Config) validateAndNormalize() error {
 		return err
 	}
 
+	if c.Scheduler.EmployeeStatusTransitions == "" {
+		c.Scheduler.EmployeeStatusTransitions = defaultEmployeeStatusTransitionsSchedule
+	}
+
+	if err := c.Mail.validateAndNormalize(); err != nil {
+		return err
+	}
+
+	if err := c.Auth.validateAndNormalize(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *MailConfig) validateAndNormalize() error {
+	switch m.Driver {
+	case "":
+		m.Driver = MailDriverLog
+	case MailDriverLog:
+	case MailDriverFile:
+		if m.Path == "" {
+			return fmt.Errorf("config: mail.path must be set for the file driver")
+		}
+	case MailDriverSMTP:
+		if m.From == "" {
+			return fmt.Errorf("config: mail.from must be set for the smtp driver")
+		}
+		if m.SMTP.Host == "" {
+			return fmt.Errorf("config: mail.smtp.host must be set")
+		}
+		if m.SMTP.Port == 0 {
+			return fmt.Errorf("config: mail.smtp.port must be set")
+		}
+		return nil
+	default:
+		return fmt.Errorf("config: mail.driver %q is not supported", m.Driver)
+	}
+
+	if m.From == "" {
+		m.From = defaultMailFrom
+	}
+	return nil
+}
+
+func (a *AuthConfig) validateAndNormalize() error {
+	if a.JWTSecret != "" && len(a.JWTSecret) < minJWTSecretLength {
+		return fmt.Errorf("config: auth.jwt_secret must be at least %d bytes", minJWTSecretLength)
+	}
+	if a.Issuer == "" {
+		a.Issuer = defaultAuthIssuer
+	}
+
+	switch a.PasswordHash {
+	case "":
+		a.PasswordHash = PasswordHashArgon2id
+	case PasswordHashArgon2id, PasswordHashBcrypt:
+	default:
+		return fmt.Errorf("config: auth.password_hash %q is not supported", a.PasswordHash)
+	}
+
+	if a.MaxFailedAttempts < 0 {
+		return fmt.Errorf("config: auth.max_failed_attempts must not be negative")
+	}
+	if a.MaxFailedAttempts == 0 {
+		a.MaxFailedAttempts = defaultMaxFailedAttempts
+	}
+
+	durations := []struct {
+		name     string
+		raw      string
+		fallback time.Duration
+		dest     *time.Duration
+	}{
+		{name: "access_token_ttl", raw: a.AccessTokenTTLRaw, fallback: defaultAccessTokenTTL, dest: &a.AccessTokenTTL},
+		{name: "refresh_token_ttl", raw: a.RefreshTokenTTLRaw, fallback: defaultRefreshTokenTTL, dest: &a.RefreshTokenTTL},
+		{name: "lockout_duration", raw: a.LockoutDurationRaw, fallback: defaultLockoutDuration, dest: &a.LockoutDuration},
+	}
+	for _, d := range durations {
+		value, err := parseDurationAllowEmpty(d.raw)
+		if err != nil {
+			return fmt.Errorf("config: auth.%s: %w", d.name, err)
+		}
+		if value < 0 {
+			return fmt.Errorf("config: auth.%s must not be negative", d.name)
+		}
+		if value == 0 {
+			value = d.fallback
+		}
+		*d.dest = value
+	}
+
 	return nil
 }
 
 func (d *DatabaseConfig) validateAndNormalize() error {
+	switch d.Driver {
+	case "":
+		d.Driver = DriverPostgres
+	case DriverPostgres:
+	case DriverMemory:
+		return nil
+	case DriverSQLite:
+		if d.Path == "" {
+			return fmt.Errorf("config: database.path must be set for the sqlite driver")
+		}
+		return nil
+	default:
+		return fmt.Errorf("config: database.driver %q is not supported", d.Driver)
+	}
+
 	if d.Host == "" {
 		return fmt.Errorf("config: database.host must be set")
 	}
@@ -101,9 +324,63 @@ func (d *DatabaseConfig) validateAndNormalize() error {
 	}
 	d.ConnMaxIdleTime = idleTime
 
+	readOnlyTimeout, err := parseDurationAllowEmpty(d.ReadOnlyStatementTimeoutRaw)
+	if err != nil {
+		return fmt.Errorf("config: database.read_only_statement_timeout: %w", err)
+	}
+	d.ReadOnlyStatementTimeout = readOnlyTimeout
+
+	readWriteTimeout, err := parseDurationAllowEmpty(d.ReadWriteStatementTimeoutRaw)
+	if err != nil {
+		return fmt.Errorf("config: database.read_write_statement_timeout: %w", err)
+	}
+	d.ReadWriteStatementTimeout = readWriteTimeout
+
+	for i, replica := range d.Replicas {
+		if replica.Host == "" {
+			return fmt.Errorf("config: database.replicas[%d].host must be set", i)
+		}
+	}
+
+	interval, err := parseDurationAllowEmpty(d.ReplicaHealthCheckRaw)
+	if err != nil {
+		return fmt.Errorf("config: database.replica_health_check_interval: %w", err)
+	}
+	if interval == 0 {
+		interval = defaultReplicaHealthCheckInterval
+	}
+	d.ReplicaHealthCheckInterval = interval
+
 	return nil
 }
 
+// ReplicaDatabaseConfigs はレプリカごとの接続設定をプライマリの設定で補完して返します。
+func (d DatabaseConfig) ReplicaDatabaseConfigs() []DatabaseConfig {
+	configs := make([]DatabaseConfig, 0, len(d.Replicas))
+	for _, replica := range d.Replicas {
+		cfg := d
+		cfg.Replicas = nil
+		cfg.Host = replica.Host
+		if replica.Port != 0 {
+			cfg.Port = replica.Port
+		}
+		if replica.User != "" {
+			cfg.User = replica.User
+		}
+		if replica.Password != "" {
+			cfg.Password = replica.Password
+		}
+		if replica.Name != "" {
+			cfg.Name = replica.Name
+		}
+		if replica.SSLMode != "" {
+			cfg.SSLMode = replica.SSLMode
+		}
+		configs = append(configs, cfg)
+	}
+	return configs
+}
+
 func parseDurationAllowEmpty(raw string) (time.Duration, error) {
 	if raw == "" {
 		return 0, nil
@@ -26,6 +26,9 @@ database:
   max_idle_conns: 5
   conn_max_lifetime: "15m"
   conn_max_idle_time: "5m"
+  read_only_statement_timeout: "3s"
+  read_write_statement_timeout: "10s"
+  auto_migrate: true
 `)
 
 	if err := os.WriteFile(path, content, 0o600); err != nil {
@@ -41,6 +44,10 @@ database:
 		t.Errorf("unexpected listen addr: %s", cfg.Server.ListenAddr)
 	}
 
+	if cfg.Database.Driver != DriverPostgres {
+		t.Errorf("expected default driver postgres, got %q", cfg.Database.Driver)
+	}
+
 	if cfg.Database.ConnMaxLifetime != 15*time.Minute {
 		t.Errorf("expected ConnMaxLifetime 15m, got %v", cfg.Database.ConnMaxLifetime)
 	}
@@ -48,6 +55,18 @@ database:
 	if cfg.Database.ConnMaxIdleTime != 5*time.Minute {
 		t.Errorf("expected ConnMaxIdleTime 5m, got %v", cfg.Database.ConnMaxIdleTime)
 	}
+
+	if cfg.Database.ReadOnlyStatementTimeout != 3*time.Second {
+		t.Errorf("expected ReadOnlyStatementTimeout 3s, got %v", cfg.Database.ReadOnlyStatementTimeout)
+	}
+
+	if cfg.Database.ReadWriteStatementTimeout != 10*time.Second {
+		t.Errorf("expected ReadWriteStatementTimeout 10s, got %v", cfg.Database.ReadWriteStatementTimeout)
+	}
+
+	if !cfg.Database.AutoMigrate {
+		t.Errorf("expected AutoMigrate to be enabled")
+	}
 }
 
 func TestLoad_MissingField(t *testing.T) {
@@ -84,3 +103,310 @@ func TestDatabaseConfigDSN_EscapesCredentials(t *testing.T) {
 		t.Fatalf("unexpected DSN. want %s got %s", expected, dsn)
 	}
 }
+
+func TestLoad_Replicas(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  host: primary
+  port: 5432
+  user: user
+  password: pass
+  name: app
+  read_your_writes: true
+  replica_health_check_interval: "3s"
+  replicas:
+    - host: replica-1
+    - host: replica-2
+      port: 6432
+      user: reader
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if !cfg.Database.ReadYourWrites {
+		t.Errorf("expected read_your_writes to be enabled")
+	}
+	if cfg.Database.ReplicaHealthCheckInterval != 3*time.Second {
+		t.Errorf("expected health check interval 3s, got %v", cfg.Database.ReplicaHealthCheckInterval)
+	}
+
+	replicas := cfg.Database.ReplicaDatabaseConfigs()
+	if len(replicas) != 2 {
+		t.Fatalf("expected 2 replicas, got %d", len(replicas))
+	}
+	if replicas[0].Host != "replica-1" || replicas[0].Port != 5432 || replicas[0].User != "user" {
+		t.Errorf("expected replica-1 to inherit primary settings, got %+v", replicas[0])
+	}
+	if replicas[1].Port != 6432 || replicas[1].User != "reader" || replicas[1].Password != "pass" {
+		t.Errorf("unexpected replica-2 settings: %+v", replicas[1])
+	}
+}
+
+func TestLoad_ReplicaWithoutHost(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  host: primary
+  port: 5432
+  user: user
+  password: pass
+  name: app
+  replicas:
+    - port: 6432
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error when replica host is missing")
+	}
+}
+
+func TestLoad_MemoryDriver(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  driver: memory
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Database.Driver != DriverMemory {
+		t.Fatalf("expected memory driver, got %q", cfg.Database.Driver)
+	}
+	if cfg.Scheduler.Enabled || cfg.Scheduler.EmployeeStatusTransitions != defaultEmployeeStatusTransitionsSchedule {
+		t.Fatalf("unexpected scheduler defaults: %+v", cfg.Scheduler)
+	}
+	if cfg.Mail.Driver != MailDriverLog || cfg.Mail.From != defaultMailFrom {
+		t.Fatalf("unexpected mail defaults: %+v", cfg.Mail)
+	}
+}
+
+func TestLoad_Scheduler(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  driver: memory
+
+scheduler:
+  enabled: true
+  employee_status_transitions: "@hourly"
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if !cfg.Scheduler.Enabled || cfg.Scheduler.EmployeeStatusTransitions != "@hourly" {
+		t.Fatalf("unexpected scheduler settings: %+v", cfg.Scheduler)
+	}
+}
+
+func TestLoad_UnsupportedDriver(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  driver: mysql
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	if _, err := Load(path); err == nil {
+		t.Fatal("expected error for unsupported driver")
+	}
+}
+
+func TestLoad_SQLiteDriver(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  driver: sqlite
+  path: data/app.db
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Database.Driver != DriverSQLite || cfg.Database.Path != "data/app.db" {
+		t.Fatalf("unexpected sqlite settings: %+v", cfg.Database)
+	}
+
+	missing := filepath.Join(dir, "missing-path.yaml")
+	if err := os.WriteFile(missing, []byte("server:\n  listen_addr: \":50051\"\ndatabase:\n  driver: sqlite\n"), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	if _, err := Load(missing); err == nil {
+		t.Fatal("expected error when sqlite path is missing")
+	}
+}
+
+func TestLoad_Mail(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.yaml")
+	content := []byte(`server:
+  listen_addr: ":50051"
+
+database:
+  driver: memory
+
+mail:
+  driver: smtp
+  from: no-reply@example.com
+  smtp:
+    host: smtp.example.com
+    port: 587
+    username: mailer
+    password: secret
+`)
+
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Mail.Driver != MailDriverSMTP || cfg.Mail.From != "no-reply@example.com" || cfg.Mail.SMTP.Host != "smtp.example.com" || cfg.Mail.SMTP.Port != 587 {
+		t.Fatalf("unexpected mail settings: %+v", cfg.Mail)
+	}
+
+	for name, mail := range map[string]string{
+		"file without path": "mail:\n  driver: file\n",
+		"smtp without from": "mail:\n  driver: smtp\n  smtp:\n    host: smtp.example.com\n    port: 25\n",
+		"smtp without host": "mail:\n  driver: smtp\n  from: no-reply@example.com\n",
+		"unsupported":       "mail:\n  driver: sendgrid\n",
+	} {
+		invalid := filepath.Join(dir, "invalid.yaml")
+		if err := os.WriteFile(invalid, []byte("server:\n  listen_addr: \":50051\"\ndatabase:\n  driver: memory\n"+mail), 0o600); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+		if _, err := Load(invalid); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestLoad_Auth(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	base := "server:\n  listen_addr: \":50051\"\ndatabase:\n  driver: memory\n"
+
+	defaults := filepath.Join(dir, "defaults.yaml")
+	if err := os.WriteFile(defaults, []byte(base), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	cfg, err := Load(defaults)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	want := AuthConfig{
+		Issuer:            defaultAuthIssuer,
+		PasswordHash:      PasswordHashArgon2id,
+		AccessTokenTTL:    15 * time.Minute,
+		RefreshTokenTTL:   720 * time.Hour,
+		MaxFailedAttempts: 5,
+		LockoutDuration:   15 * time.Minute,
+	}
+	if cfg.Auth != want {
+		t.Fatalf("unexpected default auth settings: %+v", cfg.Auth)
+	}
+
+	path := filepath.Join(dir, "config.yaml")
+	content := base + `auth:
+  jwt_secret: 0123456789abcdef0123456789abcdef
+  issuer: example
+  password_hash: bcrypt
+  access_token_ttl: 5m
+  refresh_token_ttl: 24h
+  max_failed_attempts: 3
+  lockout_duration: 1h
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	cfg, err = Load(path)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.Auth.Issuer != "example" || cfg.Auth.PasswordHash != PasswordHashBcrypt || cfg.Auth.AccessTokenTTL != 5*time.Minute ||
+		cfg.Auth.RefreshTokenTTL != 24*time.Hour || cfg.Auth.MaxFailedAttempts != 3 || cfg.Auth.LockoutDuration != time.Hour {
+		t.Fatalf("unexpected auth settings: %+v", cfg.Auth)
+	}
+
+	for name, auth := range map[string]string{
+		"short secret":      "auth:\n  jwt_secret: short\n",
+		"unsupported hash":  "auth:\n  password_hash: md5\n",
+		"negative attempts": "auth:\n  max_failed_attempts: -1\n",
+		"invalid duration":  "auth:\n  access_token_ttl: soon\n",
+		"negative duration": "auth:\n  lockout_duration: -1m\n",
+	} {
+		invalid := filepath.Join(dir, "invalid.yaml")
+		if err := os.WriteFile(invalid, []byte(base+auth), 0o600); err != nil {
+			t.Fatalf("failed to write config file: %v", err)
+		}
+		if _, err := Load(invalid); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"github.com/golang-migrate/migrate/v4"
+	_ "github.com/golang-migrate/migrate/v4/database/postgres"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// lockKey はマイグレーション実行を直列化するためのアドバイザリロックキーです。
+const lockKey int64 = 0x636f646578 // "codex"
+
+// New は fsys 内の dir をソースとする migrate インスタンスを生成します。
+func New(fsys fs.FS, dir, dsn string) (*migrate.Migrate, error) {
+	source, err := iofs.New(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("migration: open source %s: %w", dir, err)
+	}
+
+	m, err := migrate.NewWithSourceInstance("iofs", source, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("migration: create migrate instance: %w", err)
+	}
+	return m, nil
+}
+
+// Up は未適用のマイグレーションをすべて適用します。適用対象が無い場合は nil を返します。
+func Up(fsys fs.FS, dir, dsn string) error {
+	m, err := New(fsys, dir, dsn)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migration: up: %w", err)
+	}
+	return nil
+}
+
+// To は version まで順にマイグレーションを適用します。適用対象が無い場合は nil を返します。
+func To(fsys fs.FS, dir, dsn string, version uint) error {
+	m, err := New(fsys, dir, dsn)
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	if err := m.Migrate(version); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return fmt.Errorf("migration: migrate to %d: %w", version, err)
+	}
+	return nil
+}
+
+// UpWithLock は PostgreSQL のアドバイザリロックを取得したうえで Up を実行します。
+// 複数のサーバーが同時に起動した場合でも、マイグレーションは 1 プロセスずつ順に実行されます。
+func UpWithLock(ctx context.Context, fsys fs.FS, dir, dsn string) error {
+	conn, err := pgx.Connect(ctx, dsn)
+	if err != nil {
+		return fmt.Errorf("migration: connect: %w", err)
+	}
+	defer conn.Close(context.Background())
+
+	return withAdvisoryLock(ctx, conn, lockKey, func() error {
+		return Up(fsys, dir, dsn)
+	})
+}
+
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+func withAdvisoryLock(ctx context.Context, conn execer, key int64, fn func() error) error {
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, key); err != nil {
+		return fmt.Errorf("migration: acquire advisory lock: %w", err)
+	}
+
+	fnErr := fn()
+
+	if _, err := conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, key); err != nil {
+		return errors.Join(fnErr, fmt.Errorf("migration: release advisory lock: %w", err))
+	}
+	return fnErr
+}
@@ -0,0 +1,90 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"regexp"
+	"testing"
+
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+	"github.com/ogurasousui/codex-grpc-clean-arch/assets"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func TestEmbeddedMigrations(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name string
+		fsys fs.FS
+		dir  string
+	}{
+		{name: "migrations", fsys: assets.Migrations, dir: assets.MigrationsDir},
+		{name: "seeds", fsys: assets.Seeds, dir: assets.SeedsDir},
+	} {
+		driver, err := iofs.New(tc.fsys, tc.dir)
+		if err != nil {
+			t.Fatalf("%s: iofs.New returned error: %v", tc.name, err)
+		}
+
+		first, err := driver.First()
+		if err != nil {
+			t.Fatalf("%s: First returned error: %v", tc.name, err)
+		}
+		if first != 1 {
+			t.Fatalf("%s: expected first version 1, got %d", tc.name, first)
+		}
+		_ = driver.Close()
+	}
+}
+
+func TestWithAdvisoryLock_ReleasesAfterRun(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("failed to create mock conn: %v", err)
+	}
+	defer mock.Close(context.Background())
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WithArgs(lockKey).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).WithArgs(lockKey).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+	called := false
+	expectedErr := errors.New("migrate failed")
+	err = withAdvisoryLock(context.Background(), mock, lockKey, func() error {
+		called = true
+		return expectedErr
+	})
+
+	if !called {
+		t.Fatalf("expected function to run while holding the lock")
+	}
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected %v, got %v", expectedErr, err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestWithAdvisoryLock_LockFailure(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("failed to create mock conn: %v", err)
+	}
+	defer mock.Close(context.Background())
+
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_lock($1)")).WithArgs(lockKey).WillReturnError(errors.New("connection reset"))
+
+	err = withAdvisoryLock(context.Background(), mock, lockKey, func() error {
+		t.Fatalf("function must not run without the lock")
+		return nil
+	})
+	if err == nil {
+		t.Fatalf("expected lock error")
+	}
+}
@@ -0,0 +1,105 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// lockConn はアドバイザリロックを保持する専用接続です。
+type lockConn interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+	// Release は接続をプールへ戻します。
+	Release()
+	// Close は接続をプールへ戻さずに閉じます。セッションが終わるため保持していたロックも解放されます。
+	Close()
+}
+
+type pooledLockConn struct {
+	*pgxpool.Conn
+}
+
+func (c pooledLockConn) Close() {
+	_ = c.Hijack().Close(context.Background())
+}
+
+// AdvisoryLockElector は PostgreSQL のセッションレベルのアドバイザリロックでリーダーを選出します。
+// ロックを取得した接続をプールから借りたまま保持し、接続が切れた場合はリーダー権を失ったものとして扱います。
+type AdvisoryLockElector struct {
+	acquire func(ctx context.Context) (lockConn, error)
+	key     int64
+
+	mu   sync.Mutex
+	conn lockConn
+}
+
+// NewAdvisoryLockElector は pool の接続で key のアドバイザリロックを取得する AdvisoryLockElector を生成します。
+func NewAdvisoryLockElector(pool *pgxpool.Pool, key int64) *AdvisoryLockElector {
+	return newAdvisoryLockElector(func(ctx context.Context) (lockConn, error) {
+		conn, err := pool.Acquire(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return pooledLockConn{Conn: conn}, nil
+	}, key)
+}
+
+func newAdvisoryLockElector(acquire func(ctx context.Context) (lockConn, error), key int64) *AdvisoryLockElector {
+	return &AdvisoryLockElector{acquire: acquire, key: key}
+}
+
+// IsLeader はロックを保持していればその接続が生きているかを確認し、保持していなければ取得を試みます。
+func (e *AdvisoryLockElector) IsLeader(ctx context.Context) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn != nil {
+		if _, err := e.conn.Exec(ctx, `SELECT 1`); err == nil {
+			return true, nil
+		}
+		e.conn.Close()
+		e.conn = nil
+	}
+
+	conn, err := e.acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("postgres: acquire connection for advisory lock: %w", err)
+	}
+
+	var locked bool
+	if err := conn.QueryRow(ctx, `SELECT pg_try_advisory_lock($1)`, e.key).Scan(&locked); err != nil {
+		conn.Close()
+		return false, fmt.Errorf("postgres: try advisory lock: %w", err)
+	}
+	if !locked {
+		conn.Release()
+		return false, nil
+	}
+
+	e.conn = conn
+	return true, nil
+}
+
+// Resign はロックを解放し、接続をプールへ戻します。
+func (e *AdvisoryLockElector) Resign(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn == nil {
+		return nil
+	}
+	conn := e.conn
+	e.conn = nil
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock($1)`, e.key); err != nil {
+		conn.Close()
+		return fmt.Errorf("postgres: release advisory lock: %w", err)
+	}
+	conn.Release()
+	return nil
+}
@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+type mockLockConn struct {
+	pgxmock.PgxConnIface
+	released bool
+	closed   bool
+}
+
+func (c *mockLockConn) Release() { c.released = true }
+func (c *mockLockConn) Close()   { c.closed = true }
+
+func newMockLockConn(t *testing.T) *mockLockConn {
+	t.Helper()
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("failed to create mock conn: %v", err)
+	}
+	return &mockLockConn{PgxConnIface: mock}
+}
+
+const testLockKey int64 = 42
+
+func TestAdvisoryLockElector_AcquiresAndKeepsLock(t *testing.T) {
+	t.Parallel()
+
+	conn := newMockLockConn(t)
+	acquired := 0
+	elector := newAdvisoryLockElector(func(context.Context) (lockConn, error) {
+		acquired++
+		return conn, nil
+	}, testLockKey)
+
+	conn.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_lock($1)")).WithArgs(testLockKey).
+		WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	conn.ExpectExec(regexp.QuoteMeta("SELECT 1")).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+	conn.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_unlock($1)")).WithArgs(testLockKey).WillReturnResult(pgxmock.NewResult("SELECT", 1))
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		leader, err := elector.IsLeader(ctx)
+		if err != nil || !leader {
+			t.Fatalf("expected leadership on call %d, got %v, %v", i, leader, err)
+		}
+	}
+	if acquired != 1 {
+		t.Fatalf("expected lock connection to be kept, acquired %d times", acquired)
+	}
+
+	if err := elector.Resign(ctx); err != nil {
+		t.Fatalf("Resign returned error: %v", err)
+	}
+	if !conn.released || conn.closed {
+		t.Fatalf("expected connection to be released to the pool")
+	}
+	if err := conn.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestAdvisoryLockElector_NotLeaderWhenLockHeldElsewhere(t *testing.T) {
+	t.Parallel()
+
+	conn := newMockLockConn(t)
+	elector := newAdvisoryLockElector(func(context.Context) (lockConn, error) { return conn, nil }, testLockKey)
+
+	conn.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_lock($1)")).WithArgs(testLockKey).
+		WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(false))
+
+	leader, err := elector.IsLeader(context.Background())
+	if err != nil || leader {
+		t.Fatalf("expected follower, got %v, %v", leader, err)
+	}
+	if !conn.released {
+		t.Fatalf("expected connection to be released")
+	}
+	if err := elector.Resign(context.Background()); err != nil {
+		t.Fatalf("Resign without leadership returned error: %v", err)
+	}
+	if err := conn.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestAdvisoryLockElector_ReacquiresAfterConnectionLoss(t *testing.T) {
+	t.Parallel()
+
+	lost := newMockLockConn(t)
+	fresh := newMockLockConn(t)
+	conns := []*mockLockConn{lost, fresh}
+	elector := newAdvisoryLockElector(func(context.Context) (lockConn, error) {
+		conn := conns[0]
+		conns = conns[1:]
+		return conn, nil
+	}, testLockKey)
+
+	lost.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_lock($1)")).WithArgs(testLockKey).
+		WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	lost.ExpectExec(regexp.QuoteMeta("SELECT 1")).WillReturnError(errors.New("connection reset"))
+	fresh.ExpectQuery(regexp.QuoteMeta("SELECT pg_try_advisory_lock($1)")).WithArgs(testLockKey).
+		WillReturnRows(pgxmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+
+	ctx := context.Background()
+	if leader, err := elector.IsLeader(ctx); err != nil || !leader {
+		t.Fatalf("expected leadership, got %v, %v", leader, err)
+	}
+	if leader, err := elector.IsLeader(ctx); err != nil || !leader {
+		t.Fatalf("expected leadership after reacquire, got %v, %v", leader, err)
+	}
+	if !lost.closed {
+		t.Fatalf("expected lost connection to be closed")
+	}
+	if err := lost.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+	if err := fresh.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,185 @@
+package postgres
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Pool はプライマリ／レプリカとして利用できる接続プールの抽象です。
+type Pool interface {
+	txStarter
+	Queryer
+	Ping(ctx context.Context) error
+}
+
+// ReplicaSetOptions は ReplicaSet の挙動を調整するオプションです。
+type ReplicaSetOptions struct {
+	// ReadYourWrites が true の場合、同一リクエスト内で書き込みが行われた後の読み取りをプライマリへ送ります。
+	ReadYourWrites bool
+	// HealthCheckInterval はレプリカのヘルスチェック間隔です。0 以下の場合 Run は何もしません。
+	HealthCheckInterval time.Duration
+}
+
+// ReplicaSet はプライマリと読み取り専用レプリカへのルーティングを行います。
+// 読み取り専用トランザクションは正常なレプリカへ送られ、利用可能なレプリカが無い場合はプライマリへフェイルオーバーします。
+// トランザクション外のクエリは常にプライマリで実行します。
+type ReplicaSet struct {
+	primary        Pool
+	replicas       []*replicaNode
+	next           atomic.Uint64
+	readYourWrites bool
+	interval       time.Duration
+}
+
+type replicaNode struct {
+	pool    Pool
+	healthy atomic.Bool
+}
+
+// NewReplicaSet は ReplicaSet を生成します。レプリカは初期状態で正常とみなします。
+func NewReplicaSet(primary Pool, replicas []Pool, opts ReplicaSetOptions) *ReplicaSet {
+	nodes := make([]*replicaNode, 0, len(replicas))
+	for _, replica := range replicas {
+		if replica == nil {
+			continue
+		}
+		node := &replicaNode{pool: replica}
+		node.healthy.Store(true)
+		nodes = append(nodes, node)
+	}
+	return &ReplicaSet{
+		primary:        primary,
+		replicas:       nodes,
+		readYourWrites: opts.ReadYourWrites,
+		interval:       opts.HealthCheckInterval,
+	}
+}
+
+// BeginTx はアクセスモードに応じてプライマリまたはレプリカでトランザクションを開始します。
+func (r *ReplicaSet) BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error) {
+	if txOptions.AccessMode != pgx.ReadOnly || !r.readAllowed(ctx) {
+		return r.primary.BeginTx(ctx, txOptions)
+	}
+
+	node := r.pickReplica()
+	if node == nil {
+		return r.primary.BeginTx(ctx, txOptions)
+	}
+
+	tx, err := node.pool.BeginTx(ctx, txOptions)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, err
+		}
+		node.healthy.Store(false)
+		return r.primary.BeginTx(ctx, txOptions)
+	}
+	return tx, nil
+}
+
+// Query はプライマリでクエリを実行します。
+func (r *ReplicaSet) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	return r.primary.Query(ctx, sql, args...)
+}
+
+// QueryRow はプライマリで単一行クエリを実行します。
+func (r *ReplicaSet) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	return r.primary.QueryRow(ctx, sql, args...)
+}
+
+// Exec は常にプライマリで実行し、成功した場合はリクエスト内の書き込みとして記録します。
+func (r *ReplicaSet) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	tag, err := r.primary.Exec(ctx, sql, args...)
+	if err != nil {
+		return tag, err
+	}
+	markWritten(ctx)
+	return tag, nil
+}
+
+// CheckHealth は全レプリカへ Ping を送り、正常性を更新します。
+func (r *ReplicaSet) CheckHealth(ctx context.Context) {
+	for _, node := range r.replicas {
+		node.healthy.Store(node.pool.Ping(ctx) == nil)
+	}
+}
+
+// Run はコンテキストがキャンセルされるまで定期的にヘルスチェックを行います。
+func (r *ReplicaSet) Run(ctx context.Context) {
+	if len(r.replicas) == 0 || r.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.CheckHealth(ctx)
+		}
+	}
+}
+
+func (r *ReplicaSet) readAllowed(ctx context.Context) bool {
+	if len(r.replicas) == 0 {
+		return false
+	}
+	if r.readYourWrites && hasWritten(ctx) {
+		return false
+	}
+	return true
+}
+
+func (r *ReplicaSet) pickReplica() *replicaNode {
+	count := uint64(len(r.replicas))
+	if count == 0 {
+		return nil
+	}
+	start := r.next.Add(1)
+	for i := uint64(0); i < count; i++ {
+		node := r.replicas[(start+i)%count]
+		if node.healthy.Load() {
+			return node
+		}
+	}
+	return nil
+}
+
+type writeTrackerContextKey struct{}
+
+type writeTracker struct {
+	written atomic.Bool
+}
+
+// ContextWithWriteTracking はリクエスト単位で書き込み有無を記録する領域をコンテキストへ追加します。
+// ReadYourWrites を有効にする場合、リクエストの開始時に呼び出してください。
+func ContextWithWriteTracking(ctx context.Context) context.Context {
+	if _, ok := ctx.Value(writeTrackerContextKey{}).(*writeTracker); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, writeTrackerContextKey{}, &writeTracker{})
+}
+
+func markWritten(ctx context.Context) {
+	if ctx == nil {
+		return
+	}
+	if tracker, ok := ctx.Value(writeTrackerContextKey{}).(*writeTracker); ok {
+		tracker.written.Store(true)
+	}
+}
+
+func hasWritten(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	tracker, ok := ctx.Value(writeTrackerContextKey{}).(*writeTracker)
+	return ok && tracker.written.Load()
+}
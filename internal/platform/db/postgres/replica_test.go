@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func newMockPool(t *testing.T) pgxmock.PgxPoolIface {
+	t.Helper()
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	t.Cleanup(mock.Close)
+	return mock
+}
+
+func assertExpectations(t *testing.T, mocks ...pgxmock.PgxPoolIface) {
+	t.Helper()
+	for _, mock := range mocks {
+		if err := mock.ExpectationsWereMet(); err != nil {
+			t.Fatalf("unmet expectations: %v", err)
+		}
+	}
+}
+
+func TestReplicaSet_ReadOnlyUsesReplica(t *testing.T) {
+	t.Parallel()
+
+	primary := newMockPool(t)
+	replica := newMockPool(t)
+
+	tm := NewTransactionManager(NewReplicaSet(primary, []Pool{replica}, ReplicaSetOptions{}))
+
+	replica.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	replica.ExpectCommit()
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadWrite})
+	primary.ExpectCommit()
+
+	if err := tm.WithinReadOnly(context.Background(), func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("WithinReadOnly returned error: %v", err)
+	}
+	if err := tm.WithinReadWrite(context.Background(), func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("WithinReadWrite returned error: %v", err)
+	}
+
+	assertExpectations(t, primary, replica)
+}
+
+func TestReplicaSet_FailoverToPrimary(t *testing.T) {
+	t.Parallel()
+
+	primary := newMockPool(t)
+	replica := newMockPool(t)
+
+	set := NewReplicaSet(primary, []Pool{replica}, ReplicaSetOptions{})
+	tm := NewTransactionManager(set)
+
+	replica.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly}).WillReturnError(errors.New("connection refused"))
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	primary.ExpectCommit()
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	primary.ExpectCommit()
+
+	for i := 0; i < 2; i++ {
+		if err := tm.WithinReadOnly(context.Background(), func(context.Context) error { return nil }); err != nil {
+			t.Fatalf("WithinReadOnly returned error: %v", err)
+		}
+	}
+
+	assertExpectations(t, primary, replica)
+
+	replica.ExpectPing()
+	set.CheckHealth(context.Background())
+	if set.pickReplica() == nil {
+		t.Fatalf("expected replica to recover after successful health check")
+	}
+}
+
+func TestReplicaSet_CheckHealthMarksUnhealthy(t *testing.T) {
+	t.Parallel()
+
+	primary := newMockPool(t)
+	replica := newMockPool(t)
+
+	set := NewReplicaSet(primary, []Pool{replica}, ReplicaSetOptions{})
+
+	replica.ExpectPing().WillReturnError(errors.New("timeout"))
+	set.CheckHealth(context.Background())
+
+	if set.pickReplica() != nil {
+		t.Fatalf("expected no healthy replica")
+	}
+
+	assertExpectations(t, primary, replica)
+}
+
+func TestReplicaSet_ReadYourWrites(t *testing.T) {
+	t.Parallel()
+
+	primary := newMockPool(t)
+	replica := newMockPool(t)
+
+	tm := NewTransactionManager(NewReplicaSet(primary, []Pool{replica}, ReplicaSetOptions{ReadYourWrites: true}))
+
+	ctx := ContextWithWriteTracking(context.Background())
+
+	replica.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	replica.ExpectCommit()
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadWrite})
+	primary.ExpectCommit()
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	primary.ExpectCommit()
+
+	noop := func(context.Context) error { return nil }
+	if err := tm.WithinReadOnly(ctx, noop); err != nil {
+		t.Fatalf("first read returned error: %v", err)
+	}
+	if err := tm.WithinReadWrite(ctx, noop); err != nil {
+		t.Fatalf("write returned error: %v", err)
+	}
+	if err := tm.WithinReadOnly(ctx, noop); err != nil {
+		t.Fatalf("read after write returned error: %v", err)
+	}
+
+	assertExpectations(t, primary, replica)
+}
+
+func TestReplicaSet_ExecOutsideTransactionMarksWritten(t *testing.T) {
+	t.Parallel()
+
+	primary := newMockPool(t)
+	replica := newMockPool(t)
+
+	set := NewReplicaSet(primary, []Pool{replica}, ReplicaSetOptions{ReadYourWrites: true})
+	tm := NewTransactionManager(set)
+
+	ctx := ContextWithWriteTracking(context.Background())
+
+	primary.ExpectExec("DELETE FROM sessions").WillReturnResult(pgxmock.NewResult("DELETE", 1))
+	primary.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	primary.ExpectCommit()
+
+	if got := QueryerFromContext(ctx, set); got != set {
+		t.Fatalf("expected replica set as queryer outside transaction")
+	}
+	if _, err := set.Exec(ctx, "DELETE FROM sessions"); err != nil {
+		t.Fatalf("Exec returned error: %v", err)
+	}
+	if err := tm.WithinReadOnly(ctx, func(context.Context) error { return nil }); err != nil {
+		t.Fatalf("read after exec returned error: %v", err)
+	}
+
+	assertExpectations(t, primary, replica)
+}
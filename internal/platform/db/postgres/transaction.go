@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
@@ -14,6 +15,9 @@ type transactionContextKey struct{}
 
 var txContextKey = transactionContextKey{}
 
+// queryCanceledCode は statement_timeout 超過やキャンセル時の SQLSTATE です。
+const queryCanceledCode = "57014"
+
 // TransactionManager は pgx を用いたトランザクション制御を提供します。
 type txStarter interface {
 	BeginTx(ctx context.Context, txOptions pgx.TxOptions) (pgx.Tx, error)
@@ -21,18 +25,37 @@ type txStarter interface {
 
 // TransactionManager は pgx を用いたトランザクション制御を提供します。
 type TransactionManager struct {
-	pool txStarter
+	pool                      txStarter
+	readOnlyStatementTimeout  time.Duration
+	readWriteStatementTimeout time.Duration
+}
+
+// TransactionOption は TransactionManager の挙動を調整します。
+type TransactionOption func(*TransactionManager)
+
+// WithStatementTimeouts はトランザクション種別ごとの既定 statement_timeout を設定します。
+// 0 を指定した種別はコンテキストの期限がある場合のみタイムアウトを設定します。
+func WithStatementTimeouts(readOnly, readWrite time.Duration) TransactionOption {
+	return func(m *TransactionManager) {
+		m.readOnlyStatementTimeout = readOnly
+		m.readWriteStatementTimeout = readWrite
+	}
 }
 
 // NewTransactionManager は TransactionManager を生成します。
-func NewTransactionManager(pool txStarter) *TransactionManager {
+func NewTransactionManager(pool txStarter, opts ...TransactionOption) *TransactionManager {
 	if pool == nil {
 		return nil
 	}
-	return &TransactionManager{pool: pool}
+	m := &TransactionManager{pool: pool}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
 // WithinReadOnly は読み取り専用トランザクションを開始し、fn を実行します。
+// pool が ReplicaSet の場合、トランザクションはレプリカで開始されます。
 func (m *TransactionManager) WithinReadOnly(ctx context.Context, fn func(context.Context) error) error {
 	if m == nil {
 		return fn(ctx)
@@ -59,7 +82,7 @@ func (m *TransactionManager) within(ctx context.Context, opts pgx.TxOptions, fn
 
 	tx, err := m.pool.BeginTx(ctx, opts)
 	if err != nil {
-		return fmt.Errorf("postgres: begin tx: %w", err)
+		return translateCancellation(fmt.Errorf("postgres: begin tx: %w", err))
 	}
 
 	committed := false
@@ -69,28 +92,84 @@ func (m *TransactionManager) within(ctx context.Context, opts pgx.TxOptions, fn
 		}
 	}()
 
+	if timeout := m.statementTimeout(ctx, opts.AccessMode); timeout > 0 {
+		if _, err := tx.Exec(ctx, fmt.Sprintf("SET LOCAL statement_timeout = %d", statementTimeoutMillis(timeout))); err != nil {
+			return translateCancellation(fmt.Errorf("postgres: set statement_timeout: %w", err))
+		}
+	}
+
 	txCtx := contextWithTx(ctx, tx)
 
 	if err := fn(txCtx); err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
-			return errors.Join(err, fmt.Errorf("postgres: rollback: %w", rbErr))
+			return translateCancellation(errors.Join(err, fmt.Errorf("postgres: rollback: %w", rbErr)))
 		}
-		return err
+		return translateCancellation(err)
 	}
 
 	if err := tx.Commit(ctx); err != nil {
 		if !errors.Is(err, pgx.ErrTxClosed) {
 			if rbErr := tx.Rollback(ctx); rbErr != nil && !errors.Is(rbErr, pgx.ErrTxClosed) {
-				return errors.Join(fmt.Errorf("postgres: commit: %w", err), fmt.Errorf("postgres: rollback after commit failure: %w", rbErr))
+				return translateCancellation(errors.Join(fmt.Errorf("postgres: commit: %w", err), fmt.Errorf("postgres: rollback after commit failure: %w", rbErr)))
 			}
 		}
-		return fmt.Errorf("postgres: commit: %w", err)
+		return translateCancellation(fmt.Errorf("postgres: commit: %w", err))
 	}
 
 	committed = true
+	if opts.AccessMode == pgx.ReadWrite {
+		markWritten(ctx)
+	}
 	return nil
 }
 
+// statementTimeout はトランザクション種別の既定値とコンテキストの残り時間のうち短い方を返します。
+func (m *TransactionManager) statementTimeout(ctx context.Context, mode pgx.TxAccessMode) time.Duration {
+	timeout := m.readWriteStatementTimeout
+	if mode == pgx.ReadOnly {
+		timeout = m.readOnlyStatementTimeout
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		remaining := time.Until(deadline)
+		if remaining < time.Millisecond {
+			remaining = time.Millisecond
+		}
+		if timeout <= 0 || remaining < timeout {
+			timeout = remaining
+		}
+	}
+
+	return timeout
+}
+
+// statementTimeoutMillis は statement_timeout に設定するミリ秒を返します。
+// PostgreSQL では 0 がタイムアウトなしを意味するため、1ms 未満の端数は切り上げます。
+func statementTimeoutMillis(timeout time.Duration) int64 {
+	ms := timeout.Milliseconds()
+	if timeout > time.Duration(ms)*time.Millisecond {
+		ms++
+	}
+	if ms < 1 {
+		ms = 1
+	}
+	return ms
+}
+
+// translateCancellation は statement_timeout やクエリキャンセルによるエラーを
+// context.DeadlineExceeded / context.Canceled として判定できるようにラップします。
+func translateCancellation(err error) error {
+	if err == nil || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return err
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == queryCanceledCode {
+		return fmt.Errorf("postgres: statement canceled: %w: %w", context.DeadlineExceeded, err)
+	}
+	return err
+}
+
 func contextWithTx(ctx context.Context, tx pgx.Tx) context.Context {
 	return context.WithValue(ctx, txContextKey, tx)
 }
@@ -3,9 +3,12 @@ package postgres
 import (
 	"context"
 	"errors"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	pgxmock "github.com/pashagolub/pgxmock/v4"
 )
 
@@ -101,3 +104,128 @@ func TestTransactionManager_NestedReuse(t *testing.T) {
 		t.Fatalf("unmet expectations: %v", err)
 	}
 }
+
+func TestTransactionManager_StatementTimeoutByKind(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	tm := NewTransactionManager(mock, WithStatementTimeouts(2*time.Second, 5*time.Second))
+
+	mock.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL statement_timeout = 2000")).WillReturnResult(pgxmock.NewResult("SET", 0))
+	mock.ExpectCommit()
+	mock.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadWrite})
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL statement_timeout = 5000")).WillReturnResult(pgxmock.NewResult("SET", 0))
+	mock.ExpectCommit()
+
+	noop := func(context.Context) error { return nil }
+	if err := tm.WithinReadOnly(context.Background(), noop); err != nil {
+		t.Fatalf("WithinReadOnly returned error: %v", err)
+	}
+	if err := tm.WithinReadWrite(context.Background(), noop); err != nil {
+		t.Fatalf("WithinReadWrite returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestTransactionManager_StatementTimeoutHonorsDeadline(t *testing.T) {
+	t.Parallel()
+
+	tm := &TransactionManager{readOnlyStatementTimeout: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+
+	got := tm.statementTimeout(ctx, pgx.ReadOnly)
+	if got <= 0 || got > 3*time.Second {
+		t.Fatalf("expected timeout shortened to deadline, got %v", got)
+	}
+
+	if got := tm.statementTimeout(context.Background(), pgx.ReadWrite); got != 0 {
+		t.Fatalf("expected no timeout for read write without deadline, got %v", got)
+	}
+}
+
+func TestTransactionManager_SubMillisecondStatementTimeout(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	// 0 はタイムアウトなしを意味するため、1ms 未満でも 1 以上に切り上げます。
+	tm := NewTransactionManager(mock, WithStatementTimeouts(500*time.Microsecond, 1500*time.Microsecond))
+
+	mock.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL statement_timeout = 1") + "$").WillReturnResult(pgxmock.NewResult("SET", 0))
+	mock.ExpectCommit()
+	mock.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadWrite})
+	mock.ExpectExec(regexp.QuoteMeta("SET LOCAL statement_timeout = 2") + "$").WillReturnResult(pgxmock.NewResult("SET", 0))
+	mock.ExpectCommit()
+
+	noop := func(context.Context) error { return nil }
+	if err := tm.WithinReadOnly(context.Background(), noop); err != nil {
+		t.Fatalf("WithinReadOnly returned error: %v", err)
+	}
+	if err := tm.WithinReadWrite(context.Background(), noop); err != nil {
+		t.Fatalf("WithinReadWrite returned error: %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
+
+func TestStatementTimeoutMillis(t *testing.T) {
+	t.Parallel()
+
+	cases := map[time.Duration]int64{
+		time.Nanosecond:                    1,
+		999 * time.Microsecond:             1,
+		time.Millisecond:                   1,
+		time.Millisecond + time.Nanosecond: 2,
+		2 * time.Second:                    2000,
+	}
+	for in, want := range cases {
+		if got := statementTimeoutMillis(in); got != want {
+			t.Errorf("statementTimeoutMillis(%v) = %d, want %d", in, got, want)
+		}
+	}
+}
+
+func TestTransactionManager_QueryCanceledMapsToDeadlineExceeded(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewPool()
+	if err != nil {
+		t.Fatalf("failed to create mock pool: %v", err)
+	}
+	defer mock.Close()
+
+	tm := NewTransactionManager(mock)
+
+	mock.ExpectBeginTx(pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	mock.ExpectRollback()
+
+	err = tm.WithinReadOnly(context.Background(), func(context.Context) error {
+		return &pgconn.PgError{Code: queryCanceledCode, Message: "canceling statement due to statement timeout"}
+	})
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,241 @@
+// Package schema は PostgreSQL のスキーマ構造を取得し、差分を比較する機能を提供します。
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ignoredTables は比較対象から除外するテーブルです（golang-migrate の管理テーブル）。
+var ignoredTables = map[string]bool{"schema_migrations": true}
+
+// Snapshot はスキーマ内のテーブル構造のスナップショットです。
+type Snapshot struct {
+	Tables map[string]*Table
+}
+
+// Table はテーブルの列・インデックス・制約を保持します。
+type Table struct {
+	Name        string
+	Columns     map[string]Column
+	Indexes     map[string]string
+	Constraints map[string]string
+}
+
+// Column は列定義です。
+type Column struct {
+	DataType string
+	Nullable bool
+	Default  string
+}
+
+func (c Column) String() string {
+	var b strings.Builder
+	b.WriteString(c.DataType)
+	if !c.Nullable {
+		b.WriteString(" NOT NULL")
+	}
+	if c.Default != "" {
+		b.WriteString(" DEFAULT ")
+		b.WriteString(c.Default)
+	}
+	return b.String()
+}
+
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// Inspect は information_schema / pg_catalog から指定スキーマの構造を取得します。
+// 定義文字列に含まれるスキーマ修飾子は取り除き、異なるスキーマ同士を比較できるようにします。
+func Inspect(ctx context.Context, q queryer, schemaName string) (*Snapshot, error) {
+	snap := &Snapshot{Tables: make(map[string]*Table)}
+
+	table := func(name string) *Table {
+		t, ok := snap.Tables[name]
+		if !ok {
+			t = &Table{
+				Name:        name,
+				Columns:     make(map[string]Column),
+				Indexes:     make(map[string]string),
+				Constraints: make(map[string]string),
+			}
+			snap.Tables[name] = t
+		}
+		return t
+	}
+
+	if err := scanRows(ctx, q, `
+        SELECT table_name
+          FROM information_schema.tables
+         WHERE table_schema = $1 AND table_type = 'BASE TABLE'
+    `, schemaName, func(rows pgx.Rows) error {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		if !ignoredTables[name] {
+			table(name)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("schema: inspect tables: %w", err)
+	}
+
+	if err := scanRows(ctx, q, `
+        SELECT table_name, column_name, data_type, is_nullable, COALESCE(column_default, '')
+          FROM information_schema.columns
+         WHERE table_schema = $1
+    `, schemaName, func(rows pgx.Rows) error {
+		var tableName, columnName, dataType, nullable, def string
+		if err := rows.Scan(&tableName, &columnName, &dataType, &nullable, &def); err != nil {
+			return err
+		}
+		if t, ok := snap.Tables[tableName]; ok {
+			t.Columns[columnName] = Column{
+				DataType: dataType,
+				Nullable: nullable == "YES",
+				Default:  unqualify(def, schemaName),
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("schema: inspect columns: %w", err)
+	}
+
+	if err := scanRows(ctx, q, `
+        SELECT tablename, indexname, indexdef
+          FROM pg_catalog.pg_indexes
+         WHERE schemaname = $1
+    `, schemaName, func(rows pgx.Rows) error {
+		var tableName, indexName, def string
+		if err := rows.Scan(&tableName, &indexName, &def); err != nil {
+			return err
+		}
+		if t, ok := snap.Tables[tableName]; ok {
+			t.Indexes[indexName] = unqualify(def, schemaName)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("schema: inspect indexes: %w", err)
+	}
+
+	if err := scanRows(ctx, q, `
+        SELECT cls.relname, con.conname, pg_catalog.pg_get_constraintdef(con.oid, true)
+          FROM pg_catalog.pg_constraint con
+          JOIN pg_catalog.pg_class cls ON cls.oid = con.conrelid
+          JOIN pg_catalog.pg_namespace ns ON ns.oid = cls.relnamespace
+         WHERE ns.nspname = $1
+    `, schemaName, func(rows pgx.Rows) error {
+		var tableName, constraintName, def string
+		if err := rows.Scan(&tableName, &constraintName, &def); err != nil {
+			return err
+		}
+		if t, ok := snap.Tables[tableName]; ok {
+			t.Constraints[constraintName] = unqualify(def, schemaName)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("schema: inspect constraints: %w", err)
+	}
+
+	return snap, nil
+}
+
+func scanRows(ctx context.Context, q queryer, sql, schemaName string, fn func(pgx.Rows) error) error {
+	rows, err := q.Query(ctx, sql, schemaName)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		if err := fn(rows); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func unqualify(def, schemaName string) string {
+	def = strings.ReplaceAll(def, `"`+schemaName+`".`, "")
+	return strings.ReplaceAll(def, schemaName+".", "")
+}
+
+// Diff は expected（適用済みのマイグレーションから構築したスキーマ）と actual（稼働中のデータベース）の差分を
+// 人が読める形式の行として返します。差分が無い場合は空スライスを返します。
+// 各行の先頭の記号は次のとおりです。
+//
+//	"-" マイグレーションにのみ存在する（データベースに欠けている）要素
+//	"+" データベースにのみ存在する要素
+//	"~" 両方に存在するが定義が異なる要素
+func Diff(expected, actual *Snapshot) []string {
+	var lines []string
+
+	for _, name := range unionKeys(expected.Tables, actual.Tables) {
+		exp, inExpected := expected.Tables[name]
+		act, inActual := actual.Tables[name]
+		switch {
+		case !inActual:
+			lines = append(lines, fmt.Sprintf("- table %s", name))
+			continue
+		case !inExpected:
+			lines = append(lines, fmt.Sprintf("+ table %s", name))
+			continue
+		}
+
+		for _, col := range unionKeys(exp.Columns, act.Columns) {
+			e, inE := exp.Columns[col]
+			a, inA := act.Columns[col]
+			switch {
+			case !inA:
+				lines = append(lines, fmt.Sprintf("- column %s.%s %s", name, col, e))
+			case !inE:
+				lines = append(lines, fmt.Sprintf("+ column %s.%s %s", name, col, a))
+			case e != a:
+				lines = append(lines, fmt.Sprintf("~ column %s.%s: %s -> %s", name, col, e, a))
+			}
+		}
+
+		lines = append(lines, diffDefinitions("index", name, exp.Indexes, act.Indexes)...)
+		lines = append(lines, diffDefinitions("constraint", name, exp.Constraints, act.Constraints)...)
+	}
+
+	return lines
+}
+
+func diffDefinitions(kind, table string, expected, actual map[string]string) []string {
+	var lines []string
+	for _, key := range unionKeys(expected, actual) {
+		e, inE := expected[key]
+		a, inA := actual[key]
+		switch {
+		case !inA:
+			lines = append(lines, fmt.Sprintf("- %s %s.%s: %s", kind, table, key, e))
+		case !inE:
+			lines = append(lines, fmt.Sprintf("+ %s %s.%s: %s", kind, table, key, a))
+		case e != a:
+			lines = append(lines, fmt.Sprintf("~ %s %s.%s: %s -> %s", kind, table, key, e, a))
+		}
+	}
+	return lines
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]bool, len(a)+len(b))
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
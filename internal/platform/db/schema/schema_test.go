@@ -0,0 +1,111 @@
+package schema
+
+import (
+	"context"
+	"reflect"
+	"regexp"
+	"testing"
+
+	pgxmock "github.com/pashagolub/pgxmock/v4"
+)
+
+func newSnapshot(tables ...*Table) *Snapshot {
+	snap := &Snapshot{Tables: make(map[string]*Table)}
+	for _, t := range tables {
+		snap.Tables[t.Name] = t
+	}
+	return snap
+}
+
+func usersTable() *Table {
+	return &Table{
+		Name: "users",
+		Columns: map[string]Column{
+			"id":    {DataType: "uuid", Default: "gen_random_uuid()"},
+			"email": {DataType: "text"},
+		},
+		Indexes: map[string]string{
+			"users_pkey": "CREATE UNIQUE INDEX users_pkey ON users USING btree (id)",
+		},
+		Constraints: map[string]string{
+			"users_pkey": "PRIMARY KEY (id)",
+		},
+	}
+}
+
+func TestDiff_NoDifference(t *testing.T) {
+	t.Parallel()
+
+	if lines := Diff(newSnapshot(usersTable()), newSnapshot(usersTable())); len(lines) != 0 {
+		t.Fatalf("expected no differences, got %v", lines)
+	}
+}
+
+func TestDiff_ReportsDrift(t *testing.T) {
+	t.Parallel()
+
+	actualUsers := usersTable()
+	actualUsers.Columns["email"] = Column{DataType: "text", Nullable: true}
+	actualUsers.Columns["nickname"] = Column{DataType: "text", Nullable: true}
+	actualUsers.Indexes["idx_users_email"] = "CREATE INDEX idx_users_email ON users USING btree (email)"
+
+	expected := newSnapshot(usersTable(), &Table{Name: "companies", Columns: map[string]Column{}})
+	actual := newSnapshot(actualUsers, &Table{Name: "hotfix", Columns: map[string]Column{}})
+
+	got := Diff(expected, actual)
+	want := []string{
+		"- table companies",
+		"+ table hotfix",
+		"~ column users.email: text NOT NULL -> text",
+		"+ column users.nickname text",
+		"+ index users.idx_users_email: CREATE INDEX idx_users_email ON users USING btree (email)",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected diff:\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestInspect_StripsSchemaQualifier(t *testing.T) {
+	t.Parallel()
+
+	mock, err := pgxmock.NewConn()
+	if err != nil {
+		t.Fatalf("failed to create mock conn: %v", err)
+	}
+	defer mock.Close(context.Background())
+
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.tables")).WithArgs("scratch").
+		WillReturnRows(pgxmock.NewRows([]string{"table_name"}).AddRow("employees").AddRow("schema_migrations"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.columns")).WithArgs("scratch").
+		WillReturnRows(pgxmock.NewRows([]string{"table_name", "column_name", "data_type", "is_nullable", "column_default"}).
+			AddRow("employees", "user_id", "uuid", "NO", "").
+			AddRow("schema_migrations", "version", "bigint", "NO", ""))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM pg_catalog.pg_indexes")).WithArgs("scratch").
+		WillReturnRows(pgxmock.NewRows([]string{"tablename", "indexname", "indexdef"}).
+			AddRow("employees", "idx_employees_user_id", "CREATE INDEX idx_employees_user_id ON scratch.employees USING btree (user_id)"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM pg_catalog.pg_constraint")).WithArgs("scratch").
+		WillReturnRows(pgxmock.NewRows([]string{"relname", "conname", "def"}).
+			AddRow("employees", "employees_user_id_fkey", "FOREIGN KEY (user_id) REFERENCES scratch.users(id) ON DELETE RESTRICT"))
+
+	snap, err := Inspect(context.Background(), mock, "scratch")
+	if err != nil {
+		t.Fatalf("Inspect returned error: %v", err)
+	}
+
+	if _, ok := snap.Tables["schema_migrations"]; ok {
+		t.Fatalf("expected schema_migrations to be ignored")
+	}
+	employees := snap.Tables["employees"]
+	if employees == nil {
+		t.Fatalf("expected employees table")
+	}
+	if got := employees.Indexes["idx_employees_user_id"]; got != "CREATE INDEX idx_employees_user_id ON employees USING btree (user_id)" {
+		t.Fatalf("expected unqualified index definition, got %q", got)
+	}
+	if got := employees.Constraints["employees_user_id_fkey"]; got != "FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE RESTRICT" {
+		t.Fatalf("expected unqualified constraint definition, got %q", got)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Fatalf("unmet expectations: %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+// Package sqlite は SQLite (modernc.org/sqlite, cgo 不要) への接続とトランザクション制御を提供します。
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/url"
+
+	// golang-migrate の sqlite ドライバ（modernc.org/sqlite を登録します）。
+	_ "github.com/golang-migrate/migrate/v4/database/sqlite"
+)
+
+// busyTimeoutMillis は書き込みロック待ちの上限です。
+const busyTimeoutMillis = 5000
+
+// DSN は database/sql 用の接続文字列を返します。
+// 接続ごとに外部キー制約と WAL を有効化し、書き込みトランザクションは BEGIN IMMEDIATE で開始します。
+func DSN(path string) string {
+	query := url.Values{}
+	query.Add("_pragma", "foreign_keys(1)")
+	query.Add("_pragma", fmt.Sprintf("busy_timeout(%d)", busyTimeoutMillis))
+	query.Add("_pragma", "journal_mode(WAL)")
+	query.Set("_txlock", "immediate")
+	return "file:" + path + "?" + query.Encode()
+}
+
+// MigrationURL は golang-migrate 用の接続 URL を返します。
+func MigrationURL(path string) string {
+	return "sqlite://" + path
+}
+
+// Open は SQLite データベースを開き疎通確認を行います。
+func Open(ctx context.Context, path string) (*sql.DB, error) {
+	db, err := sql.Open("sqlite", DSN(path))
+	if err != nil {
+		return nil, fmt.Errorf("sqlite: open %s: %w", path, err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("sqlite: ping: %w", err)
+	}
+
+	return db, nil
+}
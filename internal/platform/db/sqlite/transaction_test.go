@@ -0,0 +1,65 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestTransactionManager_CommitAndRollback(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	db, err := Open(ctx, filepath.Join(t.TempDir(), "tx.db"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	if _, err := db.ExecContext(ctx, `CREATE TABLE items (name TEXT NOT NULL)`); err != nil {
+		t.Fatalf("create table: %v", err)
+	}
+
+	tm := NewTransactionManager(db)
+	insert := func(txCtx context.Context, name string) error {
+		_, err := QueryerFromContext(txCtx, db).ExecContext(txCtx, `INSERT INTO items (name) VALUES (?)`, name)
+		return err
+	}
+
+	if err := tm.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		return insert(txCtx, "committed")
+	}); err != nil {
+		t.Fatalf("WithinReadWrite returned error: %v", err)
+	}
+
+	errBoom := errors.New("boom")
+	err = tm.WithinReadWrite(ctx, func(txCtx context.Context) error {
+		if err := insert(txCtx, "rolled-back"); err != nil {
+			return err
+		}
+		return errBoom
+	})
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("expected errBoom, got %v", err)
+	}
+
+	var count int
+	if err := tm.WithinReadOnly(ctx, func(txCtx context.Context) error {
+		return QueryerFromContext(txCtx, db).QueryRowContext(txCtx, `SELECT COUNT(*) FROM items`).Scan(&count)
+	}); err != nil {
+		t.Fatalf("WithinReadOnly returned error: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected only the committed row, got %d rows", count)
+	}
+}
+
+func TestDSN_EnablesForeignKeys(t *testing.T) {
+	t.Parallel()
+
+	want := "file:data/app.db?_pragma=foreign_keys%281%29&_pragma=busy_timeout%285000%29&_pragma=journal_mode%28WAL%29&_txlock=immediate"
+	if got := DSN("data/app.db"); got != want {
+		t.Fatalf("unexpected dsn:\n got: %s\nwant: %s", got, want)
+	}
+}
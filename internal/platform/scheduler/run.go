@@ -0,0 +1,63 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RunStatus はジョブ実行の状態です。
+type RunStatus string
+
+const (
+	RunStatusRunning   RunStatus = "running"
+	RunStatusSucceeded RunStatus = "succeeded"
+	RunStatusFailed    RunStatus = "failed"
+)
+
+var (
+	ErrRunNotFound      = errors.New("scheduler: job run not found")
+	ErrRunAlreadyExists = errors.New("scheduler: job run already recorded for the scheduled time")
+)
+
+// Run はジョブ 1 回分の実行記録です。ScheduledAt はスケジュール上の実行予定時刻（分単位）です。
+type Run struct {
+	ID          string
+	JobName     string
+	Status      RunStatus
+	ScheduledAt time.Time
+	StartedAt   time.Time
+	FinishedAt  *time.Time
+	Error       *string
+}
+
+// RunRepository はジョブ実行記録の永続化を抽象化します。
+type RunRepository interface {
+	// CreateRun は実行開始を記録します。同じジョブ・予定時刻の記録がある場合は ErrRunAlreadyExists を返します。
+	CreateRun(ctx context.Context, run *Run) (*Run, error)
+	// FinishRun は実行結果（状態・終了時刻・エラー）を記録します。
+	FinishRun(ctx context.Context, run *Run) (*Run, error)
+	// ListRuns は jobName の実行記録を予定時刻の降順で最大 limit 件返します。
+	ListRuns(ctx context.Context, jobName string, limit int) ([]*Run, error)
+}
+
+// LeaderElector は複数プロセスのうちジョブを実行するリーダーを決定します。
+type LeaderElector interface {
+	// IsLeader は自プロセスがリーダーかを返します。リーダーでない場合はリーダー権の取得を試みます。
+	IsLeader(ctx context.Context) (bool, error)
+	// Resign は保持しているリーダー権を手放します。
+	Resign(ctx context.Context) error
+}
+
+// StandaloneElector は常にリーダーとして振る舞う LeaderElector です。単一プロセスで動かす場合に利用します。
+type StandaloneElector struct{}
+
+// IsLeader は常に true を返します。
+func (StandaloneElector) IsLeader(context.Context) (bool, error) {
+	return true, nil
+}
+
+// Resign は何もしません。
+func (StandaloneElector) Resign(context.Context) error {
+	return nil
+}
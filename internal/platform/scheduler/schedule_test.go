@@ -0,0 +1,55 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule_Matches(t *testing.T) {
+	t.Parallel()
+
+	// 2025-03-03 は月曜日です。
+	monday := func(hour, minute int) time.Time { return time.Date(2025, 3, 3, hour, minute, 0, 0, time.UTC) }
+
+	tests := []struct {
+		spec  string
+		at    time.Time
+		match bool
+	}{
+		{"* * * * *", monday(13, 7), true},
+		{"5 0 * * *", monday(0, 5), true},
+		{"5 0 * * *", monday(0, 6), false},
+		{"*/15 * * * *", monday(9, 45), true},
+		{"*/15 * * * *", monday(9, 50), false},
+		{"0 9-17/4 * * *", monday(13, 0), true},
+		{"0 9-17/4 * * *", monday(11, 0), false},
+		{"0 0 * * 1-5", monday(0, 0), true},
+		{"0 0 * * 0,6", monday(0, 0), false},
+		{"0 0 * * 7", time.Date(2025, 3, 2, 0, 0, 0, 0, time.UTC), true},
+		{"0 0 1 * 1", monday(0, 0), true},
+		{"0 0 1 * 2", monday(0, 0), false},
+		{"@daily", monday(0, 0), true},
+		{"@hourly", monday(13, 1), false},
+		{"@monthly", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), true},
+	}
+
+	for _, tt := range tests {
+		schedule, err := ParseSchedule(tt.spec)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) returned error: %v", tt.spec, err)
+		}
+		if got := schedule.Matches(tt.at); got != tt.match {
+			t.Errorf("%q at %s: expected %v, got %v", tt.spec, tt.at, tt.match, got)
+		}
+	}
+}
+
+func TestParseSchedule_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "a * * * *", "@often"} {
+		if _, err := ParseSchedule(spec); err == nil {
+			t.Errorf("expected error for %q", spec)
+		}
+	}
+}
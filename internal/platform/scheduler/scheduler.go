@@ -0,0 +1,187 @@
+// Package scheduler はプロセス内で cron 形式のスケジュールに従ってジョブを実行します。
+// 複数のサーバーが起動している場合は LeaderElector が選んだリーダーのみがジョブを実行し、
+// 実行結果は RunRepository に記録します。
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Clock は現在時刻を提供します。
+type Clock interface {
+	Now() time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now().UTC()
+}
+
+// JobFunc はスケジュールに従って実行される処理です。
+type JobFunc func(ctx context.Context) error
+
+type job struct {
+	name     string
+	schedule *Schedule
+	fn       JobFunc
+	running  atomic.Bool
+}
+
+// Scheduler は登録されたジョブを毎分確認し、スケジュールに一致したものを実行します。
+// 同じジョブの前回の実行が終わっていない場合、その回は実行しません。
+type Scheduler struct {
+	elector LeaderElector
+	runs    RunRepository
+	clock   Clock
+
+	mu   sync.Mutex
+	jobs []*job
+	wg   sync.WaitGroup
+}
+
+// New は Scheduler を生成します。
+func New(elector LeaderElector, runs RunRepository, clock Clock) *Scheduler {
+	if elector == nil {
+		elector = StandaloneElector{}
+	}
+	if clock == nil {
+		clock = realClock{}
+	}
+	return &Scheduler{elector: elector, runs: runs, clock: clock}
+}
+
+// Register は name のジョブを spec のスケジュールで登録します。
+func (s *Scheduler) Register(name, spec string, fn JobFunc) error {
+	if name == "" || fn == nil {
+		return fmt.Errorf("scheduler: job name and func are required")
+	}
+
+	schedule, err := ParseSchedule(spec)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, j := range s.jobs {
+		if j.name == name {
+			return fmt.Errorf("scheduler: job %q is already registered", name)
+		}
+	}
+	s.jobs = append(s.jobs, &job{name: name, schedule: schedule, fn: fn})
+	return nil
+}
+
+// Run は ctx がキャンセルされるまで毎分ジョブを確認します。
+// 終了時は実行中のジョブの完了を待ってからリーダー権を手放します。
+func (s *Scheduler) Run(ctx context.Context) error {
+	defer func() {
+		s.wg.Wait()
+		if err := s.elector.Resign(context.Background()); err != nil {
+			log.Printf("scheduler: resign leadership: %v", err)
+		}
+	}()
+
+	for {
+		now := s.clock.Now()
+		next := now.Truncate(time.Minute).Add(time.Minute)
+		timer := time.NewTimer(next.Sub(now))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+			s.tick(ctx, next)
+		}
+	}
+}
+
+// tick は scheduledAt に実行予定のジョブを起動します。
+func (s *Scheduler) tick(ctx context.Context, scheduledAt time.Time) {
+	scheduledAt = scheduledAt.Truncate(time.Minute)
+
+	s.mu.Lock()
+	due := make([]*job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		if j.schedule.Matches(scheduledAt) {
+			due = append(due, j)
+		}
+	}
+	s.mu.Unlock()
+
+	if len(due) == 0 {
+		return
+	}
+
+	leader, err := s.elector.IsLeader(ctx)
+	if err != nil {
+		log.Printf("scheduler: leader election: %v", err)
+		return
+	}
+	if !leader {
+		return
+	}
+
+	for _, j := range due {
+		if !j.running.CompareAndSwap(false, true) {
+			log.Printf("scheduler: job %s skipped: previous run is still in progress", j.name)
+			continue
+		}
+		s.wg.Add(1)
+		go func(j *job) {
+			defer s.wg.Done()
+			defer j.running.Store(false)
+			s.execute(ctx, j, scheduledAt)
+		}(j)
+	}
+}
+
+// execute はジョブを 1 回実行し、開始と結果を記録します。
+// 同じ予定時刻の実行が他のプロセスで記録済みの場合は実行しません。
+func (s *Scheduler) execute(ctx context.Context, j *job, scheduledAt time.Time) {
+	run, err := s.runs.CreateRun(ctx, &Run{
+		JobName:     j.name,
+		Status:      RunStatusRunning,
+		ScheduledAt: scheduledAt,
+		StartedAt:   s.clock.Now(),
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRunAlreadyExists) {
+			log.Printf("scheduler: record start of job %s: %v", j.name, err)
+		}
+		return
+	}
+
+	jobErr := invoke(ctx, j.fn)
+
+	finishedAt := s.clock.Now()
+	run.FinishedAt = &finishedAt
+	run.Status = RunStatusSucceeded
+	if jobErr != nil {
+		message := jobErr.Error()
+		run.Status = RunStatusFailed
+		run.Error = &message
+		log.Printf("scheduler: job %s failed: %v", j.name, jobErr)
+	}
+
+	if _, err := s.runs.FinishRun(context.WithoutCancel(ctx), run); err != nil {
+		log.Printf("scheduler: record result of job %s: %v", j.name, err)
+	}
+}
+
+func invoke(ctx context.Context, fn JobFunc) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("scheduler: job panicked: %v", r)
+		}
+	}()
+	return fn(ctx)
+}
@@ -0,0 +1,186 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+type stubClock struct {
+	now time.Time
+}
+
+func (c *stubClock) Now() time.Time {
+	return c.now
+}
+
+type stubElector struct {
+	leader   bool
+	err      error
+	resigned bool
+}
+
+func (e *stubElector) IsLeader(context.Context) (bool, error) {
+	return e.leader, e.err
+}
+
+func (e *stubElector) Resign(context.Context) error {
+	e.resigned = true
+	return nil
+}
+
+type fakeRunRepo struct {
+	mu   sync.Mutex
+	runs []*Run
+}
+
+func (r *fakeRunRepo) CreateRun(_ context.Context, run *Run) (*Run, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, existing := range r.runs {
+		if existing.JobName == run.JobName && existing.ScheduledAt.Equal(run.ScheduledAt) {
+			return nil, ErrRunAlreadyExists
+		}
+	}
+	created := *run
+	created.ID = strconv.Itoa(len(r.runs) + 1)
+	r.runs = append(r.runs, &created)
+	clone := created
+	return &clone, nil
+}
+
+func (r *fakeRunRepo) FinishRun(_ context.Context, run *Run) (*Run, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, existing := range r.runs {
+		if existing.ID == run.ID {
+			updated := *run
+			r.runs[i] = &updated
+			return run, nil
+		}
+	}
+	return nil, ErrRunNotFound
+}
+
+func (r *fakeRunRepo) ListRuns(_ context.Context, jobName string, _ int) ([]*Run, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*Run
+	for _, run := range r.runs {
+		if run.JobName == jobName {
+			result = append(result, run)
+		}
+	}
+	return result, nil
+}
+
+func TestScheduler_TickRunsDueJobsAndRecordsRuns(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunRepo{}
+	clock := &stubClock{now: time.Date(2025, 3, 3, 0, 5, 1, 0, time.UTC)}
+	s := New(&stubElector{leader: true}, runs, clock)
+
+	var called []string
+	var mu sync.Mutex
+	record := func(name string, err error) JobFunc {
+		return func(context.Context) error {
+			mu.Lock()
+			called = append(called, name)
+			mu.Unlock()
+			return err
+		}
+	}
+	if err := s.Register("daily", "5 0 * * *", record("daily", nil)); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	if err := s.Register("failing", "*/5 * * * *", record("failing", errors.New("boom"))); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	if err := s.Register("hourly", "0 * * * *", record("hourly", nil)); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+	if err := s.Register("daily", "* * * * *", record("daily", nil)); err == nil {
+		t.Fatalf("expected duplicate job name to be rejected")
+	}
+	if err := s.Register("broken", "not a schedule", record("broken", nil)); err == nil {
+		t.Fatalf("expected invalid schedule to be rejected")
+	}
+
+	scheduledAt := time.Date(2025, 3, 3, 0, 5, 0, 0, time.UTC)
+	ctx := context.Background()
+	s.tick(ctx, scheduledAt)
+	s.wg.Wait()
+
+	if len(called) != 2 {
+		t.Fatalf("expected 2 jobs to run, got %v", called)
+	}
+
+	daily, _ := runs.ListRuns(ctx, "daily", 10)
+	if len(daily) != 1 || daily[0].Status != RunStatusSucceeded || !daily[0].ScheduledAt.Equal(scheduledAt) || daily[0].FinishedAt == nil {
+		t.Fatalf("unexpected daily run: %+v", daily)
+	}
+	failing, _ := runs.ListRuns(ctx, "failing", 10)
+	if len(failing) != 1 || failing[0].Status != RunStatusFailed || failing[0].Error == nil || *failing[0].Error != "boom" {
+		t.Fatalf("unexpected failing run: %+v", failing)
+	}
+
+	// 同じ予定時刻の実行は記録済みのため再実行しません。
+	s.tick(ctx, scheduledAt)
+	s.wg.Wait()
+	if len(called) != 2 {
+		t.Fatalf("expected runs for the same scheduled time to be skipped, got %v", called)
+	}
+}
+
+func TestScheduler_TickSkipsWhenNotLeader(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunRepo{}
+	s := New(&stubElector{leader: false}, runs, &stubClock{now: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)})
+	called := false
+	if err := s.Register("job", "* * * * *", func(context.Context) error { called = true; return nil }); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	s.tick(context.Background(), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC))
+	s.wg.Wait()
+	if called || len(runs.runs) != 0 {
+		t.Fatalf("expected follower not to run jobs")
+	}
+}
+
+func TestScheduler_RecoversPanickingJob(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunRepo{}
+	s := New(nil, runs, &stubClock{now: time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)})
+	if err := s.Register("panics", "* * * * *", func(context.Context) error { panic("unexpected") }); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	s.tick(context.Background(), time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC))
+	s.wg.Wait()
+	if len(runs.runs) != 1 || runs.runs[0].Status != RunStatusFailed {
+		t.Fatalf("expected panic to be recorded as failure, got %+v", runs.runs)
+	}
+}
+
+func TestScheduler_RunResignsOnShutdown(t *testing.T) {
+	t.Parallel()
+
+	elector := &stubElector{leader: true}
+	s := New(elector, &fakeRunRepo{}, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.Run(ctx); err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if !elector.resigned {
+		t.Fatalf("expected leadership to be resigned on shutdown")
+	}
+}
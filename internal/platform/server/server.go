@@ -6,12 +6,16 @@ import (
 	"fmt"
 	"net"
 
+	authpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/auth/v1"
 	companypb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/company/v1"
+	departmentpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/department/v1"
 	employeepb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/employee/v1"
 	greeterpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/greeter/v1"
 	userpb "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/gen/user/v1"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/grpc/handler"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/auth"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/company"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/department"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/employee"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/hello"
 	"github.com/ogurasousui/codex-grpc-clean-arch/internal/core/user"
@@ -26,7 +30,7 @@ type Server struct {
 }
 
 // New は指定されたアドレスで待ち受ける gRPC サーバーを構築します。
-func New(listenAddr string, greeter hello.Greeter, userSvc user.UseCase, companySvc company.UseCase, employeeSvc employee.UseCase, opts ...grpc.ServerOption) *Server {
+func New(listenAddr string, greeter hello.Greeter, userSvc user.UseCase, companySvc company.UseCase, employeeSvc employee.UseCase, departmentSvc department.UseCase, authSvc auth.UseCase, opts ...grpc.ServerOption) *Server {
 	srv := grpc.NewServer(opts...)
 	greeterHandler := handler.NewGreeterHandler(greeter)
 	greeterpb.RegisterGreeterServiceServer(srv, greeterHandler)
@@ -36,6 +40,10 @@ func New(listenAddr string, greeter hello.Greeter, userSvc user.UseCase, company
 	companypb.RegisterCompanyServiceServer(srv, companyHandler)
 	employeeHandler := handler.NewEmployeeGrpcHandler(employeeSvc)
 	employeepb.RegisterEmployeeServiceServer(srv, employeeHandler)
+	departmentHandler := handler.NewDepartmentGrpcHandler(departmentSvc)
+	departmentpb.RegisterDepartmentServiceServer(srv, departmentHandler)
+	authHandler := handler.NewAuthGrpcHandler(authSvc)
+	authpb.RegisterAuthServiceServer(srv, authHandler)
 	reflection.Register(srv)
 
 	return &Server{
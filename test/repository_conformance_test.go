@@ -0,0 +1,64 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	repo "github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/postgres"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/adapters/repository/repositorytest"
+	"github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/config"
+	pg "github.com/ogurasousui/codex-grpc-clean-arch/internal/platform/db/postgres"
+)
+
+// TestPostgresRepositoryConformance はテーブルを TRUNCATE するため t.Parallel を呼ばず、
+// 並列実行される他の統合テストより先に単独で実行します。
+func TestPostgresRepositoryConformance(t *testing.T) {
+	cfg, err := config.Load(resolvePath(configPathFromEnv()))
+	if err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if err := resetMigrations(cfg.Database.DSN(), resolvePath(migrationsDir)); err != nil {
+		t.Fatalf("failed to migrate database: %v", err)
+	}
+
+	ctx := context.Background()
+	pool, err := pg.NewPool(ctx, cfg.Database)
+	if err != nil {
+		t.Fatalf("failed to create pool: %v", err)
+	}
+	t.Cleanup(func() { pool.Close() })
+
+	factory := func(t *testing.T) repositorytest.Repositories {
+		t.Helper()
+		truncateTables(t, pool)
+		return repositorytest.Repositories{
+			Users:               repo.NewUserRepository(pool),
+			Companies:           repo.NewCompanyRepository(pool),
+			Employees:           repo.NewEmployeeRepository(pool),
+			Departments:         repo.NewDepartmentRepository(pool),
+			JobRuns:             repo.NewJobRunRepository(pool),
+			EmailChangeTokens:   repo.NewEmailChangeTokenRepository(pool),
+			Credentials:         repo.NewCredentialRepository(pool),
+			PasswordSetupTokens: repo.NewPasswordSetupTokenRepository(pool),
+		}
+	}
+
+	t.Run("Users", func(t *testing.T) { repositorytest.RunUserRepositorySuite(t, factory) })
+	t.Run("Companies", func(t *testing.T) { repositorytest.RunCompanyRepositorySuite(t, factory) })
+	t.Run("Employees", func(t *testing.T) { repositorytest.RunEmployeeRepositorySuite(t, factory) })
+	t.Run("Departments", func(t *testing.T) { repositorytest.RunDepartmentRepositorySuite(t, factory) })
+	t.Run("JobRuns", func(t *testing.T) { repositorytest.RunJobRunRepositorySuite(t, factory) })
+	t.Run("EmailChangeTokens", func(t *testing.T) { repositorytest.RunEmailChangeTokenRepositorySuite(t, factory) })
+	t.Run("Credentials", func(t *testing.T) { repositorytest.RunCredentialRepositorySuite(t, factory) })
+	t.Run("PasswordSetupTokens", func(t *testing.T) { repositorytest.RunPasswordSetupTokenRepositorySuite(t, factory) })
+}
+
+func truncateTables(t *testing.T, pool *pgxpool.Pool) {
+	t.Helper()
+	if _, err := pool.Exec(context.Background(), `TRUNCATE job_runs, password_setup_tokens, user_credentials, email_change_tokens, employee_history, employees, departments, companies, users CASCADE`); err != nil {
+		t.Fatalf("failed to truncate tables: %v", err)
+	}
+}